@@ -0,0 +1,316 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.2
+// - protoc             v3.14.0
+// source: notifications/inbox.proto
+
+package notifications
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	InboxService_GetUserNotifications_FullMethodName         = "/inbox.InboxService/GetUserNotifications"
+	InboxService_CountUserUnreadNotifications_FullMethodName = "/inbox.InboxService/CountUserUnreadNotifications"
+	InboxService_MarkNotificationAsRead_FullMethodName       = "/inbox.InboxService/MarkNotificationAsRead"
+	InboxService_MarkAllNotificationsAsRead_FullMethodName   = "/inbox.InboxService/MarkAllNotificationsAsRead"
+	InboxService_DeleteNotification_FullMethodName           = "/inbox.InboxService/DeleteNotification"
+	InboxService_StreamUserNotifications_FullMethodName      = "/inbox.InboxService/StreamUserNotifications"
+)
+
+// InboxServiceClient is the client API for InboxService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type InboxServiceClient interface {
+	GetUserNotifications(ctx context.Context, in *GetUserNotificationsIn, opts ...grpc.CallOption) (*GetUserNotificationsOut, error)
+	CountUserUnreadNotifications(ctx context.Context, in *CountUserUnreadNotificationsIn, opts ...grpc.CallOption) (*CountOut, error)
+	MarkNotificationAsRead(ctx context.Context, in *MarkNotificationAsReadIn, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	MarkAllNotificationsAsRead(ctx context.Context, in *MarkAllNotificationsAsReadIn, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteNotification(ctx context.Context, in *DeleteNotificationIn, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	StreamUserNotifications(ctx context.Context, in *StreamUserNotificationsIn, opts ...grpc.CallOption) (grpc.ServerStreamingClient[NotificationEvent], error)
+}
+
+type inboxServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewInboxServiceClient(cc grpc.ClientConnInterface) InboxServiceClient {
+	return &inboxServiceClient{cc}
+}
+
+func (c *inboxServiceClient) GetUserNotifications(ctx context.Context, in *GetUserNotificationsIn, opts ...grpc.CallOption) (*GetUserNotificationsOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserNotificationsOut)
+	err := c.cc.Invoke(ctx, InboxService_GetUserNotifications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inboxServiceClient) CountUserUnreadNotifications(ctx context.Context, in *CountUserUnreadNotificationsIn, opts ...grpc.CallOption) (*CountOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CountOut)
+	err := c.cc.Invoke(ctx, InboxService_CountUserUnreadNotifications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inboxServiceClient) MarkNotificationAsRead(ctx context.Context, in *MarkNotificationAsReadIn, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, InboxService_MarkNotificationAsRead_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inboxServiceClient) MarkAllNotificationsAsRead(ctx context.Context, in *MarkAllNotificationsAsReadIn, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, InboxService_MarkAllNotificationsAsRead_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inboxServiceClient) DeleteNotification(ctx context.Context, in *DeleteNotificationIn, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, InboxService_DeleteNotification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inboxServiceClient) StreamUserNotifications(ctx context.Context, in *StreamUserNotificationsIn, opts ...grpc.CallOption) (grpc.ServerStreamingClient[NotificationEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &InboxService_ServiceDesc.Streams[0], InboxService_StreamUserNotifications_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamUserNotificationsIn, NotificationEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type InboxService_StreamUserNotificationsClient = grpc.ServerStreamingClient[NotificationEvent]
+
+// InboxServiceServer is the server API for InboxService service.
+// All implementations must embed UnimplementedInboxServiceServer
+// for forward compatibility.
+type InboxServiceServer interface {
+	GetUserNotifications(context.Context, *GetUserNotificationsIn) (*GetUserNotificationsOut, error)
+	CountUserUnreadNotifications(context.Context, *CountUserUnreadNotificationsIn) (*CountOut, error)
+	MarkNotificationAsRead(context.Context, *MarkNotificationAsReadIn) (*emptypb.Empty, error)
+	MarkAllNotificationsAsRead(context.Context, *MarkAllNotificationsAsReadIn) (*emptypb.Empty, error)
+	DeleteNotification(context.Context, *DeleteNotificationIn) (*emptypb.Empty, error)
+	StreamUserNotifications(*StreamUserNotificationsIn, grpc.ServerStreamingServer[NotificationEvent]) error
+	mustEmbedUnimplementedInboxServiceServer()
+}
+
+// UnimplementedInboxServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedInboxServiceServer struct{}
+
+func (UnimplementedInboxServiceServer) GetUserNotifications(context.Context, *GetUserNotificationsIn) (*GetUserNotificationsOut, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUserNotifications not implemented")
+}
+func (UnimplementedInboxServiceServer) CountUserUnreadNotifications(context.Context, *CountUserUnreadNotificationsIn) (*CountOut, error) {
+	return nil, status.Error(codes.Unimplemented, "method CountUserUnreadNotifications not implemented")
+}
+func (UnimplementedInboxServiceServer) MarkNotificationAsRead(context.Context, *MarkNotificationAsReadIn) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method MarkNotificationAsRead not implemented")
+}
+func (UnimplementedInboxServiceServer) MarkAllNotificationsAsRead(context.Context, *MarkAllNotificationsAsReadIn) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method MarkAllNotificationsAsRead not implemented")
+}
+func (UnimplementedInboxServiceServer) DeleteNotification(context.Context, *DeleteNotificationIn) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteNotification not implemented")
+}
+func (UnimplementedInboxServiceServer) StreamUserNotifications(*StreamUserNotificationsIn, grpc.ServerStreamingServer[NotificationEvent]) error {
+	return status.Error(codes.Unimplemented, "method StreamUserNotifications not implemented")
+}
+func (UnimplementedInboxServiceServer) mustEmbedUnimplementedInboxServiceServer() {}
+func (UnimplementedInboxServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeInboxServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to InboxServiceServer will
+// result in compilation errors.
+type UnsafeInboxServiceServer interface {
+	mustEmbedUnimplementedInboxServiceServer()
+}
+
+func RegisterInboxServiceServer(s grpc.ServiceRegistrar, srv InboxServiceServer) {
+	// If the following call panics, it indicates UnimplementedInboxServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&InboxService_ServiceDesc, srv)
+}
+
+func _InboxService_GetUserNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserNotificationsIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InboxServiceServer).GetUserNotifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InboxService_GetUserNotifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InboxServiceServer).GetUserNotifications(ctx, req.(*GetUserNotificationsIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InboxService_CountUserUnreadNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountUserUnreadNotificationsIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InboxServiceServer).CountUserUnreadNotifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InboxService_CountUserUnreadNotifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InboxServiceServer).CountUserUnreadNotifications(ctx, req.(*CountUserUnreadNotificationsIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InboxService_MarkNotificationAsRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkNotificationAsReadIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InboxServiceServer).MarkNotificationAsRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InboxService_MarkNotificationAsRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InboxServiceServer).MarkNotificationAsRead(ctx, req.(*MarkNotificationAsReadIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InboxService_MarkAllNotificationsAsRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkAllNotificationsAsReadIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InboxServiceServer).MarkAllNotificationsAsRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InboxService_MarkAllNotificationsAsRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InboxServiceServer).MarkAllNotificationsAsRead(ctx, req.(*MarkAllNotificationsAsReadIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InboxService_DeleteNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteNotificationIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InboxServiceServer).DeleteNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InboxService_DeleteNotification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InboxServiceServer).DeleteNotification(ctx, req.(*DeleteNotificationIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InboxService_StreamUserNotifications_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamUserNotificationsIn)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(InboxServiceServer).StreamUserNotifications(m, &grpc.GenericServerStream[StreamUserNotificationsIn, NotificationEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type InboxService_StreamUserNotificationsServer = grpc.ServerStreamingServer[NotificationEvent]
+
+// InboxService_ServiceDesc is the grpc.ServiceDesc for InboxService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var InboxService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "inbox.InboxService",
+	HandlerType: (*InboxServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetUserNotifications",
+			Handler:    _InboxService_GetUserNotifications_Handler,
+		},
+		{
+			MethodName: "CountUserUnreadNotifications",
+			Handler:    _InboxService_CountUserUnreadNotifications_Handler,
+		},
+		{
+			MethodName: "MarkNotificationAsRead",
+			Handler:    _InboxService_MarkNotificationAsRead_Handler,
+		},
+		{
+			MethodName: "MarkAllNotificationsAsRead",
+			Handler:    _InboxService_MarkAllNotificationsAsRead_Handler,
+		},
+		{
+			MethodName: "DeleteNotification",
+			Handler:    _InboxService_DeleteNotification_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamUserNotifications",
+			Handler:       _InboxService_StreamUserNotifications_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "notifications/inbox.proto",
+}
@@ -0,0 +1,327 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.3
+// 	protoc        v3.14.0
+// source: notifications/previews.proto
+
+package notifications
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PreviewNotificationIn struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// One of "verify_email", "forget_password", "ticket_updated" and "ticket_deleted":
+	NotificationType string `protobuf:"bytes,1,opt,name=notificationType,proto3" json:"notificationType,omitempty"`
+	// Recipient of verify_email and forget_password notifications:
+	UserID uint64 `protobuf:"varint,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	// Ticket of ticket_updated notification:
+	TicketID uint64 `protobuf:"varint,3,opt,name=ticketID,proto3" json:"ticketID,omitempty"`
+	// Data of deleted ticket of ticket_deleted notification:
+	TicketOwnerID       uint64   `protobuf:"varint,4,opt,name=ticketOwnerID,proto3" json:"ticketOwnerID,omitempty"`
+	Name                string   `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	Description         string   `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	Price               *float32 `protobuf:"fixed32,7,opt,name=price,proto3,oneof" json:"price,omitempty"`
+	Quantity            uint32   `protobuf:"varint,8,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	RespondedMastersIDs []uint64 `protobuf:"varint,9,rep,packed,name=respondedMastersIDs,proto3" json:"respondedMastersIDs,omitempty"`
+	// Owner of master is recipient of ticket notifications. The first responded master is used by default:
+	MasterID *uint64 `protobuf:"varint,10,opt,name=masterID,proto3,oneof" json:"masterID,omitempty"`
+	// Locale of verify_email and forget_password notifications. Default locale is used, if not provided:
+	Locale        *string `protobuf:"bytes,11,opt,name=locale,proto3,oneof" json:"locale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewNotificationIn) Reset() {
+	*x = PreviewNotificationIn{}
+	mi := &file_notifications_previews_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewNotificationIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewNotificationIn) ProtoMessage() {}
+
+func (x *PreviewNotificationIn) ProtoReflect() protoreflect.Message {
+	mi := &file_notifications_previews_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewNotificationIn.ProtoReflect.Descriptor instead.
+func (*PreviewNotificationIn) Descriptor() ([]byte, []int) {
+	return file_notifications_previews_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PreviewNotificationIn) GetNotificationType() string {
+	if x != nil {
+		return x.NotificationType
+	}
+	return ""
+}
+
+func (x *PreviewNotificationIn) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *PreviewNotificationIn) GetTicketID() uint64 {
+	if x != nil {
+		return x.TicketID
+	}
+	return 0
+}
+
+func (x *PreviewNotificationIn) GetTicketOwnerID() uint64 {
+	if x != nil {
+		return x.TicketOwnerID
+	}
+	return 0
+}
+
+func (x *PreviewNotificationIn) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PreviewNotificationIn) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PreviewNotificationIn) GetPrice() float32 {
+	if x != nil && x.Price != nil {
+		return *x.Price
+	}
+	return 0
+}
+
+func (x *PreviewNotificationIn) GetQuantity() uint32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *PreviewNotificationIn) GetRespondedMastersIDs() []uint64 {
+	if x != nil {
+		return x.RespondedMastersIDs
+	}
+	return nil
+}
+
+func (x *PreviewNotificationIn) GetMasterID() uint64 {
+	if x != nil && x.MasterID != nil {
+		return *x.MasterID
+	}
+	return 0
+}
+
+func (x *PreviewNotificationIn) GetLocale() string {
+	if x != nil && x.Locale != nil {
+		return *x.Locale
+	}
+	return ""
+}
+
+type PreviewNotificationOut struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	RecipientID uint64                 `protobuf:"varint,1,opt,name=recipientID,proto3" json:"recipientID,omitempty"`
+	Subject     string                 `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	Html        string                 `protobuf:"bytes,3,opt,name=html,proto3" json:"html,omitempty"`
+	// Plain text alternative of email:
+	Text          string `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewNotificationOut) Reset() {
+	*x = PreviewNotificationOut{}
+	mi := &file_notifications_previews_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewNotificationOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewNotificationOut) ProtoMessage() {}
+
+func (x *PreviewNotificationOut) ProtoReflect() protoreflect.Message {
+	mi := &file_notifications_previews_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewNotificationOut.ProtoReflect.Descriptor instead.
+func (*PreviewNotificationOut) Descriptor() ([]byte, []int) {
+	return file_notifications_previews_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PreviewNotificationOut) GetRecipientID() uint64 {
+	if x != nil {
+		return x.RecipientID
+	}
+	return 0
+}
+
+func (x *PreviewNotificationOut) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *PreviewNotificationOut) GetHtml() string {
+	if x != nil {
+		return x.Html
+	}
+	return ""
+}
+
+func (x *PreviewNotificationOut) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+var File_notifications_previews_proto protoreflect.FileDescriptor
+
+var file_notifications_previews_proto_rawDesc = []byte{
+	0x0a, 0x1c, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f,
+	0x70, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08,
+	0x70, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x22, 0x9c, 0x03, 0x0a, 0x15, 0x50, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x6e, 0x12, 0x2a, 0x0a, 0x10, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x4f, 0x77, 0x6e, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x74, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19,
+	0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x48, 0x00, 0x52,
+	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x71, 0x75, 0x61,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x30, 0x0a, 0x13, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64,
+	0x65, 0x64, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x49, 0x44, 0x73, 0x18, 0x09, 0x20, 0x03,
+	0x28, 0x04, 0x52, 0x13, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x4d, 0x61, 0x73,
+	0x74, 0x65, 0x72, 0x73, 0x49, 0x44, 0x73, 0x12, 0x1f, 0x0a, 0x08, 0x6d, 0x61, 0x73, 0x74, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x48, 0x01, 0x52, 0x08, 0x6d, 0x61, 0x73,
+	0x74, 0x65, 0x72, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x65, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x42,
+	0x0b, 0x0a, 0x09, 0x5f, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x49, 0x44, 0x42, 0x09, 0x0a, 0x07,
+	0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x22, 0x7c, 0x0a, 0x16, 0x50, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75,
+	0x74, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e,
+	0x74, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x68, 0x74, 0x6d, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x74, 0x6d,
+	0x6c, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x65, 0x78, 0x74, 0x32, 0x6d, 0x0a, 0x0f, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5a, 0x0a, 0x13, 0x50, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1f, 0x2e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e,
+	0x1a, 0x20, 0x2e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x2e, 0x50, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f,
+	0x75, 0x74, 0x22, 0x00, 0x42, 0x4a, 0x5a, 0x48, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x44, 0x4b, 0x68, 0x6f, 0x72, 0x6b, 0x6f, 0x76, 0x2f, 0x68, 0x6d, 0x74, 0x6d,
+	0x2d, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x3b, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_notifications_previews_proto_rawDescOnce sync.Once
+	file_notifications_previews_proto_rawDescData = file_notifications_previews_proto_rawDesc
+)
+
+func file_notifications_previews_proto_rawDescGZIP() []byte {
+	file_notifications_previews_proto_rawDescOnce.Do(func() {
+		file_notifications_previews_proto_rawDescData = protoimpl.X.CompressGZIP(file_notifications_previews_proto_rawDescData)
+	})
+	return file_notifications_previews_proto_rawDescData
+}
+
+var file_notifications_previews_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_notifications_previews_proto_goTypes = []any{
+	(*PreviewNotificationIn)(nil),  // 0: previews.PreviewNotificationIn
+	(*PreviewNotificationOut)(nil), // 1: previews.PreviewNotificationOut
+}
+var file_notifications_previews_proto_depIdxs = []int32{
+	0, // 0: previews.PreviewsService.PreviewNotification:input_type -> previews.PreviewNotificationIn
+	1, // 1: previews.PreviewsService.PreviewNotification:output_type -> previews.PreviewNotificationOut
+	1, // [1:2] is the sub-list for method output_type
+	0, // [0:1] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_notifications_previews_proto_init() }
+func file_notifications_previews_proto_init() {
+	if File_notifications_previews_proto != nil {
+		return
+	}
+	file_notifications_previews_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_notifications_previews_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_notifications_previews_proto_goTypes,
+		DependencyIndexes: file_notifications_previews_proto_depIdxs,
+		MessageInfos:      file_notifications_previews_proto_msgTypes,
+	}.Build()
+	File_notifications_previews_proto = out.File
+	file_notifications_previews_proto_rawDesc = nil
+	file_notifications_previews_proto_goTypes = nil
+	file_notifications_previews_proto_depIdxs = nil
+}
@@ -0,0 +1,123 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.2
+// - protoc             v3.14.0
+// source: notifications/previews.proto
+
+package notifications
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	PreviewsService_PreviewNotification_FullMethodName = "/previews.PreviewsService/PreviewNotification"
+)
+
+// PreviewsServiceClient is the client API for PreviewsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PreviewsServiceClient interface {
+	// Renders email of notification with real users and tickets data, but nothing is sent or saved:
+	PreviewNotification(ctx context.Context, in *PreviewNotificationIn, opts ...grpc.CallOption) (*PreviewNotificationOut, error)
+}
+
+type previewsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPreviewsServiceClient(cc grpc.ClientConnInterface) PreviewsServiceClient {
+	return &previewsServiceClient{cc}
+}
+
+func (c *previewsServiceClient) PreviewNotification(ctx context.Context, in *PreviewNotificationIn, opts ...grpc.CallOption) (*PreviewNotificationOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreviewNotificationOut)
+	err := c.cc.Invoke(ctx, PreviewsService_PreviewNotification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PreviewsServiceServer is the server API for PreviewsService service.
+// All implementations must embed UnimplementedPreviewsServiceServer
+// for forward compatibility.
+type PreviewsServiceServer interface {
+	// Renders email of notification with real users and tickets data, but nothing is sent or saved:
+	PreviewNotification(context.Context, *PreviewNotificationIn) (*PreviewNotificationOut, error)
+	mustEmbedUnimplementedPreviewsServiceServer()
+}
+
+// UnimplementedPreviewsServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPreviewsServiceServer struct{}
+
+func (UnimplementedPreviewsServiceServer) PreviewNotification(context.Context, *PreviewNotificationIn) (*PreviewNotificationOut, error) {
+	return nil, status.Error(codes.Unimplemented, "method PreviewNotification not implemented")
+}
+func (UnimplementedPreviewsServiceServer) mustEmbedUnimplementedPreviewsServiceServer() {}
+func (UnimplementedPreviewsServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafePreviewsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PreviewsServiceServer will
+// result in compilation errors.
+type UnsafePreviewsServiceServer interface {
+	mustEmbedUnimplementedPreviewsServiceServer()
+}
+
+func RegisterPreviewsServiceServer(s grpc.ServiceRegistrar, srv PreviewsServiceServer) {
+	// If the following call panics, it indicates UnimplementedPreviewsServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PreviewsService_ServiceDesc, srv)
+}
+
+func _PreviewsService_PreviewNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewNotificationIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PreviewsServiceServer).PreviewNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PreviewsService_PreviewNotification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PreviewsServiceServer).PreviewNotification(ctx, req.(*PreviewNotificationIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PreviewsService_ServiceDesc is the grpc.ServiceDesc for PreviewsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PreviewsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "previews.PreviewsService",
+	HandlerType: (*PreviewsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PreviewNotification",
+			Handler:    _PreviewsService_PreviewNotification_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "notifications/previews.proto",
+}
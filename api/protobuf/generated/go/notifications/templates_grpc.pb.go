@@ -0,0 +1,273 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.2
+// - protoc             v3.14.0
+// source: notifications/templates.proto
+
+package notifications
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	TemplatesService_CreateTemplate_FullMethodName  = "/templates.TemplatesService/CreateTemplate"
+	TemplatesService_UpdateTemplate_FullMethodName  = "/templates.TemplatesService/UpdateTemplate"
+	TemplatesService_PublishTemplate_FullMethodName = "/templates.TemplatesService/PublishTemplate"
+	TemplatesService_GetTemplates_FullMethodName    = "/templates.TemplatesService/GetTemplates"
+	TemplatesService_DiffTemplates_FullMethodName   = "/templates.TemplatesService/DiffTemplates"
+)
+
+// TemplatesServiceClient is the client API for TemplatesService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TemplatesServiceClient interface {
+	CreateTemplate(ctx context.Context, in *CreateTemplateIn, opts ...grpc.CallOption) (*TemplateOut, error)
+	UpdateTemplate(ctx context.Context, in *UpdateTemplateIn, opts ...grpc.CallOption) (*TemplateOut, error)
+	PublishTemplate(ctx context.Context, in *PublishTemplateIn, opts ...grpc.CallOption) (*TemplateOut, error)
+	GetTemplates(ctx context.Context, in *GetTemplatesIn, opts ...grpc.CallOption) (*GetTemplatesOut, error)
+	DiffTemplates(ctx context.Context, in *DiffTemplatesIn, opts ...grpc.CallOption) (*DiffTemplatesOut, error)
+}
+
+type templatesServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTemplatesServiceClient(cc grpc.ClientConnInterface) TemplatesServiceClient {
+	return &templatesServiceClient{cc}
+}
+
+func (c *templatesServiceClient) CreateTemplate(ctx context.Context, in *CreateTemplateIn, opts ...grpc.CallOption) (*TemplateOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TemplateOut)
+	err := c.cc.Invoke(ctx, TemplatesService_CreateTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *templatesServiceClient) UpdateTemplate(ctx context.Context, in *UpdateTemplateIn, opts ...grpc.CallOption) (*TemplateOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TemplateOut)
+	err := c.cc.Invoke(ctx, TemplatesService_UpdateTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *templatesServiceClient) PublishTemplate(ctx context.Context, in *PublishTemplateIn, opts ...grpc.CallOption) (*TemplateOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TemplateOut)
+	err := c.cc.Invoke(ctx, TemplatesService_PublishTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *templatesServiceClient) GetTemplates(ctx context.Context, in *GetTemplatesIn, opts ...grpc.CallOption) (*GetTemplatesOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTemplatesOut)
+	err := c.cc.Invoke(ctx, TemplatesService_GetTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *templatesServiceClient) DiffTemplates(ctx context.Context, in *DiffTemplatesIn, opts ...grpc.CallOption) (*DiffTemplatesOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiffTemplatesOut)
+	err := c.cc.Invoke(ctx, TemplatesService_DiffTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TemplatesServiceServer is the server API for TemplatesService service.
+// All implementations must embed UnimplementedTemplatesServiceServer
+// for forward compatibility.
+type TemplatesServiceServer interface {
+	CreateTemplate(context.Context, *CreateTemplateIn) (*TemplateOut, error)
+	UpdateTemplate(context.Context, *UpdateTemplateIn) (*TemplateOut, error)
+	PublishTemplate(context.Context, *PublishTemplateIn) (*TemplateOut, error)
+	GetTemplates(context.Context, *GetTemplatesIn) (*GetTemplatesOut, error)
+	DiffTemplates(context.Context, *DiffTemplatesIn) (*DiffTemplatesOut, error)
+	mustEmbedUnimplementedTemplatesServiceServer()
+}
+
+// UnimplementedTemplatesServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTemplatesServiceServer struct{}
+
+func (UnimplementedTemplatesServiceServer) CreateTemplate(context.Context, *CreateTemplateIn) (*TemplateOut, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateTemplate not implemented")
+}
+func (UnimplementedTemplatesServiceServer) UpdateTemplate(context.Context, *UpdateTemplateIn) (*TemplateOut, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateTemplate not implemented")
+}
+func (UnimplementedTemplatesServiceServer) PublishTemplate(context.Context, *PublishTemplateIn) (*TemplateOut, error) {
+	return nil, status.Error(codes.Unimplemented, "method PublishTemplate not implemented")
+}
+func (UnimplementedTemplatesServiceServer) GetTemplates(context.Context, *GetTemplatesIn) (*GetTemplatesOut, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTemplates not implemented")
+}
+func (UnimplementedTemplatesServiceServer) DiffTemplates(context.Context, *DiffTemplatesIn) (*DiffTemplatesOut, error) {
+	return nil, status.Error(codes.Unimplemented, "method DiffTemplates not implemented")
+}
+func (UnimplementedTemplatesServiceServer) mustEmbedUnimplementedTemplatesServiceServer() {}
+func (UnimplementedTemplatesServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeTemplatesServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TemplatesServiceServer will
+// result in compilation errors.
+type UnsafeTemplatesServiceServer interface {
+	mustEmbedUnimplementedTemplatesServiceServer()
+}
+
+func RegisterTemplatesServiceServer(s grpc.ServiceRegistrar, srv TemplatesServiceServer) {
+	// If the following call panics, it indicates UnimplementedTemplatesServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TemplatesService_ServiceDesc, srv)
+}
+
+func _TemplatesService_CreateTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTemplateIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TemplatesServiceServer).CreateTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TemplatesService_CreateTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TemplatesServiceServer).CreateTemplate(ctx, req.(*CreateTemplateIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TemplatesService_UpdateTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTemplateIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TemplatesServiceServer).UpdateTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TemplatesService_UpdateTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TemplatesServiceServer).UpdateTemplate(ctx, req.(*UpdateTemplateIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TemplatesService_PublishTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishTemplateIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TemplatesServiceServer).PublishTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TemplatesService_PublishTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TemplatesServiceServer).PublishTemplate(ctx, req.(*PublishTemplateIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TemplatesService_GetTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTemplatesIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TemplatesServiceServer).GetTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TemplatesService_GetTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TemplatesServiceServer).GetTemplates(ctx, req.(*GetTemplatesIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TemplatesService_DiffTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffTemplatesIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TemplatesServiceServer).DiffTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TemplatesService_DiffTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TemplatesServiceServer).DiffTemplates(ctx, req.(*DiffTemplatesIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TemplatesService_ServiceDesc is the grpc.ServiceDesc for TemplatesService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TemplatesService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "templates.TemplatesService",
+	HandlerType: (*TemplatesServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateTemplate",
+			Handler:    _TemplatesService_CreateTemplate_Handler,
+		},
+		{
+			MethodName: "UpdateTemplate",
+			Handler:    _TemplatesService_UpdateTemplate_Handler,
+		},
+		{
+			MethodName: "PublishTemplate",
+			Handler:    _TemplatesService_PublishTemplate_Handler,
+		},
+		{
+			MethodName: "GetTemplates",
+			Handler:    _TemplatesService_GetTemplates_Handler,
+		},
+		{
+			MethodName: "DiffTemplates",
+			Handler:    _TemplatesService_DiffTemplates_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "notifications/templates.proto",
+}
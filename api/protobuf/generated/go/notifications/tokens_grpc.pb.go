@@ -0,0 +1,163 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.2
+// - protoc             v3.14.0
+// source: notifications/tokens.proto
+
+package notifications
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	TokensService_VerifyToken_FullMethodName  = "/tokens.TokensService/VerifyToken"
+	TokensService_ConsumeToken_FullMethodName = "/tokens.TokensService/ConsumeToken"
+)
+
+// TokensServiceClient is the client API for TokensService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TokensServiceClient interface {
+	// VerifyToken checks token from verify-email or forget-password link without invalidating it:
+	VerifyToken(ctx context.Context, in *VerifyTokenIn, opts ...grpc.CallOption) (*Token, error)
+	// ConsumeToken checks token and invalidates it, so that the same link could not be used again:
+	ConsumeToken(ctx context.Context, in *ConsumeTokenIn, opts ...grpc.CallOption) (*Token, error)
+}
+
+type tokensServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTokensServiceClient(cc grpc.ClientConnInterface) TokensServiceClient {
+	return &tokensServiceClient{cc}
+}
+
+func (c *tokensServiceClient) VerifyToken(ctx context.Context, in *VerifyTokenIn, opts ...grpc.CallOption) (*Token, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Token)
+	err := c.cc.Invoke(ctx, TokensService_VerifyToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tokensServiceClient) ConsumeToken(ctx context.Context, in *ConsumeTokenIn, opts ...grpc.CallOption) (*Token, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Token)
+	err := c.cc.Invoke(ctx, TokensService_ConsumeToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TokensServiceServer is the server API for TokensService service.
+// All implementations must embed UnimplementedTokensServiceServer
+// for forward compatibility.
+type TokensServiceServer interface {
+	// VerifyToken checks token from verify-email or forget-password link without invalidating it:
+	VerifyToken(context.Context, *VerifyTokenIn) (*Token, error)
+	// ConsumeToken checks token and invalidates it, so that the same link could not be used again:
+	ConsumeToken(context.Context, *ConsumeTokenIn) (*Token, error)
+	mustEmbedUnimplementedTokensServiceServer()
+}
+
+// UnimplementedTokensServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTokensServiceServer struct{}
+
+func (UnimplementedTokensServiceServer) VerifyToken(context.Context, *VerifyTokenIn) (*Token, error) {
+	return nil, status.Error(codes.Unimplemented, "method VerifyToken not implemented")
+}
+func (UnimplementedTokensServiceServer) ConsumeToken(context.Context, *ConsumeTokenIn) (*Token, error) {
+	return nil, status.Error(codes.Unimplemented, "method ConsumeToken not implemented")
+}
+func (UnimplementedTokensServiceServer) mustEmbedUnimplementedTokensServiceServer() {}
+func (UnimplementedTokensServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeTokensServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TokensServiceServer will
+// result in compilation errors.
+type UnsafeTokensServiceServer interface {
+	mustEmbedUnimplementedTokensServiceServer()
+}
+
+func RegisterTokensServiceServer(s grpc.ServiceRegistrar, srv TokensServiceServer) {
+	// If the following call panics, it indicates UnimplementedTokensServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TokensService_ServiceDesc, srv)
+}
+
+func _TokensService_VerifyToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyTokenIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TokensServiceServer).VerifyToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TokensService_VerifyToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TokensServiceServer).VerifyToken(ctx, req.(*VerifyTokenIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TokensService_ConsumeToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConsumeTokenIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TokensServiceServer).ConsumeToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TokensService_ConsumeToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TokensServiceServer).ConsumeToken(ctx, req.(*ConsumeTokenIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TokensService_ServiceDesc is the grpc.ServiceDesc for TokensService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TokensService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "tokens.TokensService",
+	HandlerType: (*TokensServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "VerifyToken",
+			Handler:    _TokensService_VerifyToken_Handler,
+		},
+		{
+			MethodName: "ConsumeToken",
+			Handler:    _TokensService_ConsumeToken_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "notifications/tokens.proto",
+}
@@ -0,0 +1,274 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.2
+// - protoc             v3.14.0
+// source: notifications/webhooks.proto
+
+package notifications
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	WebhooksService_CreateWebhook_FullMethodName        = "/webhooks.WebhooksService/CreateWebhook"
+	WebhooksService_GetWebhooks_FullMethodName          = "/webhooks.WebhooksService/GetWebhooks"
+	WebhooksService_UpdateWebhook_FullMethodName        = "/webhooks.WebhooksService/UpdateWebhook"
+	WebhooksService_DeleteWebhook_FullMethodName        = "/webhooks.WebhooksService/DeleteWebhook"
+	WebhooksService_GetWebhookDeliveries_FullMethodName = "/webhooks.WebhooksService/GetWebhookDeliveries"
+)
+
+// WebhooksServiceClient is the client API for WebhooksService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type WebhooksServiceClient interface {
+	CreateWebhook(ctx context.Context, in *CreateWebhookIn, opts ...grpc.CallOption) (*CreateWebhookOut, error)
+	GetWebhooks(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetWebhooksOut, error)
+	UpdateWebhook(ctx context.Context, in *UpdateWebhookIn, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteWebhook(ctx context.Context, in *DeleteWebhookIn, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetWebhookDeliveries(ctx context.Context, in *GetWebhookDeliveriesIn, opts ...grpc.CallOption) (*GetWebhookDeliveriesOut, error)
+}
+
+type webhooksServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWebhooksServiceClient(cc grpc.ClientConnInterface) WebhooksServiceClient {
+	return &webhooksServiceClient{cc}
+}
+
+func (c *webhooksServiceClient) CreateWebhook(ctx context.Context, in *CreateWebhookIn, opts ...grpc.CallOption) (*CreateWebhookOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateWebhookOut)
+	err := c.cc.Invoke(ctx, WebhooksService_CreateWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhooksServiceClient) GetWebhooks(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetWebhooksOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWebhooksOut)
+	err := c.cc.Invoke(ctx, WebhooksService_GetWebhooks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhooksServiceClient) UpdateWebhook(ctx context.Context, in *UpdateWebhookIn, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, WebhooksService_UpdateWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhooksServiceClient) DeleteWebhook(ctx context.Context, in *DeleteWebhookIn, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, WebhooksService_DeleteWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhooksServiceClient) GetWebhookDeliveries(ctx context.Context, in *GetWebhookDeliveriesIn, opts ...grpc.CallOption) (*GetWebhookDeliveriesOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWebhookDeliveriesOut)
+	err := c.cc.Invoke(ctx, WebhooksService_GetWebhookDeliveries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WebhooksServiceServer is the server API for WebhooksService service.
+// All implementations must embed UnimplementedWebhooksServiceServer
+// for forward compatibility.
+type WebhooksServiceServer interface {
+	CreateWebhook(context.Context, *CreateWebhookIn) (*CreateWebhookOut, error)
+	GetWebhooks(context.Context, *emptypb.Empty) (*GetWebhooksOut, error)
+	UpdateWebhook(context.Context, *UpdateWebhookIn) (*emptypb.Empty, error)
+	DeleteWebhook(context.Context, *DeleteWebhookIn) (*emptypb.Empty, error)
+	GetWebhookDeliveries(context.Context, *GetWebhookDeliveriesIn) (*GetWebhookDeliveriesOut, error)
+	mustEmbedUnimplementedWebhooksServiceServer()
+}
+
+// UnimplementedWebhooksServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedWebhooksServiceServer struct{}
+
+func (UnimplementedWebhooksServiceServer) CreateWebhook(context.Context, *CreateWebhookIn) (*CreateWebhookOut, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateWebhook not implemented")
+}
+func (UnimplementedWebhooksServiceServer) GetWebhooks(context.Context, *emptypb.Empty) (*GetWebhooksOut, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetWebhooks not implemented")
+}
+func (UnimplementedWebhooksServiceServer) UpdateWebhook(context.Context, *UpdateWebhookIn) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateWebhook not implemented")
+}
+func (UnimplementedWebhooksServiceServer) DeleteWebhook(context.Context, *DeleteWebhookIn) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteWebhook not implemented")
+}
+func (UnimplementedWebhooksServiceServer) GetWebhookDeliveries(context.Context, *GetWebhookDeliveriesIn) (*GetWebhookDeliveriesOut, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetWebhookDeliveries not implemented")
+}
+func (UnimplementedWebhooksServiceServer) mustEmbedUnimplementedWebhooksServiceServer() {}
+func (UnimplementedWebhooksServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeWebhooksServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WebhooksServiceServer will
+// result in compilation errors.
+type UnsafeWebhooksServiceServer interface {
+	mustEmbedUnimplementedWebhooksServiceServer()
+}
+
+func RegisterWebhooksServiceServer(s grpc.ServiceRegistrar, srv WebhooksServiceServer) {
+	// If the following call panics, it indicates UnimplementedWebhooksServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&WebhooksService_ServiceDesc, srv)
+}
+
+func _WebhooksService_CreateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhooksServiceServer).CreateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WebhooksService_CreateWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhooksServiceServer).CreateWebhook(ctx, req.(*CreateWebhookIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebhooksService_GetWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhooksServiceServer).GetWebhooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WebhooksService_GetWebhooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhooksServiceServer).GetWebhooks(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebhooksService_UpdateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWebhookIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhooksServiceServer).UpdateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WebhooksService_UpdateWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhooksServiceServer).UpdateWebhook(ctx, req.(*UpdateWebhookIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebhooksService_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhooksServiceServer).DeleteWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WebhooksService_DeleteWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhooksServiceServer).DeleteWebhook(ctx, req.(*DeleteWebhookIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebhooksService_GetWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWebhookDeliveriesIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhooksServiceServer).GetWebhookDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WebhooksService_GetWebhookDeliveries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhooksServiceServer).GetWebhookDeliveries(ctx, req.(*GetWebhookDeliveriesIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WebhooksService_ServiceDesc is the grpc.ServiceDesc for WebhooksService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WebhooksService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "webhooks.WebhooksService",
+	HandlerType: (*WebhooksServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateWebhook",
+			Handler:    _WebhooksService_CreateWebhook_Handler,
+		},
+		{
+			MethodName: "GetWebhooks",
+			Handler:    _WebhooksService_GetWebhooks_Handler,
+		},
+		{
+			MethodName: "UpdateWebhook",
+			Handler:    _WebhooksService_UpdateWebhook_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _WebhooksService_DeleteWebhook_Handler,
+		},
+		{
+			MethodName: "GetWebhookDeliveries",
+			Handler:    _WebhooksService_GetWebhookDeliveries_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "notifications/webhooks.proto",
+}
@@ -13,7 +13,7 @@ import (
 )
 
 type Client struct {
-	notifications.EmailsServiceClient
+	notifications.CommunicationsServiceClient
 }
 
 func main() {
@@ -28,16 +28,17 @@ func main() {
 	}
 
 	client := &Client{
-		EmailsServiceClient: notifications.NewEmailsServiceClient(clientConnection),
+		CommunicationsServiceClient: notifications.NewCommunicationsServiceClient(clientConnection),
 	}
 
 	ctx := metadata.AppendToOutgoingContext(context.Background(), requestid.Key, requestid.New())
 
-	emails, err := client.GetUserEmailCommunications(
+	communications, err := client.GetUserCommunications(
 		ctx,
-		&notifications.GetUserEmailCommunicationsIn{
-			UserID: 1,
+		&notifications.GetUserCommunicationsIn{
+			UserID:  1,
+			Channel: "email",
 		},
 	)
-	fmt.Printf("Emails: %+v\nErr: %v\n", emails, err)
+	fmt.Printf("Communications: %+v\nErr: %v\n", communications, err)
 }
@@ -0,0 +1,195 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+
+	"github.com/DKhorkov/hmtm-notifications/dto"
+	"github.com/DKhorkov/hmtm-notifications/internal/config"
+)
+
+const (
+	listCommand    = "list"
+	inspectCommand = "inspect"
+	replayCommand  = "replay"
+	usage          = "Usage: deadletters [-limit N] list | inspect <sequence> | replay <sequence>"
+)
+
+func main() {
+	limit := flag.Int("limit", 100, "maximum number of dead-lettered messages to list")
+	timeout := flag.Duration("timeout", 10*time.Second, "timeout for operations with NATS")
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		fmt.Println(usage)
+		os.Exit(1)
+	}
+
+	settings := config.New()
+
+	connection, err := nats.Connect(
+		settings.NATS.ClientURL,
+		nats.Name("hmtm-notifications-dead-letters"),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	defer connection.Close()
+
+	js, err := jetstream.New(connection)
+	if err != nil {
+		panic(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	stream, err := js.Stream(ctx, settings.NATS.DeadLetterStreamName)
+	if err != nil {
+		panic(err)
+	}
+
+	switch flag.Arg(0) {
+	case listCommand:
+		err = list(ctx, stream, settings.NATS.Subjects.DeadLetter, *limit)
+	case inspectCommand:
+		err = inspect(ctx, stream, flag.Arg(1))
+	case replayCommand:
+		err = replay(ctx, js, stream, flag.Arg(1))
+	default:
+		fmt.Println(usage)
+		os.Exit(1)
+	}
+
+	if err != nil {
+		panic(err)
+	}
+}
+
+// list prints short info about dead-lettered messages, starting from the oldest one.
+func list(ctx context.Context, stream jetstream.Stream, subject string, limit int) error {
+	var sequence uint64 = 1
+	for range limit {
+		// Fetches next existing message, so replayed (deleted) messages are skipped:
+		message, err := stream.GetMsg(ctx, sequence, jetstream.WithGetMsgSubject(subject))
+		if errors.Is(err, jetstream.ErrMsgNotFound) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		deadLetter, err := toDeadLetter(message)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf(
+			"Sequence: %d | Subject: %s | Attempts: %d | FailedAt: %s | Error: %s\n",
+			message.Sequence,
+			deadLetter.Subject,
+			deadLetter.Attempts,
+			deadLetter.FailedAt.Format(time.RFC3339),
+			deadLetter.Error,
+		)
+
+		sequence = message.Sequence + 1
+	}
+
+	return nil
+}
+
+// inspect prints full info about dead-lettered message, including original payload.
+func inspect(ctx context.Context, stream jetstream.Stream, rawSequence string) error {
+	sequence, err := parseSequence(rawSequence)
+	if err != nil {
+		return err
+	}
+
+	message, err := stream.GetMsg(ctx, sequence)
+	if err != nil {
+		return err
+	}
+
+	deadLetter, err := toDeadLetter(message)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf(
+		"Sequence: %d\nSubject: %s\nAttempts: %d\nFailedAt: %s\nError: %s\nPayload: %s\n",
+		message.Sequence,
+		deadLetter.Subject,
+		deadLetter.Attempts,
+		deadLetter.FailedAt.Format(time.RFC3339),
+		deadLetter.Error,
+		deadLetter.Payload,
+	)
+
+	return nil
+}
+
+// replay publishes original payload of dead-lettered message to its original subject and deletes
+// message from dead-letter stream to prevent repeated replays.
+func replay(
+	ctx context.Context,
+	js jetstream.JetStream,
+	stream jetstream.Stream,
+	rawSequence string,
+) error {
+	sequence, err := parseSequence(rawSequence)
+	if err != nil {
+		return err
+	}
+
+	message, err := stream.GetMsg(ctx, sequence)
+	if err != nil {
+		return err
+	}
+
+	deadLetter, err := toDeadLetter(message)
+	if err != nil {
+		return err
+	}
+
+	if _, err = js.Publish(ctx, deadLetter.Subject, deadLetter.Payload); err != nil {
+		return err
+	}
+
+	if err = stream.DeleteMsg(ctx, sequence); err != nil {
+		return err
+	}
+
+	fmt.Printf("Message with sequence %d was replayed to \"%s\" subject\n", sequence, deadLetter.Subject)
+
+	return nil
+}
+
+func toDeadLetter(message *jetstream.RawStreamMsg) (*dto.DeadLetterDTO, error) {
+	var deadLetter dto.DeadLetterDTO
+	if err := json.Unmarshal(message.Data, &deadLetter); err != nil {
+		return nil, err
+	}
+
+	return &deadLetter, nil
+}
+
+func parseSequence(rawSequence string) (uint64, error) {
+	sequence, err := strconv.ParseUint(rawSequence, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid sequence \"%s\": %w", rawSequence, err)
+	}
+
+	return sequence, nil
+}
@@ -25,6 +25,13 @@ func main() {
 
 	ticketUpdatedDTO := dto.TicketUpdatedDTO{
 		TicketID: 1,
+		Previous: &dto.TicketSnapshotDTO{
+			Name:        "Old ticket name",
+			Description: "Old ticket description",
+			Quantity:    1,
+			CategoryID:  1,
+			TagIDs:      []uint32{1},
+		},
 	}
 
 	content, err := json.Marshal(ticketUpdatedDTO)
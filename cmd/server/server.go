@@ -1,7 +1,6 @@
 package main
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/DKhorkov/libs/db"
@@ -9,20 +8,24 @@ import (
 	"github.com/DKhorkov/libs/tracing"
 	"github.com/nats-io/nats.go"
 
-	customnats "github.com/DKhorkov/libs/nats"
-
 	"github.com/DKhorkov/hmtm-notifications/internal/app"
+	"github.com/DKhorkov/hmtm-notifications/internal/broadcasters"
+	"github.com/DKhorkov/hmtm-notifications/internal/cleaners"
 	ssogrpcclient "github.com/DKhorkov/hmtm-notifications/internal/clients/sso/grpc"
 	ticketsgrpcclient "github.com/DKhorkov/hmtm-notifications/internal/clients/tickets/grpc"
 	toysgrpcclient "github.com/DKhorkov/hmtm-notifications/internal/clients/toys/grpc"
 	"github.com/DKhorkov/hmtm-notifications/internal/config"
 	"github.com/DKhorkov/hmtm-notifications/internal/contentbuilders"
 	grpccontroller "github.com/DKhorkov/hmtm-notifications/internal/controllers/grpc"
+	"github.com/DKhorkov/hmtm-notifications/internal/dispatchers"
 	"github.com/DKhorkov/hmtm-notifications/internal/interfaces"
+	"github.com/DKhorkov/hmtm-notifications/internal/reloaders"
 	"github.com/DKhorkov/hmtm-notifications/internal/repositories"
 	"github.com/DKhorkov/hmtm-notifications/internal/senders"
 	"github.com/DKhorkov/hmtm-notifications/internal/services"
+	"github.com/DKhorkov/hmtm-notifications/internal/signers"
 	"github.com/DKhorkov/hmtm-notifications/internal/usecases"
+	"github.com/DKhorkov/hmtm-notifications/internal/workers"
 	"github.com/DKhorkov/hmtm-notifications/internal/workers/handlers/builders"
 )
 
@@ -33,6 +36,9 @@ func main() {
 		settings.Logging.LogFilePath,
 	)
 
+	// Components are stopped in reverse registration order, so intake is stopped first and DB pool and tracer last:
+	application := app.New(logger, app.WithShutdownTimeout(settings.ShutdownTimeout))
+
 	dbConnector, err := db.New(
 		db.BuildDsn(settings.Database),
 		settings.Database.Driver,
@@ -46,22 +52,14 @@ func main() {
 		panic(err)
 	}
 
-	defer func() {
-		if err = dbConnector.Close(); err != nil {
-			logging.LogError(logger, "Failed to close db connections pool", err)
-		}
-	}()
+	application.Register(app.NewCloserComponent("db connections pool", dbConnector.Close))
 
 	traceProvider, err := tracing.New(settings.Tracing.Server)
 	if err != nil {
 		panic(err)
 	}
 
-	defer func() {
-		if err = traceProvider.Shutdown(context.Background()); err != nil {
-			logging.LogError(logger, "Error shutting down tracer", err)
-		}
-	}()
+	application.Register(app.Component{Name: "tracer", Stop: traceProvider.Shutdown})
 
 	ssoClient, err := ssogrpcclient.New(
 		settings.Clients.SSO.Host,
@@ -120,66 +118,283 @@ func main() {
 		logger,
 	)
 
-	emailsRepository := repositories.NewEmailsRepository(
+	communicationsRepository := repositories.NewCommunicationsRepository(
+		dbConnector,
+		logger,
+		traceProvider,
+		settings.Tracing.Spans.Repositories.Communications,
+	)
+
+	communicationsService := services.NewCommunicationsService(
+		communicationsRepository,
+		logger,
+	)
+
+	processedMessagesRepository := repositories.NewProcessedMessagesRepository(
+		dbConnector,
+		logger,
+		traceProvider,
+		settings.Tracing.Spans.Repositories.ProcessedMessages,
+	)
+
+	processedMessagesService := services.NewProcessedMessagesService(
+		processedMessagesRepository,
+		logger,
+	)
+
+	notificationsRepository := repositories.NewNotificationsRepository(
 		dbConnector,
 		logger,
 		traceProvider,
-		settings.Tracing.Spans.Repositories.Emails,
+		settings.Tracing.Spans.Repositories.Notifications,
+	)
+
+	notificationsService := services.NewNotificationsService(
+		notificationsRepository,
+		logger,
 	)
 
-	emailsService := services.NewEmailsService(
-		emailsRepository,
+	webhooksRepository := repositories.NewWebhooksRepository(
+		dbConnector,
 		logger,
+		traceProvider,
+		settings.Tracing.Spans.Repositories.Webhooks,
 	)
 
+	webhooksService := services.NewWebhooksService(
+		webhooksRepository,
+		logger,
+	)
+
+	tokensRepository := repositories.NewTokensRepository(
+		dbConnector,
+		logger,
+		traceProvider,
+		settings.Tracing.Spans.Repositories.Tokens,
+	)
+
+	tokensService := services.NewTokensService(
+		tokensRepository,
+		logger,
+	)
+
+	templatesRepository := repositories.NewTemplatesRepository(
+		dbConnector,
+		logger,
+		traceProvider,
+		settings.Tracing.Spans.Repositories.Templates,
+	)
+
+	templatesService := services.NewTemplatesService(
+		templatesRepository,
+		logger,
+	)
+
+	tokenSigner, err := signers.NewTokenSigner(settings.Tokens)
+	if err != nil {
+		panic(err)
+	}
+
+	notificationsBroadcaster, err := broadcasters.NewNATSNotificationsBroadcaster(
+		settings.NATS.ClientURL,
+		settings.Streams.Subject,
+		settings.Streams.BufferSize,
+		logger,
+		nats.Name("notifications-broadcaster"),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	// Templates are validated on startup, so broken template prevents service from starting:
+	templates, err := contentbuilders.NewTemplates(logger)
+	if err != nil {
+		panic(err)
+	}
+
 	contentBuilders := interfaces.ContentBuilders{
 		VerifyEmail: contentbuilders.NewVerifyEmailContentBuilder(
+			templates,
 			settings.Email.VerifyEmailURL,
 		),
 		ForgetPassword: contentbuilders.NewForgetPasswordContentBuilder(
+			templates,
 			settings.Email.ForgetPasswordURL,
 		),
 		TicketUpdated: contentbuilders.NewTicketUpdatedContentBuilder(
+			templates,
 			settings.Email.TicketUpdatedURL,
 		),
 		TicketDeleted: contentbuilders.NewTicketDeletedContentBuilder(
+			templates,
 			settings.Email.TicketDeletedURL,
 		),
+		// Plain text alternatives of ticket emails are generated from HTML bodies on sending:
+		Text: interfaces.EmailTextContentBuilders{
+			VerifyEmail: contentbuilders.NewVerifyEmailTextContentBuilder(
+				templates,
+				settings.Email.VerifyEmailURL,
+			),
+			ForgetPassword: contentbuilders.NewForgetPasswordTextContentBuilder(
+				templates,
+				settings.Email.ForgetPasswordURL,
+			),
+		},
+		SMS: interfaces.SMSContentBuilders{
+			ForgetPassword: contentbuilders.NewForgetPasswordSMSContentBuilder(
+				templates,
+				settings.Email.ForgetPasswordURL,
+			),
+			TicketUpdated: contentbuilders.NewTicketUpdatedSMSContentBuilder(
+				templates,
+				settings.Email.TicketUpdatedURL,
+				settings.SMS.MaxSegments,
+			),
+			TicketDeleted: contentbuilders.NewTicketDeletedSMSContentBuilder(
+				templates,
+				settings.SMS.MaxSegments,
+			),
+		},
+		Inbox: interfaces.InboxContentBuilders{
+			VerifyEmail: contentbuilders.NewVerifyEmailInboxContentBuilder(
+				templates,
+				settings.Email.VerifyEmailURL,
+			),
+			ForgetPassword: contentbuilders.NewForgetPasswordInboxContentBuilder(
+				templates,
+				settings.Email.ForgetPasswordURL,
+			),
+			TicketUpdated: contentbuilders.NewTicketUpdatedInboxContentBuilder(
+				templates,
+				settings.Email.TicketUpdatedURL,
+			),
+			TicketDeleted: contentbuilders.NewTicketDeletedInboxContentBuilder(
+				templates,
+				settings.Email.TicketDeletedURL,
+			),
+		},
+		Templates: templates,
 	}
 
+	emailSender := senders.NewEmailSender(
+		settings.Email.SMTP,
+		traceProvider,
+		settings.Tracing.Spans.Senders.Email,
+	)
+
+	application.Register(app.NewCloserComponent("SMTP connections pool", emailSender.Close))
+
 	communicationsSenders := interfaces.Senders{
-		Email: senders.NewEmailSender(
-			settings.Email.SMTP,
+		Email: senders.NewRateLimitedEmailSender(emailSender, settings.Email.RateLimit),
+		Telegram: senders.NewTelegramSender(
+			settings.Telegram,
+			traceProvider,
+			settings.Tracing.Spans.Senders.Telegram,
+		),
+		SMS: senders.NewSMSSender(
+			senders.NewHTTPSMSProvider(settings.SMS.Provider),
 			traceProvider,
-			settings.Tracing.Spans.Senders.Email,
+			settings.Tracing.Spans.Senders.SMS,
 		),
 	}
 
 	useCases := usecases.New(
-		emailsService,
+		communicationsService,
+		processedMessagesService,
+		notificationsService,
+		notificationsBroadcaster,
+		webhooksService,
+		tokensService,
+		tokenSigner,
+		templatesService,
 		ssoService,
 		toysService,
 		ticketsService,
 		contentBuilders,
+		settings.UseCases,
+	)
+
+	communicationsDispatcher := dispatchers.NewCommunicationsDispatcher(
+		communicationsService,
 		communicationsSenders,
+		settings.Dispatchers.Communications,
+		traceProvider,
+		settings.Tracing.Spans.Dispatchers.Communications,
+		logger,
+	)
+
+	application.Register(app.NewServiceComponent("communications dispatcher", communicationsDispatcher))
+
+	webhooksDispatcher := dispatchers.NewWebhooksDispatcher(
+		webhooksService,
+		senders.NewWebhookSender(
+			settings.Webhooks,
+			traceProvider,
+			settings.Tracing.Spans.Senders.Webhook,
+		),
+		settings.Dispatchers.Webhooks,
+		settings.Webhooks.DisableThreshold,
+		settings.Webhooks.MaxDeferrals,
+		traceProvider,
+		settings.Tracing.Spans.Dispatchers.Webhooks,
+		logger,
 	)
 
+	application.Register(app.NewServiceComponent("webhooks dispatcher", webhooksDispatcher))
+
+	processedMessagesCleaner := cleaners.NewProcessedMessagesCleaner(
+		processedMessagesService,
+		settings.Cleaners.ProcessedMessages,
+		traceProvider,
+		settings.Tracing.Spans.Cleaners.ProcessedMessages,
+		logger,
+	)
+
+	application.Register(app.NewServiceComponent("processed messages cleaner", processedMessagesCleaner))
+
+	templatesReloader := reloaders.NewTemplatesReloader(
+		templatesService,
+		templates,
+		settings.Reloaders.Templates,
+		traceProvider,
+		settings.Tracing.Spans.Reloaders.Templates,
+		logger,
+	)
+
+	application.Register(app.NewServiceComponent("templates reloader", templatesReloader))
+
 	controller := grpccontroller.New(
 		settings.HTTP.Host,
 		settings.HTTP.Port,
 		useCases,
+		settings.Streams.HeartbeatInterval,
 		logger,
 		traceProvider,
 		settings.Tracing.Spans.Root,
 	)
 
-	verifyEmailWorker, err := customnats.NewWorker(
+	streamSubjects := []string{
+		settings.NATS.Subjects.VerifyEmail,
+		settings.NATS.Subjects.ForgetPassword,
+		settings.NATS.Subjects.TicketUpdated,
+		settings.NATS.Subjects.TicketDeleted,
+	}
+
+	verifyEmailWorker, err := workers.NewWorker(
 		settings.NATS.ClientURL,
+		settings.NATS.StreamName,
+		settings.NATS.Workers.VerifyEmail.Name,
 		settings.NATS.Subjects.VerifyEmail,
-		customnats.WithGoroutinesPoolSize(settings.NATS.GoroutinesPoolSize),
-		customnats.WithMessageChannelBufferSize(settings.NATS.MessageChannelBufferSize),
-		customnats.WithNatsOptions(nats.Name(settings.NATS.Workers.VerifyEmail.Name)),
-		customnats.WithMessageHandler(
+		logger,
+		workers.WithStreamSubjects(streamSubjects...),
+		workers.WithGoroutinesPoolSize(settings.NATS.GoroutinesPoolSize),
+		workers.WithMessageChannelBufferSize(settings.NATS.MessageChannelBufferSize),
+		workers.WithAckWait(settings.NATS.AckWait),
+		workers.WithMaxDeliver(settings.NATS.MaxDeliver),
+		workers.WithNakDelay(settings.NATS.NakDelay),
+		workers.WithDeadLetter(settings.NATS.DeadLetterStreamName, settings.NATS.Subjects.DeadLetter),
+		workers.WithNatsOptions(nats.Name(settings.NATS.Workers.VerifyEmail.Name)),
+		workers.WithMessageHandler(
 			builders.NewVerifyEmailBuilder(
 				useCases,
 				traceProvider,
@@ -192,30 +407,28 @@ func main() {
 		panic(err)
 	}
 
-	if err = verifyEmailWorker.Run(); err != nil {
-		panic(err)
-	}
+	application.Register(
+		app.NewServiceComponent(
+			fmt.Sprintf("\"%s\" worker", settings.NATS.Workers.VerifyEmail.Name),
+			verifyEmailWorker,
+		),
+	)
 
-	defer func() {
-		if err = verifyEmailWorker.Stop(); err != nil {
-			logging.LogError(
-				logger,
-				fmt.Sprintf(
-					"Error shutting down \"%s\" worker",
-					settings.NATS.Workers.VerifyEmail.Name,
-				),
-				err,
-			)
-		}
-	}()
-
-	forgetPasswordWorker, err := customnats.NewWorker(
+	forgetPasswordWorker, err := workers.NewWorker(
 		settings.NATS.ClientURL,
+		settings.NATS.StreamName,
+		settings.NATS.Workers.ForgetPassword.Name,
 		settings.NATS.Subjects.ForgetPassword,
-		customnats.WithGoroutinesPoolSize(settings.NATS.GoroutinesPoolSize),
-		customnats.WithMessageChannelBufferSize(settings.NATS.MessageChannelBufferSize),
-		customnats.WithNatsOptions(nats.Name(settings.NATS.Workers.ForgetPassword.Name)),
-		customnats.WithMessageHandler(
+		logger,
+		workers.WithStreamSubjects(streamSubjects...),
+		workers.WithGoroutinesPoolSize(settings.NATS.GoroutinesPoolSize),
+		workers.WithMessageChannelBufferSize(settings.NATS.MessageChannelBufferSize),
+		workers.WithAckWait(settings.NATS.AckWait),
+		workers.WithMaxDeliver(settings.NATS.MaxDeliver),
+		workers.WithNakDelay(settings.NATS.NakDelay),
+		workers.WithDeadLetter(settings.NATS.DeadLetterStreamName, settings.NATS.Subjects.DeadLetter),
+		workers.WithNatsOptions(nats.Name(settings.NATS.Workers.ForgetPassword.Name)),
+		workers.WithMessageHandler(
 			builders.NewForgetPasswordBuilder(
 				useCases,
 				traceProvider,
@@ -228,30 +441,28 @@ func main() {
 		panic(err)
 	}
 
-	if err = forgetPasswordWorker.Run(); err != nil {
-		panic(err)
-	}
+	application.Register(
+		app.NewServiceComponent(
+			fmt.Sprintf("\"%s\" worker", settings.NATS.Workers.ForgetPassword.Name),
+			forgetPasswordWorker,
+		),
+	)
 
-	defer func() {
-		if err = forgetPasswordWorker.Stop(); err != nil {
-			logging.LogError(
-				logger,
-				fmt.Sprintf(
-					"Error shutting down \"%s\" worker",
-					settings.NATS.Workers.ForgetPassword.Name,
-				),
-				err,
-			)
-		}
-	}()
-
-	ticketUpdatedWorker, err := customnats.NewWorker(
+	ticketUpdatedWorker, err := workers.NewWorker(
 		settings.NATS.ClientURL,
+		settings.NATS.StreamName,
+		settings.NATS.Workers.TicketUpdated.Name,
 		settings.NATS.Subjects.TicketUpdated,
-		customnats.WithGoroutinesPoolSize(settings.NATS.GoroutinesPoolSize),
-		customnats.WithMessageChannelBufferSize(settings.NATS.MessageChannelBufferSize),
-		customnats.WithNatsOptions(nats.Name(settings.NATS.Workers.TicketUpdated.Name)),
-		customnats.WithMessageHandler(
+		logger,
+		workers.WithStreamSubjects(streamSubjects...),
+		workers.WithGoroutinesPoolSize(settings.NATS.GoroutinesPoolSize),
+		workers.WithMessageChannelBufferSize(settings.NATS.MessageChannelBufferSize),
+		workers.WithAckWait(settings.NATS.AckWait),
+		workers.WithMaxDeliver(settings.NATS.MaxDeliver),
+		workers.WithNakDelay(settings.NATS.NakDelay),
+		workers.WithDeadLetter(settings.NATS.DeadLetterStreamName, settings.NATS.Subjects.DeadLetter),
+		workers.WithNatsOptions(nats.Name(settings.NATS.Workers.TicketUpdated.Name)),
+		workers.WithMessageHandler(
 			builders.NewTicketUpdatedBuilder(
 				useCases,
 				traceProvider,
@@ -264,30 +475,28 @@ func main() {
 		panic(err)
 	}
 
-	if err = ticketUpdatedWorker.Run(); err != nil {
-		panic(err)
-	}
+	application.Register(
+		app.NewServiceComponent(
+			fmt.Sprintf("\"%s\" worker", settings.NATS.Workers.TicketUpdated.Name),
+			ticketUpdatedWorker,
+		),
+	)
 
-	defer func() {
-		if err = ticketUpdatedWorker.Stop(); err != nil {
-			logging.LogError(
-				logger,
-				fmt.Sprintf(
-					"Error shutting down \"%s\" worker",
-					settings.NATS.Workers.TicketUpdated.Name,
-				),
-				err,
-			)
-		}
-	}()
-
-	ticketDeletedWorker, err := customnats.NewWorker(
+	ticketDeletedWorker, err := workers.NewWorker(
 		settings.NATS.ClientURL,
+		settings.NATS.StreamName,
+		settings.NATS.Workers.TicketDeleted.Name,
 		settings.NATS.Subjects.TicketDeleted,
-		customnats.WithGoroutinesPoolSize(settings.NATS.GoroutinesPoolSize),
-		customnats.WithMessageChannelBufferSize(settings.NATS.MessageChannelBufferSize),
-		customnats.WithNatsOptions(nats.Name(settings.NATS.Workers.TicketDeleted.Name)),
-		customnats.WithMessageHandler(
+		logger,
+		workers.WithStreamSubjects(streamSubjects...),
+		workers.WithGoroutinesPoolSize(settings.NATS.GoroutinesPoolSize),
+		workers.WithMessageChannelBufferSize(settings.NATS.MessageChannelBufferSize),
+		workers.WithAckWait(settings.NATS.AckWait),
+		workers.WithMaxDeliver(settings.NATS.MaxDeliver),
+		workers.WithNakDelay(settings.NATS.NakDelay),
+		workers.WithDeadLetter(settings.NATS.DeadLetterStreamName, settings.NATS.Subjects.DeadLetter),
+		workers.WithNatsOptions(nats.Name(settings.NATS.Workers.TicketDeleted.Name)),
+		workers.WithMessageHandler(
 			builders.NewTicketDeletedBuilder(
 				useCases,
 				traceProvider,
@@ -300,23 +509,20 @@ func main() {
 		panic(err)
 	}
 
-	if err = ticketDeletedWorker.Run(); err != nil {
-		panic(err)
-	}
+	application.Register(
+		app.NewServiceComponent(
+			fmt.Sprintf("\"%s\" worker", settings.NATS.Workers.TicketDeleted.Name),
+			ticketDeletedWorker,
+		),
+	)
 
-	defer func() {
-		if err = ticketDeletedWorker.Stop(); err != nil {
-			logging.LogError(
-				logger,
-				fmt.Sprintf(
-					"Error shutting down \"%s\" worker",
-					settings.NATS.Workers.TicketDeleted.Name,
-				),
-				err,
-			)
-		}
-	}()
-
-	application := app.New(controller)
-	application.Run()
+	application.Register(app.NewControllerComponent("gRPC controller", controller))
+
+	// Registered after gRPC controller to be stopped before it, since graceful stop of gRPC server waits for
+	// live notifications streams, which are finished only after closing of their subscriptions:
+	application.Register(app.NewCloserComponent("notifications broadcaster", notificationsBroadcaster.Close))
+
+	if err = application.Run(); err != nil {
+		logging.LogError(logger, "Application was not gracefully stopped", err)
+	}
 }
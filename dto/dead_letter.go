@@ -0,0 +1,11 @@
+package dto
+
+import "time"
+
+type DeadLetterDTO struct {
+	Subject  string    `json:"subject"`
+	Payload  []byte    `json:"payload"`
+	Error    string    `json:"error"`
+	Attempts uint64    `json:"attempts"`
+	FailedAt time.Time `json:"failedAt"`
+}
@@ -1,5 +1,8 @@
 package dto
 
+// ForgetPasswordDTO has Locale of user, who requested notification, since SSO does not store locales of users.
 type ForgetPasswordDTO struct {
-	UserID uint64 `json:"userId"`
+	UserID         uint64 `json:"userId"`
+	Locale         string `json:"locale,omitempty"`
+	IdempotencyKey string `json:"idempotencyKey,omitempty"`
 }
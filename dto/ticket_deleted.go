@@ -7,4 +7,5 @@ type TicketDeletedDTO struct {
 	Price               *float32 `json:"price,omitempty"`
 	Quantity            uint32   `json:"quantity"`
 	RespondedMastersIDs []uint64 `json:"respondedMastersIds"`
+	IdempotencyKey      string   `json:"idempotencyKey,omitempty"`
 }
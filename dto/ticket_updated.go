@@ -2,4 +2,18 @@ package dto
 
 type TicketUpdatedDTO struct {
 	TicketID uint64 `json:"ticketId"`
+	// Previous state of ticket is not sent by old publishers, so only current state is shown in notification:
+	Previous       *TicketSnapshotDTO `json:"previous,omitempty"`
+	IdempotencyKey string             `json:"idempotencyKey,omitempty"`
+}
+
+// TicketSnapshotDTO is a state of ticket before update. Attachments are links to attached files.
+type TicketSnapshotDTO struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Price       *float32 `json:"price,omitempty"`
+	Quantity    uint32   `json:"quantity"`
+	CategoryID  uint32   `json:"categoryId"`
+	TagIDs      []uint32 `json:"tagIds"`
+	Attachments []string `json:"attachments,omitempty"`
 }
@@ -1,32 +1,141 @@
 package app
 
 import (
-	"os"
+	"context"
+	"errors"
+	"fmt"
 	"os/signal"
 	"syscall"
+	"time"
 
-	"github.com/DKhorkov/hmtm-notifications/internal/interfaces"
+	"github.com/DKhorkov/libs/logging"
 )
 
-func New(controller interfaces.Controller) *App {
-	return &App{
-		controller: controller,
+const defaultShutdownTimeout = 30 * time.Second
+
+func New(logger logging.Logger, opts ...Option) *App {
+	application := &App{
+		logger:          logger,
+		shutdownTimeout: defaultShutdownTimeout,
+	}
+
+	for _, opt := range opts {
+		opt(application)
+	}
+
+	return application
+}
+
+// Option represents golang functional option pattern func for App configuration.
+type Option func(application *App)
+
+// WithShutdownTimeout sets time, which is given to all components for graceful shutdown.
+func WithShutdownTimeout(timeout time.Duration) Option {
+	return func(application *App) {
+		application.shutdownTimeout = timeout
 	}
 }
 
+// App manages lifecycle of registered components. Components are started in registration order
+// and stopped in reverse order, so components, which receive requests or messages, should be registered last
+// to stop intake and drain in-flight work before resources, used by them, are closed.
 type App struct {
-	controller interfaces.Controller
+	logger          logging.Logger
+	shutdownTimeout time.Duration
+	components      []Component
 }
 
-func (application *App) Run() {
-	// Launch asynchronous for graceful shutdown purpose:
-	go application.controller.Run()
+// Register adds components to App. Must be called before Run.
+func (application *App) Register(components ...Component) {
+	application.components = append(application.components, components...)
+}
 
-	// Graceful shutdown. When system signal will be received, signal.Notify function will write it to channel.
-	// After this event, main goroutine will be unblocked (<-stopChannel blocks it) and application will be
+// Run starts all components and blocks until SIGINT or SIGTERM is received. After that components are
+// gracefully stopped.
+func (application *App) Run() error {
+	// Graceful shutdown. When system signal will be received, context will be canceled and application will be
 	// gracefully stopped:
-	stopChannel := make(chan os.Signal, 1)
-	signal.Notify(stopChannel, syscall.SIGINT, syscall.SIGTERM)
-	<-stopChannel
-	application.controller.Stop()
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	return application.run(ctx)
+}
+
+func (application *App) run(ctx context.Context) error {
+	started, err := application.start()
+	if err != nil {
+		return errors.Join(err, application.stop(started))
+	}
+
+	<-ctx.Done()
+
+	return application.stop(started)
+}
+
+// start starts components one by one and returns started ones. Stops on first failure.
+func (application *App) start() ([]Component, error) {
+	for i, component := range application.components {
+		if component.Start == nil {
+			continue
+		}
+
+		if err := component.Start(); err != nil {
+			return application.components[:i], fmt.Errorf("failed to start %s: %w", component.Name, err)
+		}
+	}
+
+	return application.components, nil
+}
+
+// stop stops provided components in reverse order within shutdown timeout. Components, which were not
+// stopped before timeout, are skipped, because waiting for them would delay shutdown infinitely.
+func (application *App) stop(components []Component) error {
+	ctx, cancel := context.WithTimeout(context.Background(), application.shutdownTimeout)
+	defer cancel()
+
+	var stopErr error
+
+	for i := len(components) - 1; i >= 0; i-- {
+		component := components[i]
+		if component.Stop == nil {
+			continue
+		}
+
+		if ctx.Err() != nil {
+			stopErr = errors.Join(
+				stopErr,
+				fmt.Errorf("shutdown timeout exceeded before stopping %s: %w", component.Name, ctx.Err()),
+			)
+
+			continue
+		}
+
+		if err := stopWithContext(ctx, component); err != nil {
+			logging.LogError(application.logger, fmt.Sprintf("Error shutting down %s", component.Name), err)
+
+			stopErr = errors.Join(stopErr, fmt.Errorf("failed to stop %s: %w", component.Name, err))
+		}
+	}
+
+	if stopErr == nil {
+		logging.LogInfo(application.logger, "Application was gracefully stopped.")
+	}
+
+	return stopErr
+}
+
+// stopWithContext calls stop hook and waits for its completion until context is done.
+func stopWithContext(ctx context.Context, component Component) error {
+	done := make(chan error, 1)
+
+	go func() {
+		done <- component.Stop(ctx)
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 }
@@ -0,0 +1,151 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	mocklogging "github.com/DKhorkov/libs/logging/mocks"
+)
+
+// recordingComponent creates Component, which records calls of its hooks to events.
+func recordingComponent(name string, events *[]string, startErr, stopErr error) Component {
+	return Component{
+		Name: name,
+		Start: func() error {
+			*events = append(*events, "start "+name)
+
+			return startErr
+		},
+		Stop: func(context.Context) error {
+			*events = append(*events, "stop "+name)
+
+			return stopErr
+		},
+	}
+}
+
+func TestApp_run(t *testing.T) {
+	testCases := []struct {
+		name           string
+		components     func(events *[]string) []Component
+		expectedEvents []string
+		errorExpected  bool
+	}{
+		{
+			name: "components stopped in reverse order",
+			components: func(events *[]string) []Component {
+				return []Component{
+					recordingComponent("db", events, nil, nil),
+					{Name: "closer without start", Stop: func(context.Context) error { return nil }},
+					recordingComponent("dispatcher", events, nil, nil),
+					recordingComponent("worker", events, nil, nil),
+				}
+			},
+			expectedEvents: []string{
+				"start db",
+				"start dispatcher",
+				"start worker",
+				"stop worker",
+				"stop dispatcher",
+				"stop db",
+			},
+		},
+		{
+			name: "start error stops already started components",
+			components: func(events *[]string) []Component {
+				return []Component{
+					recordingComponent("db", events, nil, nil),
+					recordingComponent("dispatcher", events, errors.New("start failed"), nil),
+					recordingComponent("worker", events, nil, nil),
+				}
+			},
+			expectedEvents: []string{
+				"start db",
+				"start dispatcher",
+				"stop db",
+			},
+			errorExpected: true,
+		},
+		{
+			name: "stop error does not prevent stopping of other components",
+			components: func(events *[]string) []Component {
+				return []Component{
+					recordingComponent("db", events, nil, nil),
+					recordingComponent("worker", events, nil, errors.New("stop failed")),
+				}
+			},
+			expectedEvents: []string{
+				"start db",
+				"start worker",
+				"stop worker",
+				"stop db",
+			},
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			logger := mocklogging.NewMockLogger(ctrl)
+			logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+			logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+			var events []string
+
+			application := New(logger)
+			application.Register(tc.components(&events)...)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			err := application.run(ctx)
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tc.expectedEvents, events)
+		})
+	}
+}
+
+func TestApp_runShutdownTimeout(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	logger := mocklogging.NewMockLogger(ctrl)
+	logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+	var dbClosed bool
+
+	application := New(logger, WithShutdownTimeout(time.Millisecond*10))
+	application.Register(
+		NewCloserComponent("db", func() error {
+			dbClosed = true
+
+			return nil
+		}),
+		Component{
+			Name: "hanging worker",
+			Stop: func(context.Context) error {
+				time.Sleep(time.Second)
+
+				return nil
+			},
+		},
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	start := time.Now()
+	err := application.run(ctx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Less(t, time.Since(start), time.Second)
+	require.False(t, dbClosed)
+}
@@ -0,0 +1,60 @@
+package app
+
+import (
+	"context"
+
+	"github.com/DKhorkov/hmtm-notifications/internal/interfaces"
+)
+
+// Component represents part of application with lifecycle, managed by App. Both hooks are optional.
+// Start must not block. Stop should return, when context is done, even if component was not stopped yet.
+type Component struct {
+	Name  string
+	Start func() error
+	Stop  func(ctx context.Context) error
+}
+
+// Service represents background component, which could be started and stopped, such as worker or dispatcher.
+type Service interface {
+	Run() error
+	Stop() error
+}
+
+// NewServiceComponent creates Component, which runs and stops provided Service.
+func NewServiceComponent(name string, service Service) Component {
+	return Component{
+		Name:  name,
+		Start: service.Run,
+		Stop: func(context.Context) error {
+			return service.Stop()
+		},
+	}
+}
+
+// NewControllerComponent creates Component, which serves provided Controller in background goroutine.
+func NewControllerComponent(name string, controller interfaces.Controller) Component {
+	return Component{
+		Name: name,
+		Start: func() error {
+			go controller.Run()
+
+			return nil
+		},
+		Stop: func(context.Context) error {
+			controller.Stop()
+
+			return nil
+		},
+	}
+}
+
+// NewCloserComponent creates Component, which closes resource on shutdown. Used for resources, which are opened
+// during initialization, such as connections pools.
+func NewCloserComponent(name string, closeFunc func() error) Component {
+	return Component{
+		Name: name,
+		Stop: func(context.Context) error {
+			return closeFunc()
+		},
+	}
+}
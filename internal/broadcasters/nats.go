@@ -0,0 +1,107 @@
+package broadcasters
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/DKhorkov/libs/logging"
+	"github.com/nats-io/nats.go"
+
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+)
+
+// NATSNotificationsBroadcaster shares notifications between all service instances via core NATS subject.
+// Notification, created by any instance, is delivered to live subscribers of every instance, since NATS
+// message, which triggered its creation, could be handled by instance, which holds no stream of recipient.
+// Delivery is at-most-once: notifications, lost due to NATS unavailability, are received by clients on
+// resumption from last seen notification.
+type NATSNotificationsBroadcaster struct {
+	local        *NotificationsBroadcaster
+	connection   *nats.Conn
+	subscription *nats.Subscription
+	subject      string
+	logger       logging.Logger
+}
+
+func NewNATSNotificationsBroadcaster(
+	url string,
+	subject string,
+	bufferSize int,
+	logger logging.Logger,
+	opts ...nats.Option,
+) (*NATSNotificationsBroadcaster, error) {
+	connection, err := nats.Connect(url, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	broadcaster := &NATSNotificationsBroadcaster{
+		local:      NewNotificationsBroadcaster(bufferSize),
+		connection: connection,
+		subject:    subject,
+		logger:     logger,
+	}
+
+	subscription, err := connection.Subscribe(subject, broadcaster.handle)
+	if err != nil {
+		connection.Close()
+
+		return nil, err
+	}
+
+	broadcaster.subscription = subscription
+
+	return broadcaster, nil
+}
+
+// Publish sends notification to all service instances. Notification is already saved, so publishing
+// failure is only logged.
+func (b *NATSNotificationsBroadcaster) Publish(notification entities.Notification) {
+	// Notifications, created during shutdown, are not broadcast:
+	if b.connection.IsClosed() {
+		return
+	}
+
+	content, err := json.Marshal(notification)
+	if err == nil {
+		err = b.connection.Publish(b.subject, content)
+	}
+
+	if err != nil {
+		logging.LogError(
+			b.logger,
+			fmt.Sprintf("Failed to broadcast Notification with ID=%d", notification.ID),
+			err,
+		)
+	}
+}
+
+func (b *NATSNotificationsBroadcaster) Subscribe(
+	userID uint64,
+) (<-chan entities.Notification, func(), error) {
+	return b.local.Subscribe(userID)
+}
+
+// Close stops receiving of notifications from other instances and closes all local subscriptions.
+func (b *NATSNotificationsBroadcaster) Close() error {
+	err := b.subscription.Unsubscribe()
+	b.connection.Close()
+
+	if closeErr := b.local.Close(); closeErr != nil {
+		return closeErr
+	}
+
+	return err
+}
+
+// handle delivers notification, received from any instance, to local subscribers.
+func (b *NATSNotificationsBroadcaster) handle(message *nats.Msg) {
+	var notification entities.Notification
+	if err := json.Unmarshal(message.Data, &notification); err != nil {
+		logging.LogError(b.logger, "Failed to decode broadcast Notification", err)
+
+		return
+	}
+
+	b.local.Publish(notification)
+}
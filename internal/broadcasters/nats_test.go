@@ -0,0 +1,51 @@
+package broadcasters
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	mocklogging "github.com/DKhorkov/libs/logging/mocks"
+
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+)
+
+func TestNATSNotificationsBroadcaster_handle(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	logger := mocklogging.NewMockLogger(ctrl)
+	broadcaster := &NATSNotificationsBroadcaster{
+		local:  NewNotificationsBroadcaster(1),
+		logger: logger,
+	}
+
+	notifications, unsubscribe, err := broadcaster.Subscribe(1)
+	require.NoError(t, err)
+
+	defer unsubscribe()
+
+	expected := entities.Notification{
+		ID:        1,
+		UserID:    1,
+		Type:      entities.NotificationTypeTicketUpdated,
+		Title:     "Title",
+		CreatedAt: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	content, err := json.Marshal(expected)
+	require.NoError(t, err)
+
+	broadcaster.handle(&nats.Msg{Data: content})
+	require.Equal(t, expected, <-notifications)
+
+	logger.
+		EXPECT().
+		Error(gomock.Any(), gomock.Any()).
+		Times(1)
+
+	broadcaster.handle(&nats.Msg{Data: []byte("invalid")})
+	require.Empty(t, notifications)
+}
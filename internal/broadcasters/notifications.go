@@ -0,0 +1,107 @@
+package broadcasters
+
+import (
+	"sync"
+
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-notifications/internal/errors"
+)
+
+// NotificationsBroadcaster delivers in-app notifications to live subscribers within current instance.
+// Every subscriber has its own buffer of bufferSize notifications. Subscriber, which buffer is full, is
+// dropped instead of blocking publisher, and should resubscribe from last received notification.
+type NotificationsBroadcaster struct {
+	bufferSize  int
+	subscribers map[uint64]map[*subscriber]struct{}
+	closed      bool
+	mutex       *sync.Mutex
+}
+
+func NewNotificationsBroadcaster(bufferSize int) *NotificationsBroadcaster {
+	return &NotificationsBroadcaster{
+		bufferSize:  bufferSize,
+		subscribers: make(map[uint64]map[*subscriber]struct{}),
+		mutex:       new(sync.Mutex),
+	}
+}
+
+type subscriber struct {
+	notifications chan entities.Notification
+}
+
+// Publish sends notification to all subscribers of its recipient without blocking.
+func (b *NotificationsBroadcaster) Publish(notification entities.Notification) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	for s := range b.subscribers[notification.UserID] {
+		select {
+		case s.notifications <- notification:
+		default:
+			b.remove(notification.UserID, s)
+		}
+	}
+}
+
+// Subscribe registers subscriber for notifications of provided user. Returned channel is closed after
+// unsubscribe call, on broadcaster closing or if subscriber was dropped due to slow reading.
+func (b *NotificationsBroadcaster) Subscribe(
+	userID uint64,
+) (<-chan entities.Notification, func(), error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if b.closed {
+		return nil, nil, &customerrors.NotificationsStreamClosedError{}
+	}
+
+	s := &subscriber{notifications: make(chan entities.Notification, b.bufferSize)}
+	if b.subscribers[userID] == nil {
+		b.subscribers[userID] = make(map[*subscriber]struct{})
+	}
+
+	b.subscribers[userID][s] = struct{}{}
+
+	unsubscribe := func() {
+		b.mutex.Lock()
+		defer b.mutex.Unlock()
+
+		b.remove(userID, s)
+	}
+
+	return s.notifications, unsubscribe, nil
+}
+
+// Close closes all subscriptions and rejects new ones. Publishing after closing is a no-op.
+func (b *NotificationsBroadcaster) Close() error {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.closed = true
+	for userID, userSubscribers := range b.subscribers {
+		for s := range userSubscribers {
+			b.remove(userID, s)
+		}
+	}
+
+	return nil
+}
+
+// remove closes subscriber channel, if subscriber is still registered. Must be called under mutex.
+func (b *NotificationsBroadcaster) remove(userID uint64, s *subscriber) {
+	userSubscribers, ok := b.subscribers[userID]
+	if !ok {
+		return
+	}
+
+	if _, ok = userSubscribers[s]; !ok {
+		return
+	}
+
+	delete(userSubscribers, s)
+	close(s.notifications)
+
+	if len(userSubscribers) == 0 {
+		delete(b.subscribers, userID)
+	}
+}
@@ -0,0 +1,83 @@
+package broadcasters
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-notifications/internal/errors"
+)
+
+func TestNotificationsBroadcaster_Publish(t *testing.T) {
+	broadcaster := NewNotificationsBroadcaster(1)
+
+	firstUserNotifications, unsubscribeFirst, err := broadcaster.Subscribe(1)
+	require.NoError(t, err)
+
+	defer unsubscribeFirst()
+
+	secondUserNotifications, unsubscribeSecond, err := broadcaster.Subscribe(2)
+	require.NoError(t, err)
+
+	defer unsubscribeSecond()
+
+	notification := entities.Notification{ID: 1, UserID: 1}
+	broadcaster.Publish(notification)
+
+	require.Equal(t, notification, <-firstUserNotifications)
+	require.Empty(t, secondUserNotifications)
+}
+
+func TestNotificationsBroadcaster_PublishToSlowSubscriber(t *testing.T) {
+	broadcaster := NewNotificationsBroadcaster(1)
+
+	notifications, unsubscribe, err := broadcaster.Subscribe(1)
+	require.NoError(t, err)
+
+	broadcaster.Publish(entities.Notification{ID: 1, UserID: 1})
+	broadcaster.Publish(entities.Notification{ID: 2, UserID: 1})
+
+	// Buffered notification is still delivered, but subscriber is dropped after overflow:
+	require.Equal(t, uint64(1), (<-notifications).ID)
+
+	_, ok := <-notifications
+	require.False(t, ok)
+
+	// Unsubscribing of dropped subscriber is safe:
+	unsubscribe()
+}
+
+func TestNotificationsBroadcaster_Unsubscribe(t *testing.T) {
+	broadcaster := NewNotificationsBroadcaster(1)
+
+	notifications, unsubscribe, err := broadcaster.Subscribe(1)
+	require.NoError(t, err)
+
+	unsubscribe()
+	unsubscribe()
+
+	_, ok := <-notifications
+	require.False(t, ok)
+
+	// Publishing without subscribers is a no-op:
+	broadcaster.Publish(entities.Notification{ID: 1, UserID: 1})
+}
+
+func TestNotificationsBroadcaster_Close(t *testing.T) {
+	broadcaster := NewNotificationsBroadcaster(1)
+
+	notifications, unsubscribe, err := broadcaster.Subscribe(1)
+	require.NoError(t, err)
+
+	require.NoError(t, broadcaster.Close())
+
+	_, ok := <-notifications
+	require.False(t, ok)
+
+	unsubscribe()
+	broadcaster.Publish(entities.Notification{ID: 1, UserID: 1})
+
+	_, _, err = broadcaster.Subscribe(1)
+	require.ErrorAs(t, err, new(*customerrors.NotificationsStreamClosedError))
+}
@@ -0,0 +1,71 @@
+package cleaners
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/DKhorkov/libs/logging"
+	"github.com/DKhorkov/libs/tracing"
+
+	"github.com/DKhorkov/hmtm-notifications/internal/config"
+	"github.com/DKhorkov/hmtm-notifications/internal/interfaces"
+	"github.com/DKhorkov/hmtm-notifications/internal/runners"
+)
+
+// ProcessedMessagesCleaner periodically deletes processed messages, which lifetime has expired,
+// so deduplication store does not grow infinitely.
+type ProcessedMessagesCleaner struct {
+	*runners.PeriodicRunner
+
+	processedMessagesService interfaces.ProcessedMessagesService
+	config                   config.CleanerConfig
+	traceProvider            tracing.Provider
+	spanConfig               tracing.SpanConfig
+	logger                   logging.Logger
+}
+
+func NewProcessedMessagesCleaner(
+	processedMessagesService interfaces.ProcessedMessagesService,
+	config config.CleanerConfig,
+	traceProvider tracing.Provider,
+	spanConfig tracing.SpanConfig,
+	logger logging.Logger,
+) *ProcessedMessagesCleaner {
+	cleaner := &ProcessedMessagesCleaner{
+		processedMessagesService: processedMessagesService,
+		config:                   config,
+		traceProvider:            traceProvider,
+		spanConfig:               spanConfig,
+		logger:                   logger,
+	}
+
+	cleaner.PeriodicRunner = runners.NewPeriodicRunner(config.Interval, cleaner.clean)
+
+	return cleaner
+}
+
+func (c *ProcessedMessagesCleaner) clean(ctx context.Context) {
+	ctx, span := c.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(c.spanConfig.Events.Start.Name, c.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(c.spanConfig.Events.End.Name, c.spanConfig.Events.End.Opts...)
+
+	now := time.Now().UTC()
+
+	deleted, err := c.processedMessagesService.DeleteExpiredProcessedMessages(
+		ctx,
+		now.Add(-c.config.TTL),
+		now.Add(-c.config.ReservationTimeout),
+	)
+	if err != nil {
+		logging.LogErrorContext(ctx, c.logger, "Failed to delete expired processed messages", err)
+
+		return
+	}
+
+	if deleted > 0 {
+		logging.LogInfo(c.logger, fmt.Sprintf("Deleted %d expired processed messages", deleted))
+	}
+}
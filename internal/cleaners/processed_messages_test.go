@@ -0,0 +1,153 @@
+package cleaners
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	mocklogging "github.com/DKhorkov/libs/logging/mocks"
+	"github.com/DKhorkov/libs/tracing"
+	mocktracing "github.com/DKhorkov/libs/tracing/mocks"
+
+	"github.com/DKhorkov/hmtm-notifications/internal/config"
+	"github.com/DKhorkov/hmtm-notifications/internal/runners"
+	mockservices "github.com/DKhorkov/hmtm-notifications/mocks/services"
+)
+
+var cleanerConfig = config.CleanerConfig{
+	Interval:           time.Hour,
+	TTL:                time.Hour,
+	ReservationTimeout: time.Minute,
+}
+
+func TestProcessedMessagesCleaner_clean(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	processedMessagesService := mockservices.NewMockProcessedMessagesService(ctrl)
+	traceProvider := mocktracing.NewMockProvider(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+	cleaner := NewProcessedMessagesCleaner(
+		processedMessagesService,
+		cleanerConfig,
+		traceProvider,
+		tracing.SpanConfig{},
+		logger,
+	)
+
+	testCases := []struct {
+		name       string
+		setupMocks func(
+			processedMessagesService *mockservices.MockProcessedMessagesService,
+			logger *mocklogging.MockLogger,
+		)
+	}{
+		{
+			name: "expired processed messages deleted",
+			setupMocks: func(
+				processedMessagesService *mockservices.MockProcessedMessagesService,
+				logger *mocklogging.MockLogger,
+			) {
+				processedMessagesService.
+					EXPECT().
+					DeleteExpiredProcessedMessages(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(
+						func(_ context.Context, processedBefore, reservedBefore time.Time) (uint64, error) {
+							require.WithinDuration(t, time.Now().Add(-cleanerConfig.TTL), processedBefore, time.Second)
+							require.WithinDuration(
+								t,
+								time.Now().Add(-cleanerConfig.ReservationTimeout),
+								reservedBefore,
+								time.Second,
+							)
+
+							return 2, nil
+						},
+					).
+					Times(1)
+
+				logger.
+					EXPECT().
+					Info(gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+		},
+		{
+			name: "nothing to delete",
+			setupMocks: func(
+				processedMessagesService *mockservices.MockProcessedMessagesService,
+				_ *mocklogging.MockLogger,
+			) {
+				processedMessagesService.
+					EXPECT().
+					DeleteExpiredProcessedMessages(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(uint64(0), nil).
+					Times(1)
+			},
+		},
+		{
+			name: "delete error",
+			setupMocks: func(
+				processedMessagesService *mockservices.MockProcessedMessagesService,
+				logger *mocklogging.MockLogger,
+			) {
+				processedMessagesService.
+					EXPECT().
+					DeleteExpiredProcessedMessages(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(uint64(0), errors.New("db error")).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			traceProvider.
+				EXPECT().
+				Span(gomock.Any(), gomock.Any()).
+				Return(context.Background(), mocktracing.NewMockSpan()).
+				Times(1)
+
+			tc.setupMocks(processedMessagesService, logger)
+			cleaner.clean(context.Background())
+		})
+	}
+}
+
+func TestProcessedMessagesCleaner_RunAndStop(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	processedMessagesService := mockservices.NewMockProcessedMessagesService(ctrl)
+	traceProvider := mocktracing.NewMockProvider(ctrl)
+	cleaner := NewProcessedMessagesCleaner(
+		processedMessagesService,
+		cleanerConfig,
+		traceProvider,
+		tracing.SpanConfig{},
+		mocklogging.NewMockLogger(ctrl),
+	)
+
+	traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		MaxTimes(1)
+
+	processedMessagesService.
+		EXPECT().
+		DeleteExpiredProcessedMessages(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(uint64(0), nil).
+		MaxTimes(1)
+
+	require.ErrorIs(t, cleaner.Stop(), runners.ErrRunnerAlreadyStopped)
+	require.NoError(t, cleaner.Run())
+	require.ErrorIs(t, cleaner.Run(), runners.ErrRunnerAlreadyRunning)
+	require.NoError(t, cleaner.Stop())
+	require.ErrorIs(t, cleaner.Stop(), runners.ErrRunnerAlreadyStopped)
+}
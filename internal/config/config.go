@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/DKhorkov/libs/db"
@@ -10,12 +11,17 @@ import (
 	"github.com/DKhorkov/libs/tracing"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
+
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
 )
 
 func New() Config {
 	return Config{
 		Environment: loadenv.GetEnv("ENVIRONMENT", "local"),
 		Version:     loadenv.GetEnv("VERSION", "latest"),
+		ShutdownTimeout: time.Second * time.Duration(
+			loadenv.GetEnvAsInt("SHUTDOWN_TIMEOUT", 30),
+		),
 		HTTP: HTTPConfig{
 			Host: loadenv.GetEnv("HOST", "0.0.0.0"),
 			Port: loadenv.GetEnvAsInt("PORT", 8040),
@@ -112,7 +118,177 @@ func New() Config {
 					},
 				},
 				Repositories: SpanRepositories{
-					Emails: tracing.SpanConfig{
+					Communications: tracing.SpanConfig{
+						Opts: []trace.SpanStartOption{
+							trace.WithAttributes(
+								attribute.String(
+									"Environment",
+									loadenv.GetEnv("ENVIRONMENT", "local"),
+								),
+							),
+						},
+						Events: tracing.SpanEventsConfig{
+							Start: tracing.SpanEventConfig{
+								Name: "Calling database",
+								Opts: []trace.EventOption{
+									trace.WithAttributes(
+										attribute.String(
+											"Environment",
+											loadenv.GetEnv("ENVIRONMENT", "local"),
+										),
+									),
+								},
+							},
+							End: tracing.SpanEventConfig{
+								Name: "Received response from database",
+								Opts: []trace.EventOption{
+									trace.WithAttributes(
+										attribute.String(
+											"Environment",
+											loadenv.GetEnv("ENVIRONMENT", "local"),
+										),
+									),
+								},
+							},
+						},
+					},
+					ProcessedMessages: tracing.SpanConfig{
+						Opts: []trace.SpanStartOption{
+							trace.WithAttributes(
+								attribute.String(
+									"Environment",
+									loadenv.GetEnv("ENVIRONMENT", "local"),
+								),
+							),
+						},
+						Events: tracing.SpanEventsConfig{
+							Start: tracing.SpanEventConfig{
+								Name: "Calling database",
+								Opts: []trace.EventOption{
+									trace.WithAttributes(
+										attribute.String(
+											"Environment",
+											loadenv.GetEnv("ENVIRONMENT", "local"),
+										),
+									),
+								},
+							},
+							End: tracing.SpanEventConfig{
+								Name: "Received response from database",
+								Opts: []trace.EventOption{
+									trace.WithAttributes(
+										attribute.String(
+											"Environment",
+											loadenv.GetEnv("ENVIRONMENT", "local"),
+										),
+									),
+								},
+							},
+						},
+					},
+					Notifications: tracing.SpanConfig{
+						Opts: []trace.SpanStartOption{
+							trace.WithAttributes(
+								attribute.String(
+									"Environment",
+									loadenv.GetEnv("ENVIRONMENT", "local"),
+								),
+							),
+						},
+						Events: tracing.SpanEventsConfig{
+							Start: tracing.SpanEventConfig{
+								Name: "Calling database",
+								Opts: []trace.EventOption{
+									trace.WithAttributes(
+										attribute.String(
+											"Environment",
+											loadenv.GetEnv("ENVIRONMENT", "local"),
+										),
+									),
+								},
+							},
+							End: tracing.SpanEventConfig{
+								Name: "Received response from database",
+								Opts: []trace.EventOption{
+									trace.WithAttributes(
+										attribute.String(
+											"Environment",
+											loadenv.GetEnv("ENVIRONMENT", "local"),
+										),
+									),
+								},
+							},
+						},
+					},
+					Webhooks: tracing.SpanConfig{
+						Opts: []trace.SpanStartOption{
+							trace.WithAttributes(
+								attribute.String(
+									"Environment",
+									loadenv.GetEnv("ENVIRONMENT", "local"),
+								),
+							),
+						},
+						Events: tracing.SpanEventsConfig{
+							Start: tracing.SpanEventConfig{
+								Name: "Calling database",
+								Opts: []trace.EventOption{
+									trace.WithAttributes(
+										attribute.String(
+											"Environment",
+											loadenv.GetEnv("ENVIRONMENT", "local"),
+										),
+									),
+								},
+							},
+							End: tracing.SpanEventConfig{
+								Name: "Received response from database",
+								Opts: []trace.EventOption{
+									trace.WithAttributes(
+										attribute.String(
+											"Environment",
+											loadenv.GetEnv("ENVIRONMENT", "local"),
+										),
+									),
+								},
+							},
+						},
+					},
+					Tokens: tracing.SpanConfig{
+						Opts: []trace.SpanStartOption{
+							trace.WithAttributes(
+								attribute.String(
+									"Environment",
+									loadenv.GetEnv("ENVIRONMENT", "local"),
+								),
+							),
+						},
+						Events: tracing.SpanEventsConfig{
+							Start: tracing.SpanEventConfig{
+								Name: "Calling database",
+								Opts: []trace.EventOption{
+									trace.WithAttributes(
+										attribute.String(
+											"Environment",
+											loadenv.GetEnv("ENVIRONMENT", "local"),
+										),
+									),
+								},
+							},
+							End: tracing.SpanEventConfig{
+								Name: "Received response from database",
+								Opts: []trace.EventOption{
+									trace.WithAttributes(
+										attribute.String(
+											"Environment",
+											loadenv.GetEnv("ENVIRONMENT", "local"),
+										),
+									),
+								},
+							},
+						},
+					},
+					Templates: tracing.SpanConfig{
 						Opts: []trace.SpanStartOption{
 							trace.WithAttributes(
 								attribute.String(
@@ -424,12 +600,268 @@ func New() Config {
 							},
 						},
 					},
+					Telegram: tracing.SpanConfig{
+						Opts: []trace.SpanStartOption{
+							trace.WithAttributes(
+								attribute.String(
+									"Environment",
+									loadenv.GetEnv("ENVIRONMENT", "local"),
+								),
+							),
+						},
+						Events: tracing.SpanEventsConfig{
+							Start: tracing.SpanEventConfig{
+								Name: "Sending telegram message",
+								Opts: []trace.EventOption{
+									trace.WithAttributes(
+										attribute.String(
+											"Environment",
+											loadenv.GetEnv("ENVIRONMENT", "local"),
+										),
+									),
+								},
+							},
+							End: tracing.SpanEventConfig{
+								Name: "Sent telegram message",
+								Opts: []trace.EventOption{
+									trace.WithAttributes(
+										attribute.String(
+											"Environment",
+											loadenv.GetEnv("ENVIRONMENT", "local"),
+										),
+									),
+								},
+							},
+						},
+					},
+					SMS: tracing.SpanConfig{
+						Opts: []trace.SpanStartOption{
+							trace.WithAttributes(
+								attribute.String(
+									"Environment",
+									loadenv.GetEnv("ENVIRONMENT", "local"),
+								),
+							),
+						},
+						Events: tracing.SpanEventsConfig{
+							Start: tracing.SpanEventConfig{
+								Name: "Sending sms message",
+								Opts: []trace.EventOption{
+									trace.WithAttributes(
+										attribute.String(
+											"Environment",
+											loadenv.GetEnv("ENVIRONMENT", "local"),
+										),
+									),
+								},
+							},
+							End: tracing.SpanEventConfig{
+								Name: "Sent sms message",
+								Opts: []trace.EventOption{
+									trace.WithAttributes(
+										attribute.String(
+											"Environment",
+											loadenv.GetEnv("ENVIRONMENT", "local"),
+										),
+									),
+								},
+							},
+						},
+					},
+					Webhook: tracing.SpanConfig{
+						Opts: []trace.SpanStartOption{
+							trace.WithAttributes(
+								attribute.String(
+									"Environment",
+									loadenv.GetEnv("ENVIRONMENT", "local"),
+								),
+							),
+						},
+						Events: tracing.SpanEventsConfig{
+							Start: tracing.SpanEventConfig{
+								Name: "Sending webhook event",
+								Opts: []trace.EventOption{
+									trace.WithAttributes(
+										attribute.String(
+											"Environment",
+											loadenv.GetEnv("ENVIRONMENT", "local"),
+										),
+									),
+								},
+							},
+							End: tracing.SpanEventConfig{
+								Name: "Sent webhook event",
+								Opts: []trace.EventOption{
+									trace.WithAttributes(
+										attribute.String(
+											"Environment",
+											loadenv.GetEnv("ENVIRONMENT", "local"),
+										),
+									),
+								},
+							},
+						},
+					},
+				},
+				Dispatchers: SpanDispatchers{
+					Communications: tracing.SpanConfig{
+						Opts: []trace.SpanStartOption{
+							trace.WithAttributes(
+								attribute.String(
+									"Environment",
+									loadenv.GetEnv("ENVIRONMENT", "local"),
+								),
+							),
+						},
+						Events: tracing.SpanEventsConfig{
+							Start: tracing.SpanEventConfig{
+								Name: "Dispatching communication",
+								Opts: []trace.EventOption{
+									trace.WithAttributes(
+										attribute.String(
+											"Environment",
+											loadenv.GetEnv("ENVIRONMENT", "local"),
+										),
+									),
+								},
+							},
+							End: tracing.SpanEventConfig{
+								Name: "Dispatched communication",
+								Opts: []trace.EventOption{
+									trace.WithAttributes(
+										attribute.String(
+											"Environment",
+											loadenv.GetEnv("ENVIRONMENT", "local"),
+										),
+									),
+								},
+							},
+						},
+					},
+					Webhooks: tracing.SpanConfig{
+						Opts: []trace.SpanStartOption{
+							trace.WithAttributes(
+								attribute.String(
+									"Environment",
+									loadenv.GetEnv("ENVIRONMENT", "local"),
+								),
+							),
+						},
+						Events: tracing.SpanEventsConfig{
+							Start: tracing.SpanEventConfig{
+								Name: "Dispatching webhook delivery",
+								Opts: []trace.EventOption{
+									trace.WithAttributes(
+										attribute.String(
+											"Environment",
+											loadenv.GetEnv("ENVIRONMENT", "local"),
+										),
+									),
+								},
+							},
+							End: tracing.SpanEventConfig{
+								Name: "Dispatched webhook delivery",
+								Opts: []trace.EventOption{
+									trace.WithAttributes(
+										attribute.String(
+											"Environment",
+											loadenv.GetEnv("ENVIRONMENT", "local"),
+										),
+									),
+								},
+							},
+						},
+					},
+				},
+				Cleaners: SpanCleaners{
+					ProcessedMessages: tracing.SpanConfig{
+						Opts: []trace.SpanStartOption{
+							trace.WithAttributes(
+								attribute.String(
+									"Environment",
+									loadenv.GetEnv("ENVIRONMENT", "local"),
+								),
+							),
+						},
+						Events: tracing.SpanEventsConfig{
+							Start: tracing.SpanEventConfig{
+								Name: "Deleting expired processed messages",
+								Opts: []trace.EventOption{
+									trace.WithAttributes(
+										attribute.String(
+											"Environment",
+											loadenv.GetEnv("ENVIRONMENT", "local"),
+										),
+									),
+								},
+							},
+							End: tracing.SpanEventConfig{
+								Name: "Deleted expired processed messages",
+								Opts: []trace.EventOption{
+									trace.WithAttributes(
+										attribute.String(
+											"Environment",
+											loadenv.GetEnv("ENVIRONMENT", "local"),
+										),
+									),
+								},
+							},
+						},
+					},
+				},
+				Reloaders: SpanReloaders{
+					Templates: tracing.SpanConfig{
+						Opts: []trace.SpanStartOption{
+							trace.WithAttributes(
+								attribute.String(
+									"Environment",
+									loadenv.GetEnv("ENVIRONMENT", "local"),
+								),
+							),
+						},
+						Events: tracing.SpanEventsConfig{
+							Start: tracing.SpanEventConfig{
+								Name: "Reloading published templates",
+								Opts: []trace.EventOption{
+									trace.WithAttributes(
+										attribute.String(
+											"Environment",
+											loadenv.GetEnv("ENVIRONMENT", "local"),
+										),
+									),
+								},
+							},
+							End: tracing.SpanEventConfig{
+								Name: "Reloaded published templates",
+								Opts: []trace.EventOption{
+									trace.WithAttributes(
+										attribute.String(
+											"Environment",
+											loadenv.GetEnv("ENVIRONMENT", "local"),
+										),
+									),
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		NATS: NATSConfig{
 			MessageChannelBufferSize: loadenv.GetEnvAsInt("NATS_MESSAGE_CHANNEL_BUFFER_SIZE", 1),
 			GoroutinesPoolSize:       loadenv.GetEnvAsInt("NATS_GOROUTINES_POOL_SIZE", 1),
+			StreamName:               loadenv.GetEnv("NATS_STREAM_NAME", "NOTIFICATIONS"),
+			DeadLetterStreamName: loadenv.GetEnv(
+				"NATS_DEAD_LETTER_STREAM_NAME",
+				"NOTIFICATIONS_DEAD_LETTER",
+			),
+			AckWait: time.Second * time.Duration(
+				loadenv.GetEnvAsInt("NATS_ACK_WAIT", 30),
+			),
+			MaxDeliver: loadenv.GetEnvAsInt("NATS_MAX_DELIVER", 5),
+			NakDelay: time.Second * time.Duration(
+				loadenv.GetEnvAsInt("NATS_NAK_DELAY", 10),
+			),
 			ClientURL: fmt.Sprintf(
 				"nats://%s:%d",
 				loadenv.GetEnv("NATS_HOST", "0.0.0.0"),
@@ -440,6 +872,7 @@ func New() Config {
 				ForgetPassword: loadenv.GetEnv("NATS_FORGET_PASSWORD_SUBJECT", "forget-password"),
 				TicketUpdated:  loadenv.GetEnv("NATS_TICKET_UPDATED_SUBJECT", "ticket-updated"),
 				TicketDeleted:  loadenv.GetEnv("NATS_TICKET_DELETED_SUBJECT", "ticket-deleted"),
+				DeadLetter:     loadenv.GetEnv("NATS_DEAD_LETTER_SUBJECT", "notifications-dead-letter"),
 			},
 			Workers: NATSWorkers{
 				VerifyEmail: NATSWorker{
@@ -459,12 +892,194 @@ func New() Config {
 				},
 			},
 		},
+		UseCases: UseCasesConfig{
+			// Number of recipients of ticket notifications, which are processed concurrently:
+			FanOutConcurrency: loadenv.GetEnvAsInt("FAN_OUT_CONCURRENCY", 10),
+			// Maximum number of missed notifications, which are sent on live notifications stream resumption:
+			StreamResumeLimit: loadenv.GetEnvAsInt("STREAMS_RESUME_LIMIT", 100),
+			// Telegram channel is used by routing policy only if enabled:
+			TelegramEnabled: loadenv.GetEnvAsBool("TELEGRAM_ENABLED", false),
+			// SMS channel is used by routing policy only if enabled:
+			SMSEnabled: loadenv.GetEnvAsBool("SMS_ENABLED", false),
+			// Created notifications are delivered as events to enabled webhooks:
+			WebhooksEnabled: loadenv.GetEnvAsBool("WEBHOOKS_ENABLED", true),
+			// Lifetimes of tokens, sent in verify-email and forget-password links:
+			TokensTTL: TokensTTLConfig{
+				entities.TokenPurposeVerifyEmail: time.Minute * time.Duration(
+					loadenv.GetEnvAsInt("TOKENS_VERIFY_EMAIL_TTL", 1440),
+				),
+				entities.TokenPurposeForgetPassword: time.Minute * time.Duration(
+					loadenv.GetEnvAsInt("TOKENS_FORGET_PASSWORD_TTL", 30),
+				),
+			},
+			// Channels of every notification type in priority order. Next channel is used as fallback,
+			// if communication through previous one has failed:
+			Routing: RoutingConfig{
+				entities.NotificationTypeVerifyEmail: routingChannels(
+					"ROUTING_VERIFY_EMAIL",
+					entities.CommunicationChannelEmail,
+				),
+				entities.NotificationTypeForgetPassword: routingChannels(
+					"ROUTING_FORGET_PASSWORD",
+					entities.CommunicationChannelEmail,
+				),
+				entities.NotificationTypeTicketUpdated: routingChannels(
+					"ROUTING_TICKET_UPDATED",
+					entities.CommunicationChannelTelegram,
+					entities.CommunicationChannelEmail,
+				),
+				entities.NotificationTypeTicketDeleted: routingChannels(
+					"ROUTING_TICKET_DELETED",
+					entities.CommunicationChannelTelegram,
+					entities.CommunicationChannelEmail,
+				),
+			},
+		},
+		Dispatchers: DispatchersConfig{
+			Communications: DispatcherConfig{
+				Interval: time.Second * time.Duration(
+					loadenv.GetEnvAsInt("EMAILS_DISPATCHER_INTERVAL", 5),
+				),
+				BatchSize:   loadenv.GetEnvAsInt("EMAILS_DISPATCHER_BATCH_SIZE", 50),
+				MaxAttempts: loadenv.GetEnvAsInt("EMAILS_DISPATCHER_MAX_ATTEMPTS", 5),
+				RetryBaseDelay: time.Second * time.Duration(
+					loadenv.GetEnvAsInt("EMAILS_DISPATCHER_RETRY_BASE_DELAY", 10),
+				),
+				RetryMaxDelay: time.Second * time.Duration(
+					loadenv.GetEnvAsInt("EMAILS_DISPATCHER_RETRY_MAX_DELAY", 3600),
+				),
+				LeaseTimeout: time.Second * time.Duration(
+					loadenv.GetEnvAsInt("EMAILS_DISPATCHER_LEASE_TIMEOUT", 60),
+				),
+			},
+			Webhooks: DispatcherConfig{
+				Interval: time.Second * time.Duration(
+					loadenv.GetEnvAsInt("WEBHOOKS_DISPATCHER_INTERVAL", 5),
+				),
+				BatchSize:   loadenv.GetEnvAsInt("WEBHOOKS_DISPATCHER_BATCH_SIZE", 50),
+				MaxAttempts: loadenv.GetEnvAsInt("WEBHOOKS_DISPATCHER_MAX_ATTEMPTS", 8),
+				RetryBaseDelay: time.Second * time.Duration(
+					loadenv.GetEnvAsInt("WEBHOOKS_DISPATCHER_RETRY_BASE_DELAY", 30),
+				),
+				RetryMaxDelay: time.Second * time.Duration(
+					loadenv.GetEnvAsInt("WEBHOOKS_DISPATCHER_RETRY_MAX_DELAY", 3600),
+				),
+				// Should be greater than WEBHOOKS_TIMEOUT to prevent claiming by another dispatcher during sending:
+				LeaseTimeout: time.Second * time.Duration(
+					loadenv.GetEnvAsInt("WEBHOOKS_DISPATCHER_LEASE_TIMEOUT", 60),
+				),
+			},
+		},
+		Cleaners: CleanersConfig{
+			ProcessedMessages: CleanerConfig{
+				Interval: time.Minute * time.Duration(
+					loadenv.GetEnvAsInt("PROCESSED_MESSAGES_CLEANER_INTERVAL", 10),
+				),
+				TTL: time.Hour * time.Duration(
+					loadenv.GetEnvAsInt("PROCESSED_MESSAGES_TTL", 72),
+				),
+				ReservationTimeout: time.Minute * time.Duration(
+					loadenv.GetEnvAsInt("PROCESSED_MESSAGES_RESERVATION_TIMEOUT", 30),
+				),
+			},
+		},
+		Reloaders: ReloadersConfig{
+			// Published templates are reloaded by every service instance, so publishing via another instance is
+			// applied not later than after interval:
+			Templates: ReloaderConfig{
+				Interval: time.Second * time.Duration(
+					loadenv.GetEnvAsInt("TEMPLATES_RELOAD_INTERVAL", 60),
+				),
+			},
+		},
+		Streams: StreamsConfig{
+			// Interval of heartbeat messages, which keep idle live notifications streams alive. Default interval
+			// is used, if provided one is not positive:
+			HeartbeatInterval: time.Second * time.Duration(
+				loadenv.GetEnvAsInt("STREAMS_HEARTBEAT_INTERVAL", 15),
+			),
+			// Number of notifications, which could be buffered for slow stream, before it is closed:
+			BufferSize: loadenv.GetEnvAsInt("STREAMS_BUFFER_SIZE", 32),
+			// Core NATS subject, which is used to share created notifications between service instances:
+			Subject: loadenv.GetEnv("NATS_NOTIFICATIONS_CREATED_SUBJECT", "notifications-created"),
+		},
+		Telegram: TelegramConfig{
+			BotToken: loadenv.GetEnv("TELEGRAM_BOT_TOKEN", ""),
+			BaseURL:  loadenv.GetEnv("TELEGRAM_BOT_API_URL", "https://api.telegram.org"),
+			Timeout: time.Second * time.Duration(
+				loadenv.GetEnvAsInt("TELEGRAM_TIMEOUT", 10),
+			),
+		},
+		SMS: SMSConfig{
+			Provider: SMSProviderConfig{
+				URL:    loadenv.GetEnv("SMS_PROVIDER_URL", "http://localhost:8095/messages"),
+				APIKey: loadenv.GetEnv("SMS_PROVIDER_API_KEY", ""),
+				Sender: loadenv.GetEnv("SMS_SENDER_NAME", "HMTM"),
+				Timeout: time.Second * time.Duration(
+					loadenv.GetEnvAsInt("SMS_PROVIDER_TIMEOUT", 10),
+				),
+			},
+			// Maximum number of segments, which single SMS can be split into by operator:
+			MaxSegments: loadenv.GetEnvAsInt("SMS_MAX_SEGMENTS", 2),
+		},
+		Tokens: TokensConfig{
+			// Comma-separated "<id>:<secret>" pairs, starting from the key for signing new tokens. There is no
+			// default key, so service does not start with publicly known secret:
+			SigningKeys: tokenSigningKeys("TOKENS_SIGNING_KEYS"),
+		},
+		Webhooks: WebhooksConfig{
+			Timeout: time.Second * time.Duration(
+				loadenv.GetEnvAsInt("WEBHOOKS_TIMEOUT", 10),
+			),
+			// Number of failed deliveries in a row, after which webhook is disabled:
+			DisableThreshold: loadenv.GetEnvAsInt("WEBHOOKS_DISABLE_THRESHOLD", 5),
+			// Number of rate-limited attempts of delivery, which are not counted as attempts:
+			MaxDeferrals: loadenv.GetEnvAsInt("WEBHOOKS_MAX_DEFERRALS", 10),
+		},
 		Email: EmailConfig{
 			SMTP: SMTPConfig{
 				Host:     loadenv.GetEnv("EMAIL_SMTP_HOST", "smtp.freesmtpservers.com"),
 				Port:     loadenv.GetEnvAsInt("EMAIL_SMTP_PORT", 25),
 				Login:    loadenv.GetEnv("EMAIL_SMTP_LOGIN", "smtp"),
 				Password: loadenv.GetEnv("EMAIL_SMTP_PASSWORD", "smtp"),
+				// Number of additional attempts for transient SMTP failures:
+				RetriesCount: loadenv.GetEnvAsInt("EMAIL_SMTP_RETRIES_COUNT", 3),
+				RetryBaseDelay: time.Second * time.Duration(
+					loadenv.GetEnvAsInt("EMAIL_SMTP_RETRY_BASE_DELAY", 1),
+				),
+				RetryMaxDelay: time.Second * time.Duration(
+					loadenv.GetEnvAsInt("EMAIL_SMTP_RETRY_MAX_DELAY", 10),
+				),
+				// Total time, which can be spent on sending single email, including retries:
+				RetryBudget: time.Second * time.Duration(
+					loadenv.GetEnvAsInt("EMAIL_SMTP_RETRY_BUDGET", 30),
+				),
+				// Maximum number of simultaneously opened SMTP connections:
+				PoolSize: loadenv.GetEnvAsInt("EMAIL_SMTP_POOL_SIZE", 2),
+				// Time, after which idle SMTP connection is closed instead of reusing:
+				IdleTimeout: time.Second * time.Duration(
+					loadenv.GetEnvAsInt("EMAIL_SMTP_IDLE_TIMEOUT", 30),
+				),
+			},
+			RateLimit: EmailRateLimitConfig{
+				Global: RateLimitConfig{
+					Messages: loadenv.GetEnvAsInt("EMAIL_RATE_LIMIT_MESSAGES", 60),
+					Period: time.Second * time.Duration(
+						loadenv.GetEnvAsInt("EMAIL_RATE_LIMIT_PERIOD", 60),
+					),
+					Burst: loadenv.GetEnvAsInt("EMAIL_RATE_LIMIT_BURST", 10),
+				},
+				Recipient: RateLimitConfig{
+					Messages: loadenv.GetEnvAsInt("EMAIL_RECIPIENT_RATE_LIMIT_MESSAGES", 10),
+					Period: time.Second * time.Duration(
+						loadenv.GetEnvAsInt("EMAIL_RECIPIENT_RATE_LIMIT_PERIOD", 3600),
+					),
+					Burst: loadenv.GetEnvAsInt("EMAIL_RECIPIENT_RATE_LIMIT_BURST", 3),
+				},
+				// Should be less than EMAILS_DISPATCHER_LEASE_TIMEOUT to prevent claiming by another dispatcher:
+				MaxDelay: time.Second * time.Duration(
+					loadenv.GetEnvAsInt("EMAIL_RATE_LIMIT_MAX_DELAY", 30),
+				),
 			},
 			VerifyEmailURL: loadenv.GetEnv(
 				"VERIFY_EMAIL_URL",
@@ -509,6 +1124,9 @@ type SpansConfig struct {
 	Clients      SpanClients
 	Handlers     SpanHandlers
 	Senders      SpanSenders
+	Dispatchers  SpanDispatchers
+	Cleaners     SpanCleaners
+	Reloaders    SpanReloaders
 }
 
 type SpanHandlers struct {
@@ -519,11 +1137,32 @@ type SpanHandlers struct {
 }
 
 type SpanSenders struct {
-	Email tracing.SpanConfig
+	Email    tracing.SpanConfig
+	Telegram tracing.SpanConfig
+	SMS      tracing.SpanConfig
+	Webhook  tracing.SpanConfig
+}
+
+type SpanDispatchers struct {
+	Communications tracing.SpanConfig
+	Webhooks       tracing.SpanConfig
+}
+
+type SpanCleaners struct {
+	ProcessedMessages tracing.SpanConfig
+}
+
+type SpanReloaders struct {
+	Templates tracing.SpanConfig
 }
 
 type SpanRepositories struct {
-	Emails tracing.SpanConfig
+	Communications    tracing.SpanConfig
+	ProcessedMessages tracing.SpanConfig
+	Notifications     tracing.SpanConfig
+	Webhooks          tracing.SpanConfig
+	Tokens            tracing.SpanConfig
+	Templates         tracing.SpanConfig
 }
 
 type SpanClients struct {
@@ -536,6 +1175,11 @@ type NATSConfig struct {
 	ClientURL                string
 	MessageChannelBufferSize int
 	GoroutinesPoolSize       int
+	StreamName               string
+	DeadLetterStreamName     string
+	AckWait                  time.Duration
+	MaxDeliver               int
+	NakDelay                 time.Duration
 	Subjects                 NATSSubjects
 	Workers                  NATSWorkers
 }
@@ -545,6 +1189,7 @@ type NATSSubjects struct {
 	ForgetPassword string
 	TicketUpdated  string
 	TicketDeleted  string
+	DeadLetter     string
 }
 
 type NATSWorkers struct {
@@ -558,29 +1203,197 @@ type NATSWorker struct {
 	Name string
 }
 
+type UseCasesConfig struct {
+	FanOutConcurrency int
+	StreamResumeLimit int
+	TelegramEnabled   bool
+	SMSEnabled        bool
+	WebhooksEnabled   bool
+	TokensTTL         TokensTTLConfig
+	Routing           RoutingConfig
+}
+
+// RoutingConfig contains channels of every notification type in priority order.
+type RoutingConfig map[entities.NotificationType][]entities.CommunicationChannel
+
+// TokensTTLConfig contains lifetime of tokens of every purpose.
+type TokensTTLConfig map[entities.TokenPurpose]time.Duration
+
+// TokensConfig describes signing of tokens. The first of SigningKeys is used for signing, while all of them
+// are used for verification, so that key could be rotated without invalidation of issued tokens.
+type TokensConfig struct {
+	SigningKeys []TokenSigningKey
+}
+
+// TokenSigningKey is a secret for HMAC signing of tokens. ID is added to every token to find key for verification.
+type TokenSigningKey struct {
+	ID     string
+	Secret string
+}
+
+type CleanersConfig struct {
+	ProcessedMessages CleanerConfig
+}
+
+// CleanerConfig represents configuration of periodic deletion of expired entities. TTL is a lifetime of
+// processed entity, while ReservationTimeout is a lifetime of entity, which processing was not finished.
+type CleanerConfig struct {
+	Interval           time.Duration
+	TTL                time.Duration
+	ReservationTimeout time.Duration
+}
+
+type ReloadersConfig struct {
+	Templates ReloaderConfig
+}
+
+// ReloaderConfig represents configuration of periodic reloading of data, which is cached in memory.
+type ReloaderConfig struct {
+	Interval time.Duration
+}
+
+type DispatchersConfig struct {
+	Communications DispatcherConfig
+	Webhooks       DispatcherConfig
+}
+
+// DispatcherConfig describes outbox dispatcher. Failed attempts are retried with exponential backoff,
+// starting from RetryBaseDelay and limited by RetryMaxDelay, until MaxAttempts is reached.
+type DispatcherConfig struct {
+	Interval       time.Duration
+	BatchSize      int
+	MaxAttempts    int
+	RetryBaseDelay time.Duration
+	RetryMaxDelay  time.Duration
+	LeaseTimeout   time.Duration
+}
+
 type EmailConfig struct {
 	SMTP              SMTPConfig
+	RateLimit         EmailRateLimitConfig
 	VerifyEmailURL    string
 	ForgetPasswordURL string
 	TicketUpdatedURL  string
 	TicketDeletedURL  string
 }
 
+// StreamsConfig describes live notifications streams.
+type StreamsConfig struct {
+	HeartbeatInterval time.Duration
+	BufferSize        int
+	Subject           string
+}
+
+// TelegramConfig describes Telegram Bot API access. BaseURL could be changed to use local Bot API server.
+type TelegramConfig struct {
+	BotToken string
+	BaseURL  string
+	Timeout  time.Duration
+}
+
+// WebhooksConfig describes delivery of events to webhooks. Timeout limits single delivery attempt.
+type WebhooksConfig struct {
+	Timeout          time.Duration
+	DisableThreshold int
+	MaxDeferrals     int
+}
+
+// SMSConfig describes SMS delivery. Content of every SMS is limited by MaxSegments, since operators
+// charge for every segment of concatenated message.
+type SMSConfig struct {
+	Provider    SMSProviderConfig
+	MaxSegments int
+}
+
+// SMSProviderConfig describes HTTP API of SMS gateway. Sender is a name or number, shown to recipient.
+type SMSProviderConfig struct {
+	URL     string
+	APIKey  string
+	Sender  string
+	Timeout time.Duration
+}
+
+// EmailRateLimitConfig describes limits of outgoing emails for whole SMTP account and for every recipient.
+// Sending over limits is delayed up to MaxDelay and rescheduled, if longer delay is required.
+type EmailRateLimitConfig struct {
+	Global    RateLimitConfig
+	Recipient RateLimitConfig
+	MaxDelay  time.Duration
+}
+
+// RateLimitConfig describes token bucket, which allows Messages per Period with bursts up to Burst messages.
+// Limiting is disabled, if Messages is not positive.
+type RateLimitConfig struct {
+	Messages int
+	Period   time.Duration
+	Burst    int
+}
+
 type SMTPConfig struct {
-	Host     string
-	Port     int
-	Login    string
-	Password string
+	Host           string
+	Port           int
+	Login          string
+	Password       string
+	RetriesCount   int
+	RetryBaseDelay time.Duration
+	RetryMaxDelay  time.Duration
+	RetryBudget    time.Duration
+	PoolSize       int
+	IdleTimeout    time.Duration
 }
 
 type Config struct {
-	HTTP        HTTPConfig
-	Database    db.Config
-	Logging     logging.Config
-	Clients     ClientsConfig
-	Tracing     TracingConfig
-	Environment string
-	Version     string
-	NATS        NATSConfig
-	Email       EmailConfig
+	HTTP            HTTPConfig
+	Database        db.Config
+	Logging         logging.Config
+	Clients         ClientsConfig
+	Tracing         TracingConfig
+	Environment     string
+	Version         string
+	ShutdownTimeout time.Duration
+	NATS            NATSConfig
+	Email           EmailConfig
+	Telegram        TelegramConfig
+	SMS             SMSConfig
+	Webhooks        WebhooksConfig
+	Tokens          TokensConfig
+	Dispatchers     DispatchersConfig
+	Cleaners        CleanersConfig
+	Reloaders       ReloadersConfig
+	UseCases        UseCasesConfig
+	Streams         StreamsConfig
+}
+
+// routingChannels reads comma-separated channels from environment variable or returns default channels.
+func routingChannels(
+	name string,
+	defaultChannels ...entities.CommunicationChannel,
+) []entities.CommunicationChannel {
+	rawChannels := loadenv.GetEnvAsSlice(name, nil, ",")
+	if len(rawChannels) == 0 {
+		return defaultChannels
+	}
+
+	channels := make([]entities.CommunicationChannel, 0, len(rawChannels))
+	for _, rawChannel := range rawChannels {
+		channels = append(channels, entities.CommunicationChannel(strings.TrimSpace(rawChannel)))
+	}
+
+	return channels
+}
+
+// tokenSigningKeys reads comma-separated "<id>:<secret>" pairs from environment variable or returns default keys.
+func tokenSigningKeys(name string, defaultKeys ...TokenSigningKey) []TokenSigningKey {
+	rawKeys := loadenv.GetEnvAsSlice(name, nil, ",")
+	if len(rawKeys) == 0 {
+		return defaultKeys
+	}
+
+	keys := make([]TokenSigningKey, 0, len(rawKeys))
+	for _, rawKey := range rawKeys {
+		id, secret, _ := strings.Cut(strings.TrimSpace(rawKey), ":")
+		keys = append(keys, TokenSigningKey{ID: id, Secret: secret})
+	}
+
+	return keys
 }
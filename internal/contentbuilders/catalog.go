@@ -0,0 +1,113 @@
+package contentbuilders
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	texttemplate "text/template"
+)
+
+// Every locale directory contains catalog with formats and short messages: subjects, SMS and inbox notifications.
+const catalogFileName = "messages.json"
+
+// Keys of messages in catalogs:
+const (
+	verifyEmailSubjectMessage       = "verify_email.subject"
+	verifyEmailInboxTitleMessage    = "verify_email.inbox.title"
+	verifyEmailInboxTextMessage     = "verify_email.inbox.text"
+	forgetPasswordSubjectMessage    = "forget_password.subject"
+	forgetPasswordSMSMessage        = "forget_password.sms"
+	forgetPasswordInboxTitleMessage = "forget_password.inbox.title"
+	forgetPasswordInboxTextMessage  = "forget_password.inbox.text"
+	ticketUpdatedSubjectMessage     = "ticket_updated.subject"
+	ticketUpdatedSMSMessage         = "ticket_updated.sms"
+	ticketUpdatedInboxTitleMessage  = "ticket_updated.inbox.title"
+	ticketUpdatedInboxTextMessage   = "ticket_updated.inbox.text"
+	ticketDeletedSubjectMessage     = "ticket_deleted.subject"
+	ticketDeletedSMSMessage         = "ticket_deleted.sms"
+	ticketDeletedInboxTitleMessage  = "ticket_deleted.inbox.title"
+	ticketDeletedInboxTextMessage   = "ticket_deleted.inbox.text"
+)
+
+// messageKeys must be present in catalog of default locale, while other catalogs may contain only some of them.
+var messageKeys = []string{
+	verifyEmailSubjectMessage,
+	verifyEmailInboxTitleMessage,
+	verifyEmailInboxTextMessage,
+	forgetPasswordSubjectMessage,
+	forgetPasswordSMSMessage,
+	forgetPasswordInboxTitleMessage,
+	forgetPasswordInboxTextMessage,
+	ticketUpdatedSubjectMessage,
+	ticketUpdatedSMSMessage,
+	ticketUpdatedInboxTitleMessage,
+	ticketUpdatedInboxTextMessage,
+	ticketDeletedSubjectMessage,
+	ticketDeletedSMSMessage,
+	ticketDeletedInboxTitleMessage,
+	ticketDeletedInboxTextMessage,
+}
+
+type catalog struct {
+	Format   catalogFormat     `json:"format"`
+	Messages map[string]string `json:"messages"`
+}
+
+// catalogFormat describes formatting of numbers, prices and dates. Currency is a pattern with "{amount}"
+// placeholder and Date is a layout of Go time package.
+type catalogFormat struct {
+	DecimalSeparator string `json:"decimalSeparator"`
+	GroupSeparator   string `json:"groupSeparator"`
+	Currency         string `json:"currency"`
+	Date             string `json:"date"`
+}
+
+func readCatalog(fsys fs.FS, dir string) (*catalog, error) {
+	data, err := fs.ReadFile(fsys, path.Join(dir, catalogFileName))
+	if err != nil {
+		return nil, err
+	}
+
+	var result catalog
+	if err = json.Unmarshal(data, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// parseMessages parses messages of catalog as text templates and validates them with message view models.
+// Unknown keys are reported, since they are mostly misspelled keys, which would be silently ignored otherwise.
+func parseMessages(
+	messages map[string]string,
+	funcs map[string]any,
+) (map[string]*texttemplate.Template, error) {
+	known := make(map[string]bool, len(messageKeys))
+	for _, key := range messageKeys {
+		known[key] = true
+	}
+
+	parsed := make(map[string]*texttemplate.Template, len(messages))
+	for key, message := range messages {
+		if !known[key] {
+			return nil, fmt.Errorf("unknown message %q", key)
+		}
+
+		tmpl, err := texttemplate.New(key).Option("missingkey=error").Funcs(funcs).Parse(message)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %q message: %w", key, err)
+		}
+
+		for _, view := range []messageView{{}, sampleMessageView} {
+			if err = tmpl.Execute(io.Discard, view); err != nil {
+				return nil, fmt.Errorf("invalid %q message: %w", key, err)
+			}
+		}
+
+		parsed[key] = tmpl
+	}
+
+	return parsed, nil
+}
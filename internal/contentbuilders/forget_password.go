@@ -1,46 +1,32 @@
 package contentbuilders
 
 import (
-	"fmt"
-	"strconv"
-
-	"github.com/DKhorkov/libs/security"
-
 	"github.com/DKhorkov/hmtm-notifications/internal/entities"
 )
 
 type ForgetPasswordContentBuilder struct {
+	templates             *Templates
 	forgetPasswordURLBase string
 }
 
-func NewForgetPasswordContentBuilder(forgetPasswordURLBase string) *ForgetPasswordContentBuilder {
+func NewForgetPasswordContentBuilder(templates *Templates, forgetPasswordURLBase string) *ForgetPasswordContentBuilder {
 	return &ForgetPasswordContentBuilder{
+		templates:             templates,
 		forgetPasswordURLBase: forgetPasswordURLBase,
 	}
 }
 
-func (b *ForgetPasswordContentBuilder) Subject() string {
-	return "Восстановление пароля от аккаунта"
+func (b *ForgetPasswordContentBuilder) Subject(user entities.User) string {
+	return must(b.templates.message(user.Locale, forgetPasswordSubjectMessage, messageView{}))
 }
 
-func (b *ForgetPasswordContentBuilder) Body(user entities.User) string {
-	link := fmt.Sprintf(
-		"%s/%s",
-		b.forgetPasswordURLBase,
-		security.RawEncode([]byte(strconv.FormatUint(user.ID, 10))),
-	)
-
-	template := `<p>Добрый день, %s!</p>
-<p>На данный email было запрошено письмо для восстановления забытого пароля.</p>
-<p>Пожалуйста, перейдите по <a href="%s">ссылке</a>, чтобы сменить пароль!</p>
-<p>Если это были не Вы - проигнорируйте данное письмо!</p>
-<p>С уважением,<br>
-команда Handmade Toys Marketplace.</p>
-`
-
-	return fmt.Sprintf(
-		template,
-		user.DisplayName,
-		link,
-	)
+func (b *ForgetPasswordContentBuilder) Body(user entities.User, token string) string {
+	return must(b.templates.renderHTML(
+		user.Locale,
+		forgetPasswordTemplateName,
+		forgetPasswordView{
+			layoutView: layoutView{RecipientName: untrusted(user.DisplayName)},
+			Link:       tokenLink(b.forgetPasswordURLBase, token),
+		},
+	))
 }
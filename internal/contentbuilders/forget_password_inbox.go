@@ -0,0 +1,41 @@
+package contentbuilders
+
+import (
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+)
+
+type ForgetPasswordInboxContentBuilder struct {
+	templates             *Templates
+	forgetPasswordURLBase string
+}
+
+func NewForgetPasswordInboxContentBuilder(
+	templates *Templates,
+	forgetPasswordURLBase string,
+) *ForgetPasswordInboxContentBuilder {
+	return &ForgetPasswordInboxContentBuilder{
+		templates:             templates,
+		forgetPasswordURLBase: forgetPasswordURLBase,
+	}
+}
+
+func (b *ForgetPasswordInboxContentBuilder) Title(user entities.User) string {
+	return must(b.templates.message(user.Locale, forgetPasswordInboxTitleMessage, messageView{}))
+}
+
+func (b *ForgetPasswordInboxContentBuilder) Text(user entities.User) string {
+	return must(b.templates.message(
+		user.Locale,
+		forgetPasswordInboxTextMessage,
+		messageView{
+			RecipientName:  untrusted(user.DisplayName),
+			RecipientEmail: user.Email,
+		},
+	))
+}
+
+// Link leads to page, where user can request a new link. Notification does not contain signed token, since
+// notifications are also delivered to webhooks and notification streams.
+func (b *ForgetPasswordInboxContentBuilder) Link() string {
+	return b.forgetPasswordURLBase
+}
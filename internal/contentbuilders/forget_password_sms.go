@@ -0,0 +1,30 @@
+package contentbuilders
+
+import (
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+)
+
+type ForgetPasswordSMSContentBuilder struct {
+	templates             *Templates
+	forgetPasswordURLBase string
+}
+
+func NewForgetPasswordSMSContentBuilder(
+	templates *Templates,
+	forgetPasswordURLBase string,
+) *ForgetPasswordSMSContentBuilder {
+	return &ForgetPasswordSMSContentBuilder{
+		templates:             templates,
+		forgetPasswordURLBase: forgetPasswordURLBase,
+	}
+}
+
+// Text is not fitted into SMS_MAX_SEGMENTS, unlike other SMS: signed token makes link longer than two segments,
+// and message without link is useless for password recovery.
+func (b *ForgetPasswordSMSContentBuilder) Text(user entities.User, token string) string {
+	return must(b.templates.message(
+		user.Locale,
+		forgetPasswordSMSMessage,
+		messageView{Link: tokenLink(b.forgetPasswordURLBase, token)},
+	))
+}
@@ -9,28 +9,34 @@ import (
 )
 
 func TestForgetPasswordContentBuilder_Subject(t *testing.T) {
-	builder := NewForgetPasswordContentBuilder("http://example.com/forget-password")
+	builder := NewForgetPasswordContentBuilder(newTestTemplates(t), "http://example.com/forget-password")
 
 	testCases := []struct {
 		name     string
+		user     entities.User
 		expected string
 	}{
 		{
 			name:     "default subject",
 			expected: "Восстановление пароля от аккаунта",
 		},
+		{
+			name:     "english subject",
+			user:     entities.User{Locale: "en-US"},
+			expected: "Account password recovery",
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			result := builder.Subject()
+			result := builder.Subject(tc.user)
 			require.Equal(t, tc.expected, result)
 		})
 	}
 }
 
 func TestForgetPasswordContentBuilder_Body(t *testing.T) {
-	builder := NewForgetPasswordContentBuilder("http://example.com/forget-password")
+	builder := NewForgetPasswordContentBuilder(newTestTemplates(t), "http://example.com/forget-password")
 
 	testCases := []struct {
 		name     string
@@ -43,12 +49,20 @@ func TestForgetPasswordContentBuilder_Body(t *testing.T) {
 				ID:          1,
 				DisplayName: "Alice",
 			},
-			expected: `<p>Добрый день, Alice!</p>
+			expected: `<!DOCTYPE html>
+<html lang="ru">
+<head>
+<meta charset="UTF-8">
+</head>
+<body>
+<p>Добрый день, Alice!</p>
 <p>На данный email было запрошено письмо для восстановления забытого пароля.</p>
-<p>Пожалуйста, перейдите по <a href="http://example.com/forget-password/MQ">ссылке</a>, чтобы сменить пароль!</p>
+<p>Пожалуйста, перейдите по <a href="http://example.com/forget-password/2025-05.eyJ1aWQiOjF9.c2lnbmF0dXJl">ссылке</a>, чтобы сменить пароль!</p>
 <p>Если это были не Вы - проигнорируйте данное письмо!</p>
 <p>С уважением,<br>
 команда Handmade Toys Marketplace.</p>
+</body>
+</html>
 `,
 		},
 		{
@@ -57,12 +71,20 @@ func TestForgetPasswordContentBuilder_Body(t *testing.T) {
 				ID:          123,
 				DisplayName: "Bob <Test>",
 			},
-			expected: `<p>Добрый день, Bob <Test>!</p>
+			expected: `<!DOCTYPE html>
+<html lang="ru">
+<head>
+<meta charset="UTF-8">
+</head>
+<body>
+<p>Добрый день, Bob &lt;Test&gt;!</p>
 <p>На данный email было запрошено письмо для восстановления забытого пароля.</p>
-<p>Пожалуйста, перейдите по <a href="http://example.com/forget-password/MTIz">ссылке</a>, чтобы сменить пароль!</p>
+<p>Пожалуйста, перейдите по <a href="http://example.com/forget-password/2025-05.eyJ1aWQiOjF9.c2lnbmF0dXJl">ссылке</a>, чтобы сменить пароль!</p>
 <p>Если это были не Вы - проигнорируйте данное письмо!</p>
 <p>С уважением,<br>
 команда Handmade Toys Marketplace.</p>
+</body>
+</html>
 `,
 		},
 		{
@@ -71,19 +93,27 @@ func TestForgetPasswordContentBuilder_Body(t *testing.T) {
 				ID:          987654321,
 				DisplayName: "Charlie",
 			},
-			expected: `<p>Добрый день, Charlie!</p>
+			expected: `<!DOCTYPE html>
+<html lang="ru">
+<head>
+<meta charset="UTF-8">
+</head>
+<body>
+<p>Добрый день, Charlie!</p>
 <p>На данный email было запрошено письмо для восстановления забытого пароля.</p>
-<p>Пожалуйста, перейдите по <a href="http://example.com/forget-password/OTg3NjU0MzIx">ссылке</a>, чтобы сменить пароль!</p>
+<p>Пожалуйста, перейдите по <a href="http://example.com/forget-password/2025-05.eyJ1aWQiOjF9.c2lnbmF0dXJl">ссылке</a>, чтобы сменить пароль!</p>
 <p>Если это были не Вы - проигнорируйте данное письмо!</p>
 <p>С уважением,<br>
 команда Handmade Toys Marketplace.</p>
+</body>
+</html>
 `,
 		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			result := builder.Body(tc.user)
+			result := builder.Body(tc.user, testToken)
 			require.Equal(t, tc.expected, result)
 		})
 	}
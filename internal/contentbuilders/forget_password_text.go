@@ -0,0 +1,32 @@
+package contentbuilders
+
+import (
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+)
+
+// ForgetPasswordTextContentBuilder builds plain text alternative of forget-password body with link kept in place.
+type ForgetPasswordTextContentBuilder struct {
+	templates             *Templates
+	forgetPasswordURLBase string
+}
+
+func NewForgetPasswordTextContentBuilder(
+	templates *Templates,
+	forgetPasswordURLBase string,
+) *ForgetPasswordTextContentBuilder {
+	return &ForgetPasswordTextContentBuilder{
+		templates:             templates,
+		forgetPasswordURLBase: forgetPasswordURLBase,
+	}
+}
+
+func (b *ForgetPasswordTextContentBuilder) Text(user entities.User, token string) string {
+	return must(b.templates.renderText(
+		user.Locale,
+		forgetPasswordTemplateName,
+		forgetPasswordView{
+			layoutView: layoutView{RecipientName: untrusted(user.DisplayName)},
+			Link:       tokenLink(b.forgetPasswordURLBase, token),
+		},
+	))
+}
@@ -0,0 +1,102 @@
+package contentbuilders
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Placeholder of formatted amount in currency pattern of catalog:
+const currencyAmountPlaceholder = "{amount}"
+
+// formatter formats numbers, prices and dates according to format of locale catalog.
+type formatter struct {
+	decimalSeparator string
+	groupSeparator   string
+	currency         string
+	dateLayout       string
+}
+
+func newFormatter(format catalogFormat) (formatter, error) {
+	if format.DecimalSeparator == "" {
+		return formatter{}, errors.New("decimal separator is not set")
+	}
+
+	if !strings.Contains(format.Currency, currencyAmountPlaceholder) {
+		return formatter{}, fmt.Errorf("currency pattern %q does not contain %s", format.Currency, currencyAmountPlaceholder)
+	}
+
+	if format.Date == "" {
+		return formatter{}, errors.New("date layout is not set")
+	}
+
+	return formatter{
+		decimalSeparator: format.DecimalSeparator,
+		groupSeparator:   format.GroupSeparator,
+		currency:         format.Currency,
+		dateLayout:       format.Date,
+	}, nil
+}
+
+// funcs returns functions, which are available in templates and messages of locale.
+func (f formatter) funcs() map[string]any {
+	return map[string]any{
+		"number": f.number,
+		"price":  f.price,
+		"date":   f.date,
+	}
+}
+
+// number formats integer with digits grouped by thousands.
+func (f formatter) number(value any) string {
+	switch value.(type) {
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return f.group(fmt.Sprintf("%d", value))
+	default:
+		return fmt.Sprint(value)
+	}
+}
+
+// price formats amount with two decimal places according to currency pattern.
+func (f formatter) price(value float32) string {
+	integer, fraction, _ := strings.Cut(strconv.FormatFloat(float64(value), 'f', 2, 32), ".")
+
+	return strings.ReplaceAll(f.currency, currencyAmountPlaceholder, f.group(integer)+f.decimalSeparator+fraction)
+}
+
+func (f formatter) date(value time.Time) string {
+	return value.Format(f.dateLayout)
+}
+
+// group separates thousands of integer, which may have leading minus sign.
+func (f formatter) group(integer string) string {
+	sign, digits := "", integer
+	if strings.HasPrefix(digits, "-") {
+		sign, digits = "-", digits[1:]
+	}
+
+	if f.groupSeparator == "" || len(digits) <= 3 {
+		return sign + digits
+	}
+
+	var builder strings.Builder
+
+	builder.WriteString(sign)
+
+	head := len(digits) % 3
+	if head > 0 {
+		builder.WriteString(digits[:head])
+	}
+
+	for i := head; i < len(digits); i += 3 {
+		if i > 0 {
+			builder.WriteString(f.groupSeparator)
+		}
+
+		builder.WriteString(digits[i : i+3])
+	}
+
+	return builder.String()
+}
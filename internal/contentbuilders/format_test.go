@@ -0,0 +1,104 @@
+package contentbuilders
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewFormatter(t *testing.T) {
+	testCases := []struct {
+		name          string
+		format        catalogFormat
+		errorExpected bool
+	}{
+		{
+			name: "valid format",
+			format: catalogFormat{
+				DecimalSeparator: ".",
+				Currency:         "RUB {amount}",
+				Date:             "Jan 2, 2006",
+			},
+			errorExpected: false,
+		},
+		{
+			name: "missing decimal separator",
+			format: catalogFormat{
+				Currency: "RUB {amount}",
+				Date:     "Jan 2, 2006",
+			},
+			errorExpected: true,
+		},
+		{
+			name: "currency without amount",
+			format: catalogFormat{
+				DecimalSeparator: ".",
+				Currency:         "RUB",
+				Date:             "Jan 2, 2006",
+			},
+			errorExpected: true,
+		},
+		{
+			name: "missing date layout",
+			format: catalogFormat{
+				DecimalSeparator: ".",
+				Currency:         "RUB {amount}",
+			},
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := newFormatter(tc.format)
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestFormatter(t *testing.T) {
+	russian := formatter{
+		decimalSeparator: ",",
+		groupSeparator:   " ",
+		currency:         "{amount} руб.",
+		dateLayout:       "02.01.2006",
+	}
+
+	english := formatter{
+		decimalSeparator: ".",
+		groupSeparator:   ",",
+		currency:         "RUB {amount}",
+		dateLayout:       "Jan 2, 2006",
+	}
+
+	date := time.Date(2025, time.May, 9, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name     string
+		actual   string
+		expected string
+	}{
+		{name: "russian small number", actual: russian.number(uint32(5)), expected: "5"},
+		{name: "russian grouped number", actual: russian.number(1234567), expected: "1 234 567"},
+		{name: "russian negative number", actual: russian.number(int64(-1500)), expected: "-1 500"},
+		{name: "russian price", actual: russian.price(150.75), expected: "150,75 руб."},
+		{name: "russian grouped price", actual: russian.price(12345.5), expected: "12 345,50 руб."},
+		{name: "russian date", actual: russian.date(date), expected: "09.05.2025"},
+		{name: "english grouped number", actual: english.number(uint32(1000)), expected: "1,000"},
+		{name: "english price", actual: english.price(0.5), expected: "RUB 0.50"},
+		{name: "english grouped price", actual: english.price(1234567), expected: "RUB 1,234,567.00"},
+		{name: "english date", actual: english.date(date), expected: "May 9, 2025"},
+		{name: "not integer number", actual: english.number("text"), expected: "text"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.actual)
+		})
+	}
+}
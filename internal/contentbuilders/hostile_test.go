@@ -0,0 +1,187 @@
+package contentbuilders
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DKhorkov/libs/pointers"
+
+	"github.com/DKhorkov/hmtm-notifications/dto"
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+)
+
+// hostileValues are used as display names, ticket names and descriptions to check, that user-controlled data can not
+// inject markup, links, headers or fake lines into content, sent to other users.
+var hostileValues = []string{
+	`<script>alert(1)</script>`,
+	`"><a href="https://evil.example/login">Войти</a>`,
+	`' onmouseover='alert(1)`,
+	`<img src=x onerror=alert(1)>`,
+	`javascript:alert(1)`,
+	"Bob\r\nBcc: victim@example.com",
+	"Alice\n\nПерейдите по ссылке https://evil.example/login",
+	"Mallory\u202Egnp.exe",
+}
+
+func TestContentBuilders_HostileHTML(t *testing.T) {
+	templates := newTestTemplates(t)
+	verifyEmailBuilder := NewVerifyEmailContentBuilder(templates, "http://example.com/verify-email")
+	forgetPasswordBuilder := NewForgetPasswordContentBuilder(templates, "http://example.com/forget-password")
+	ticketUpdatedBuilder := NewTicketUpdatedContentBuilder(templates, "http://example.com/update-ticket")
+	ticketDeletedBuilder := NewTicketDeletedContentBuilder(templates, "http://example.com/delete-ticket")
+
+	for _, value := range hostileValues {
+		user := entities.User{ID: 1, DisplayName: value}
+		ticket := entities.RawTicket{
+			ID:          1,
+			Name:        value,
+			Description: value,
+			Quantity:    1,
+			Price:       pointers.New[float32](1),
+		}
+		ticketData := dto.TicketDeletedDTO{
+			Name:        value,
+			Description: value,
+			Quantity:    1,
+			Price:       pointers.New[float32](1),
+		}
+		changes := &entities.TicketChanges{
+			Before: entities.TicketSnapshot{Name: value, Description: value, Category: value, Tags: []string{value}},
+			After:  entities.TicketSnapshot{Name: "Ticket", Description: "Description", Category: "Category"},
+		}
+
+		bodies := map[string]string{
+			"verify email":           verifyEmailBuilder.Body(user, testToken),
+			"forget password":        forgetPasswordBuilder.Body(user, testToken),
+			"ticket updated":         ticketUpdatedBuilder.Body(ticket, nil, user),
+			"ticket updated changes": ticketUpdatedBuilder.Body(ticket, changes, user),
+			"ticket deleted":         ticketDeletedBuilder.Body(ticketData, user, user),
+		}
+
+		for name, body := range bodies {
+			t.Run(name+"/"+value, func(t *testing.T) {
+				require.NotContains(t, body, "<script")
+				require.NotContains(t, body, "<img")
+				require.NotContains(t, body, `href="https://evil.example`)
+				require.NotContains(t, body, `href="javascript:`)
+				require.NotContains(t, body, "' onmouseover='")
+				require.NotContains(t, body, "\u202E")
+				require.NotContains(t, body, "\nBcc:")
+
+				// The only link of every body is built by content builder:
+				require.Equal(t, 1, strings.Count(body, "<a "))
+			})
+		}
+	}
+}
+
+func TestContentBuilders_HostilePlainText(t *testing.T) {
+	templates := newTestTemplates(t)
+	ticketUpdatedBuilder := NewTicketUpdatedContentBuilder(templates, "http://example.com/update-ticket")
+	ticketDeletedBuilder := NewTicketDeletedContentBuilder(templates, "http://example.com/delete-ticket")
+	verifyEmailTextBuilder := NewVerifyEmailTextContentBuilder(templates, "http://example.com/verify-email")
+	forgetPasswordTextBuilder := NewForgetPasswordTextContentBuilder(templates, "http://example.com/forget-password")
+	forgetPasswordSMSBuilder := NewForgetPasswordSMSContentBuilder(templates, "http://example.com/forget-password")
+	ticketUpdatedSMSBuilder := NewTicketUpdatedSMSContentBuilder(templates, "http://example.com/update-ticket", 0)
+	ticketDeletedSMSBuilder := NewTicketDeletedSMSContentBuilder(templates, 0)
+	verifyEmailInboxBuilder := NewVerifyEmailInboxContentBuilder(templates, "http://example.com/verify-email")
+	forgetPasswordInboxBuilder := NewForgetPasswordInboxContentBuilder(templates, "http://example.com/forget-password")
+	ticketUpdatedInboxBuilder := NewTicketUpdatedInboxContentBuilder(templates, "http://example.com/update-ticket")
+	ticketDeletedInboxBuilder := NewTicketDeletedInboxContentBuilder(templates, "http://example.com/delete-ticket")
+
+	for _, value := range hostileValues {
+		user := entities.User{ID: 1, DisplayName: value}
+		ticket := entities.RawTicket{ID: 1, Name: value, Description: value}
+		ticketData := dto.TicketDeletedDTO{Name: value, Description: value}
+
+		// Single-line contents must stay single-line, while multi-line ones must not get injected lines:
+		lines := map[string]string{
+			"ticket updated subject":        ticketUpdatedBuilder.Subject(ticket, user),
+			"ticket deleted subject":        ticketDeletedBuilder.Subject(ticketData, user),
+			"forget password sms":           forgetPasswordSMSBuilder.Text(user, testToken),
+			"ticket updated sms":            ticketUpdatedSMSBuilder.Text(ticket, user),
+			"ticket deleted sms":            ticketDeletedSMSBuilder.Text(ticketData, user),
+			"verify email inbox text":       verifyEmailInboxBuilder.Text(user),
+			"forget password inbox text":    forgetPasswordInboxBuilder.Text(user),
+			"ticket updated inbox title":    ticketUpdatedInboxBuilder.Title(ticket, user),
+			"ticket updated inbox text":     ticketUpdatedInboxBuilder.Text(ticket, user),
+			"ticket deleted inbox title":    ticketDeletedInboxBuilder.Title(ticketData, user),
+			"ticket deleted inbox text":     ticketDeletedInboxBuilder.Text(ticketData, user, user),
+			"verify email text greeting":    firstLine(verifyEmailTextBuilder.Text(user, testToken)),
+			"forget password text greeting": firstLine(forgetPasswordTextBuilder.Text(user, testToken)),
+		}
+
+		for name, line := range lines {
+			t.Run(name+"/"+value, func(t *testing.T) {
+				require.NotContains(t, line, "\n")
+				require.NotContains(t, line, "\r")
+				require.NotContains(t, line, "\u202E")
+			})
+		}
+
+		texts := map[string]string{
+			"verify email text":    verifyEmailTextBuilder.Text(user, testToken),
+			"forget password text": forgetPasswordTextBuilder.Text(user, testToken),
+		}
+
+		for name, text := range texts {
+			t.Run(name+"/"+value, func(t *testing.T) {
+				require.NotContains(t, text, "\nBcc:")
+				require.NotContains(t, text, "\nПерейдите по ссылке https://evil.example")
+			})
+		}
+	}
+}
+
+func Test_untrusted(t *testing.T) {
+	testCases := []struct {
+		name     string
+		value    string
+		expected string
+	}{
+		{
+			name:     "plain value",
+			value:    "Teddy Bear",
+			expected: "Teddy Bear",
+		},
+		{
+			name:     "markup is kept for escaping by context",
+			value:    "<b>Teddy</b> & Bear",
+			expected: "<b>Teddy</b> & Bear",
+		},
+		{
+			name:     "line breaks",
+			value:    "Bob\r\nBcc: victim@example.com  ",
+			expected: "Bob Bcc: victim@example.com",
+		},
+		{
+			name:     "control characters",
+			value:    "Te\x00dd\x1by\tBear",
+			expected: "Te dd y Bear",
+		},
+		{
+			name:     "bidirectional formatting characters",
+			value:    "Mallory\u202Egnp.exe\u2066\u200F",
+			expected: "Mallorygnp.exe",
+		},
+		{
+			name:     "surrounding spaces",
+			value:    "  Teddy   Bear  ",
+			expected: "Teddy Bear",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, untrusted(tc.value))
+		})
+	}
+}
+
+func firstLine(text string) string {
+	line, _, _ := strings.Cut(text, "\n")
+
+	return line
+}
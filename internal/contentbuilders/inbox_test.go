@@ -0,0 +1,79 @@
+package contentbuilders
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DKhorkov/hmtm-notifications/dto"
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+)
+
+func TestVerifyEmailInboxContentBuilder(t *testing.T) {
+	builder := NewVerifyEmailInboxContentBuilder(newTestTemplates(t), "http://example.com/verify-email")
+	user := entities.User{ID: 1, DisplayName: "Alice"}
+
+	require.Equal(t, "Подтвердите адрес электронной почты", builder.Title(user))
+	require.Equal(
+		t,
+		"Alice, пожалуйста, подтвердите адрес электронной почты, чтобы пользоваться всеми возможностями маркетплейса.",
+		builder.Text(user),
+	)
+	require.Equal(t, "http://example.com/verify-email", builder.Link())
+}
+
+func TestForgetPasswordInboxContentBuilder(t *testing.T) {
+	builder := NewForgetPasswordInboxContentBuilder(newTestTemplates(t), "http://example.com/forget-password")
+	user := entities.User{ID: 123, DisplayName: "Bob", Email: "bob@example.com"}
+
+	require.Equal(t, "Восстановление пароля", builder.Title(user))
+	require.Equal(
+		t,
+		"Bob, ссылка для восстановления пароля отправлена на адрес bob@example.com.",
+		builder.Text(user),
+	)
+	require.Equal(t, "http://example.com/forget-password", builder.Link())
+}
+
+func TestTicketUpdatedInboxContentBuilder(t *testing.T) {
+	builder := NewTicketUpdatedInboxContentBuilder(newTestTemplates(t), "http://example.com/tickets")
+	ticket := entities.RawTicket{ID: 42, Name: "Teddy Bear"}
+	respondOwner := entities.User{ID: 2}
+
+	require.Equal(t, "Заявка «Teddy Bear» изменена", builder.Title(ticket, respondOwner))
+	require.Equal(
+		t,
+		"Заявка на создание игрушки «Teddy Bear», на которую вы откликнулись, была изменена.",
+		builder.Text(ticket, respondOwner),
+	)
+	require.Equal(t, "http://example.com/tickets/42", builder.Link(ticket))
+}
+
+func TestTicketDeletedInboxContentBuilder(t *testing.T) {
+	builder := NewTicketDeletedInboxContentBuilder(newTestTemplates(t), "http://example.com/users")
+	ticketData := dto.TicketDeletedDTO{Name: "Teddy Bear"}
+	ticketOwner := entities.User{ID: 7, DisplayName: "Alice"}
+	respondOwner := entities.User{ID: 2}
+
+	require.Equal(t, "Заявка «Teddy Bear» удалена", builder.Title(ticketData, respondOwner))
+	require.Equal(
+		t,
+		"Пользователь Alice удалил заявку на создание игрушки «Teddy Bear». Ваш отклик на нее также удален.",
+		builder.Text(ticketData, ticketOwner, respondOwner),
+	)
+	require.Equal(t, "http://example.com/users/7", builder.Link(ticketData, ticketOwner))
+}
+
+func TestTicketDeletedInboxContentBuilder_Locale(t *testing.T) {
+	builder := NewTicketDeletedInboxContentBuilder(newTestTemplates(t), "http://example.com/users")
+	ticketData := dto.TicketDeletedDTO{Name: "Teddy Bear"}
+	ticketOwner := entities.User{ID: 7, DisplayName: "Alice"}
+	respondOwner := entities.User{ID: 2, Locale: "en-GB"}
+
+	require.Equal(t, `Request "Teddy Bear" deleted`, builder.Title(ticketData, respondOwner))
+	require.Equal(
+		t,
+		`User Alice has deleted the toy request "Teddy Bear". Your response to it has been deleted too.`,
+		builder.Text(ticketData, ticketOwner, respondOwner),
+	)
+}
@@ -0,0 +1,190 @@
+package contentbuilders
+
+import (
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"path"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-notifications/internal/errors"
+)
+
+// subjectMessages maps every notification template to catalog message, which is overridden by subject of
+// template, stored in database.
+var subjectMessages = map[string]string{
+	verifyEmailTemplateName:    verifyEmailSubjectMessage,
+	forgetPasswordTemplateName: forgetPasswordSubjectMessage,
+	ticketUpdatedTemplateName:  ticketUpdatedSubjectMessage,
+	ticketDeletedTemplateName:  ticketDeletedSubjectMessage,
+}
+
+// Validate parses template, stored in database, with layout, partials and formatting functions of its locale
+// and executes it with view models of its notification type, as NewTemplates does with embedded templates.
+func (t *Templates) Validate(template entities.Template) error {
+	_, err := t.parseStored(template)
+
+	return err
+}
+
+// SetPublished replaces previously published templates. Invalid templates are skipped and reported by error,
+// so embedded ones are rendered instead of them.
+func (t *Templates) SetPublished(templates []entities.Template) error {
+	published := make(map[string]*localeTemplates)
+
+	var errs []error
+
+	for _, template := range templates {
+		parsed, err := t.parseStored(template)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("template with ID=%d: %w", template.ID, err))
+
+			continue
+		}
+
+		locale, ok := published[template.Locale]
+		if !ok {
+			locale = newLocaleTemplates()
+			published[template.Locale] = locale
+		}
+
+		for name, tmpl := range parsed.html {
+			locale.html[name] = tmpl
+		}
+
+		for name, tmpl := range parsed.text {
+			locale.text[name] = tmpl
+		}
+
+		for key, tmpl := range parsed.messages {
+			locale.messages[key] = tmpl
+		}
+	}
+
+	t.mutex.Lock()
+	t.published = published
+	t.mutex.Unlock()
+
+	return errors.Join(errs...)
+}
+
+// parseStored parses HTML, plain text and subject of template, stored in database. HTML and plain text are
+// bodies of "content" block, while empty plain text and subject are not overridden.
+func (t *Templates) parseStored(template entities.Template) (*localeTemplates, error) {
+	locale, ok := t.locales[template.Locale]
+	if !ok {
+		return nil, &customerrors.InvalidTemplateError{
+			Message: fmt.Sprintf("unknown locale %q", template.Locale),
+		}
+	}
+
+	name := string(template.NotificationType)
+
+	htmlViews, ok := htmlTemplateViews[name]
+	if !ok {
+		return nil, &customerrors.InvalidTemplateError{
+			Message: fmt.Sprintf("unknown notification type %q", template.NotificationType),
+		}
+	}
+
+	if strings.TrimSpace(template.HTML) == "" {
+		return nil, &customerrors.InvalidTemplateError{Message: "html template is empty"}
+	}
+
+	parsed := newLocaleTemplates()
+
+	htmlTmpl, err := t.parseStoredHTML(locale, name, template.HTML)
+	if err == nil {
+		err = validateTemplate(htmlTmpl, htmlViews)
+	}
+
+	if err != nil {
+		return nil, &customerrors.InvalidTemplateError{
+			Message: fmt.Sprintf("invalid %s html template", name),
+			BaseErr: err,
+		}
+	}
+
+	parsed.html[name] = htmlTmpl
+
+	if template.Text != "" {
+		textViews, ok := textTemplateViews[name]
+		if !ok {
+			return nil, &customerrors.InvalidTemplateError{
+				Message: fmt.Sprintf("%s notification has no plain text template", name),
+			}
+		}
+
+		textTmpl, err := t.parseStoredText(locale, name, template.Text)
+		if err == nil {
+			err = validateTemplate(textTmpl, textViews)
+		}
+
+		if err != nil {
+			return nil, &customerrors.InvalidTemplateError{
+				Message: fmt.Sprintf("invalid %s text template", name),
+				BaseErr: err,
+			}
+		}
+
+		parsed.text[name] = textTmpl
+	}
+
+	if template.Subject != "" {
+		messages, err := parseMessages(map[string]string{subjectMessages[name]: template.Subject}, locale.funcs)
+		if err != nil {
+			return nil, &customerrors.InvalidTemplateError{
+				Message: fmt.Sprintf("invalid %s subject", name),
+				BaseErr: err,
+			}
+		}
+
+		parsed.messages = messages
+	}
+
+	return parsed, nil
+}
+
+func (t *Templates) parseStoredHTML(
+	locale *localeTemplates,
+	name string,
+	content string,
+) (*htmltemplate.Template, error) {
+	tmpl, err := htmltemplate.New(name).
+		Option("missingkey=error").
+		Funcs(locale.funcs).
+		ParseFS(t.fsys, layoutPatterns(t.layoutDir(locale, htmlTemplatesDir, "html"), "html")...)
+	if err != nil {
+		return nil, err
+	}
+
+	return tmpl.New(contentTemplateName).Parse(content)
+}
+
+func (t *Templates) parseStoredText(
+	locale *localeTemplates,
+	name string,
+	content string,
+) (*texttemplate.Template, error) {
+	tmpl, err := texttemplate.New(name).
+		Option("missingkey=error").
+		Funcs(locale.funcs).
+		ParseFS(t.fsys, layoutPatterns(t.layoutDir(locale, textTemplatesDir, "txt"), "txt")...)
+	if err != nil {
+		return nil, err
+	}
+
+	return tmpl.New(contentTemplateName).Parse(content)
+}
+
+// layoutDir returns directory with layout and partials of locale or of default locale, if locale has no layout.
+func (t *Templates) layoutDir(locale *localeTemplates, templatesDir, extension string) string {
+	dir := path.Join(locale.dir, templatesDir)
+	if templateExists(t.fsys, dir, layoutTemplateName, extension) {
+		return dir
+	}
+
+	return path.Join(localesDir, defaultLocale, templatesDir)
+}
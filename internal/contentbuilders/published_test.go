@@ -0,0 +1,192 @@
+package contentbuilders
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-notifications/internal/errors"
+)
+
+func TestTemplates_Validate(t *testing.T) {
+	templates, err := newTemplates(newTestLocalesFS(t), newTestLogger(t))
+	require.NoError(t, err)
+
+	valid := entities.Template{
+		NotificationType: entities.NotificationTypeVerifyEmail,
+		Locale:           "en",
+		Subject:          "{{.RecipientName}}",
+		HTML:             "{{.Link}}",
+		Text:             "{{.Link}}",
+	}
+
+	testCases := []struct {
+		name          string
+		modify        func(template *entities.Template)
+		errorExpected bool
+	}{
+		{
+			name:          "valid template",
+			modify:        func(*entities.Template) {},
+			errorExpected: false,
+		},
+		{
+			name: "without subject and plain text",
+			modify: func(template *entities.Template) {
+				template.Subject = ""
+				template.Text = ""
+			},
+			errorExpected: false,
+		},
+		{
+			name: "unknown locale",
+			modify: func(template *entities.Template) {
+				template.Locale = "de"
+			},
+			errorExpected: true,
+		},
+		{
+			name: "unknown notification type",
+			modify: func(template *entities.Template) {
+				template.NotificationType = "unknown"
+			},
+			errorExpected: true,
+		},
+		{
+			name: "empty html",
+			modify: func(template *entities.Template) {
+				template.HTML = " "
+			},
+			errorExpected: true,
+		},
+		{
+			name: "unknown field",
+			modify: func(template *entities.Template) {
+				template.HTML = "{{.Unknown}}"
+			},
+			errorExpected: true,
+		},
+		{
+			name: "syntax error",
+			modify: func(template *entities.Template) {
+				template.Text = "{{.Link}"
+			},
+			errorExpected: true,
+		},
+		{
+			name: "plain text of notification without plain text template",
+			modify: func(template *entities.Template) {
+				template.NotificationType = entities.NotificationTypeTicketDeleted
+				template.HTML = "{{.TicketName}}"
+			},
+			errorExpected: true,
+		},
+		{
+			name: "unknown field in subject",
+			modify: func(template *entities.Template) {
+				template.Subject = "{{.Link}}{{.Token}}"
+			},
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			template := valid
+			tc.modify(&template)
+
+			err := templates.Validate(template)
+			if tc.errorExpected {
+				require.ErrorAs(t, err, new(*customerrors.InvalidTemplateError))
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTemplates_SetPublished(t *testing.T) {
+	templates, err := newTemplates(newTestLocalesFS(t), newTestLogger(t))
+	require.NoError(t, err)
+
+	err = templates.SetPublished(
+		[]entities.Template{
+			{
+				ID:               1,
+				NotificationType: entities.NotificationTypeVerifyEmail,
+				Locale:           "en",
+				Subject:          "Published {{.RecipientName}}",
+				HTML:             "Published {{.Link}}",
+			},
+			{
+				ID:               2,
+				NotificationType: entities.NotificationTypeForgetPassword,
+				Locale:           "en",
+				HTML:             "{{.Unknown}}",
+			},
+		},
+	)
+	require.ErrorAs(t, err, new(*customerrors.InvalidTemplateError))
+	require.ErrorContains(t, err, "ID=2")
+
+	view := verifyEmailView{Link: "Link"}
+
+	// Published template is rendered for its locale only:
+	require.Equal(t, "Published Link", must(templates.renderHTML("en-US", verifyEmailTemplateName, view)))
+	require.Equal(t, "Published ", must(templates.message("en", verifyEmailSubjectMessage, messageView{})))
+	require.Equal(t, "", must(templates.renderHTML("ru", verifyEmailTemplateName, view)))
+
+	// Embedded templates are rendered instead of not published and invalid templates:
+	require.Equal(t, "Link", must(templates.renderText("en", verifyEmailTemplateName, view)))
+	require.Equal(t, "Recipient", must(templates.renderHTML("en", forgetPasswordTemplateName, sampleForgetPasswordView)))
+
+	require.NoError(t, templates.SetPublished(nil))
+	require.Equal(t, "Link", must(templates.renderHTML("en", verifyEmailTemplateName, view)))
+	require.Equal(t, "Subject", must(templates.message("en", verifyEmailSubjectMessage, messageView{})))
+}
+
+func TestTemplates_PublishedFailureFallback(t *testing.T) {
+	logger := newTestLogger(t)
+	templates, err := newTemplates(newTestLocalesFS(t), logger)
+	require.NoError(t, err)
+
+	// Both templates pass validation with sample view models, but fail on data without changed tags or with short
+	// ticket name:
+	err = templates.SetPublished(
+		[]entities.Template{
+			{
+				ID:               1,
+				NotificationType: entities.NotificationTypeTicketUpdated,
+				Locale:           "en",
+				Subject:          "{{if .TicketName}}{{slice .TicketName 3}}{{end}}",
+				HTML:             "{{if .Changes}}{{index .Changes.Tags.Added 0}}{{end}}",
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	logger.
+		EXPECT().
+		Error(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(2)
+
+	view := ticketUpdatedView{
+		layoutView: layoutView{RecipientName: "Bob"},
+		Changes:    &ticketChangesView{Name: &fieldChangeView[string]{Before: "Old", After: "New"}},
+	}
+
+	// Embedded templates of the same locale are rendered instead of failed published ones:
+	content, err := templates.renderHTML("en", ticketUpdatedTemplateName, view)
+	require.NoError(t, err)
+	require.Equal(t, "Bob", content)
+
+	subject, err := templates.message("en", ticketUpdatedSubjectMessage, messageView{TicketName: "ab"})
+	require.NoError(t, err)
+	require.Equal(t, "ab", subject)
+
+	// Published templates are still rendered for data, they do not fail on:
+	view.Changes.Tags = &listChangeView{Added: []string{"Tag"}}
+	require.Equal(t, "BobTag", must(templates.renderHTML("en", ticketUpdatedTemplateName, view)))
+}
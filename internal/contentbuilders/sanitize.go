@@ -0,0 +1,39 @@
+package contentbuilders
+
+import (
+	"strings"
+	"unicode"
+)
+
+// untrusted prepares user-controlled value (display name, ticket name or description) for insertion into content.
+// Markup is escaped by html/template according to context of value in HTML templates, while plain text contexts
+// (subjects, SMS, inbox notifications and plain text emails) do not interpret markup at all. Therefore only
+// characters, which can forge content structure, are removed: line breaks and other control characters, which
+// could inject headers or fake lines, are replaced by spaces, and bidirectional formatting characters, which
+// could visually reorder text, are dropped.
+func untrusted(value string) string {
+	var builder strings.Builder
+
+	builder.Grow(len(value))
+
+	for _, r := range value {
+		switch {
+		case isBidiControl(r):
+			continue
+		case unicode.IsControl(r):
+			builder.WriteRune(' ')
+		default:
+			builder.WriteRune(r)
+		}
+	}
+
+	// Line and paragraph separators are also collapsed, since they are spaces for strings.Fields:
+	return strings.Join(strings.Fields(builder.String()), " ")
+}
+
+// isBidiControl reports whether rune is Unicode bidirectional formatting character.
+func isBidiControl(r rune) bool {
+	return r == '\u061C' || r == '\u200E' || r == '\u200F' ||
+		(r >= '\u202A' && r <= '\u202E') ||
+		(r >= '\u2066' && r <= '\u2069')
+}
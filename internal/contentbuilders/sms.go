@@ -0,0 +1,131 @@
+package contentbuilders
+
+import (
+	"strings"
+	"unicode/utf16"
+)
+
+const (
+	// Lengths of single and concatenated message segments for GSM 03.38 and UCS-2 encodings:
+	smsGSMSegmentLength              = 160
+	smsGSMConcatenatedSegmentLength  = 153
+	smsUCS2SegmentLength             = 70
+	smsUCS2ConcatenatedSegmentLength = 67
+
+	smsEllipsis = "..."
+)
+
+const (
+	smsGSMBasicCharset = "@£$¥èéùìòÇ\nØø\rÅåΔ_ΦΓΛΩΠΨΣΘΞÆæßÉ !\"#¤%&'()*+,-./0123456789:;<=>?" +
+		"¡ABCDEFGHIJKLMNOPQRSTUVWXYZÄÖÑÜ§¿abcdefghijklmnopqrstuvwxyzäöñüà"
+
+	// Characters of GSM 03.38 extension table take two septets:
+	smsGSMExtensionCharset = "\f^{}\\[~]|€"
+)
+
+// SMSSegments returns number of segments, which text will be split into by SMS operator.
+// Text, containing only GSM 03.38 characters, is sent in 7-bit encoding, any other text - in UCS-2.
+func SMSSegments(text string) int {
+	if text == "" {
+		return 0
+	}
+
+	length, isGSM := smsGSMLength(text)
+	if isGSM {
+		if length <= smsGSMSegmentLength {
+			return 1
+		}
+
+		return (length + smsGSMConcatenatedSegmentLength - 1) / smsGSMConcatenatedSegmentLength
+	}
+
+	length = len(utf16.Encode([]rune(text)))
+	if length <= smsUCS2SegmentLength {
+		return 1
+	}
+
+	return (length + smsUCS2ConcatenatedSegmentLength - 1) / smsUCS2ConcatenatedSegmentLength
+}
+
+func smsGSMLength(text string) (int, bool) {
+	var length int
+	for _, char := range text {
+		switch {
+		case strings.ContainsRune(smsGSMBasicCharset, char):
+			length++
+		case strings.ContainsRune(smsGSMExtensionCharset, char):
+			length += 2
+		default:
+			return 0, false
+		}
+	}
+
+	return length, true
+}
+
+// fitSMS builds message with provided value, which fits into maxSegments. If message is too long,
+// value (for example, name of ticket) is shortened by words, so that fixed parts of message, such as links,
+// are kept untouched. If even shortened value does not help, whole message is truncated.
+func fitSMS(maxSegments int, value string, build func(value string) string) string {
+	fits := func(text string) bool {
+		return SMSSegments(text) <= maxSegments
+	}
+
+	if message := build(value); fits(message) {
+		return message
+	}
+
+	shortened := shortenSMSText(
+		value,
+		func(candidate string) bool {
+			return fits(build(candidate))
+		},
+	)
+
+	if shortened != "" {
+		return build(shortened)
+	}
+
+	return truncateSMS(build(value), maxSegments)
+}
+
+// truncateSMS truncates text by words with ellipsis, so that it fits into maxSegments.
+func truncateSMS(text string, maxSegments int) string {
+	if SMSSegments(text) <= maxSegments {
+		return text
+	}
+
+	return shortenSMSText(
+		text,
+		func(candidate string) bool {
+			return SMSSegments(candidate) <= maxSegments
+		},
+	)
+}
+
+// shortenSMSText removes words from the end of text until shortened text with ellipsis fits.
+// Single word, which is too long, is cut by characters. Empty string is returned, if nothing fits.
+func shortenSMSText(text string, fits func(candidate string) bool) string {
+	runes := []rune(strings.TrimSpace(text))
+	for len(runes) > 0 {
+		cut := len(runes) - 1
+		if space := strings.LastIndexAny(string(runes), " \n"); space > 0 {
+			cut = len([]rune(string(runes)[:space]))
+		}
+
+		runes = []rune(strings.TrimRight(string(runes[:cut]), " \n,.;:-"))
+		if len(runes) == 0 {
+			break
+		}
+
+		if candidate := string(runes) + smsEllipsis; fits(candidate) {
+			return candidate
+		}
+	}
+
+	return ""
+}
+
+func normalizeSMSMaxSegments(maxSegments int) int {
+	return max(maxSegments, 1)
+}
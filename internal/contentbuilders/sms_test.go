@@ -0,0 +1,256 @@
+package contentbuilders
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DKhorkov/hmtm-notifications/dto"
+	"github.com/DKhorkov/hmtm-notifications/internal/config"
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+	"github.com/DKhorkov/hmtm-notifications/internal/signers"
+)
+
+func TestSMSSegments(t *testing.T) {
+	testCases := []struct {
+		name     string
+		text     string
+		expected int
+	}{
+		{
+			name:     "empty text",
+			text:     "",
+			expected: 0,
+		},
+		{
+			name:     "short gsm text",
+			text:     "Hello, world!",
+			expected: 1,
+		},
+		{
+			name:     "gsm text of single segment length",
+			text:     strings.Repeat("a", 160),
+			expected: 1,
+		},
+		{
+			name:     "gsm text longer than single segment",
+			text:     strings.Repeat("a", 161),
+			expected: 2,
+		},
+		{
+			name:     "gsm extension characters take two septets",
+			text:     strings.Repeat("{", 81),
+			expected: 2,
+		},
+		{
+			name:     "ucs-2 text of single segment length",
+			text:     strings.Repeat("я", 70),
+			expected: 1,
+		},
+		{
+			name:     "ucs-2 text of two segments length",
+			text:     strings.Repeat("я", 134),
+			expected: 2,
+		},
+		{
+			name:     "ucs-2 text longer than two segments",
+			text:     strings.Repeat("я", 135),
+			expected: 3,
+		},
+		{
+			name:     "single non gsm character switches to ucs-2",
+			text:     strings.Repeat("a", 70) + "я",
+			expected: 2,
+		},
+		{
+			name:     "surrogate pairs take two code units",
+			text:     strings.Repeat("😀", 36),
+			expected: 2,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, SMSSegments(tc.text))
+		})
+	}
+}
+
+func Test_truncateSMS(t *testing.T) {
+	testCases := []struct {
+		name        string
+		text        string
+		maxSegments int
+		expected    string
+	}{
+		{
+			name:        "text fits",
+			text:        "Short message",
+			maxSegments: 1,
+			expected:    "Short message",
+		},
+		{
+			name:        "text is truncated by words",
+			text:        strings.Repeat("word ", 40),
+			maxSegments: 1,
+			expected:    strings.TrimSpace(strings.Repeat("word ", 31)) + "...",
+		},
+		{
+			name:        "single long word is truncated by characters",
+			text:        strings.Repeat("a", 200),
+			maxSegments: 1,
+			expected:    strings.Repeat("a", 157) + "...",
+		},
+		{
+			name:        "trailing punctuation is removed before ellipsis",
+			text:        strings.Repeat("a", 150) + ", bbbbbbbbbbbb",
+			maxSegments: 1,
+			expected:    strings.Repeat("a", 150) + "...",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := truncateSMS(tc.text, tc.maxSegments)
+			require.Equal(t, tc.expected, actual)
+			require.LessOrEqual(t, SMSSegments(actual), tc.maxSegments)
+		})
+	}
+}
+
+func TestForgetPasswordSMSContentBuilder_Text(t *testing.T) {
+	signer, err := signers.NewTokenSigner(
+		config.TokensConfig{
+			SigningKeys: []config.TokenSigningKey{
+				{ID: "2025-05", Secret: "current-tokens-signing-key-0123456789"},
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	signedToken, err := signer.Sign(
+		entities.TokenClaims{
+			UserID:    1234567,
+			Purpose:   entities.TokenPurposeForgetPassword,
+			Nonce:     strings.Repeat("f", 32),
+			ExpiresAt: time.Now().Add(time.Hour),
+		},
+	)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		urlBase  string
+		token    string
+		expected string
+	}{
+		{
+			name:     "success",
+			urlBase:  "http://example.com/forget-password",
+			token:    testToken,
+			expected: "HMTM: для восстановления пароля перейдите по ссылке http://example.com/forget-password/" + testToken,
+		},
+		{
+			name:    "signed token does not fit into two segments and is not cut",
+			urlBase: "http://localhost:8090/sso/forget-password",
+			token:   signedToken,
+			expected: "HMTM: для восстановления пароля перейдите по ссылке http://localhost:8090/sso/forget-password/" +
+				signedToken,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			builder := NewForgetPasswordSMSContentBuilder(newTestTemplates(t), tc.urlBase)
+			actual := builder.Text(entities.User{}, tc.token)
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+
+	// Default SMS_MAX_SEGMENTS would cut the link of real token:
+	builder := NewForgetPasswordSMSContentBuilder(newTestTemplates(t), "http://localhost:8090/sso/forget-password")
+	require.Greater(t, SMSSegments(builder.Text(entities.User{}, signedToken)), 2)
+}
+
+func TestTicketUpdatedSMSContentBuilder_Text(t *testing.T) {
+	testCases := []struct {
+		name         string
+		maxSegments  int
+		ticket       entities.RawTicket
+		respondOwner entities.User
+		expected     string
+	}{
+		{
+			name:        "short ticket name",
+			maxSegments: 2,
+			ticket:      entities.RawTicket{ID: 1, Name: "Teddy Bear"},
+			expected:    "HMTM: заявка «Teddy Bear» была изменена. Подробнее: http://example.com/tickets/1",
+		},
+		{
+			name:         "english recipient",
+			maxSegments:  1,
+			ticket:       entities.RawTicket{ID: 1, Name: "Teddy Bear"},
+			respondOwner: entities.User{Locale: "en"},
+			expected:     `HMTM: request "Teddy Bear" has been changed. Details: http://example.com/tickets/1`,
+		},
+		{
+			name:        "long ticket name is shortened and link is kept",
+			maxSegments: 2,
+			ticket: entities.RawTicket{
+				ID:   1,
+				Name: strings.TrimSpace(strings.Repeat("Большой плюшевый медведь ", 5)),
+			},
+			expected: "HMTM: заявка «Большой плюшевый медведь Большой плюшевый медведь Большой...» была изменена. " +
+				"Подробнее: http://example.com/tickets/1",
+		},
+		{
+			name:        "message is truncated, if shortening of ticket name does not help",
+			maxSegments: 0,
+			ticket:      entities.RawTicket{ID: 1, Name: "Медведь"},
+			expected:    "HMTM: заявка «Медведь» была изменена. Подробнее...",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			builder := NewTicketUpdatedSMSContentBuilder(newTestTemplates(t), "http://example.com/tickets", tc.maxSegments)
+			actual := builder.Text(tc.ticket, tc.respondOwner)
+			require.Equal(t, tc.expected, actual)
+			require.LessOrEqual(t, SMSSegments(actual), max(tc.maxSegments, 1))
+		})
+	}
+}
+
+func TestTicketDeletedSMSContentBuilder_Text(t *testing.T) {
+	testCases := []struct {
+		name        string
+		maxSegments int
+		ticketData  dto.TicketDeletedDTO
+		expected    string
+	}{
+		{
+			name:        "short ticket name",
+			maxSegments: 2,
+			ticketData:  dto.TicketDeletedDTO{Name: "Teddy Bear"},
+			expected:    "HMTM: заявка «Teddy Bear» была удалена, ваш отклик на нее также удален.",
+		},
+		{
+			name:        "long ticket name is shortened",
+			maxSegments: 2,
+			ticketData:  dto.TicketDeletedDTO{Name: strings.Repeat("Медведь", 20)},
+			expected: "HMTM: заявка «" + strings.Repeat("Медведь", 10) + "...» " +
+				"была удалена, ваш отклик на нее также удален.",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			builder := NewTicketDeletedSMSContentBuilder(newTestTemplates(t), tc.maxSegments)
+			actual := builder.Text(tc.ticketData, entities.User{})
+			require.Equal(t, tc.expected, actual)
+			require.LessOrEqual(t, SMSSegments(actual), tc.maxSegments)
+		})
+	}
+}
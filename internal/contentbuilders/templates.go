@@ -0,0 +1,382 @@
+package contentbuilders
+
+import (
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"io"
+	"io/fs"
+	"path"
+	"regexp"
+	"strings"
+	"sync"
+	texttemplate "text/template"
+
+	"github.com/DKhorkov/libs/logging"
+)
+
+// localesFS contains directory of every locale with catalog of messages and formats, layouts, partials and
+// notification templates. Every notification template defines "content" block, which is rendered by "layout"
+// between "header" and "footer" partials.
+//
+//go:embed locales
+var localesFS embed.FS
+
+const (
+	localesDir = "locales"
+
+	// Content is rendered in default locale, if recipient's locale is unknown or is not translated:
+	defaultLocale = "ru"
+
+	layoutTemplateName  = "layout"
+	contentTemplateName = "content"
+
+	htmlTemplatesDir = "html"
+	textTemplatesDir = "text"
+
+	verifyEmailTemplateName    = "verify_email"
+	forgetPasswordTemplateName = "forget_password"
+	ticketUpdatedTemplateName  = "ticket_updated"
+	ticketDeletedTemplateName  = "ticket_deleted"
+)
+
+// Lookups in nil maps of noPublishedTemplates find nothing, so embedded templates are rendered:
+var noPublishedTemplates = &localeTemplates{}
+
+// Locale directories are named by lowercase IETF language tags, such as "en" or "pt-br":
+var localeNameRegexp = regexp.MustCompile(`^[a-z]{2,3}(-[a-z0-9]{2,8})*$`)
+
+// htmlTemplateViews maps every HTML template to view models, which it is validated with on startup.
+// Both empty and filled view models are executed to check fields of optional blocks too.
+var htmlTemplateViews = map[string][]any{
+	verifyEmailTemplateName:    {verifyEmailView{}, sampleVerifyEmailView},
+	forgetPasswordTemplateName: {forgetPasswordView{}, sampleForgetPasswordView},
+	ticketUpdatedTemplateName:  {ticketUpdatedView{}, sampleTicketUpdatedView},
+	ticketDeletedTemplateName:  {ticketDeletedView{}, sampleTicketDeletedView},
+}
+
+// textTemplateViews maps every plain text template to view models, which it is validated with on startup.
+var textTemplateViews = map[string][]any{
+	verifyEmailTemplateName:    {verifyEmailView{}, sampleVerifyEmailView},
+	forgetPasswordTemplateName: {forgetPasswordView{}, sampleForgetPasswordView},
+}
+
+// Templates contains parsed templates and messages of every locale, executed by content builders.
+type Templates struct {
+	fsys    fs.FS
+	locales map[string]*localeTemplates
+
+	// Published templates, stored in database, are rendered instead of embedded templates of the same locale:
+	published map[string]*localeTemplates
+	mutex     *sync.RWMutex
+	logger    logging.Logger
+}
+
+// localeTemplates contains templates and messages of single locale. Templates and messages, which are not
+// translated, are taken from default locale on loading.
+type localeTemplates struct {
+	html     map[string]*htmltemplate.Template
+	text     map[string]*texttemplate.Template
+	messages map[string]*texttemplate.Template
+
+	// Directory and formatting functions of locale are used for parsing templates, stored in database:
+	dir   string
+	funcs map[string]any
+}
+
+func newLocaleTemplates() *localeTemplates {
+	return &localeTemplates{
+		html:     make(map[string]*htmltemplate.Template, len(htmlTemplateViews)),
+		text:     make(map[string]*texttemplate.Template, len(textTemplateViews)),
+		messages: make(map[string]*texttemplate.Template, len(messageKeys)),
+	}
+}
+
+// NewTemplates parses embedded templates and catalogs of all locales and executes every template and message with
+// its view models, so missing template, unknown field or syntax error is reported on startup instead of sending
+// notification. New locale is added by new directory only.
+func NewTemplates(logger logging.Logger) (*Templates, error) {
+	return newTemplates(localesFS, logger)
+}
+
+func newTemplates(fsys fs.FS, logger logging.Logger) (*Templates, error) {
+	entries, err := fs.ReadDir(fsys, localesDir)
+	if err != nil {
+		return nil, err
+	}
+
+	// Default locale must contain all templates and messages, since it is used as fallback for other ones:
+	fallback, err := loadLocale(fsys, defaultLocale, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load %s locale: %w", defaultLocale, err)
+	}
+
+	templates := &Templates{
+		fsys:      fsys,
+		locales:   map[string]*localeTemplates{defaultLocale: fallback},
+		published: make(map[string]*localeTemplates),
+		mutex:     new(sync.RWMutex),
+		logger:    logger,
+	}
+
+	for _, entry := range entries {
+		if !entry.IsDir() || entry.Name() == defaultLocale {
+			continue
+		}
+
+		if !localeNameRegexp.MatchString(entry.Name()) {
+			return nil, fmt.Errorf("invalid locale directory name %q", entry.Name())
+		}
+
+		locale, err := loadLocale(fsys, entry.Name(), fallback)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load %s locale: %w", entry.Name(), err)
+		}
+
+		templates.locales[entry.Name()] = locale
+	}
+
+	return templates, nil
+}
+
+// loadLocale parses catalog and templates of locale with its formatting functions. Templates and messages, which
+// are missing in locale, are taken from fallback locale. Fallback is nil for default locale.
+func loadLocale(fsys fs.FS, name string, fallback *localeTemplates) (*localeTemplates, error) {
+	dir := path.Join(localesDir, name)
+
+	catalog, err := readCatalog(fsys, dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read catalog: %w", err)
+	}
+
+	format, err := newFormatter(catalog.Format)
+	if err != nil {
+		return nil, fmt.Errorf("invalid format: %w", err)
+	}
+
+	funcs := format.funcs()
+
+	messages, err := parseMessages(catalog.Messages, funcs)
+	if err != nil {
+		return nil, err
+	}
+
+	locale := newLocaleTemplates()
+	locale.messages = messages
+	locale.dir = dir
+	locale.funcs = funcs
+
+	for _, key := range messageKeys {
+		if _, ok := locale.messages[key]; ok {
+			continue
+		}
+
+		if fallback == nil {
+			return nil, fmt.Errorf("message %q is missing", key)
+		}
+
+		locale.messages[key] = fallback.messages[key]
+	}
+
+	htmlDir := path.Join(dir, htmlTemplatesDir)
+	for name, views := range htmlTemplateViews {
+		if fallback != nil && !templateExists(fsys, htmlDir, name, "html") {
+			locale.html[name] = fallback.html[name]
+
+			continue
+		}
+
+		tmpl, err := htmltemplate.New(name).
+			Option("missingkey=error").
+			Funcs(funcs).
+			ParseFS(fsys, templatePatterns(htmlDir, name, "html")...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s html template: %w", name, err)
+		}
+
+		if err = validateTemplate(tmpl, views); err != nil {
+			return nil, fmt.Errorf("invalid %s html template: %w", name, err)
+		}
+
+		locale.html[name] = tmpl
+	}
+
+	textDir := path.Join(dir, textTemplatesDir)
+	for name, views := range textTemplateViews {
+		if fallback != nil && !templateExists(fsys, textDir, name, "txt") {
+			locale.text[name] = fallback.text[name]
+
+			continue
+		}
+
+		tmpl, err := texttemplate.New(name).
+			Option("missingkey=error").
+			Funcs(funcs).
+			ParseFS(fsys, templatePatterns(textDir, name, "txt")...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s text template: %w", name, err)
+		}
+
+		if err = validateTemplate(tmpl, views); err != nil {
+			return nil, fmt.Errorf("invalid %s text template: %w", name, err)
+		}
+
+		locale.text[name] = tmpl
+	}
+
+	return locale, nil
+}
+
+// renderHTML executes layout of HTML template of locale with provided view model. Published template, which fails
+// on view model, is logged and embedded template of the same locale is rendered instead of it.
+func (t *Templates) renderHTML(locale, name string, view any) (string, error) {
+	tag := t.resolveLocale(locale)
+	if tmpl, ok := t.publishedFor(tag).html[name]; ok {
+		content, err := render(tmpl, view)
+		if err == nil {
+			return content, nil
+		}
+
+		t.logPublishedFailure(tag, name, err)
+	}
+
+	return render(t.locales[tag].html[name], view)
+}
+
+// renderText executes layout of plain text template of locale with provided view model. Published template, which
+// fails on view model, is logged and embedded template of the same locale is rendered instead of it.
+func (t *Templates) renderText(locale, name string, view any) (string, error) {
+	tag := t.resolveLocale(locale)
+	if tmpl, ok := t.publishedFor(tag).text[name]; ok {
+		content, err := render(tmpl, view)
+		if err == nil {
+			return content, nil
+		}
+
+		t.logPublishedFailure(tag, name, err)
+	}
+
+	return render(t.locales[tag].text[name], view)
+}
+
+// message executes message of locale catalog with provided view model. Published subject, which fails on view
+// model, is logged and embedded message of the same locale is executed instead of it.
+func (t *Templates) message(locale, key string, view messageView) (string, error) {
+	tag := t.resolveLocale(locale)
+	if tmpl, ok := t.publishedFor(tag).messages[key]; ok {
+		content, err := execute(tmpl, view)
+		if err == nil {
+			return content, nil
+		}
+
+		t.logPublishedFailure(tag, key, err)
+	}
+
+	return execute(t.locales[tag].messages[key], view)
+}
+
+// Published templates are validated with sample view models only, so they could still fail on real data:
+func (t *Templates) logPublishedFailure(locale, name string, err error) {
+	logging.LogError(
+		t.logger,
+		fmt.Sprintf("Failed to render published %s template of %s locale, embedded one is rendered instead", name, locale),
+		err,
+	)
+}
+
+// forLocale returns embedded templates of locale, which is IETF language tag, such as "en" or "en-US".
+func (t *Templates) forLocale(locale string) *localeTemplates {
+	return t.locales[t.resolveLocale(locale)]
+}
+
+// resolveLocale returns name of loaded locale for IETF language tag, such as "en" or "en-US". Language is used,
+// if region is not translated, and default locale - if language is not translated.
+func (t *Templates) resolveLocale(locale string) string {
+	tag := strings.ReplaceAll(strings.ToLower(strings.TrimSpace(locale)), "_", "-")
+	for tag != "" {
+		if _, ok := t.locales[tag]; ok {
+			return tag
+		}
+
+		cut := strings.LastIndex(tag, "-")
+		if cut < 0 {
+			break
+		}
+
+		tag = tag[:cut]
+	}
+
+	return defaultLocale
+}
+
+// publishedFor returns published templates of loaded locale. Returned templates could be empty, but never nil.
+func (t *Templates) publishedFor(locale string) *localeTemplates {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	if published, ok := t.published[locale]; ok {
+		return published
+	}
+
+	return noPublishedTemplates
+}
+
+type templateExecutor interface {
+	ExecuteTemplate(w io.Writer, name string, data any) error
+}
+
+func templateExists(fsys fs.FS, dir, name, extension string) bool {
+	_, err := fs.Stat(fsys, fmt.Sprintf("%s/%s.%s", dir, name, extension))
+
+	return err == nil
+}
+
+func templatePatterns(dir, name, extension string) []string {
+	return append(layoutPatterns(dir, extension), fmt.Sprintf("%s/%s.%s", dir, name, extension))
+}
+
+func layoutPatterns(dir, extension string) []string {
+	return []string{
+		fmt.Sprintf("%s/%s.%s", dir, layoutTemplateName, extension),
+		fmt.Sprintf("%s/partials/*.%s", dir, extension),
+	}
+}
+
+func validateTemplate(tmpl templateExecutor, views []any) error {
+	for _, view := range views {
+		if err := tmpl.ExecuteTemplate(io.Discard, layoutTemplateName, view); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func render(tmpl templateExecutor, view any) (string, error) {
+	var builder strings.Builder
+	if err := tmpl.ExecuteTemplate(&builder, layoutTemplateName, view); err != nil {
+		return "", err
+	}
+
+	return builder.String(), nil
+}
+
+func execute(tmpl *texttemplate.Template, view any) (string, error) {
+	var builder strings.Builder
+	if err := tmpl.Execute(&builder, view); err != nil {
+		return "", err
+	}
+
+	return builder.String(), nil
+}
+
+// must returns content of embedded template or message. Every embedded template and message is executed with its
+// view models by NewTemplates, so their failure is a programming error, while failures of published templates are
+// handled by rendering embedded ones.
+func must(content string, err error) string {
+	if err != nil {
+		panic(err)
+	}
+
+	return content
+}
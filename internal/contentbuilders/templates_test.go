@@ -0,0 +1,312 @@
+package contentbuilders
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	mocklogging "github.com/DKhorkov/libs/logging/mocks"
+)
+
+func TestNewTemplates(t *testing.T) {
+	validFS := func() fstest.MapFS {
+		return newTestLocalesFS(t)
+	}
+
+	testCases := []struct {
+		name          string
+		fsys          func() fstest.MapFS
+		errorExpected bool
+	}{
+		{
+			name:          "valid templates",
+			fsys:          validFS,
+			errorExpected: false,
+		},
+		{
+			name: "missing notification template",
+			fsys: func() fstest.MapFS {
+				fsys := validFS()
+				delete(fsys, "locales/ru/html/"+verifyEmailTemplateName+".html")
+
+				return fsys
+			},
+			errorExpected: true,
+		},
+		{
+			name: "missing partial",
+			fsys: func() fstest.MapFS {
+				fsys := validFS()
+				delete(fsys, "locales/ru/text/partials/footer.txt")
+
+				return fsys
+			},
+			errorExpected: true,
+		},
+		{
+			name: "missing partial of translated template",
+			fsys: func() fstest.MapFS {
+				fsys := validFS()
+				delete(fsys, "locales/en/html/partials/header.html")
+
+				return fsys
+			},
+			errorExpected: true,
+		},
+		{
+			name: "unknown field",
+			fsys: func() fstest.MapFS {
+				fsys := validFS()
+				fsys["locales/ru/html/"+verifyEmailTemplateName+".html"] = &fstest.MapFile{
+					Data: []byte(`{{define "content"}}{{.Unknown}}{{end}}`),
+				}
+
+				return fsys
+			},
+			errorExpected: true,
+		},
+		{
+			name: "unknown field in optional block",
+			fsys: func() fstest.MapFS {
+				fsys := validFS()
+				fsys["locales/ru/html/"+ticketUpdatedTemplateName+".html"] = &fstest.MapFile{
+					Data: []byte(`{{define "content"}}{{with .Price}}{{.Unknown}}{{end}}{{end}}`),
+				}
+
+				return fsys
+			},
+			errorExpected: true,
+		},
+		{
+			name: "syntax error",
+			fsys: func() fstest.MapFS {
+				fsys := validFS()
+				fsys["locales/ru/text/"+forgetPasswordTemplateName+".txt"] = &fstest.MapFile{
+					Data: []byte(`{{define "content"}}{{.Link}`),
+				}
+
+				return fsys
+			},
+			errorExpected: true,
+		},
+		{
+			name: "missing default locale",
+			fsys: func() fstest.MapFS {
+				fsys := validFS()
+				for name := range fsys {
+					if strings.HasPrefix(name, "locales/ru/") {
+						delete(fsys, name)
+					}
+				}
+
+				return fsys
+			},
+			errorExpected: true,
+		},
+		{
+			name: "missing catalog",
+			fsys: func() fstest.MapFS {
+				fsys := validFS()
+				delete(fsys, "locales/en/messages.json")
+
+				return fsys
+			},
+			errorExpected: true,
+		},
+		{
+			name: "missing message of default locale",
+			fsys: func() fstest.MapFS {
+				fsys := validFS()
+				fsys["locales/ru/messages.json"] = newTestCatalog(t, map[string]string{verifyEmailSubjectMessage: "Subject"})
+
+				return fsys
+			},
+			errorExpected: true,
+		},
+		{
+			name: "unknown message",
+			fsys: func() fstest.MapFS {
+				fsys := validFS()
+				fsys["locales/en/messages.json"] = newTestCatalog(t, map[string]string{"verify_email.subjet": "Subject"})
+
+				return fsys
+			},
+			errorExpected: true,
+		},
+		{
+			name: "unknown field in message",
+			fsys: func() fstest.MapFS {
+				fsys := validFS()
+				fsys["locales/en/messages.json"] = newTestCatalog(t, map[string]string{verifyEmailSubjectMessage: "{{.Unknown}}"})
+
+				return fsys
+			},
+			errorExpected: true,
+		},
+		{
+			name: "currency without amount",
+			fsys: func() fstest.MapFS {
+				fsys := validFS()
+				fsys["locales/en/messages.json"] = &fstest.MapFile{
+					Data: []byte(`{"format": {"decimalSeparator": ".", "currency": "RUB", "date": "Jan 2, 2006"}}`),
+				}
+
+				return fsys
+			},
+			errorExpected: true,
+		},
+		{
+			name: "invalid locale directory name",
+			fsys: func() fstest.MapFS {
+				fsys := validFS()
+				fsys["locales/English/messages.json"] = fsys["locales/en/messages.json"]
+
+				return fsys
+			},
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := newTemplates(tc.fsys(), newTestLogger(t))
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTemplates_forLocale(t *testing.T) {
+	templates := newTestTemplates(t)
+
+	testCases := []struct {
+		locale   string
+		expected string
+	}{
+		{locale: "en", expected: "en"},
+		{locale: "en-US", expected: "en"},
+		{locale: "EN_gb", expected: "en"},
+		{locale: "ru-RU", expected: "ru"},
+		{locale: "de", expected: defaultLocale},
+		{locale: "", expected: defaultLocale},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.locale, func(t *testing.T) {
+			require.Same(t, templates.locales[tc.expected], templates.forLocale(tc.locale))
+		})
+	}
+}
+
+func TestTemplates_PartialLocale(t *testing.T) {
+	templates, err := newTemplates(newTestLocalesFS(t), newTestLogger(t))
+	require.NoError(t, err)
+
+	// English locale of test file system translates only verify-email templates and subject:
+	require.Equal(t, "Link", must(templates.renderHTML("en", verifyEmailTemplateName, verifyEmailView{Link: "Link"})))
+	require.Equal(t, "Subject", must(templates.message("en", verifyEmailSubjectMessage, messageView{})))
+
+	require.Equal(t, "Recipient", must(templates.renderHTML("en", forgetPasswordTemplateName, sampleForgetPasswordView)))
+	require.Equal(
+		t,
+		"Ticket",
+		must(templates.message("en", ticketDeletedSMSMessage, messageView{TicketName: "Ticket"})),
+	)
+}
+
+func TestNewTemplates_Embedded(t *testing.T) {
+	_, err := NewTemplates(newTestLogger(t))
+	require.NoError(t, err)
+}
+
+// newTestTemplates returns embedded templates, which are used in production.
+func newTestTemplates(t *testing.T) *Templates {
+	t.Helper()
+
+	templates, err := NewTemplates(newTestLogger(t))
+	require.NoError(t, err)
+
+	return templates
+}
+
+// newTestLogger returns logger, which fails test on any logged message.
+func newTestLogger(t *testing.T) *mocklogging.MockLogger {
+	t.Helper()
+
+	return mocklogging.NewMockLogger(gomock.NewController(t))
+}
+
+// Signed token, which is put into verify-email and forget-password links:
+const testToken = "2025-05.eyJ1aWQiOjF9.c2lnbmF0dXJl"
+
+// newTestLocalesFS returns file system with complete default locale and English locale, which translates
+// only verify-email templates and subject.
+func newTestLocalesFS(t *testing.T) fstest.MapFS {
+	t.Helper()
+
+	layout := []byte(`{{define "layout"}}{{template "header" .}}{{template "content" .}}{{template "footer" .}}{{end}}`)
+	header := []byte(`{{define "header"}}{{.RecipientName}}{{end}}`)
+	footer := []byte(`{{define "footer"}}{{end}}`)
+	content := []byte(`{{define "content"}}{{end}}`)
+	linkContent := []byte(`{{define "content"}}{{.Link}}{{end}}`)
+
+	messages := make(map[string]string, len(messageKeys))
+	for _, key := range messageKeys {
+		messages[key] = "{{.TicketName}}"
+	}
+
+	fsys := fstest.MapFS{
+		"locales/ru/messages.json":             newTestCatalog(t, messages),
+		"locales/ru/html/layout.html":          {Data: layout},
+		"locales/ru/html/partials/header.html": {Data: header},
+		"locales/ru/html/partials/footer.html": {Data: footer},
+		"locales/ru/text/layout.txt":           {Data: layout},
+		"locales/ru/text/partials/header.txt":  {Data: header},
+		"locales/ru/text/partials/footer.txt":  {Data: footer},
+		"locales/en/messages.json":             newTestCatalog(t, map[string]string{verifyEmailSubjectMessage: "Subject"}),
+		"locales/en/html/layout.html":          {Data: layout},
+		"locales/en/html/partials/header.html": {Data: header},
+		"locales/en/html/partials/footer.html": {Data: footer},
+		"locales/en/html/verify_email.html":    {Data: linkContent},
+		"locales/en/text/layout.txt":           {Data: layout},
+		"locales/en/text/partials/header.txt":  {Data: header},
+		"locales/en/text/partials/footer.txt":  {Data: footer},
+		"locales/en/text/verify_email.txt":     {Data: linkContent},
+	}
+
+	for name := range htmlTemplateViews {
+		fsys["locales/ru/html/"+name+".html"] = &fstest.MapFile{Data: content}
+	}
+
+	for name := range textTemplateViews {
+		fsys["locales/ru/text/"+name+".txt"] = &fstest.MapFile{Data: content}
+	}
+
+	return fsys
+}
+
+func newTestCatalog(t *testing.T, messages map[string]string) *fstest.MapFile {
+	t.Helper()
+
+	data, err := json.Marshal(
+		catalog{
+			Format: catalogFormat{
+				DecimalSeparator: ",",
+				GroupSeparator:   " ",
+				Currency:         "{amount} руб.",
+				Date:             "02.01.2006",
+			},
+			Messages: messages,
+		},
+	)
+	require.NoError(t, err)
+
+	return &fstest.MapFile{Data: data}
+}
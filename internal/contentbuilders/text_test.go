@@ -0,0 +1,45 @@
+package contentbuilders
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+)
+
+func TestVerifyEmailTextContentBuilder_Text(t *testing.T) {
+	builder := NewVerifyEmailTextContentBuilder(newTestTemplates(t), "http://example.com/verify-email")
+
+	require.Equal(
+		t,
+		`Добрый день, Alice!
+
+Пожалуйста, перейдите по ссылке, чтобы подтвердить адрес электронной почты:
+http://example.com/verify-email/2025-05.eyJ1aWQiOjF9.c2lnbmF0dXJl
+
+С уважением,
+команда Handmade Toys Marketplace.`,
+		builder.Text(entities.User{ID: 1, DisplayName: "Alice"}, testToken),
+	)
+}
+
+func TestForgetPasswordTextContentBuilder_Text(t *testing.T) {
+	builder := NewForgetPasswordTextContentBuilder(newTestTemplates(t), "http://example.com/forget-password")
+
+	require.Equal(
+		t,
+		`Добрый день, Bob!
+
+На данный email было запрошено письмо для восстановления забытого пароля.
+
+Пожалуйста, перейдите по ссылке, чтобы сменить пароль:
+http://example.com/forget-password/2025-05.eyJ1aWQiOjF9.c2lnbmF0dXJl
+
+Если это были не Вы - проигнорируйте данное письмо!
+
+С уважением,
+команда Handmade Toys Marketplace.`,
+		builder.Text(entities.User{ID: 123, DisplayName: "Bob"}, testToken),
+	)
+}
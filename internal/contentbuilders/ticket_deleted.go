@@ -9,20 +9,23 @@ import (
 )
 
 type TicketDeletedContentBuilder struct {
+	templates           *Templates
 	ticketDeleteURLBase string
 }
 
-func NewTicketDeletedContentBuilder(ticketDeleteURLBase string) *TicketDeletedContentBuilder {
+func NewTicketDeletedContentBuilder(templates *Templates, ticketDeleteURLBase string) *TicketDeletedContentBuilder {
 	return &TicketDeletedContentBuilder{
+		templates:           templates,
 		ticketDeleteURLBase: ticketDeleteURLBase,
 	}
 }
 
-func (b *TicketDeletedContentBuilder) Subject(ticketData dto.TicketDeletedDTO) string {
-	return fmt.Sprintf(
-		"Заявка на создание игрушки %s была удалена",
-		ticketData.Name,
-	)
+func (b *TicketDeletedContentBuilder) Subject(ticketData dto.TicketDeletedDTO, respondOwner entities.User) string {
+	return must(b.templates.message(
+		respondOwner.Locale,
+		ticketDeletedSubjectMessage,
+		messageView{TicketName: untrusted(ticketData.Name)},
+	))
 }
 
 func (b *TicketDeletedContentBuilder) Body(
@@ -30,33 +33,21 @@ func (b *TicketDeletedContentBuilder) Body(
 	ticketOwner entities.User,
 	respondOwner entities.User,
 ) string {
-	link := fmt.Sprintf(
-		"%s/%s",
-		b.ticketDeleteURLBase,
-		strconv.FormatUint(ticketOwner.ID, 10),
-	)
-
-	var priceInfo string
-	if ticketData.Price != nil {
-		priceInfo = fmt.Sprintf(" на сумму <b>%.2f руб.</b>", *ticketData.Price)
-	}
-
-	template := `<p>Добрый день, %s!</p>
-<p>Пользователь <a href="%s">%s</a> удалил заявку на создание игрушки <b>%s</b> (<i>%s</i>) 
-в количестве <b>%d шт.</b>%s</p>
-<p>В связи с этим был удален ваш отклик на создание данной игрушки.</p>
-<p>С уважением,<br>
-команда Handmade Toys Marketplace.</p>
-`
-
-	return fmt.Sprintf(
-		template,
-		respondOwner.DisplayName,
-		link,
-		ticketOwner.DisplayName,
-		ticketData.Name,
-		ticketData.Description,
-		ticketData.Quantity,
-		priceInfo,
-	)
+	return must(b.templates.renderHTML(
+		respondOwner.Locale,
+		ticketDeletedTemplateName,
+		ticketDeletedView{
+			layoutView:      layoutView{RecipientName: untrusted(respondOwner.DisplayName)},
+			TicketOwnerName: untrusted(ticketOwner.DisplayName),
+			TicketOwnerLink: fmt.Sprintf(
+				"%s/%s",
+				b.ticketDeleteURLBase,
+				strconv.FormatUint(ticketOwner.ID, 10),
+			),
+			TicketName:        untrusted(ticketData.Name),
+			TicketDescription: untrusted(ticketData.Description),
+			Quantity:          ticketData.Quantity,
+			Price:             ticketData.Price,
+		},
+	))
 }
@@ -0,0 +1,59 @@
+package contentbuilders
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/DKhorkov/hmtm-notifications/dto"
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+)
+
+type TicketDeletedInboxContentBuilder struct {
+	templates           *Templates
+	ticketDeleteURLBase string
+}
+
+func NewTicketDeletedInboxContentBuilder(
+	templates *Templates,
+	ticketDeleteURLBase string,
+) *TicketDeletedInboxContentBuilder {
+	return &TicketDeletedInboxContentBuilder{
+		templates:           templates,
+		ticketDeleteURLBase: ticketDeleteURLBase,
+	}
+}
+
+func (b *TicketDeletedInboxContentBuilder) Title(ticketData dto.TicketDeletedDTO, respondOwner entities.User) string {
+	return must(b.templates.message(
+		respondOwner.Locale,
+		ticketDeletedInboxTitleMessage,
+		messageView{TicketName: untrusted(ticketData.Name)},
+	))
+}
+
+func (b *TicketDeletedInboxContentBuilder) Text(
+	ticketData dto.TicketDeletedDTO,
+	ticketOwner entities.User,
+	respondOwner entities.User,
+) string {
+	return must(b.templates.message(
+		respondOwner.Locale,
+		ticketDeletedInboxTextMessage,
+		messageView{
+			TicketName:      untrusted(ticketData.Name),
+			TicketOwnerName: untrusted(ticketOwner.DisplayName),
+		},
+	))
+}
+
+// Link leads to profile of ticket owner, since deleted ticket is not available anymore.
+func (b *TicketDeletedInboxContentBuilder) Link(
+	ticketData dto.TicketDeletedDTO,
+	ticketOwner entities.User,
+) string {
+	return fmt.Sprintf(
+		"%s/%s",
+		b.ticketDeleteURLBase,
+		strconv.FormatUint(ticketOwner.ID, 10),
+	)
+}
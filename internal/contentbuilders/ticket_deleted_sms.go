@@ -0,0 +1,32 @@
+package contentbuilders
+
+import (
+	"github.com/DKhorkov/hmtm-notifications/dto"
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+)
+
+type TicketDeletedSMSContentBuilder struct {
+	templates   *Templates
+	maxSegments int
+}
+
+func NewTicketDeletedSMSContentBuilder(templates *Templates, maxSegments int) *TicketDeletedSMSContentBuilder {
+	return &TicketDeletedSMSContentBuilder{
+		templates:   templates,
+		maxSegments: normalizeSMSMaxSegments(maxSegments),
+	}
+}
+
+func (b *TicketDeletedSMSContentBuilder) Text(ticketData dto.TicketDeletedDTO, respondOwner entities.User) string {
+	return fitSMS(
+		b.maxSegments,
+		untrusted(ticketData.Name),
+		func(name string) string {
+			return must(b.templates.message(
+				respondOwner.Locale,
+				ticketDeletedSMSMessage,
+				messageView{TicketName: name},
+			))
+		},
+	)
+}
@@ -12,12 +12,13 @@ import (
 )
 
 func TestTicketDeletedContentBuilder_Subject(t *testing.T) {
-	builder := NewTicketDeletedContentBuilder("http://example.com/delete-ticket")
+	builder := NewTicketDeletedContentBuilder(newTestTemplates(t), "http://example.com/delete-ticket")
 
 	testCases := []struct {
-		name       string
-		ticketData dto.TicketDeletedDTO
-		expected   string
+		name         string
+		ticketData   dto.TicketDeletedDTO
+		respondOwner entities.User
+		expected     string
 	}{
 		{
 			name: "basic ticket",
@@ -26,6 +27,14 @@ func TestTicketDeletedContentBuilder_Subject(t *testing.T) {
 			},
 			expected: "Заявка на создание игрушки Teddy Bear была удалена",
 		},
+		{
+			name: "english recipient",
+			ticketData: dto.TicketDeletedDTO{
+				Name: "Teddy Bear",
+			},
+			respondOwner: entities.User{Locale: "en"},
+			expected:     "Toy request Teddy Bear has been deleted",
+		},
 		{
 			name: "ticket with special characters",
 			ticketData: dto.TicketDeletedDTO{
@@ -37,14 +46,14 @@ func TestTicketDeletedContentBuilder_Subject(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			result := builder.Subject(tc.ticketData)
+			result := builder.Subject(tc.ticketData, tc.respondOwner)
 			require.Equal(t, tc.expected, result)
 		})
 	}
 }
 
 func TestTicketDeletedContentBuilder_Body(t *testing.T) {
-	builder := NewTicketDeletedContentBuilder("http://example.com/delete-ticket")
+	builder := NewTicketDeletedContentBuilder(newTestTemplates(t), "http://example.com/delete-ticket")
 
 	testCases := []struct {
 		name         string
@@ -68,12 +77,20 @@ func TestTicketDeletedContentBuilder_Body(t *testing.T) {
 			respondOwner: entities.User{
 				DisplayName: "Bob",
 			},
-			expected: `<p>Добрый день, Bob!</p>
-<p>Пользователь <a href="http://example.com/delete-ticket/1">Alice</a> удалил заявку на создание игрушки <b>Teddy Bear</b> (<i>A soft teddy bear</i>) 
-в количестве <b>5 шт.</b> на сумму <b>150.75 руб.</b></p>
+			expected: `<!DOCTYPE html>
+<html lang="ru">
+<head>
+<meta charset="UTF-8">
+</head>
+<body>
+<p>Добрый день, Bob!</p>
+<p>Пользователь <a href="http://example.com/delete-ticket/1">Alice</a> удалил заявку на создание игрушки
+<b>Teddy Bear</b> (<i>A soft teddy bear</i>) в количестве <b>5 шт.</b> на сумму <b>150,75 руб.</b></p>
 <p>В связи с этим был удален ваш отклик на создание данной игрушки.</p>
 <p>С уважением,<br>
 команда Handmade Toys Marketplace.</p>
+</body>
+</html>
 `,
 		},
 		{
@@ -91,12 +108,20 @@ func TestTicketDeletedContentBuilder_Body(t *testing.T) {
 			respondOwner: entities.User{
 				DisplayName: "Dave",
 			},
-			expected: `<p>Добрый день, Dave!</p>
-<p>Пользователь <a href="http://example.com/delete-ticket/2">Charlie</a> удалил заявку на создание игрушки <b>Wooden Car</b> (<i>A wooden toy car</i>) 
-в количестве <b>1 шт.</b></p>
+			expected: `<!DOCTYPE html>
+<html lang="ru">
+<head>
+<meta charset="UTF-8">
+</head>
+<body>
+<p>Добрый день, Dave!</p>
+<p>Пользователь <a href="http://example.com/delete-ticket/2">Charlie</a> удалил заявку на создание игрушки
+<b>Wooden Car</b> (<i>A wooden toy car</i>) в количестве <b>1 шт.</b></p>
 <p>В связи с этим был удален ваш отклик на создание данной игрушки.</p>
 <p>С уважением,<br>
 команда Handmade Toys Marketplace.</p>
+</body>
+</html>
 `,
 		},
 		{
@@ -114,12 +139,20 @@ func TestTicketDeletedContentBuilder_Body(t *testing.T) {
 			respondOwner: entities.User{
 				DisplayName: "Frank",
 			},
-			expected: `<p>Добрый день, Frank!</p>
-<p>Пользователь <a href="http://example.com/delete-ticket/3">Eve <Test></a> удалил заявку на создание игрушки <b>Super <Toy></b> (<i>Fun & Games</i>) 
-в количестве <b>3 шт.</b> на сумму <b>99.99 руб.</b></p>
+			expected: `<!DOCTYPE html>
+<html lang="ru">
+<head>
+<meta charset="UTF-8">
+</head>
+<body>
+<p>Добрый день, Frank!</p>
+<p>Пользователь <a href="http://example.com/delete-ticket/3">Eve &lt;Test&gt;</a> удалил заявку на создание игрушки
+<b>Super &lt;Toy&gt;</b> (<i>Fun &amp; Games</i>) в количестве <b>3 шт.</b> на сумму <b>99,99 руб.</b></p>
 <p>В связи с этим был удален ваш отклик на создание данной игрушки.</p>
 <p>С уважением,<br>
 команда Handmade Toys Marketplace.</p>
+</body>
+</html>
 `,
 		},
 	}
@@ -2,57 +2,123 @@ package contentbuilders
 
 import (
 	"fmt"
+	"slices"
 	"strconv"
 
 	"github.com/DKhorkov/hmtm-notifications/internal/entities"
 )
 
 type TicketUpdatedContentBuilder struct {
+	templates            *Templates
 	ticketUpdatedURLBase string
 }
 
-func NewTicketUpdatedContentBuilder(ticketUpdatedURLBase string) *TicketUpdatedContentBuilder {
+func NewTicketUpdatedContentBuilder(templates *Templates, ticketUpdatedURLBase string) *TicketUpdatedContentBuilder {
 	return &TicketUpdatedContentBuilder{
+		templates:            templates,
 		ticketUpdatedURLBase: ticketUpdatedURLBase,
 	}
 }
 
-func (b *TicketUpdatedContentBuilder) Subject(ticket entities.RawTicket) string {
-	return fmt.Sprintf(
-		"Заявка на создание игрушки %s была изменена",
-		ticket.Name,
-	)
+func (b *TicketUpdatedContentBuilder) Subject(ticket entities.RawTicket, respondOwner entities.User) string {
+	return must(b.templates.message(
+		respondOwner.Locale,
+		ticketUpdatedSubjectMessage,
+		messageView{TicketName: untrusted(ticket.Name)},
+	))
 }
 
 func (b *TicketUpdatedContentBuilder) Body(
 	ticket entities.RawTicket,
+	changes *entities.TicketChanges,
 	respondOwner entities.User,
 ) string {
-	link := fmt.Sprintf(
-		"%s/%s",
-		b.ticketUpdatedURLBase,
-		strconv.FormatUint(ticket.ID, 10),
-	)
-
-	var priceInfo string
-	if ticket.Price != nil {
-		priceInfo = fmt.Sprintf(" на сумму <b>%.2f руб.</b>", *ticket.Price)
-	}
-
-	template := `<p>Добрый день, %s!</p>
-<p>Заявка на создание игрушки <b>%s</b> (<i>%s</i>) в количестве <b>%d шт.</b>%s была изменена.</p>
-<p>Для большей информации, пожалуйста, перейдите по <a href="%s">ссылке</a>.</p>
-<p>С уважением,<br>
-команда Handmade Toys Marketplace.</p>
-`
-
-	return fmt.Sprintf(
-		template,
-		respondOwner.DisplayName,
-		ticket.Name,
-		ticket.Description,
-		ticket.Quantity,
-		priceInfo,
-		link,
-	)
+	return must(b.templates.renderHTML(
+		respondOwner.Locale,
+		ticketUpdatedTemplateName,
+		ticketUpdatedView{
+			layoutView:        layoutView{RecipientName: untrusted(respondOwner.DisplayName)},
+			TicketName:        untrusted(ticket.Name),
+			TicketDescription: untrusted(ticket.Description),
+			Quantity:          ticket.Quantity,
+			Price:             ticket.Price,
+			Link: fmt.Sprintf(
+				"%s/%s",
+				b.ticketUpdatedURLBase,
+				strconv.FormatUint(ticket.ID, 10),
+			),
+			Changes: newTicketChangesView(changes),
+		},
+	))
+}
+
+// newTicketChangesView compares states of ticket and returns nil, if changes are unknown or nothing was changed,
+// so notification is the same as for publishers, which do not send previous state of ticket.
+func newTicketChangesView(changes *entities.TicketChanges) *ticketChangesView {
+	if changes == nil {
+		return nil
+	}
+
+	before, after := changes.Before, changes.After
+	view := &ticketChangesView{
+		Name:        newStringChangeView(before.Name, after.Name),
+		Description: newStringChangeView(before.Description, after.Description),
+		Category:    newStringChangeView(before.Category, after.Category),
+		Tags:        newListChangeView(before.Tags, after.Tags),
+		Attachments: newListChangeView(before.Attachments, after.Attachments),
+	}
+
+	if !equalPrices(before.Price, after.Price) {
+		view.Price = &fieldChangeView[*float32]{Before: before.Price, After: after.Price}
+	}
+
+	if before.Quantity != after.Quantity {
+		view.Quantity = &fieldChangeView[uint32]{Before: before.Quantity, After: after.Quantity}
+	}
+
+	if *view == (ticketChangesView{}) {
+		return nil
+	}
+
+	return view
+}
+
+func newStringChangeView(before, after string) *fieldChangeView[string] {
+	before, after = untrusted(before), untrusted(after)
+	if before == after {
+		return nil
+	}
+
+	return &fieldChangeView[string]{Before: before, After: after}
+}
+
+// newListChangeView ignores order of items, since only removed and added items are shown.
+func newListChangeView(before, after []string) *listChangeView {
+	var view listChangeView
+
+	for _, item := range before {
+		if !slices.Contains(after, item) {
+			view.Removed = append(view.Removed, untrusted(item))
+		}
+	}
+
+	for _, item := range after {
+		if !slices.Contains(before, item) {
+			view.Added = append(view.Added, untrusted(item))
+		}
+	}
+
+	if view.Removed == nil && view.Added == nil {
+		return nil
+	}
+
+	return &view
+}
+
+func equalPrices(a, b *float32) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
 }
@@ -0,0 +1,47 @@
+package contentbuilders
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+)
+
+type TicketUpdatedInboxContentBuilder struct {
+	templates            *Templates
+	ticketUpdatedURLBase string
+}
+
+func NewTicketUpdatedInboxContentBuilder(
+	templates *Templates,
+	ticketUpdatedURLBase string,
+) *TicketUpdatedInboxContentBuilder {
+	return &TicketUpdatedInboxContentBuilder{
+		templates:            templates,
+		ticketUpdatedURLBase: ticketUpdatedURLBase,
+	}
+}
+
+func (b *TicketUpdatedInboxContentBuilder) Title(ticket entities.RawTicket, respondOwner entities.User) string {
+	return must(b.templates.message(
+		respondOwner.Locale,
+		ticketUpdatedInboxTitleMessage,
+		messageView{TicketName: untrusted(ticket.Name)},
+	))
+}
+
+func (b *TicketUpdatedInboxContentBuilder) Text(ticket entities.RawTicket, respondOwner entities.User) string {
+	return must(b.templates.message(
+		respondOwner.Locale,
+		ticketUpdatedInboxTextMessage,
+		messageView{TicketName: untrusted(ticket.Name)},
+	))
+}
+
+func (b *TicketUpdatedInboxContentBuilder) Link(ticket entities.RawTicket) string {
+	return fmt.Sprintf(
+		"%s/%s",
+		b.ticketUpdatedURLBase,
+		strconv.FormatUint(ticket.ID, 10),
+	)
+}
@@ -0,0 +1,46 @@
+package contentbuilders
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+)
+
+type TicketUpdatedSMSContentBuilder struct {
+	templates            *Templates
+	ticketUpdatedURLBase string
+	maxSegments          int
+}
+
+func NewTicketUpdatedSMSContentBuilder(
+	templates *Templates,
+	ticketUpdatedURLBase string,
+	maxSegments int,
+) *TicketUpdatedSMSContentBuilder {
+	return &TicketUpdatedSMSContentBuilder{
+		templates:            templates,
+		ticketUpdatedURLBase: ticketUpdatedURLBase,
+		maxSegments:          normalizeSMSMaxSegments(maxSegments),
+	}
+}
+
+func (b *TicketUpdatedSMSContentBuilder) Text(ticket entities.RawTicket, respondOwner entities.User) string {
+	link := fmt.Sprintf(
+		"%s/%s",
+		b.ticketUpdatedURLBase,
+		strconv.FormatUint(ticket.ID, 10),
+	)
+
+	return fitSMS(
+		b.maxSegments,
+		untrusted(ticket.Name),
+		func(name string) string {
+			return must(b.templates.message(
+				respondOwner.Locale,
+				ticketUpdatedSMSMessage,
+				messageView{TicketName: name, Link: link},
+			))
+		},
+	)
+}
@@ -11,12 +11,13 @@ import (
 )
 
 func TestTicketUpdatedContentBuilder_Subject(t *testing.T) {
-	builder := NewTicketUpdatedContentBuilder("http://example.com/update-ticket")
+	builder := NewTicketUpdatedContentBuilder(newTestTemplates(t), "http://example.com/update-ticket")
 
 	testCases := []struct {
-		name     string
-		ticket   entities.RawTicket
-		expected string
+		name         string
+		ticket       entities.RawTicket
+		respondOwner entities.User
+		expected     string
 	}{
 		{
 			name: "basic ticket",
@@ -25,6 +26,14 @@ func TestTicketUpdatedContentBuilder_Subject(t *testing.T) {
 			},
 			expected: "Заявка на создание игрушки Teddy Bear была изменена",
 		},
+		{
+			name: "english recipient",
+			ticket: entities.RawTicket{
+				Name: "Teddy Bear",
+			},
+			respondOwner: entities.User{Locale: "en"},
+			expected:     "Toy request Teddy Bear has been changed",
+		},
 		{
 			name: "ticket with special characters",
 			ticket: entities.RawTicket{
@@ -36,18 +45,19 @@ func TestTicketUpdatedContentBuilder_Subject(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			result := builder.Subject(tc.ticket)
+			result := builder.Subject(tc.ticket, tc.respondOwner)
 			require.Equal(t, tc.expected, result)
 		})
 	}
 }
 
 func TestTicketUpdatedContentBuilder_Body(t *testing.T) {
-	builder := NewTicketUpdatedContentBuilder("http://example.com/update-ticket")
+	builder := NewTicketUpdatedContentBuilder(newTestTemplates(t), "http://example.com/update-ticket")
 
 	testCases := []struct {
 		name         string
 		ticket       entities.RawTicket
+		changes      *entities.TicketChanges
 		respondOwner entities.User
 		expected     string
 	}{
@@ -63,11 +73,47 @@ func TestTicketUpdatedContentBuilder_Body(t *testing.T) {
 			respondOwner: entities.User{
 				DisplayName: "Bob",
 			},
-			expected: `<p>Добрый день, Bob!</p>
-<p>Заявка на создание игрушки <b>Teddy Bear</b> (<i>A soft teddy bear</i>) в количестве <b>5 шт.</b> на сумму <b>150.75 руб.</b> была изменена.</p>
+			expected: `<!DOCTYPE html>
+<html lang="ru">
+<head>
+<meta charset="UTF-8">
+</head>
+<body>
+<p>Добрый день, Bob!</p>
+<p>Заявка на создание игрушки <b>Teddy Bear</b> (<i>A soft teddy bear</i>) в количестве <b>5 шт.</b> на сумму <b>150,75 руб.</b> была изменена.</p>
 <p>Для большей информации, пожалуйста, перейдите по <a href="http://example.com/update-ticket/1">ссылке</a>.</p>
 <p>С уважением,<br>
 команда Handmade Toys Marketplace.</p>
+</body>
+</html>
+`,
+		},
+		{
+			name: "english recipient",
+			ticket: entities.RawTicket{
+				ID:          3,
+				Name:        "Rocking Horse",
+				Description: "A big rocking horse",
+				Quantity:    1500,
+				Price:       pointers.New[float32](12345.5),
+			},
+			respondOwner: entities.User{
+				DisplayName: "Carol",
+				Locale:      "en-US",
+			},
+			expected: `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="UTF-8">
+</head>
+<body>
+<p>Hello, Carol!</p>
+<p>The request to create toy <b>Rocking Horse</b> (<i>A big rocking horse</i>) in quantity of <b>1,500 pcs.</b> for <b>RUB 12,345.50</b> has been changed.</p>
+<p>For more information, please follow the <a href="http://example.com/update-ticket/3">link</a>.</p>
+<p>Best regards,<br>
+Handmade Toys Marketplace team.</p>
+</body>
+</html>
 `,
 		},
 		{
@@ -82,11 +128,19 @@ func TestTicketUpdatedContentBuilder_Body(t *testing.T) {
 			respondOwner: entities.User{
 				DisplayName: "Dave",
 			},
-			expected: `<p>Добрый день, Dave!</p>
+			expected: `<!DOCTYPE html>
+<html lang="ru">
+<head>
+<meta charset="UTF-8">
+</head>
+<body>
+<p>Добрый день, Dave!</p>
 <p>Заявка на создание игрушки <b>Wooden Car</b> (<i>A wooden toy car</i>) в количестве <b>1 шт.</b> была изменена.</p>
 <p>Для большей информации, пожалуйста, перейдите по <a href="http://example.com/update-ticket/2">ссылке</a>.</p>
 <p>С уважением,<br>
 команда Handmade Toys Marketplace.</p>
+</body>
+</html>
 `,
 		},
 		{
@@ -101,18 +155,168 @@ func TestTicketUpdatedContentBuilder_Body(t *testing.T) {
 			respondOwner: entities.User{
 				DisplayName: "Frank",
 			},
-			expected: `<p>Добрый день, Frank!</p>
-<p>Заявка на создание игрушки <b>Super <Toy></b> (<i>Fun & Games</i>) в количестве <b>3 шт.</b> на сумму <b>99.99 руб.</b> была изменена.</p>
+			expected: `<!DOCTYPE html>
+<html lang="ru">
+<head>
+<meta charset="UTF-8">
+</head>
+<body>
+<p>Добрый день, Frank!</p>
+<p>Заявка на создание игрушки <b>Super &lt;Toy&gt;</b> (<i>Fun &amp; Games</i>) в количестве <b>3 шт.</b> на сумму <b>99,99 руб.</b> была изменена.</p>
 <p>Для большей информации, пожалуйста, перейдите по <a href="http://example.com/update-ticket/3">ссылке</a>.</p>
 <p>С уважением,<br>
 команда Handmade Toys Marketplace.</p>
+</body>
+</html>
+`,
+		},
+		{
+			name: "changed ticket",
+			ticket: entities.RawTicket{
+				ID:          4,
+				Name:        "Teddy Bear",
+				Description: "A soft teddy bear",
+				Quantity:    2,
+				Price:       pointers.New[float32](200),
+			},
+			changes: &entities.TicketChanges{
+				Before: entities.TicketSnapshot{
+					Name:        "Teddy",
+					Description: "A soft teddy bear",
+					Quantity:    1,
+					Category:    "Plush",
+					Tags:        []string{"soft", "brown"},
+					Attachments: []string{"http://example.com/1.png"},
+				},
+				After: entities.TicketSnapshot{
+					Name:        "Teddy Bear",
+					Description: "A soft teddy bear",
+					Price:       pointers.New[float32](200),
+					Quantity:    2,
+					Category:    "Bears",
+					Tags:        []string{"brown", "big"},
+					Attachments: []string{"http://example.com/1.png", "http://example.com/2.png"},
+				},
+			},
+			respondOwner: entities.User{
+				DisplayName: "Bob",
+			},
+			expected: `<!DOCTYPE html>
+<html lang="ru">
+<head>
+<meta charset="UTF-8">
+</head>
+<body>
+<p>Добрый день, Bob!</p>
+<p>Заявка на создание игрушки <b>Teddy Bear</b> (<i>A soft teddy bear</i>) в количестве <b>2 шт.</b> на сумму <b>200,00 руб.</b> была изменена.</p>
+<p>Что изменилось:</p>
+<ul>
+<li>Название: <s>Teddy</s> → <b>Teddy Bear</b></li>
+<li>Цена: <s>не указана</s> → <b>200,00 руб.</b></li>
+<li>Количество: <s>1 шт.</s> → <b>2 шт.</b></li>
+<li>Категория: <s>Plush</s> → <b>Bears</b></li>
+<li>Теги: удалены <s>soft</s>; добавлены <b>big</b></li>
+<li>Вложения: добавлены <a href="http://example.com/2.png">http://example.com/2.png</a></li>
+</ul>
+<p>Для большей информации, пожалуйста, перейдите по <a href="http://example.com/update-ticket/4">ссылке</a>.</p>
+<p>С уважением,<br>
+команда Handmade Toys Marketplace.</p>
+</body>
+</html>
+`,
+		},
+		{
+			name: "english recipient of changed ticket",
+			ticket: entities.RawTicket{
+				ID:          5,
+				Name:        "Rocking Horse",
+				Description: "A big rocking horse",
+				Quantity:    1,
+			},
+			changes: &entities.TicketChanges{
+				Before: entities.TicketSnapshot{
+					Name:        "Rocking Horse",
+					Description: "A rocking horse",
+					Price:       pointers.New[float32](1500),
+					Quantity:    1,
+					Attachments: []string{"http://example.com/1.png"},
+				},
+				After: entities.TicketSnapshot{
+					Name:        "Rocking Horse",
+					Description: "A big rocking horse",
+					Quantity:    1,
+				},
+			},
+			respondOwner: entities.User{
+				DisplayName: "Carol",
+				Locale:      "en",
+			},
+			expected: `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="UTF-8">
+</head>
+<body>
+<p>Hello, Carol!</p>
+<p>The request to create toy <b>Rocking Horse</b> (<i>A big rocking horse</i>) in quantity of <b>1 pcs.</b> has been changed.</p>
+<p>What has changed:</p>
+<ul>
+<li>Description: <s>A rocking horse</s> → <b>A big rocking horse</b></li>
+<li>Price: <s>RUB 1,500.00</s> → <b>not set</b></li>
+<li>Attachments: removed <s>http://example.com/1.png</s></li>
+</ul>
+<p>For more information, please follow the <a href="http://example.com/update-ticket/5">link</a>.</p>
+<p>Best regards,<br>
+Handmade Toys Marketplace team.</p>
+</body>
+</html>
+`,
+		},
+		{
+			name: "nothing changed",
+			ticket: entities.RawTicket{
+				ID:          2,
+				Name:        "Wooden Car",
+				Description: "A wooden toy car",
+				Quantity:    1,
+			},
+			changes: &entities.TicketChanges{
+				Before: entities.TicketSnapshot{
+					Name:        "Wooden Car",
+					Description: "A wooden toy car",
+					Quantity:    1,
+					Tags:        []string{"wood", "car"},
+				},
+				After: entities.TicketSnapshot{
+					Name:        "Wooden Car",
+					Description: "A wooden toy car",
+					Quantity:    1,
+					Tags:        []string{"car", "wood"},
+				},
+			},
+			respondOwner: entities.User{
+				DisplayName: "Dave",
+			},
+			expected: `<!DOCTYPE html>
+<html lang="ru">
+<head>
+<meta charset="UTF-8">
+</head>
+<body>
+<p>Добрый день, Dave!</p>
+<p>Заявка на создание игрушки <b>Wooden Car</b> (<i>A wooden toy car</i>) в количестве <b>1 шт.</b> была изменена.</p>
+<p>Для большей информации, пожалуйста, перейдите по <a href="http://example.com/update-ticket/2">ссылке</a>.</p>
+<p>С уважением,<br>
+команда Handmade Toys Marketplace.</p>
+</body>
+</html>
 `,
 		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			result := builder.Body(tc.ticket, tc.respondOwner)
+			result := builder.Body(tc.ticket, tc.changes, tc.respondOwner)
 			require.Equal(t, tc.expected, result)
 		})
 	}
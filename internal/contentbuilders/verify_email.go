@@ -2,43 +2,38 @@ package contentbuilders
 
 import (
 	"fmt"
-	"strconv"
-
-	"github.com/DKhorkov/libs/security"
 
 	"github.com/DKhorkov/hmtm-notifications/internal/entities"
 )
 
 type VerifyEmailContentBuilder struct {
+	templates          *Templates
 	verifyEmailURLBase string
 }
 
-func NewVerifyEmailContentBuilder(verifyEmailURLBase string) *VerifyEmailContentBuilder {
+func NewVerifyEmailContentBuilder(templates *Templates, verifyEmailURLBase string) *VerifyEmailContentBuilder {
 	return &VerifyEmailContentBuilder{
+		templates:          templates,
 		verifyEmailURLBase: verifyEmailURLBase,
 	}
 }
 
-func (b *VerifyEmailContentBuilder) Subject() string {
-	return "Подтверждение адреса электронной почты"
+func (b *VerifyEmailContentBuilder) Subject(user entities.User) string {
+	return must(b.templates.message(user.Locale, verifyEmailSubjectMessage, messageView{}))
 }
 
-func (b *VerifyEmailContentBuilder) Body(user entities.User) string {
-	link := fmt.Sprintf(
-		"%s/%s",
-		b.verifyEmailURLBase,
-		security.RawEncode([]byte(strconv.FormatUint(user.ID, 10))),
-	)
-
-	template := `<p>Добрый день, %s!</p>
-<p>Пожалуйста, перейдите по <a href="%s">ссылке</a>, чтобы подтвердить адрес электронной почты!</p>
-<p>С уважением,<br>
-команда Handmade Toys Marketplace.</p>
-`
+func (b *VerifyEmailContentBuilder) Body(user entities.User, token string) string {
+	return must(b.templates.renderHTML(
+		user.Locale,
+		verifyEmailTemplateName,
+		verifyEmailView{
+			layoutView: layoutView{RecipientName: untrusted(user.DisplayName)},
+			Link:       tokenLink(b.verifyEmailURLBase, token),
+		},
+	))
+}
 
-	return fmt.Sprintf(
-		template,
-		user.DisplayName,
-		link,
-	)
+// tokenLink builds link, which is sent only to recipient, since signed token grants action on behalf of user.
+func tokenLink(urlBase, token string) string {
+	return fmt.Sprintf("%s/%s", urlBase, token)
 }
@@ -0,0 +1,38 @@
+package contentbuilders
+
+import (
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+)
+
+type VerifyEmailInboxContentBuilder struct {
+	templates          *Templates
+	verifyEmailURLBase string
+}
+
+func NewVerifyEmailInboxContentBuilder(
+	templates *Templates,
+	verifyEmailURLBase string,
+) *VerifyEmailInboxContentBuilder {
+	return &VerifyEmailInboxContentBuilder{
+		templates:          templates,
+		verifyEmailURLBase: verifyEmailURLBase,
+	}
+}
+
+func (b *VerifyEmailInboxContentBuilder) Title(user entities.User) string {
+	return must(b.templates.message(user.Locale, verifyEmailInboxTitleMessage, messageView{}))
+}
+
+func (b *VerifyEmailInboxContentBuilder) Text(user entities.User) string {
+	return must(b.templates.message(
+		user.Locale,
+		verifyEmailInboxTextMessage,
+		messageView{RecipientName: untrusted(user.DisplayName)},
+	))
+}
+
+// Link leads to page, where user can request a new link. Notification does not contain signed token, since
+// notifications are also delivered to webhooks and notification streams.
+func (b *VerifyEmailInboxContentBuilder) Link() string {
+	return b.verifyEmailURLBase
+}
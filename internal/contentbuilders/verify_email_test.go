@@ -9,28 +9,34 @@ import (
 )
 
 func TestVerifyEmailContentBuilder_Subject(t *testing.T) {
-	builder := NewVerifyEmailContentBuilder("http://example.com/verify-email")
+	builder := NewVerifyEmailContentBuilder(newTestTemplates(t), "http://example.com/verify-email")
 
 	testCases := []struct {
 		name     string
+		user     entities.User
 		expected string
 	}{
 		{
 			name:     "default subject",
 			expected: "Подтверждение адреса электронной почты",
 		},
+		{
+			name:     "english subject",
+			user:     entities.User{Locale: "en-US"},
+			expected: "Email address confirmation",
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			result := builder.Subject()
+			result := builder.Subject(tc.user)
 			require.Equal(t, tc.expected, result)
 		})
 	}
 }
 
 func TestVerifyEmailContentBuilder_Body(t *testing.T) {
-	builder := NewVerifyEmailContentBuilder("http://example.com/verify-email")
+	builder := NewVerifyEmailContentBuilder(newTestTemplates(t), "http://example.com/verify-email")
 
 	testCases := []struct {
 		name     string
@@ -43,10 +49,18 @@ func TestVerifyEmailContentBuilder_Body(t *testing.T) {
 				ID:          1,
 				DisplayName: "Alice",
 			},
-			expected: `<p>Добрый день, Alice!</p>
-<p>Пожалуйста, перейдите по <a href="http://example.com/verify-email/MQ">ссылке</a>, чтобы подтвердить адрес электронной почты!</p>
+			expected: `<!DOCTYPE html>
+<html lang="ru">
+<head>
+<meta charset="UTF-8">
+</head>
+<body>
+<p>Добрый день, Alice!</p>
+<p>Пожалуйста, перейдите по <a href="http://example.com/verify-email/2025-05.eyJ1aWQiOjF9.c2lnbmF0dXJl">ссылке</a>, чтобы подтвердить адрес электронной почты!</p>
 <p>С уважением,<br>
 команда Handmade Toys Marketplace.</p>
+</body>
+</html>
 `,
 		},
 		{
@@ -55,10 +69,18 @@ func TestVerifyEmailContentBuilder_Body(t *testing.T) {
 				ID:          123,
 				DisplayName: "Bob <Test>",
 			},
-			expected: `<p>Добрый день, Bob <Test>!</p>
-<p>Пожалуйста, перейдите по <a href="http://example.com/verify-email/MTIz">ссылке</a>, чтобы подтвердить адрес электронной почты!</p>
+			expected: `<!DOCTYPE html>
+<html lang="ru">
+<head>
+<meta charset="UTF-8">
+</head>
+<body>
+<p>Добрый день, Bob &lt;Test&gt;!</p>
+<p>Пожалуйста, перейдите по <a href="http://example.com/verify-email/2025-05.eyJ1aWQiOjF9.c2lnbmF0dXJl">ссылке</a>, чтобы подтвердить адрес электронной почты!</p>
 <p>С уважением,<br>
 команда Handmade Toys Marketplace.</p>
+</body>
+</html>
 `,
 		},
 		{
@@ -67,17 +89,25 @@ func TestVerifyEmailContentBuilder_Body(t *testing.T) {
 				ID:          987654321,
 				DisplayName: "Charlie",
 			},
-			expected: `<p>Добрый день, Charlie!</p>
-<p>Пожалуйста, перейдите по <a href="http://example.com/verify-email/OTg3NjU0MzIx">ссылке</a>, чтобы подтвердить адрес электронной почты!</p>
+			expected: `<!DOCTYPE html>
+<html lang="ru">
+<head>
+<meta charset="UTF-8">
+</head>
+<body>
+<p>Добрый день, Charlie!</p>
+<p>Пожалуйста, перейдите по <a href="http://example.com/verify-email/2025-05.eyJ1aWQiOjF9.c2lnbmF0dXJl">ссылке</a>, чтобы подтвердить адрес электронной почты!</p>
 <p>С уважением,<br>
 команда Handmade Toys Marketplace.</p>
+</body>
+</html>
 `,
 		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			result := builder.Body(tc.user)
+			result := builder.Body(tc.user, testToken)
 			require.Equal(t, tc.expected, result)
 		})
 	}
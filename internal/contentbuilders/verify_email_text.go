@@ -0,0 +1,29 @@
+package contentbuilders
+
+import (
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+)
+
+// VerifyEmailTextContentBuilder builds plain text alternative of verify-email body with link kept in place.
+type VerifyEmailTextContentBuilder struct {
+	templates          *Templates
+	verifyEmailURLBase string
+}
+
+func NewVerifyEmailTextContentBuilder(templates *Templates, verifyEmailURLBase string) *VerifyEmailTextContentBuilder {
+	return &VerifyEmailTextContentBuilder{
+		templates:          templates,
+		verifyEmailURLBase: verifyEmailURLBase,
+	}
+}
+
+func (b *VerifyEmailTextContentBuilder) Text(user entities.User, token string) string {
+	return must(b.templates.renderText(
+		user.Locale,
+		verifyEmailTemplateName,
+		verifyEmailView{
+			layoutView: layoutView{RecipientName: untrusted(user.DisplayName)},
+			Link:       tokenLink(b.verifyEmailURLBase, token),
+		},
+	))
+}
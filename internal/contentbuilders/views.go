@@ -0,0 +1,130 @@
+package contentbuilders
+
+import (
+	"github.com/DKhorkov/libs/pointers"
+)
+
+// View models are the only data, available in templates. Every view model embeds layoutView, which is used by
+// "header" and "footer" partials. Fields are plain strings on purpose: html/template escapes them according to their
+// context (text, attribute or URL) and replaces unsafe URLs, while template.HTML and template.URL would bypass it.
+
+type layoutView struct {
+	RecipientName string
+}
+
+type verifyEmailView struct {
+	layoutView
+	Link string
+}
+
+type forgetPasswordView struct {
+	layoutView
+	Link string
+}
+
+type ticketUpdatedView struct {
+	layoutView
+	TicketName        string
+	TicketDescription string
+	Quantity          uint32
+	Price             *float32
+	Link              string
+	// Changes are nil, if previous state of ticket is unknown or nothing was changed:
+	Changes *ticketChangesView
+}
+
+// ticketChangesView contains changed fields of ticket only, while unchanged ones are nil.
+type ticketChangesView struct {
+	Name        *fieldChangeView[string]
+	Description *fieldChangeView[string]
+	Price       *fieldChangeView[*float32]
+	Quantity    *fieldChangeView[uint32]
+	Category    *fieldChangeView[string]
+	Tags        *listChangeView
+	Attachments *listChangeView
+}
+
+type fieldChangeView[T any] struct {
+	Before T
+	After  T
+}
+
+// listChangeView contains removed and added items of list, such as tags or links to attachments.
+type listChangeView struct {
+	Removed []string
+	Added   []string
+}
+
+type ticketDeletedView struct {
+	layoutView
+	TicketOwnerName   string
+	TicketOwnerLink   string
+	TicketName        string
+	TicketDescription string
+	Quantity          uint32
+	Price             *float32
+}
+
+// messageView is available in messages of catalogs. Messages are plain text, so fields must be already
+// sanitized by content builders.
+type messageView struct {
+	RecipientName   string
+	RecipientEmail  string
+	TicketName      string
+	TicketOwnerName string
+	Link            string
+}
+
+// Sample view models have every optional field set to validate all blocks of templates on startup:
+var (
+	sampleLayoutView = layoutView{RecipientName: "Recipient"}
+
+	sampleVerifyEmailView = verifyEmailView{
+		layoutView: sampleLayoutView,
+		Link:       "https://example.com/verify-email/token",
+	}
+
+	sampleForgetPasswordView = forgetPasswordView{
+		layoutView: sampleLayoutView,
+		Link:       "https://example.com/forget-password/token",
+	}
+
+	sampleTicketUpdatedView = ticketUpdatedView{
+		layoutView:        sampleLayoutView,
+		TicketName:        "Ticket",
+		TicketDescription: "Description",
+		Quantity:          1,
+		Price:             pointers.New[float32](1),
+		Link:              "https://example.com/tickets/1",
+		Changes: &ticketChangesView{
+			Name:        &fieldChangeView[string]{Before: "Old ticket", After: "Ticket"},
+			Description: &fieldChangeView[string]{Before: "Old description", After: "Description"},
+			Price:       &fieldChangeView[*float32]{Before: nil, After: pointers.New[float32](1)},
+			Quantity:    &fieldChangeView[uint32]{Before: 2, After: 1},
+			Category:    &fieldChangeView[string]{Before: "Old category", After: "Category"},
+			Tags:        &listChangeView{Removed: []string{"Old tag"}, Added: []string{"Tag"}},
+			Attachments: &listChangeView{
+				Removed: []string{"https://example.com/attachments/1"},
+				Added:   []string{"https://example.com/attachments/2"},
+			},
+		},
+	}
+
+	sampleTicketDeletedView = ticketDeletedView{
+		layoutView:        sampleLayoutView,
+		TicketOwnerName:   "Owner",
+		TicketOwnerLink:   "https://example.com/users/1",
+		TicketName:        "Ticket",
+		TicketDescription: "Description",
+		Quantity:          1,
+		Price:             pointers.New[float32](1),
+	}
+
+	sampleMessageView = messageView{
+		RecipientName:   "Recipient",
+		RecipientEmail:  "recipient@example.com",
+		TicketName:      "Ticket",
+		TicketOwnerName: "Owner",
+		Link:            "https://example.com/tickets/1",
+	}
+)
@@ -0,0 +1,137 @@
+package communications
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/DKhorkov/libs/logging"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	customgrpc "github.com/DKhorkov/libs/grpc"
+
+	"github.com/DKhorkov/hmtm-notifications/api/protobuf/generated/go/notifications"
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+	"github.com/DKhorkov/hmtm-notifications/internal/interfaces"
+)
+
+// RegisterServer handler (serverAPI) connects CommunicationsServer to gRPC server:.
+func RegisterServer(gRPCServer *grpc.Server, useCases interfaces.UseCases, logger logging.Logger) {
+	notifications.RegisterCommunicationsServiceServer(
+		gRPCServer,
+		&ServerAPI{useCases: useCases, logger: logger},
+	)
+}
+
+type ServerAPI struct {
+	// Helps to test single endpoints, if others is not implemented yet
+	notifications.UnimplementedCommunicationsServiceServer
+	useCases interfaces.UseCases
+	logger   logging.Logger
+}
+
+func (api ServerAPI) CountUserCommunications(
+	ctx context.Context,
+	in *notifications.CountUserCommunicationsIn,
+) (*notifications.CountOut, error) {
+	channel, err := processChannel(in.GetChannel())
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := api.useCases.CountUserCommunications(ctx, in.GetUserID(), channel)
+	if err != nil {
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			fmt.Sprintf(
+				"Error occurred while trying to count %s Communications for User with ID=%d",
+				channel,
+				in.GetUserID(),
+			),
+			err,
+		)
+
+		return nil, &customgrpc.BaseError{Status: codes.Internal, Message: err.Error()}
+	}
+
+	return &notifications.CountOut{Count: count}, nil
+}
+
+func (api ServerAPI) GetUserCommunications(
+	ctx context.Context,
+	in *notifications.GetUserCommunicationsIn,
+) (*notifications.GetUserCommunicationsOut, error) {
+	channel, err := processChannel(in.GetChannel())
+	if err != nil {
+		return nil, err
+	}
+
+	var pagination *entities.Pagination
+	if in.GetPagination() != nil {
+		pagination = &entities.Pagination{
+			Limit:  in.Pagination.Limit,
+			Offset: in.Pagination.Offset,
+		}
+	}
+
+	communications, err := api.useCases.GetUserCommunications(ctx, in.GetUserID(), channel, pagination)
+	if err != nil {
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			fmt.Sprintf(
+				"Error occurred while trying to get %s Communications for User with ID=%d",
+				channel,
+				in.GetUserID(),
+			),
+			err,
+		)
+
+		return nil, &customgrpc.BaseError{Status: codes.Internal, Message: err.Error()}
+	}
+
+	processedCommunications := make([]*notifications.Communication, len(communications))
+	for i, communication := range communications {
+		processedCommunications[i] = processCommunication(communication)
+	}
+
+	return &notifications.GetUserCommunicationsOut{Communications: processedCommunications}, nil
+}
+
+func processChannel(rawChannel string) (entities.CommunicationChannel, error) {
+	channel := entities.CommunicationChannel(rawChannel)
+	switch channel {
+	case entities.CommunicationChannelEmail, entities.CommunicationChannelTelegram, entities.CommunicationChannelSMS:
+		return channel, nil
+	default:
+		return "", &customgrpc.BaseError{
+			Status:  codes.InvalidArgument,
+			Message: fmt.Sprintf("unknown communication channel %q", rawChannel),
+		}
+	}
+}
+
+func processCommunication(communication entities.Communication) *notifications.Communication {
+	// Communication can be not sent yet, if it is still pending in outbox:
+	var sentAt *timestamppb.Timestamp
+	if communication.SentAt != nil {
+		sentAt = timestamppb.New(*communication.SentAt)
+	}
+
+	return &notifications.Communication{
+		ID:                communication.ID,
+		UserID:            communication.UserID,
+		Type:              string(communication.Type),
+		Channel:           string(communication.Channel),
+		Recipient:         communication.Recipient,
+		Subject:           communication.Subject,
+		Body:              communication.Body,
+		Status:            string(communication.Status),
+		Attempts:          communication.Attempts,
+		ProviderMessageID: communication.ProviderMessageID,
+		CreatedAt:         timestamppb.New(communication.CreatedAt),
+		SentAt:            sentAt,
+	}
+}
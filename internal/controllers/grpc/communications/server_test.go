@@ -0,0 +1,234 @@
+package communications
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	customgrpc "github.com/DKhorkov/libs/grpc"
+	mocklogging "github.com/DKhorkov/libs/logging/mocks"
+	"github.com/DKhorkov/libs/pointers"
+
+	"github.com/DKhorkov/hmtm-notifications/api/protobuf/generated/go/notifications"
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+	mockusecases "github.com/DKhorkov/hmtm-notifications/mocks/usecases"
+)
+
+func TestServerAPI_GetUserCommunications(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCases := mockusecases.NewMockUseCases(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+	api := &ServerAPI{
+		useCases: useCases,
+		logger:   logger,
+	}
+
+	createdAt := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	sentAt := time.Date(2023, 1, 1, 0, 1, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name          string
+		in            *notifications.GetUserCommunicationsIn
+		setupMocks    func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger)
+		expectedOut   *notifications.GetUserCommunicationsOut
+		expectedErr   error
+		errorExpected bool
+	}{
+		{
+			name: "success with communications",
+			in: &notifications.GetUserCommunicationsIn{
+				UserID:  1,
+				Channel: "telegram",
+				Pagination: &notifications.Pagination{
+					Limit:  pointers.New[uint64](1),
+					Offset: pointers.New[uint64](1),
+				},
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					GetUserCommunications(
+						gomock.Any(),
+						uint64(1),
+						entities.CommunicationChannelTelegram,
+						&entities.Pagination{
+							Limit:  pointers.New[uint64](1),
+							Offset: pointers.New[uint64](1),
+						},
+					).
+					Return(
+						[]entities.Communication{
+							{
+								ID:                1,
+								UserID:            1,
+								Recipient:         "100500",
+								Body:              "Hello",
+								CreatedAt:         createdAt,
+								Subject:           "Subject",
+								Status:            entities.CommunicationStatusSent,
+								Attempts:          1,
+								SentAt:            &sentAt,
+								Channel:           entities.CommunicationChannelTelegram,
+								Type:              entities.NotificationTypeTicketUpdated,
+								ProviderMessageID: pointers.New("42"),
+							},
+						},
+						nil,
+					).
+					Times(1)
+			},
+			expectedOut: &notifications.GetUserCommunicationsOut{
+				Communications: []*notifications.Communication{
+					{
+						ID:                1,
+						UserID:            1,
+						Type:              string(entities.NotificationTypeTicketUpdated),
+						Channel:           "telegram",
+						Recipient:         "100500",
+						Subject:           "Subject",
+						Body:              "Hello",
+						Status:            "sent",
+						Attempts:          1,
+						ProviderMessageID: pointers.New("42"),
+						CreatedAt:         timestamppb.New(createdAt),
+						SentAt:            timestamppb.New(sentAt),
+					},
+				},
+			},
+		},
+		{
+			name: "unknown channel",
+			in: &notifications.GetUserCommunicationsIn{
+				UserID:  1,
+				Channel: "pigeon",
+			},
+			expectedErr: &customgrpc.BaseError{
+				Status:  codes.InvalidArgument,
+				Message: `unknown communication channel "pigeon"`,
+			},
+			errorExpected: true,
+		},
+		{
+			name: "error",
+			in: &notifications.GetUserCommunicationsIn{
+				UserID:  1,
+				Channel: "sms",
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					GetUserCommunications(gomock.Any(), uint64(1), entities.CommunicationChannelSMS, nil).
+					Return(nil, errors.New("internal error")).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			expectedErr:   &customgrpc.BaseError{Status: codes.Internal, Message: "internal error"},
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(useCases, logger)
+			}
+
+			resp, err := api.GetUserCommunications(context.Background(), tc.in)
+			if tc.errorExpected {
+				require.Error(t, err)
+				require.Equal(t, tc.expectedErr, err)
+				require.Nil(t, resp)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedOut, resp)
+			}
+		})
+	}
+}
+
+func TestServerAPI_CountUserCommunications(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCases := mockusecases.NewMockUseCases(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+	api := &ServerAPI{
+		useCases: useCases,
+		logger:   logger,
+	}
+
+	testCases := []struct {
+		name          string
+		in            *notifications.CountUserCommunicationsIn
+		setupMocks    func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger)
+		expectedOut   *notifications.CountOut
+		expectedErr   error
+		errorExpected bool
+	}{
+		{
+			name: "success",
+			in:   &notifications.CountUserCommunicationsIn{UserID: 1, Channel: "email"},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					CountUserCommunications(gomock.Any(), uint64(1), entities.CommunicationChannelEmail).
+					Return(uint64(1), nil).
+					Times(1)
+			},
+			expectedOut: &notifications.CountOut{Count: 1},
+		},
+		{
+			name: "empty channel",
+			in:   &notifications.CountUserCommunicationsIn{UserID: 1},
+			expectedErr: &customgrpc.BaseError{
+				Status:  codes.InvalidArgument,
+				Message: `unknown communication channel ""`,
+			},
+			errorExpected: true,
+		},
+		{
+			name: "error",
+			in:   &notifications.CountUserCommunicationsIn{UserID: 1, Channel: "email"},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					CountUserCommunications(gomock.Any(), uint64(1), entities.CommunicationChannelEmail).
+					Return(uint64(0), errors.New("error")).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			expectedErr:   &customgrpc.BaseError{Status: codes.Internal, Message: "error"},
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(useCases, logger)
+			}
+
+			resp, err := api.CountUserCommunications(context.Background(), tc.in)
+			if tc.errorExpected {
+				require.Error(t, err)
+				require.Equal(t, tc.expectedErr, err)
+				require.Nil(t, resp)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedOut, resp)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package grpccontroller
 import (
 	"fmt"
 	"net"
+	"time"
 
 	"github.com/DKhorkov/libs/logging"
 	"github.com/DKhorkov/libs/tracing"
@@ -10,7 +11,13 @@ import (
 
 	customgrpc "github.com/DKhorkov/libs/grpc/interceptors"
 
+	"github.com/DKhorkov/hmtm-notifications/internal/controllers/grpc/communications"
 	"github.com/DKhorkov/hmtm-notifications/internal/controllers/grpc/emails"
+	"github.com/DKhorkov/hmtm-notifications/internal/controllers/grpc/inbox"
+	"github.com/DKhorkov/hmtm-notifications/internal/controllers/grpc/previews"
+	"github.com/DKhorkov/hmtm-notifications/internal/controllers/grpc/templates"
+	"github.com/DKhorkov/hmtm-notifications/internal/controllers/grpc/tokens"
+	"github.com/DKhorkov/hmtm-notifications/internal/controllers/grpc/webhooks"
 	"github.com/DKhorkov/hmtm-notifications/internal/interfaces"
 )
 
@@ -19,6 +26,7 @@ func New(
 	host string,
 	port int,
 	useCases interfaces.UseCases,
+	heartbeatInterval time.Duration,
 	logger logging.Logger,
 	traceProvider tracing.Provider,
 	spanConfig tracing.SpanConfig,
@@ -31,7 +39,13 @@ func New(
 	)
 
 	// Connects our gRPC services to grpcServer:
+	communications.RegisterServer(grpcServer, useCases, logger)
 	emails.RegisterServer(grpcServer, useCases, logger)
+	inbox.RegisterServer(grpcServer, useCases, heartbeatInterval, logger)
+	webhooks.RegisterServer(grpcServer, useCases, logger)
+	tokens.RegisterServer(grpcServer, useCases, logger)
+	templates.RegisterServer(grpcServer, useCases, logger)
+	previews.RegisterServer(grpcServer, useCases, logger)
 
 	return &Controller{
 		grpcServer: grpcServer,
@@ -17,6 +17,7 @@ import (
 )
 
 // RegisterServer handler (serverAPI) connects EmailsServer to gRPC server:.
+// EmailsServer is kept for backward compatibility and returns only communications of email channel.
 func RegisterServer(gRPCServer *grpc.Server, useCases interfaces.UseCases, logger logging.Logger) {
 	notifications.RegisterEmailsServiceServer(
 		gRPCServer,
@@ -35,7 +36,7 @@ func (api ServerAPI) CountUserEmailCommunications(
 	ctx context.Context,
 	in *notifications.CountUserEmailCommunicationsIn,
 ) (*notifications.CountOut, error) {
-	count, err := api.useCases.CountUserEmailCommunications(ctx, in.GetUserID())
+	count, err := api.useCases.CountUserCommunications(ctx, in.GetUserID(), entities.CommunicationChannelEmail)
 	if err != nil {
 		logging.LogErrorContext(
 			ctx,
@@ -65,7 +66,12 @@ func (api ServerAPI) GetUserEmailCommunications(
 		}
 	}
 
-	emailCommunications, err := api.useCases.GetUserEmailCommunications(ctx, in.GetUserID(), pagination)
+	emailCommunications, err := api.useCases.GetUserCommunications(
+		ctx,
+		in.GetUserID(),
+		entities.CommunicationChannelEmail,
+		pagination,
+	)
 	if err != nil {
 		logging.LogErrorContext(
 			ctx,
@@ -82,12 +88,18 @@ func (api ServerAPI) GetUserEmailCommunications(
 
 	processedEmailCommunications := make([]*notifications.Email, len(emailCommunications))
 	for i, communication := range emailCommunications {
+		// Communication can be not sent yet, if it is still pending in outbox:
+		var sentAt *timestamppb.Timestamp
+		if communication.SentAt != nil {
+			sentAt = timestamppb.New(*communication.SentAt)
+		}
+
 		processedEmailCommunications[i] = &notifications.Email{
 			ID:      communication.ID,
 			UserID:  communication.UserID,
-			Email:   communication.Email,
-			Content: communication.Content,
-			SentAt:  timestamppb.New(communication.SentAt),
+			Email:   communication.Recipient,
+			Content: communication.Body,
+			SentAt:  sentAt,
 		}
 	}
 
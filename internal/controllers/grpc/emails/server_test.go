@@ -47,27 +47,28 @@ func TestServerAPI_GetUserEmailCommunications(t *testing.T) {
 				},
 			},
 			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
-				emailCommunications := []entities.Email{
+				emailCommunications := []entities.Communication{
 					{
-						ID:      1,
-						UserID:  1,
-						Email:   "test1@example.com",
-						Content: "Hello, this is email 1",
-						SentAt:  time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+						ID:        1,
+						UserID:    1,
+						Recipient: "test1@example.com",
+						Body:      "Hello, this is email 1",
+						SentAt:    pointers.New(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)),
 					},
 					{
-						ID:      2,
-						UserID:  1,
-						Email:   "test2@example.com",
-						Content: "Hello, this is email 2",
-						SentAt:  time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC),
+						ID:        2,
+						UserID:    1,
+						Recipient: "test2@example.com",
+						Body:      "Hello, this is email 2",
+						SentAt:    pointers.New(time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)),
 					},
 				}
 				useCases.
 					EXPECT().
-					GetUserEmailCommunications(
+					GetUserCommunications(
 						gomock.Any(),
 						uint64(1),
+						entities.CommunicationChannelEmail,
 						&entities.Pagination{
 							Limit:  pointers.New[uint64](1),
 							Offset: pointers.New[uint64](1),
@@ -109,15 +110,16 @@ func TestServerAPI_GetUserEmailCommunications(t *testing.T) {
 			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
 				useCases.
 					EXPECT().
-					GetUserEmailCommunications(
+					GetUserCommunications(
 						gomock.Any(),
 						uint64(1),
+						entities.CommunicationChannelEmail,
 						&entities.Pagination{
 							Limit:  pointers.New[uint64](1),
 							Offset: pointers.New[uint64](1),
 						},
 					).
-					Return([]entities.Email{}, nil).
+					Return([]entities.Communication{}, nil).
 					Times(1)
 			},
 			expectedOut: &notifications.GetUserEmailCommunicationsOut{
@@ -138,9 +140,10 @@ func TestServerAPI_GetUserEmailCommunications(t *testing.T) {
 			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
 				useCases.
 					EXPECT().
-					GetUserEmailCommunications(
+					GetUserCommunications(
 						gomock.Any(),
 						uint64(1),
+						entities.CommunicationChannelEmail,
 						&entities.Pagination{
 							Limit:  pointers.New[uint64](1),
 							Offset: pointers.New[uint64](1),
@@ -201,7 +204,7 @@ func TestServerAPI_CountUserEmailCommunications(t *testing.T) {
 			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
 				useCases.
 					EXPECT().
-					CountUserEmailCommunications(gomock.Any(), uint64(1)).
+					CountUserCommunications(gomock.Any(), uint64(1), entities.CommunicationChannelEmail).
 					Return(uint64(1), nil).
 					Times(1)
 			},
@@ -215,7 +218,7 @@ func TestServerAPI_CountUserEmailCommunications(t *testing.T) {
 			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
 				useCases.
 					EXPECT().
-					CountUserEmailCommunications(gomock.Any(), uint64(1)).
+					CountUserCommunications(gomock.Any(), uint64(1), entities.CommunicationChannelEmail).
 					Return(uint64(0), errors.New("error")).
 					Times(1)
 
@@ -0,0 +1,267 @@
+package inbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/DKhorkov/libs/logging"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	customgrpc "github.com/DKhorkov/libs/grpc"
+
+	"github.com/DKhorkov/hmtm-notifications/api/protobuf/generated/go/notifications"
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-notifications/internal/errors"
+	"github.com/DKhorkov/hmtm-notifications/internal/interfaces"
+)
+
+// defaultHeartbeatInterval is used, if provided heartbeat interval is not positive.
+const defaultHeartbeatInterval = 15 * time.Second
+
+// RegisterServer handler (serverAPI) connects InboxServer to gRPC server:.
+func RegisterServer(
+	gRPCServer *grpc.Server,
+	useCases interfaces.UseCases,
+	heartbeatInterval time.Duration,
+	logger logging.Logger,
+) {
+	if heartbeatInterval <= 0 {
+		heartbeatInterval = defaultHeartbeatInterval
+	}
+
+	notifications.RegisterInboxServiceServer(
+		gRPCServer,
+		&ServerAPI{useCases: useCases, heartbeatInterval: heartbeatInterval, logger: logger},
+	)
+}
+
+type ServerAPI struct {
+	// Helps to test single endpoints, if others is not implemented yet
+	notifications.UnimplementedInboxServiceServer
+	useCases          interfaces.UseCases
+	heartbeatInterval time.Duration
+	logger            logging.Logger
+}
+
+func (api ServerAPI) GetUserNotifications(
+	ctx context.Context,
+	in *notifications.GetUserNotificationsIn,
+) (*notifications.GetUserNotificationsOut, error) {
+	var pagination *entities.Pagination
+	if in.GetPagination() != nil {
+		pagination = &entities.Pagination{
+			Limit:  in.Pagination.Limit,
+			Offset: in.Pagination.Offset,
+		}
+	}
+
+	userNotifications, err := api.useCases.GetUserNotifications(ctx, in.GetUserID(), pagination)
+	if err != nil {
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			fmt.Sprintf("Error occurred while trying to get Notifications for User with ID=%d", in.GetUserID()),
+			err,
+		)
+
+		return nil, &customgrpc.BaseError{Status: codes.Internal, Message: err.Error()}
+	}
+
+	processedNotifications := make([]*notifications.Notification, len(userNotifications))
+	for i, notification := range userNotifications {
+		processedNotifications[i] = processNotification(notification)
+	}
+
+	return &notifications.GetUserNotificationsOut{Notifications: processedNotifications}, nil
+}
+
+func (api ServerAPI) CountUserUnreadNotifications(
+	ctx context.Context,
+	in *notifications.CountUserUnreadNotificationsIn,
+) (*notifications.CountOut, error) {
+	count, err := api.useCases.CountUserUnreadNotifications(ctx, in.GetUserID())
+	if err != nil {
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			fmt.Sprintf(
+				"Error occurred while trying to count unread Notifications for User with ID=%d",
+				in.GetUserID(),
+			),
+			err,
+		)
+
+		return nil, &customgrpc.BaseError{Status: codes.Internal, Message: err.Error()}
+	}
+
+	return &notifications.CountOut{Count: count}, nil
+}
+
+func (api ServerAPI) MarkNotificationAsRead(
+	ctx context.Context,
+	in *notifications.MarkNotificationAsReadIn,
+) (*emptypb.Empty, error) {
+	if err := api.useCases.MarkNotificationAsRead(ctx, in.GetID(), in.GetUserID()); err != nil {
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			fmt.Sprintf(
+				"Error occurred while trying to mark Notification with ID=%d as read for User with ID=%d",
+				in.GetID(),
+				in.GetUserID(),
+			),
+			err,
+		)
+
+		return nil, notificationError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (api ServerAPI) MarkAllNotificationsAsRead(
+	ctx context.Context,
+	in *notifications.MarkAllNotificationsAsReadIn,
+) (*emptypb.Empty, error) {
+	if err := api.useCases.MarkAllNotificationsAsRead(ctx, in.GetUserID()); err != nil {
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			fmt.Sprintf(
+				"Error occurred while trying to mark all Notifications as read for User with ID=%d",
+				in.GetUserID(),
+			),
+			err,
+		)
+
+		return nil, &customgrpc.BaseError{Status: codes.Internal, Message: err.Error()}
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (api ServerAPI) DeleteNotification(
+	ctx context.Context,
+	in *notifications.DeleteNotificationIn,
+) (*emptypb.Empty, error) {
+	if err := api.useCases.DeleteNotification(ctx, in.GetID(), in.GetUserID()); err != nil {
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			fmt.Sprintf(
+				"Error occurred while trying to delete Notification with ID=%d for User with ID=%d",
+				in.GetID(),
+				in.GetUserID(),
+			),
+			err,
+		)
+
+		return nil, notificationError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// StreamUserNotifications sends notifications of user as soon as they are created. Heartbeat is sent right after
+// subscription and on every heartbeat interval without notifications to keep stream alive. Stream is finished with
+// Unavailable status, if it was closed by server, so client should resubscribe, providing last seen ID.
+func (api ServerAPI) StreamUserNotifications(
+	in *notifications.StreamUserNotificationsIn,
+	stream grpc.ServerStreamingServer[notifications.NotificationEvent],
+) error {
+	ctx := stream.Context()
+
+	userNotifications, err := api.useCases.SubscribeToUserNotifications(ctx, in.GetUserID(), in.LastSeenID)
+	if err != nil {
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			fmt.Sprintf("Error occurred while trying to subscribe to Notifications for User with ID=%d", in.GetUserID()),
+			err,
+		)
+
+		return notificationError(err)
+	}
+
+	if err = stream.Send(heartbeatEvent()); err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(api.heartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			// Client disconnected. Subscription is closed by usecases:
+			return nil
+		case notification, ok := <-userNotifications:
+			if !ok {
+				// Context is checked first to not report disconnected client as closed stream:
+				if ctx.Err() != nil {
+					return nil
+				}
+
+				return notificationError(&customerrors.NotificationsStreamClosedError{})
+			}
+
+			event := &notifications.NotificationEvent{
+				Event: &notifications.NotificationEvent_Notification{
+					Notification: processNotification(notification),
+				},
+			}
+
+			if err = stream.Send(event); err != nil {
+				return err
+			}
+
+			ticker.Reset(api.heartbeatInterval)
+		case <-ticker.C:
+			if err = stream.Send(heartbeatEvent()); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func processNotification(notification entities.Notification) *notifications.Notification {
+	var readAt *timestamppb.Timestamp
+	if notification.ReadAt != nil {
+		readAt = timestamppb.New(*notification.ReadAt)
+	}
+
+	return &notifications.Notification{
+		ID:        notification.ID,
+		UserID:    notification.UserID,
+		Type:      string(notification.Type),
+		Title:     notification.Title,
+		Text:      notification.Text,
+		Link:      notification.Link,
+		CreatedAt: timestamppb.New(notification.CreatedAt),
+		ReadAt:    readAt,
+	}
+}
+
+func heartbeatEvent() *notifications.NotificationEvent {
+	return &notifications.NotificationEvent{
+		Event: &notifications.NotificationEvent_Heartbeat{
+			Heartbeat: &notifications.Heartbeat{SentAt: timestamppb.Now()},
+		},
+	}
+}
+
+func notificationError(err error) error {
+	switch {
+	case errors.As(err, new(*customerrors.NotificationNotFoundError)):
+		return &customgrpc.BaseError{Status: codes.NotFound, Message: err.Error()}
+	case errors.As(err, new(*customerrors.NotificationsStreamClosedError)):
+		return &customgrpc.BaseError{Status: codes.Unavailable, Message: err.Error()}
+	default:
+		return &customgrpc.BaseError{Status: codes.Internal, Message: err.Error()}
+	}
+}
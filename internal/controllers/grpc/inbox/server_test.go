@@ -0,0 +1,457 @@
+package inbox
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	customgrpc "github.com/DKhorkov/libs/grpc"
+	mocklogging "github.com/DKhorkov/libs/logging/mocks"
+	"github.com/DKhorkov/libs/pointers"
+
+	"github.com/DKhorkov/hmtm-notifications/api/protobuf/generated/go/notifications"
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-notifications/internal/errors"
+	mockusecases "github.com/DKhorkov/hmtm-notifications/mocks/usecases"
+)
+
+func TestServerAPI_GetUserNotifications(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCases := mockusecases.NewMockUseCases(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+	api := &ServerAPI{
+		useCases: useCases,
+		logger:   logger,
+	}
+
+	createdAt := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	readAt := time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name          string
+		in            *notifications.GetUserNotificationsIn
+		setupMocks    func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger)
+		expectedOut   *notifications.GetUserNotificationsOut
+		expectedErr   error
+		errorExpected bool
+	}{
+		{
+			name: "success",
+			in: &notifications.GetUserNotificationsIn{
+				UserID: 1,
+				Pagination: &notifications.Pagination{
+					Limit: pointers.New[uint64](2),
+				},
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					GetUserNotifications(
+						gomock.Any(),
+						uint64(1),
+						&entities.Pagination{Limit: pointers.New[uint64](2)},
+					).
+					Return(
+						[]entities.Notification{
+							{
+								ID:        2,
+								UserID:    1,
+								Type:      entities.NotificationTypeTicketUpdated,
+								Title:     "Title 2",
+								Text:      "Text 2",
+								Link:      "http://example.com/tickets/1",
+								CreatedAt: createdAt,
+							},
+							{
+								ID:        1,
+								UserID:    1,
+								Type:      entities.NotificationTypeVerifyEmail,
+								Title:     "Title 1",
+								Text:      "Text 1",
+								CreatedAt: createdAt,
+								ReadAt:    &readAt,
+							},
+						},
+						nil,
+					).
+					Times(1)
+			},
+			expectedOut: &notifications.GetUserNotificationsOut{
+				Notifications: []*notifications.Notification{
+					{
+						ID:        2,
+						UserID:    1,
+						Type:      "ticket_updated",
+						Title:     "Title 2",
+						Text:      "Text 2",
+						Link:      "http://example.com/tickets/1",
+						CreatedAt: timestamppb.New(createdAt),
+					},
+					{
+						ID:        1,
+						UserID:    1,
+						Type:      "verify_email",
+						Title:     "Title 1",
+						Text:      "Text 1",
+						CreatedAt: timestamppb.New(createdAt),
+						ReadAt:    timestamppb.New(readAt),
+					},
+				},
+			},
+		},
+		{
+			name: "internal error",
+			in:   &notifications.GetUserNotificationsIn{UserID: 1},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					GetUserNotifications(gomock.Any(), uint64(1), nil).
+					Return(nil, errors.New("internal error")).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			expectedErr:   &customgrpc.BaseError{Status: codes.Internal, Message: "internal error"},
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(useCases, logger)
+			}
+
+			resp, err := api.GetUserNotifications(context.Background(), tc.in)
+			if tc.errorExpected {
+				require.Error(t, err)
+				require.Equal(t, tc.expectedErr, err)
+				require.Nil(t, resp)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedOut, resp)
+			}
+		})
+	}
+}
+
+func TestServerAPI_CountUserUnreadNotifications(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCases := mockusecases.NewMockUseCases(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+	api := &ServerAPI{
+		useCases: useCases,
+		logger:   logger,
+	}
+
+	useCases.
+		EXPECT().
+		CountUserUnreadNotifications(gomock.Any(), uint64(1)).
+		Return(uint64(3), nil).
+		Times(1)
+
+	resp, err := api.CountUserUnreadNotifications(
+		context.Background(),
+		&notifications.CountUserUnreadNotificationsIn{UserID: 1},
+	)
+	require.NoError(t, err)
+	require.Equal(t, &notifications.CountOut{Count: 3}, resp)
+}
+
+func TestServerAPI_MarkNotificationAsRead(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCases := mockusecases.NewMockUseCases(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+	api := &ServerAPI{
+		useCases: useCases,
+		logger:   logger,
+	}
+
+	testCases := []struct {
+		name          string
+		setupMocks    func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger)
+		expectedErr   error
+		errorExpected bool
+	}{
+		{
+			name: "success",
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					MarkNotificationAsRead(gomock.Any(), uint64(1), uint64(2)).
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name: "not found",
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					MarkNotificationAsRead(gomock.Any(), uint64(1), uint64(2)).
+					Return(&customerrors.NotificationNotFoundError{}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			expectedErr:   &customgrpc.BaseError{Status: codes.NotFound, Message: "notification not found"},
+			errorExpected: true,
+		},
+		{
+			name: "internal error",
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					MarkNotificationAsRead(gomock.Any(), uint64(1), uint64(2)).
+					Return(errors.New("internal error")).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			expectedErr:   &customgrpc.BaseError{Status: codes.Internal, Message: "internal error"},
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(useCases, logger)
+			}
+
+			resp, err := api.MarkNotificationAsRead(
+				context.Background(),
+				&notifications.MarkNotificationAsReadIn{ID: 1, UserID: 2},
+			)
+			if tc.errorExpected {
+				require.Error(t, err)
+				require.Equal(t, tc.expectedErr, err)
+				require.Nil(t, resp)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, &emptypb.Empty{}, resp)
+			}
+		})
+	}
+}
+
+func TestServerAPI_MarkAllNotificationsAsRead(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCases := mockusecases.NewMockUseCases(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+	api := &ServerAPI{
+		useCases: useCases,
+		logger:   logger,
+	}
+
+	useCases.
+		EXPECT().
+		MarkAllNotificationsAsRead(gomock.Any(), uint64(1)).
+		Return(nil).
+		Times(1)
+
+	resp, err := api.MarkAllNotificationsAsRead(
+		context.Background(),
+		&notifications.MarkAllNotificationsAsReadIn{UserID: 1},
+	)
+	require.NoError(t, err)
+	require.Equal(t, &emptypb.Empty{}, resp)
+}
+
+func TestServerAPI_DeleteNotification(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCases := mockusecases.NewMockUseCases(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+	api := &ServerAPI{
+		useCases: useCases,
+		logger:   logger,
+	}
+
+	useCases.
+		EXPECT().
+		DeleteNotification(gomock.Any(), uint64(1), uint64(2)).
+		Return(&customerrors.NotificationNotFoundError{}).
+		Times(1)
+
+	logger.
+		EXPECT().
+		ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(1)
+
+	resp, err := api.DeleteNotification(
+		context.Background(),
+		&notifications.DeleteNotificationIn{ID: 1, UserID: 2},
+	)
+	require.Equal(t, &customgrpc.BaseError{Status: codes.NotFound, Message: "notification not found"}, err)
+	require.Nil(t, resp)
+}
+
+func TestServerAPI_StreamUserNotifications(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCases := mockusecases.NewMockUseCases(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+	api := &ServerAPI{
+		useCases:          useCases,
+		heartbeatInterval: 10 * time.Millisecond,
+		logger:            logger,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	stream := newNotificationsStream(ctx)
+	live := make(chan entities.Notification, 1)
+	createdAt := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	useCases.
+		EXPECT().
+		SubscribeToUserNotifications(gomock.Any(), uint64(1), pointers.New[uint64](2)).
+		Return(live, nil).
+		Times(1)
+
+	done := make(chan error, 1)
+
+	go func() {
+		done <- api.StreamUserNotifications(
+			&notifications.StreamUserNotificationsIn{UserID: 1, LastSeenID: pointers.New[uint64](2)},
+			stream,
+		)
+	}()
+
+	// Heartbeat is sent right after subscription:
+	require.NotNil(t, (<-stream.events).GetHeartbeat())
+
+	live <- entities.Notification{ID: 3, UserID: 1, Type: entities.NotificationTypeVerifyEmail, CreatedAt: createdAt}
+
+	var notification *notifications.Notification
+	for notification == nil {
+		notification = (<-stream.events).GetNotification()
+	}
+
+	require.Equal(
+		t,
+		&notifications.Notification{
+			ID:        3,
+			UserID:    1,
+			Type:      string(entities.NotificationTypeVerifyEmail),
+			CreatedAt: timestamppb.New(createdAt),
+		},
+		notification,
+	)
+
+	// Heartbeats are sent, while there are no notifications:
+	require.NotNil(t, (<-stream.events).GetHeartbeat())
+
+	// Client disconnection finishes stream without error:
+	stream.cancel()
+	require.NoError(t, <-done)
+}
+
+func TestServerAPI_StreamUserNotificationsClosedStream(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCases := mockusecases.NewMockUseCases(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+	api := &ServerAPI{
+		useCases:          useCases,
+		heartbeatInterval: time.Hour,
+		logger:            logger,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	live := make(chan entities.Notification)
+	close(live)
+
+	useCases.
+		EXPECT().
+		SubscribeToUserNotifications(gomock.Any(), uint64(1), nil).
+		Return(live, nil).
+		Times(1)
+
+	err := api.StreamUserNotifications(
+		&notifications.StreamUserNotificationsIn{UserID: 1},
+		newNotificationsStream(ctx),
+	)
+	require.Equal(
+		t,
+		&customgrpc.BaseError{Status: codes.Unavailable, Message: "notifications stream is closed"},
+		err,
+	)
+}
+
+func TestServerAPI_StreamUserNotificationsSubscribeError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCases := mockusecases.NewMockUseCases(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+	api := &ServerAPI{
+		useCases:          useCases,
+		heartbeatInterval: time.Hour,
+		logger:            logger,
+	}
+
+	useCases.
+		EXPECT().
+		SubscribeToUserNotifications(gomock.Any(), uint64(1), nil).
+		Return(nil, errors.New("db error")).
+		Times(1)
+
+	logger.
+		EXPECT().
+		ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(1)
+
+	err := api.StreamUserNotifications(
+		&notifications.StreamUserNotificationsIn{UserID: 1},
+		newNotificationsStream(context.Background()),
+	)
+	require.Equal(t, &customgrpc.BaseError{Status: codes.Internal, Message: "db error"}, err)
+}
+
+// notificationsStream is a server side of notifications stream, which passes sent events to channel.
+type notificationsStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	cancel context.CancelFunc
+	events chan *notifications.NotificationEvent
+}
+
+func newNotificationsStream(ctx context.Context) *notificationsStream {
+	ctx, cancel := context.WithCancel(ctx)
+
+	return &notificationsStream{
+		ctx:    ctx,
+		cancel: cancel,
+		events: make(chan *notifications.NotificationEvent, 16),
+	}
+}
+
+func (s *notificationsStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *notificationsStream) Send(event *notifications.NotificationEvent) error {
+	select {
+	case s.events <- event:
+		return nil
+	case <-s.ctx.Done():
+		return s.ctx.Err()
+	}
+}
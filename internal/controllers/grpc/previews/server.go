@@ -0,0 +1,108 @@
+package previews
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/DKhorkov/libs/logging"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	customgrpc "github.com/DKhorkov/libs/grpc"
+
+	"github.com/DKhorkov/hmtm-notifications/api/protobuf/generated/go/notifications"
+	"github.com/DKhorkov/hmtm-notifications/dto"
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-notifications/internal/errors"
+	"github.com/DKhorkov/hmtm-notifications/internal/interfaces"
+)
+
+// RegisterServer handler (serverAPI) connects PreviewsServer to gRPC server:.
+func RegisterServer(gRPCServer *grpc.Server, useCases interfaces.UseCases, logger logging.Logger) {
+	notifications.RegisterPreviewsServiceServer(gRPCServer, &ServerAPI{useCases: useCases, logger: logger})
+}
+
+type ServerAPI struct {
+	// Helps to test single endpoints, if others is not implemented yet
+	notifications.UnimplementedPreviewsServiceServer
+	useCases interfaces.UseCases
+	logger   logging.Logger
+}
+
+func (api ServerAPI) PreviewNotification(
+	ctx context.Context,
+	in *notifications.PreviewNotificationIn,
+) (*notifications.PreviewNotificationOut, error) {
+	var (
+		preview *entities.NotificationPreview
+		err     error
+	)
+
+	switch entities.NotificationType(in.GetNotificationType()) {
+	case entities.NotificationTypeVerifyEmail:
+		preview, err = api.useCases.PreviewVerifyEmailCommunication(
+			ctx,
+			dto.VerifyEmailDTO{UserID: in.GetUserID(), Locale: in.GetLocale()},
+		)
+	case entities.NotificationTypeForgetPassword:
+		preview, err = api.useCases.PreviewForgetPasswordEmailCommunication(
+			ctx,
+			dto.ForgetPasswordDTO{UserID: in.GetUserID(), Locale: in.GetLocale()},
+		)
+	case entities.NotificationTypeTicketUpdated:
+		preview, err = api.useCases.PreviewTicketUpdatedEmailCommunication(
+			ctx,
+			dto.TicketUpdatedDTO{TicketID: in.GetTicketID()},
+			in.MasterID,
+		)
+	case entities.NotificationTypeTicketDeleted:
+		preview, err = api.useCases.PreviewTicketDeletedEmailCommunication(
+			ctx,
+			dto.TicketDeletedDTO{
+				TicketOwnerID:       in.GetTicketOwnerID(),
+				Name:                in.GetName(),
+				Description:         in.GetDescription(),
+				Price:               in.Price,
+				Quantity:            in.GetQuantity(),
+				RespondedMastersIDs: in.GetRespondedMastersIDs(),
+			},
+			in.MasterID,
+		)
+	default:
+		return nil, &customgrpc.BaseError{
+			Status:  codes.InvalidArgument,
+			Message: fmt.Sprintf("unknown notification type %q", in.GetNotificationType()),
+		}
+	}
+
+	if err != nil {
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			fmt.Sprintf(
+				"Error occurred while trying to preview Notification with Type=%s",
+				in.GetNotificationType(),
+			),
+			err,
+		)
+
+		return nil, previewError(err)
+	}
+
+	return &notifications.PreviewNotificationOut{
+		RecipientID: preview.RecipientID,
+		Subject:     preview.Subject,
+		Html:        preview.HTML,
+		Text:        preview.Text,
+	}, nil
+}
+
+func previewError(err error) error {
+	switch {
+	case errors.As(err, new(*customerrors.PreviewRecipientNotFoundError)):
+		return &customgrpc.BaseError{Status: codes.NotFound, Message: err.Error()}
+	default:
+		return &customgrpc.BaseError{Status: codes.Internal, Message: err.Error()}
+	}
+}
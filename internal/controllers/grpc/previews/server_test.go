@@ -0,0 +1,136 @@
+package previews
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+
+	customgrpc "github.com/DKhorkov/libs/grpc"
+	mocklogging "github.com/DKhorkov/libs/logging/mocks"
+	"github.com/DKhorkov/libs/pointers"
+
+	"github.com/DKhorkov/hmtm-notifications/api/protobuf/generated/go/notifications"
+	"github.com/DKhorkov/hmtm-notifications/dto"
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-notifications/internal/errors"
+	mockusecases "github.com/DKhorkov/hmtm-notifications/mocks/usecases"
+)
+
+func TestServerAPI_PreviewNotification(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCases := mockusecases.NewMockUseCases(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+	api := &ServerAPI{
+		useCases: useCases,
+		logger:   logger,
+	}
+
+	testCases := []struct {
+		name          string
+		in            *notifications.PreviewNotificationIn
+		setupMocks    func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger)
+		expectedOut   *notifications.PreviewNotificationOut
+		expectedErr   error
+		errorExpected bool
+	}{
+		{
+			name: "verify email",
+			in: &notifications.PreviewNotificationIn{
+				NotificationType: "verify_email",
+				UserID:           1,
+				Locale:           pointers.New("en"),
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, _ *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					PreviewVerifyEmailCommunication(gomock.Any(), dto.VerifyEmailDTO{UserID: 1, Locale: "en"}).
+					Return(
+						&entities.NotificationPreview{RecipientID: 1, Subject: "Subject", HTML: "HTML", Text: "Text"},
+						nil,
+					).
+					Times(1)
+			},
+			expectedOut: &notifications.PreviewNotificationOut{
+				RecipientID: 1,
+				Subject:     "Subject",
+				Html:        "HTML",
+				Text:        "Text",
+			},
+		},
+		{
+			name: "ticket updated for master",
+			in: &notifications.PreviewNotificationIn{
+				NotificationType: "ticket_updated",
+				TicketID:         1,
+				MasterID:         pointers.New[uint64](2),
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, _ *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					PreviewTicketUpdatedEmailCommunication(
+						gomock.Any(),
+						dto.TicketUpdatedDTO{TicketID: 1},
+						pointers.New[uint64](2),
+					).
+					Return(&entities.NotificationPreview{RecipientID: 3}, nil).
+					Times(1)
+			},
+			expectedOut: &notifications.PreviewNotificationOut{RecipientID: 3},
+		},
+		{
+			name: "ticket deleted without recipient",
+			in: &notifications.PreviewNotificationIn{
+				NotificationType: "ticket_deleted",
+				TicketOwnerID:    1,
+				Name:             "Ticket",
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					PreviewTicketDeletedEmailCommunication(
+						gomock.Any(),
+						dto.TicketDeletedDTO{TicketOwnerID: 1, Name: "Ticket"},
+						nil,
+					).
+					Return(nil, &customerrors.PreviewRecipientNotFoundError{Message: "not found"}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			expectedErr:   &customgrpc.BaseError{Status: codes.NotFound, Message: "not found"},
+			errorExpected: true,
+		},
+		{
+			name: "unknown notification type",
+			in: &notifications.PreviewNotificationIn{
+				NotificationType: "unknown",
+			},
+			expectedErr:   &customgrpc.BaseError{Status: codes.InvalidArgument, Message: `unknown notification type "unknown"`},
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(useCases, logger)
+			}
+
+			resp, err := api.PreviewNotification(context.Background(), tc.in)
+			if tc.errorExpected {
+				require.Error(t, err)
+				require.Equal(t, tc.expectedErr, err)
+				require.Nil(t, resp)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedOut, resp)
+			}
+		})
+	}
+}
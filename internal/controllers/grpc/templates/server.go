@@ -0,0 +1,193 @@
+package templates
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/DKhorkov/libs/logging"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	customgrpc "github.com/DKhorkov/libs/grpc"
+
+	"github.com/DKhorkov/hmtm-notifications/api/protobuf/generated/go/notifications"
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-notifications/internal/errors"
+	"github.com/DKhorkov/hmtm-notifications/internal/interfaces"
+)
+
+// RegisterServer handler (serverAPI) connects TemplatesServer to gRPC server:.
+func RegisterServer(gRPCServer *grpc.Server, useCases interfaces.UseCases, logger logging.Logger) {
+	notifications.RegisterTemplatesServiceServer(gRPCServer, &ServerAPI{useCases: useCases, logger: logger})
+}
+
+type ServerAPI struct {
+	// Helps to test single endpoints, if others is not implemented yet
+	notifications.UnimplementedTemplatesServiceServer
+	useCases interfaces.UseCases
+	logger   logging.Logger
+}
+
+func (api ServerAPI) CreateTemplate(
+	ctx context.Context,
+	in *notifications.CreateTemplateIn,
+) (*notifications.TemplateOut, error) {
+	template, err := api.useCases.CreateTemplate(
+		ctx,
+		entities.Template{
+			NotificationType: entities.NotificationType(in.GetNotificationType()),
+			Locale:           in.GetLocale(),
+			Subject:          in.GetSubject(),
+			HTML:             in.GetHtml(),
+			Text:             in.GetText(),
+		},
+	)
+	if err != nil {
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			fmt.Sprintf(
+				"Error occurred while trying to create Template for NotificationType=%s and Locale=%s",
+				in.GetNotificationType(),
+				in.GetLocale(),
+			),
+			err,
+		)
+
+		return nil, templateError(err)
+	}
+
+	return &notifications.TemplateOut{Template: processTemplate(*template)}, nil
+}
+
+func (api ServerAPI) UpdateTemplate(
+	ctx context.Context,
+	in *notifications.UpdateTemplateIn,
+) (*notifications.TemplateOut, error) {
+	template, err := api.useCases.UpdateTemplate(ctx, in.GetID(), in.GetSubject(), in.GetHtml(), in.GetText())
+	if err != nil {
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			fmt.Sprintf("Error occurred while trying to update Template with ID=%d", in.GetID()),
+			err,
+		)
+
+		return nil, templateError(err)
+	}
+
+	return &notifications.TemplateOut{Template: processTemplate(*template)}, nil
+}
+
+func (api ServerAPI) PublishTemplate(
+	ctx context.Context,
+	in *notifications.PublishTemplateIn,
+) (*notifications.TemplateOut, error) {
+	template, err := api.useCases.PublishTemplate(ctx, in.GetID())
+	if err != nil {
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			fmt.Sprintf("Error occurred while trying to publish Template with ID=%d", in.GetID()),
+			err,
+		)
+
+		return nil, templateError(err)
+	}
+
+	return &notifications.TemplateOut{Template: processTemplate(*template)}, nil
+}
+
+func (api ServerAPI) GetTemplates(
+	ctx context.Context,
+	in *notifications.GetTemplatesIn,
+) (*notifications.GetTemplatesOut, error) {
+	filters := entities.TemplatesFilters{Locale: in.Locale}
+	if in.NotificationType != nil {
+		notificationType := entities.NotificationType(in.GetNotificationType())
+		filters.NotificationType = &notificationType
+	}
+
+	templates, err := api.useCases.GetTemplates(ctx, filters)
+	if err != nil {
+		logging.LogErrorContext(ctx, api.logger, "Error occurred while trying to get Templates", err)
+
+		return nil, &customgrpc.BaseError{Status: codes.Internal, Message: err.Error()}
+	}
+
+	processedTemplates := make([]*notifications.Template, len(templates))
+	for i, template := range templates {
+		processedTemplates[i] = processTemplate(template)
+	}
+
+	return &notifications.GetTemplatesOut{Templates: processedTemplates}, nil
+}
+
+func (api ServerAPI) DiffTemplates(
+	ctx context.Context,
+	in *notifications.DiffTemplatesIn,
+) (*notifications.DiffTemplatesOut, error) {
+	diff, err := api.useCases.DiffTemplates(ctx, in.GetFromID(), in.GetToID())
+	if err != nil {
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			fmt.Sprintf(
+				"Error occurred while trying to diff Templates with FromID=%d and ToID=%d",
+				in.GetFromID(),
+				in.GetToID(),
+			),
+			err,
+		)
+
+		return nil, templateError(err)
+	}
+
+	return &notifications.DiffTemplatesOut{
+		From:    processTemplate(diff.From),
+		To:      processTemplate(diff.To),
+		Subject: diff.Subject,
+		Html:    diff.HTML,
+		Text:    diff.Text,
+	}, nil
+}
+
+func templateError(err error) error {
+	switch {
+	case errors.As(err, new(*customerrors.TemplateNotFoundError)):
+		return &customgrpc.BaseError{Status: codes.NotFound, Message: err.Error()}
+	case errors.As(err, new(*customerrors.InvalidTemplateError)):
+		return &customgrpc.BaseError{Status: codes.InvalidArgument, Message: err.Error()}
+	case errors.As(err, new(*customerrors.TemplateNotDraftError)):
+		return &customgrpc.BaseError{Status: codes.FailedPrecondition, Message: err.Error()}
+	default:
+		return &customgrpc.BaseError{Status: codes.Internal, Message: err.Error()}
+	}
+}
+
+func processTemplate(template entities.Template) *notifications.Template {
+	return &notifications.Template{
+		ID:               template.ID,
+		NotificationType: string(template.NotificationType),
+		Locale:           template.Locale,
+		Version:          template.Version,
+		State:            string(template.State),
+		Subject:          template.Subject,
+		Html:             template.HTML,
+		Text:             template.Text,
+		CreatedAt:        timestamppb.New(template.CreatedAt),
+		UpdatedAt:        timestamppb.New(template.UpdatedAt),
+		PublishedAt:      optionalTimestamp(template.PublishedAt),
+	}
+}
+
+func optionalTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+
+	return timestamppb.New(*t)
+}
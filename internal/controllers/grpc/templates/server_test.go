@@ -0,0 +1,353 @@
+package templates
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	customgrpc "github.com/DKhorkov/libs/grpc"
+	mocklogging "github.com/DKhorkov/libs/logging/mocks"
+	"github.com/DKhorkov/libs/pointers"
+
+	"github.com/DKhorkov/hmtm-notifications/api/protobuf/generated/go/notifications"
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-notifications/internal/errors"
+	mockusecases "github.com/DKhorkov/hmtm-notifications/mocks/usecases"
+)
+
+var createdAt = time.Date(2025, 5, 8, 0, 0, 0, 0, time.UTC)
+
+func TestServerAPI_CreateTemplate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCases := mockusecases.NewMockUseCases(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+	api := &ServerAPI{
+		useCases: useCases,
+		logger:   logger,
+	}
+
+	in := &notifications.CreateTemplateIn{
+		NotificationType: "verify_email",
+		Locale:           "en",
+		Html:             "{{.Link}}",
+	}
+
+	template := entities.Template{
+		NotificationType: entities.NotificationTypeVerifyEmail,
+		Locale:           "en",
+		HTML:             "{{.Link}}",
+	}
+
+	testCases := []struct {
+		name          string
+		setupMocks    func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger)
+		expectedOut   *notifications.TemplateOut
+		expectedErr   error
+		errorExpected bool
+	}{
+		{
+			name: "success",
+			setupMocks: func(useCases *mockusecases.MockUseCases, _ *mocklogging.MockLogger) {
+				created := template
+				created.ID = 1
+				created.Version = 2
+				created.State = entities.TemplateStateDraft
+				created.CreatedAt = createdAt
+				created.UpdatedAt = createdAt
+
+				useCases.
+					EXPECT().
+					CreateTemplate(gomock.Any(), template).
+					Return(&created, nil).
+					Times(1)
+			},
+			expectedOut: &notifications.TemplateOut{
+				Template: &notifications.Template{
+					ID:               1,
+					NotificationType: "verify_email",
+					Locale:           "en",
+					Version:          2,
+					State:            "draft",
+					Html:             "{{.Link}}",
+					CreatedAt:        timestamppb.New(createdAt),
+					UpdatedAt:        timestamppb.New(createdAt),
+				},
+			},
+		},
+		{
+			name: "invalid template",
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					CreateTemplate(gomock.Any(), template).
+					Return(nil, &customerrors.InvalidTemplateError{Message: "invalid"}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			expectedErr:   &customgrpc.BaseError{Status: codes.InvalidArgument, Message: "invalid"},
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(useCases, logger)
+			}
+
+			resp, err := api.CreateTemplate(context.Background(), in)
+			if tc.errorExpected {
+				require.Error(t, err)
+				require.Equal(t, tc.expectedErr, err)
+				require.Nil(t, resp)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedOut, resp)
+			}
+		})
+	}
+}
+
+func TestServerAPI_UpdateTemplate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCases := mockusecases.NewMockUseCases(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+	api := &ServerAPI{
+		useCases: useCases,
+		logger:   logger,
+	}
+
+	useCases.
+		EXPECT().
+		UpdateTemplate(gomock.Any(), uint64(1), "subject", "html", "text").
+		Return(nil, &customerrors.TemplateNotDraftError{Message: "not draft"}).
+		Times(1)
+
+	logger.
+		EXPECT().
+		ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(1)
+
+	resp, err := api.UpdateTemplate(
+		context.Background(),
+		&notifications.UpdateTemplateIn{ID: 1, Subject: "subject", Html: "html", Text: "text"},
+	)
+	require.Equal(t, &customgrpc.BaseError{Status: codes.FailedPrecondition, Message: "not draft"}, err)
+	require.Nil(t, resp)
+}
+
+func TestServerAPI_PublishTemplate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCases := mockusecases.NewMockUseCases(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+	api := &ServerAPI{
+		useCases: useCases,
+		logger:   logger,
+	}
+
+	testCases := []struct {
+		name          string
+		setupMocks    func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger)
+		expectedOut   *notifications.TemplateOut
+		expectedErr   error
+		errorExpected bool
+	}{
+		{
+			name: "success",
+			setupMocks: func(useCases *mockusecases.MockUseCases, _ *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					PublishTemplate(gomock.Any(), uint64(1)).
+					Return(
+						&entities.Template{
+							ID:          1,
+							State:       entities.TemplateStatePublished,
+							CreatedAt:   createdAt,
+							UpdatedAt:   createdAt,
+							PublishedAt: &createdAt,
+						},
+						nil,
+					).
+					Times(1)
+			},
+			expectedOut: &notifications.TemplateOut{
+				Template: &notifications.Template{
+					ID:          1,
+					State:       "published",
+					CreatedAt:   timestamppb.New(createdAt),
+					UpdatedAt:   timestamppb.New(createdAt),
+					PublishedAt: timestamppb.New(createdAt),
+				},
+			},
+		},
+		{
+			name: "not found",
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					PublishTemplate(gomock.Any(), uint64(1)).
+					Return(nil, &customerrors.TemplateNotFoundError{}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			expectedErr:   &customgrpc.BaseError{Status: codes.NotFound, Message: "template not found"},
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(useCases, logger)
+			}
+
+			resp, err := api.PublishTemplate(context.Background(), &notifications.PublishTemplateIn{ID: 1})
+			if tc.errorExpected {
+				require.Error(t, err)
+				require.Equal(t, tc.expectedErr, err)
+				require.Nil(t, resp)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedOut, resp)
+			}
+		})
+	}
+}
+
+func TestServerAPI_GetTemplates(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCases := mockusecases.NewMockUseCases(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+	api := &ServerAPI{
+		useCases: useCases,
+		logger:   logger,
+	}
+
+	notificationType := entities.NotificationTypeTicketDeleted
+	useCases.
+		EXPECT().
+		GetTemplates(
+			gomock.Any(),
+			entities.TemplatesFilters{NotificationType: &notificationType, Locale: pointers.New("ru")},
+		).
+		Return(
+			[]entities.Template{
+				{
+					ID:               1,
+					NotificationType: entities.NotificationTypeTicketDeleted,
+					Locale:           "ru",
+					Version:          1,
+					State:            entities.TemplateStateArchived,
+					CreatedAt:        createdAt,
+					UpdatedAt:        createdAt,
+				},
+			},
+			nil,
+		).
+		Times(1)
+
+	resp, err := api.GetTemplates(
+		context.Background(),
+		&notifications.GetTemplatesIn{NotificationType: pointers.New("ticket_deleted"), Locale: pointers.New("ru")},
+	)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		&notifications.GetTemplatesOut{
+			Templates: []*notifications.Template{
+				{
+					ID:               1,
+					NotificationType: "ticket_deleted",
+					Locale:           "ru",
+					Version:          1,
+					State:            "archived",
+					CreatedAt:        timestamppb.New(createdAt),
+					UpdatedAt:        timestamppb.New(createdAt),
+				},
+			},
+		},
+		resp,
+	)
+}
+
+func TestServerAPI_GetTemplatesError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCases := mockusecases.NewMockUseCases(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+	api := &ServerAPI{
+		useCases: useCases,
+		logger:   logger,
+	}
+
+	useCases.
+		EXPECT().
+		GetTemplates(gomock.Any(), entities.TemplatesFilters{}).
+		Return(nil, errors.New("db error")).
+		Times(1)
+
+	logger.
+		EXPECT().
+		ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(1)
+
+	resp, err := api.GetTemplates(context.Background(), &notifications.GetTemplatesIn{})
+	require.Equal(t, &customgrpc.BaseError{Status: codes.Internal, Message: "db error"}, err)
+	require.Nil(t, resp)
+}
+
+func TestServerAPI_DiffTemplates(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCases := mockusecases.NewMockUseCases(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+	api := &ServerAPI{
+		useCases: useCases,
+		logger:   logger,
+	}
+
+	useCases.
+		EXPECT().
+		DiffTemplates(gomock.Any(), uint64(1), uint64(2)).
+		Return(
+			&entities.TemplateDiff{
+				From: entities.Template{ID: 1, CreatedAt: createdAt, UpdatedAt: createdAt},
+				To:   entities.Template{ID: 2, CreatedAt: createdAt, UpdatedAt: createdAt},
+				HTML: "-a\n+b",
+			},
+			nil,
+		).
+		Times(1)
+
+	resp, err := api.DiffTemplates(context.Background(), &notifications.DiffTemplatesIn{FromID: 1, ToID: 2})
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		&notifications.DiffTemplatesOut{
+			From: &notifications.Template{
+				ID:        1,
+				CreatedAt: timestamppb.New(createdAt),
+				UpdatedAt: timestamppb.New(createdAt),
+			},
+			To: &notifications.Template{
+				ID:        2,
+				CreatedAt: timestamppb.New(createdAt),
+				UpdatedAt: timestamppb.New(createdAt),
+			},
+			Html: "-a\n+b",
+		},
+		resp,
+	)
+}
@@ -0,0 +1,97 @@
+package tokens
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/DKhorkov/libs/logging"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	customgrpc "github.com/DKhorkov/libs/grpc"
+
+	"github.com/DKhorkov/hmtm-notifications/api/protobuf/generated/go/notifications"
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-notifications/internal/errors"
+	"github.com/DKhorkov/hmtm-notifications/internal/interfaces"
+)
+
+// RegisterServer handler (serverAPI) connects TokensServer to gRPC server:.
+func RegisterServer(gRPCServer *grpc.Server, useCases interfaces.UseCases, logger logging.Logger) {
+	notifications.RegisterTokensServiceServer(gRPCServer, &ServerAPI{useCases: useCases, logger: logger})
+}
+
+type ServerAPI struct {
+	// Helps to test single endpoints, if others is not implemented yet
+	notifications.UnimplementedTokensServiceServer
+	useCases interfaces.UseCases
+	logger   logging.Logger
+}
+
+// Tokens grant actions on behalf of users, so they are never logged.
+
+func (api ServerAPI) VerifyToken(ctx context.Context, in *notifications.VerifyTokenIn) (*notifications.Token, error) {
+	token, err := api.useCases.VerifyToken(ctx, in.GetToken(), entities.TokenPurpose(in.GetPurpose()))
+	if err != nil {
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			fmt.Sprintf("Error occurred while trying to verify Token with purpose=%s", in.GetPurpose()),
+			err,
+		)
+
+		return nil, tokenError(err)
+	}
+
+	return processToken(*token), nil
+}
+
+func (api ServerAPI) ConsumeToken(ctx context.Context, in *notifications.ConsumeTokenIn) (*notifications.Token, error) {
+	token, err := api.useCases.ConsumeToken(ctx, in.GetToken(), entities.TokenPurpose(in.GetPurpose()))
+	if err != nil {
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			fmt.Sprintf("Error occurred while trying to consume Token with purpose=%s", in.GetPurpose()),
+			err,
+		)
+
+		return nil, tokenError(err)
+	}
+
+	return processToken(*token), nil
+}
+
+func tokenError(err error) error {
+	switch {
+	case errors.As(err, new(*customerrors.InvalidTokenError)):
+		return &customgrpc.BaseError{Status: codes.InvalidArgument, Message: err.Error()}
+	case errors.As(err, new(*customerrors.TokenNotFoundError)):
+		return &customgrpc.BaseError{Status: codes.NotFound, Message: err.Error()}
+	case errors.As(err, new(*customerrors.TokenExpiredError)),
+		errors.As(err, new(*customerrors.TokenConsumedError)):
+		return &customgrpc.BaseError{Status: codes.FailedPrecondition, Message: err.Error()}
+	default:
+		return &customgrpc.BaseError{Status: codes.Internal, Message: err.Error()}
+	}
+}
+
+func processToken(token entities.Token) *notifications.Token {
+	return &notifications.Token{
+		UserID:     token.UserID,
+		Purpose:    string(token.Purpose),
+		ExpiresAt:  timestamppb.New(token.ExpiresAt),
+		ConsumedAt: optionalTimestamp(token.ConsumedAt),
+	}
+}
+
+func optionalTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+
+	return timestamppb.New(*t)
+}
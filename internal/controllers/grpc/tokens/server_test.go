@@ -0,0 +1,219 @@
+package tokens
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	customgrpc "github.com/DKhorkov/libs/grpc"
+	mocklogging "github.com/DKhorkov/libs/logging/mocks"
+
+	"github.com/DKhorkov/hmtm-notifications/api/protobuf/generated/go/notifications"
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-notifications/internal/errors"
+	mockusecases "github.com/DKhorkov/hmtm-notifications/mocks/usecases"
+)
+
+var expiresAt = time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
+
+func TestServerAPI_VerifyToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCases := mockusecases.NewMockUseCases(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+	api := &ServerAPI{
+		useCases: useCases,
+		logger:   logger,
+	}
+
+	testCases := []struct {
+		name          string
+		setupMocks    func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger)
+		expectedOut   *notifications.Token
+		expectedErr   error
+		errorExpected bool
+	}{
+		{
+			name: "success",
+			setupMocks: func(useCases *mockusecases.MockUseCases, _ *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					VerifyToken(gomock.Any(), "token", entities.TokenPurposeVerifyEmail).
+					Return(
+						&entities.Token{
+							ID:        1,
+							Nonce:     "nonce",
+							UserID:    1,
+							Purpose:   entities.TokenPurposeVerifyEmail,
+							ExpiresAt: expiresAt,
+						},
+						nil,
+					).
+					Times(1)
+			},
+			expectedOut: &notifications.Token{
+				UserID:    1,
+				Purpose:   "verify_email",
+				ExpiresAt: timestamppb.New(expiresAt),
+			},
+		},
+		{
+			name: "invalid token",
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					VerifyToken(gomock.Any(), "token", entities.TokenPurposeVerifyEmail).
+					Return(nil, &customerrors.InvalidTokenError{Message: "invalid"}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			expectedErr:   &customgrpc.BaseError{Status: codes.InvalidArgument, Message: "invalid"},
+			errorExpected: true,
+		},
+		{
+			name: "not found",
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					VerifyToken(gomock.Any(), "token", entities.TokenPurposeVerifyEmail).
+					Return(nil, &customerrors.TokenNotFoundError{}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			expectedErr:   &customgrpc.BaseError{Status: codes.NotFound, Message: "token not found"},
+			errorExpected: true,
+		},
+		{
+			name: "expired",
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					VerifyToken(gomock.Any(), "token", entities.TokenPurposeVerifyEmail).
+					Return(nil, &customerrors.TokenExpiredError{}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			expectedErr:   &customgrpc.BaseError{Status: codes.FailedPrecondition, Message: "token has expired"},
+			errorExpected: true,
+		},
+		{
+			name: "internal error",
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					VerifyToken(gomock.Any(), "token", entities.TokenPurposeVerifyEmail).
+					Return(nil, errors.New("error")).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			expectedErr:   &customgrpc.BaseError{Status: codes.Internal, Message: "error"},
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(useCases, logger)
+			}
+
+			resp, err := api.VerifyToken(
+				context.Background(),
+				&notifications.VerifyTokenIn{Token: "token", Purpose: "verify_email"},
+			)
+			if tc.errorExpected {
+				require.Error(t, err)
+				require.Equal(t, tc.expectedErr, err)
+				require.Nil(t, resp)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedOut, resp)
+			}
+		})
+	}
+}
+
+func TestServerAPI_ConsumeToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCases := mockusecases.NewMockUseCases(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+	api := &ServerAPI{
+		useCases: useCases,
+		logger:   logger,
+	}
+
+	consumedAt := expiresAt.Add(-time.Minute)
+
+	useCases.
+		EXPECT().
+		ConsumeToken(gomock.Any(), "token", entities.TokenPurposeForgetPassword).
+		Return(
+			&entities.Token{
+				UserID:     1,
+				Purpose:    entities.TokenPurposeForgetPassword,
+				ExpiresAt:  expiresAt,
+				ConsumedAt: &consumedAt,
+			},
+			nil,
+		).
+		Times(1)
+
+	resp, err := api.ConsumeToken(
+		context.Background(),
+		&notifications.ConsumeTokenIn{Token: "token", Purpose: "forget_password"},
+	)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		&notifications.Token{
+			UserID:     1,
+			Purpose:    "forget_password",
+			ExpiresAt:  timestamppb.New(expiresAt),
+			ConsumedAt: timestamppb.New(consumedAt),
+		},
+		resp,
+	)
+
+	useCases.
+		EXPECT().
+		ConsumeToken(gomock.Any(), "token", entities.TokenPurposeForgetPassword).
+		Return(nil, &customerrors.TokenConsumedError{}).
+		Times(1)
+
+	logger.
+		EXPECT().
+		ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(1)
+
+	resp, err = api.ConsumeToken(
+		context.Background(),
+		&notifications.ConsumeTokenIn{Token: "token", Purpose: "forget_password"},
+	)
+	require.Equal(
+		t,
+		&customgrpc.BaseError{Status: codes.FailedPrecondition, Message: "token has already been used"},
+		err,
+	)
+	require.Nil(t, resp)
+}
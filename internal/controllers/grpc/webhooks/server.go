@@ -0,0 +1,205 @@
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/DKhorkov/libs/logging"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	customgrpc "github.com/DKhorkov/libs/grpc"
+
+	"github.com/DKhorkov/hmtm-notifications/api/protobuf/generated/go/notifications"
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-notifications/internal/errors"
+	"github.com/DKhorkov/hmtm-notifications/internal/interfaces"
+)
+
+// RegisterServer handler (serverAPI) connects WebhooksServer to gRPC server:.
+func RegisterServer(gRPCServer *grpc.Server, useCases interfaces.UseCases, logger logging.Logger) {
+	notifications.RegisterWebhooksServiceServer(gRPCServer, &ServerAPI{useCases: useCases, logger: logger})
+}
+
+type ServerAPI struct {
+	// Helps to test single endpoints, if others is not implemented yet
+	notifications.UnimplementedWebhooksServiceServer
+	useCases interfaces.UseCases
+	logger   logging.Logger
+}
+
+func (api ServerAPI) CreateWebhook(
+	ctx context.Context,
+	in *notifications.CreateWebhookIn,
+) (*notifications.CreateWebhookOut, error) {
+	webhook, err := api.useCases.CreateWebhook(ctx, in.GetUrl(), processEventTypes(in.GetEventTypes()))
+	if err != nil {
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			fmt.Sprintf("Error occurred while trying to create Webhook with URL=%s", in.GetUrl()),
+			err,
+		)
+
+		return nil, webhookError(err)
+	}
+
+	return &notifications.CreateWebhookOut{
+		Webhook: processWebhook(*webhook),
+		Secret:  webhook.Secret,
+	}, nil
+}
+
+func (api ServerAPI) GetWebhooks(ctx context.Context, _ *emptypb.Empty) (*notifications.GetWebhooksOut, error) {
+	webhooks, err := api.useCases.GetWebhooks(ctx)
+	if err != nil {
+		logging.LogErrorContext(ctx, api.logger, "Error occurred while trying to get Webhooks", err)
+
+		return nil, &customgrpc.BaseError{Status: codes.Internal, Message: err.Error()}
+	}
+
+	processedWebhooks := make([]*notifications.Webhook, len(webhooks))
+	for i, webhook := range webhooks {
+		processedWebhooks[i] = processWebhook(webhook)
+	}
+
+	return &notifications.GetWebhooksOut{Webhooks: processedWebhooks}, nil
+}
+
+func (api ServerAPI) UpdateWebhook(ctx context.Context, in *notifications.UpdateWebhookIn) (*emptypb.Empty, error) {
+	webhook := entities.Webhook{
+		ID:         in.GetID(),
+		URL:        in.GetUrl(),
+		EventTypes: processEventTypes(in.GetEventTypes()),
+		Enabled:    in.GetEnabled(),
+	}
+
+	if err := api.useCases.UpdateWebhook(ctx, webhook); err != nil {
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			fmt.Sprintf("Error occurred while trying to update Webhook with ID=%d", in.GetID()),
+			err,
+		)
+
+		return nil, webhookError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (api ServerAPI) DeleteWebhook(ctx context.Context, in *notifications.DeleteWebhookIn) (*emptypb.Empty, error) {
+	if err := api.useCases.DeleteWebhook(ctx, in.GetID()); err != nil {
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			fmt.Sprintf("Error occurred while trying to delete Webhook with ID=%d", in.GetID()),
+			err,
+		)
+
+		return nil, webhookError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (api ServerAPI) GetWebhookDeliveries(
+	ctx context.Context,
+	in *notifications.GetWebhookDeliveriesIn,
+) (*notifications.GetWebhookDeliveriesOut, error) {
+	var pagination *entities.Pagination
+	if in.GetPagination() != nil {
+		pagination = &entities.Pagination{
+			Limit:  in.Pagination.Limit,
+			Offset: in.Pagination.Offset,
+		}
+	}
+
+	deliveries, err := api.useCases.GetWebhookDeliveries(ctx, in.GetWebhookID(), pagination)
+	if err != nil {
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			fmt.Sprintf("Error occurred while trying to get Deliveries for Webhook with ID=%d", in.GetWebhookID()),
+			err,
+		)
+
+		return nil, webhookError(err)
+	}
+
+	processedDeliveries := make([]*notifications.WebhookDelivery, len(deliveries))
+	for i, delivery := range deliveries {
+		processedDeliveries[i] = processWebhookDelivery(delivery)
+	}
+
+	return &notifications.GetWebhookDeliveriesOut{Deliveries: processedDeliveries}, nil
+}
+
+func webhookError(err error) error {
+	switch {
+	case errors.As(err, new(*customerrors.WebhookNotFoundError)):
+		return &customgrpc.BaseError{Status: codes.NotFound, Message: err.Error()}
+	case errors.As(err, new(*customerrors.InvalidWebhookError)):
+		return &customgrpc.BaseError{Status: codes.InvalidArgument, Message: err.Error()}
+	default:
+		return &customgrpc.BaseError{Status: codes.Internal, Message: err.Error()}
+	}
+}
+
+func processEventTypes(rawEventTypes []string) []entities.NotificationType {
+	if len(rawEventTypes) == 0 {
+		return nil
+	}
+
+	eventTypes := make([]entities.NotificationType, len(rawEventTypes))
+	for i, eventType := range rawEventTypes {
+		eventTypes[i] = entities.NotificationType(eventType)
+	}
+
+	return eventTypes
+}
+
+func processWebhook(webhook entities.Webhook) *notifications.Webhook {
+	eventTypes := make([]string, len(webhook.EventTypes))
+	for i, eventType := range webhook.EventTypes {
+		eventTypes[i] = string(eventType)
+	}
+
+	return &notifications.Webhook{
+		ID:                  webhook.ID,
+		Url:                 webhook.URL,
+		EventTypes:          eventTypes,
+		Enabled:             webhook.Enabled,
+		ConsecutiveFailures: webhook.ConsecutiveFailures,
+		CreatedAt:           timestamppb.New(webhook.CreatedAt),
+		UpdatedAt:           timestamppb.New(webhook.UpdatedAt),
+		DisabledAt:          optionalTimestamp(webhook.DisabledAt),
+	}
+}
+
+func processWebhookDelivery(delivery entities.WebhookDelivery) *notifications.WebhookDelivery {
+	return &notifications.WebhookDelivery{
+		ID:            delivery.ID,
+		WebhookID:     delivery.WebhookID,
+		DeliveryID:    delivery.DeliveryID,
+		EventType:     string(delivery.EventType),
+		Status:        string(delivery.Status),
+		Attempts:      delivery.Attempts,
+		LastError:     delivery.LastError,
+		NextAttemptAt: optionalTimestamp(delivery.NextAttemptAt),
+		CreatedAt:     timestamppb.New(delivery.CreatedAt),
+		DeliveredAt:   optionalTimestamp(delivery.DeliveredAt),
+	}
+}
+
+func optionalTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+
+	return timestamppb.New(*t)
+}
@@ -0,0 +1,324 @@
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	customgrpc "github.com/DKhorkov/libs/grpc"
+	mocklogging "github.com/DKhorkov/libs/logging/mocks"
+	"github.com/DKhorkov/libs/pointers"
+
+	"github.com/DKhorkov/hmtm-notifications/api/protobuf/generated/go/notifications"
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-notifications/internal/errors"
+	mockusecases "github.com/DKhorkov/hmtm-notifications/mocks/usecases"
+)
+
+var createdAt = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func TestServerAPI_CreateWebhook(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCases := mockusecases.NewMockUseCases(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+	api := &ServerAPI{
+		useCases: useCases,
+		logger:   logger,
+	}
+
+	testCases := []struct {
+		name          string
+		in            *notifications.CreateWebhookIn
+		setupMocks    func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger)
+		expectedOut   *notifications.CreateWebhookOut
+		expectedErr   error
+		errorExpected bool
+	}{
+		{
+			name: "success",
+			in: &notifications.CreateWebhookIn{
+				Url:        "https://example.com/hooks",
+				EventTypes: []string{"ticket_updated"},
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, _ *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					CreateWebhook(
+						gomock.Any(),
+						"https://example.com/hooks",
+						[]entities.NotificationType{entities.NotificationTypeTicketUpdated},
+					).
+					Return(
+						&entities.Webhook{
+							ID:         1,
+							URL:        "https://example.com/hooks",
+							Secret:     "secret",
+							EventTypes: []entities.NotificationType{entities.NotificationTypeTicketUpdated},
+							Enabled:    true,
+							CreatedAt:  createdAt,
+							UpdatedAt:  createdAt,
+						},
+						nil,
+					).
+					Times(1)
+			},
+			expectedOut: &notifications.CreateWebhookOut{
+				Webhook: &notifications.Webhook{
+					ID:         1,
+					Url:        "https://example.com/hooks",
+					EventTypes: []string{"ticket_updated"},
+					Enabled:    true,
+					CreatedAt:  timestamppb.New(createdAt),
+					UpdatedAt:  timestamppb.New(createdAt),
+				},
+				Secret: "secret",
+			},
+		},
+		{
+			name: "invalid webhook",
+			in:   &notifications.CreateWebhookIn{Url: "/hooks"},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					CreateWebhook(gomock.Any(), "/hooks", nil).
+					Return(nil, &customerrors.InvalidWebhookError{Message: "invalid"}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			expectedErr:   &customgrpc.BaseError{Status: codes.InvalidArgument, Message: "invalid"},
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(useCases, logger)
+			}
+
+			resp, err := api.CreateWebhook(context.Background(), tc.in)
+			if tc.errorExpected {
+				require.Error(t, err)
+				require.Equal(t, tc.expectedErr, err)
+				require.Nil(t, resp)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedOut, resp)
+			}
+		})
+	}
+}
+
+func TestServerAPI_GetWebhooks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCases := mockusecases.NewMockUseCases(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+	api := &ServerAPI{
+		useCases: useCases,
+		logger:   logger,
+	}
+
+	disabledAt := createdAt.Add(time.Hour)
+
+	useCases.
+		EXPECT().
+		GetWebhooks(gomock.Any()).
+		Return(
+			[]entities.Webhook{
+				{
+					ID:                  1,
+					URL:                 "https://example.com/hooks",
+					Secret:              "secret",
+					ConsecutiveFailures: 5,
+					CreatedAt:           createdAt,
+					UpdatedAt:           disabledAt,
+					DisabledAt:          &disabledAt,
+				},
+			},
+			nil,
+		).
+		Times(1)
+
+	resp, err := api.GetWebhooks(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		&notifications.GetWebhooksOut{
+			Webhooks: []*notifications.Webhook{
+				{
+					ID:                  1,
+					Url:                 "https://example.com/hooks",
+					EventTypes:          []string{},
+					ConsecutiveFailures: 5,
+					CreatedAt:           timestamppb.New(createdAt),
+					UpdatedAt:           timestamppb.New(disabledAt),
+					DisabledAt:          timestamppb.New(disabledAt),
+				},
+			},
+		},
+		resp,
+	)
+}
+
+func TestServerAPI_UpdateWebhook(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCases := mockusecases.NewMockUseCases(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+	api := &ServerAPI{
+		useCases: useCases,
+		logger:   logger,
+	}
+
+	useCases.
+		EXPECT().
+		UpdateWebhook(
+			gomock.Any(),
+			entities.Webhook{ID: 1, URL: "https://example.com/hooks", Enabled: true},
+		).
+		Return(&customerrors.WebhookNotFoundError{}).
+		Times(1)
+
+	logger.
+		EXPECT().
+		ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(1)
+
+	resp, err := api.UpdateWebhook(
+		context.Background(),
+		&notifications.UpdateWebhookIn{ID: 1, Url: "https://example.com/hooks", Enabled: true},
+	)
+	require.Nil(t, resp)
+	require.Equal(t, &customgrpc.BaseError{Status: codes.NotFound, Message: "webhook not found"}, err)
+}
+
+func TestServerAPI_DeleteWebhook(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCases := mockusecases.NewMockUseCases(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+	api := &ServerAPI{
+		useCases: useCases,
+		logger:   logger,
+	}
+
+	useCases.
+		EXPECT().
+		DeleteWebhook(gomock.Any(), uint64(1)).
+		Return(nil).
+		Times(1)
+
+	resp, err := api.DeleteWebhook(context.Background(), &notifications.DeleteWebhookIn{ID: 1})
+	require.NoError(t, err)
+	require.Equal(t, &emptypb.Empty{}, resp)
+}
+
+func TestServerAPI_GetWebhookDeliveries(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCases := mockusecases.NewMockUseCases(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+	api := &ServerAPI{
+		useCases: useCases,
+		logger:   logger,
+	}
+
+	testCases := []struct {
+		name          string
+		in            *notifications.GetWebhookDeliveriesIn
+		setupMocks    func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger)
+		expectedOut   *notifications.GetWebhookDeliveriesOut
+		expectedErr   error
+		errorExpected bool
+	}{
+		{
+			name: "success",
+			in: &notifications.GetWebhookDeliveriesIn{
+				WebhookID:  1,
+				Pagination: &notifications.Pagination{Limit: pointers.New[uint64](1)},
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, _ *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					GetWebhookDeliveries(gomock.Any(), uint64(1), &entities.Pagination{Limit: pointers.New[uint64](1)}).
+					Return(
+						[]entities.WebhookDelivery{
+							{
+								ID:          2,
+								WebhookID:   1,
+								DeliveryID:  "delivery",
+								EventType:   entities.NotificationTypeVerifyEmail,
+								Payload:     "{}",
+								Status:      entities.WebhookDeliveryStatusDelivered,
+								Attempts:    2,
+								LastError:   pointers.New("timeout"),
+								CreatedAt:   createdAt,
+								DeliveredAt: &createdAt,
+							},
+						},
+						nil,
+					).
+					Times(1)
+			},
+			expectedOut: &notifications.GetWebhookDeliveriesOut{
+				Deliveries: []*notifications.WebhookDelivery{
+					{
+						ID:          2,
+						WebhookID:   1,
+						DeliveryID:  "delivery",
+						EventType:   "verify_email",
+						Status:      "delivered",
+						Attempts:    2,
+						LastError:   pointers.New("timeout"),
+						CreatedAt:   timestamppb.New(createdAt),
+						DeliveredAt: timestamppb.New(createdAt),
+					},
+				},
+			},
+		},
+		{
+			name: "internal error",
+			in:   &notifications.GetWebhookDeliveriesIn{WebhookID: 1},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogging.MockLogger) {
+				useCases.
+					EXPECT().
+					GetWebhookDeliveries(gomock.Any(), uint64(1), nil).
+					Return(nil, errors.New("internal error")).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			expectedErr:   &customgrpc.BaseError{Status: codes.Internal, Message: "internal error"},
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(useCases, logger)
+			}
+
+			resp, err := api.GetWebhookDeliveries(context.Background(), tc.in)
+			if tc.errorExpected {
+				require.Error(t, err)
+				require.Equal(t, tc.expectedErr, err)
+				require.Nil(t, resp)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedOut, resp)
+			}
+		})
+	}
+}
@@ -0,0 +1,242 @@
+package dispatchers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/DKhorkov/libs/logging"
+	"github.com/DKhorkov/libs/tracing"
+
+	"github.com/DKhorkov/hmtm-notifications/internal/config"
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+	"github.com/DKhorkov/hmtm-notifications/internal/interfaces"
+	"github.com/DKhorkov/hmtm-notifications/internal/runners"
+	"github.com/DKhorkov/hmtm-notifications/internal/senders"
+)
+
+// CommunicationsDispatcher sends pending communications, stored in communications outbox by usecases, through
+// their channels and marks them as sent or failed. Several dispatchers can work concurrently, because every
+// communication is claimed before sending.
+type CommunicationsDispatcher struct {
+	*runners.PeriodicRunner
+
+	communicationsService interfaces.CommunicationsService
+	communicationsSenders interfaces.Senders
+	config                config.DispatcherConfig
+	traceProvider         tracing.Provider
+	spanConfig            tracing.SpanConfig
+	logger                logging.Logger
+}
+
+func NewCommunicationsDispatcher(
+	communicationsService interfaces.CommunicationsService,
+	communicationsSenders interfaces.Senders,
+	config config.DispatcherConfig,
+	traceProvider tracing.Provider,
+	spanConfig tracing.SpanConfig,
+	logger logging.Logger,
+) *CommunicationsDispatcher {
+	dispatcher := &CommunicationsDispatcher{
+		communicationsService: communicationsService,
+		communicationsSenders: communicationsSenders,
+		config:                config,
+		traceProvider:         traceProvider,
+		spanConfig:            spanConfig,
+		logger:                logger,
+	}
+
+	dispatcher.PeriodicRunner = runners.NewPeriodicRunner(config.Interval, dispatcher.dispatch)
+
+	return dispatcher
+}
+
+// dispatch processes one batch of pending communications.
+func (d *CommunicationsDispatcher) dispatch(ctx context.Context) {
+	communications, err := d.communicationsService.GetPendingCommunications(ctx, uint64(d.config.BatchSize))
+	if err != nil {
+		logging.LogErrorContext(ctx, d.logger, "Failed to get pending communications", err)
+
+		return
+	}
+
+	for _, communication := range communications {
+		// Stop processing of current batch on shutdown. Not processed communications will be sent by next launch:
+		if ctx.Err() != nil {
+			return
+		}
+
+		d.process(ctx, communication)
+	}
+}
+
+func (d *CommunicationsDispatcher) process(ctx context.Context, communication entities.Communication) {
+	ctx, span := d.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(d.spanConfig.Events.Start.Name, d.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(d.spanConfig.Events.End.Name, d.spanConfig.Events.End.Opts...)
+
+	claimed, err := d.communicationsService.ClaimCommunication(
+		ctx,
+		communication,
+		time.Now().UTC().Add(d.config.LeaseTimeout),
+	)
+	if err != nil {
+		logging.LogErrorContext(
+			ctx,
+			d.logger,
+			fmt.Sprintf("Failed to claim communication with ID=%d", communication.ID),
+			err,
+		)
+
+		return
+	}
+
+	// Communication was already claimed by another dispatcher:
+	if !claimed {
+		return
+	}
+
+	attempts := communication.Attempts + 1
+
+	// Context is not used for sending to prevent interruption of SMTP session on shutdown:
+	providerMessageID, sendErr := d.send(context.WithoutCancel(ctx), communication)
+
+	var (
+		permanentErr     *senders.PermanentError
+		rateLimitedErr   *senders.RateLimitedError
+		partiallySentErr *senders.PartiallySentError
+	)
+
+	// Messages, sent before failure, are saved, so that they are not sent again by next attempts:
+	if errors.As(sendErr, &partiallySentErr) {
+		err = d.communicationsService.SaveCommunicationSentParts(
+			context.WithoutCancel(ctx),
+			communication.ID,
+			attempts,
+			partiallySentErr.SentParts,
+			firstProviderMessageID(communication, partiallySentErr.MessageID),
+		)
+		if err != nil {
+			logging.LogErrorContext(
+				ctx,
+				d.logger,
+				fmt.Sprintf("Failed to save sent parts of communication with ID=%d", communication.ID),
+				err,
+			)
+		}
+	}
+
+	switch {
+	case sendErr == nil:
+		err = d.communicationsService.MarkCommunicationSent(
+			context.WithoutCancel(ctx),
+			communication.ID,
+			attempts,
+			firstProviderMessageID(communication, providerMessageID),
+		)
+	// Communication was not sent due to rate limit, so attempt is not consumed:
+	case errors.As(sendErr, &rateLimitedErr):
+		err = d.communicationsService.DeferCommunication(
+			context.WithoutCancel(ctx),
+			communication.ID,
+			attempts,
+			time.Now().UTC().Add(rateLimitedErr.RetryAfter),
+		)
+	// Permanent errors will be repeated on next attempts, so there is no reason to retry:
+	case errors.As(sendErr, &permanentErr), int(attempts) >= d.config.MaxAttempts:
+		logging.LogErrorContext(
+			ctx,
+			d.logger,
+			fmt.Sprintf(
+				"Failed to send communication with ID=%d after %d attempts",
+				communication.ID,
+				attempts,
+			),
+			sendErr,
+		)
+
+		err = d.communicationsService.MarkCommunicationFailed(
+			context.WithoutCancel(ctx),
+			communication.ID,
+			attempts,
+			sendErr.Error(),
+		)
+	default:
+		err = d.communicationsService.RescheduleCommunication(
+			context.WithoutCancel(ctx),
+			communication.ID,
+			attempts,
+			sendErr.Error(),
+			time.Now().UTC().Add(d.retryDelay(attempts)),
+		)
+	}
+
+	if err != nil {
+		logging.LogErrorContext(
+			ctx,
+			d.logger,
+			fmt.Sprintf("Failed to update communication with ID=%d", communication.ID),
+			err,
+		)
+	}
+}
+
+// send delivers communication through its channel to recipient and returns ID of message, assigned by provider.
+func (d *CommunicationsDispatcher) send(ctx context.Context, communication entities.Communication) (string, error) {
+	switch communication.Channel {
+	case entities.CommunicationChannelEmail:
+		var text string
+		if communication.TextBody != nil {
+			text = *communication.TextBody
+		}
+
+		return d.communicationsSenders.Email.Send(
+			ctx,
+			communication.Subject,
+			communication.Body,
+			text,
+			[]string{communication.Recipient},
+		)
+	case entities.CommunicationChannelTelegram:
+		return d.communicationsSenders.Telegram.Send(
+			ctx,
+			communication.Recipient,
+			communication.Body,
+			communication.SentParts,
+		)
+	case entities.CommunicationChannelSMS:
+		return d.communicationsSenders.SMS.Send(ctx, communication.Recipient, communication.Body)
+	default:
+		return "", &senders.PermanentError{
+			BaseErr: fmt.Errorf("unknown communication channel %q", communication.Channel),
+		}
+	}
+}
+
+// firstProviderMessageID returns ID of the first message of communication, which could be sent by previous
+// attempt, if communication is split into several messages.
+func firstProviderMessageID(communication entities.Communication, messageID string) string {
+	if communication.SentParts > 0 && communication.ProviderMessageID != nil {
+		return *communication.ProviderMessageID
+	}
+
+	return messageID
+}
+
+// retryDelay calculates exponential backoff delay for provided number of already made attempts.
+func (d *CommunicationsDispatcher) retryDelay(attempts uint32) time.Duration {
+	return retryDelay(attempts, d.config.RetryBaseDelay, d.config.RetryMaxDelay)
+}
+
+// retryDelay doubles baseDelay for every already made attempt after the first one, limiting it by maxDelay.
+func retryDelay(attempts uint32, baseDelay, maxDelay time.Duration) time.Duration {
+	delay := baseDelay
+	for i := uint32(1); i < attempts && delay < maxDelay; i++ {
+		delay *= 2
+	}
+
+	return min(delay, maxDelay)
+}
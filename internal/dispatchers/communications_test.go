@@ -0,0 +1,656 @@
+package dispatchers
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	mocklogging "github.com/DKhorkov/libs/logging/mocks"
+	"github.com/DKhorkov/libs/pointers"
+	"github.com/DKhorkov/libs/tracing"
+	mocktracing "github.com/DKhorkov/libs/tracing/mocks"
+
+	"github.com/DKhorkov/hmtm-notifications/internal/config"
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+	"github.com/DKhorkov/hmtm-notifications/internal/interfaces"
+	"github.com/DKhorkov/hmtm-notifications/internal/runners"
+	"github.com/DKhorkov/hmtm-notifications/internal/senders"
+	mocksenders "github.com/DKhorkov/hmtm-notifications/mocks/senders"
+	mockservices "github.com/DKhorkov/hmtm-notifications/mocks/services"
+)
+
+var dispatcherConfig = config.DispatcherConfig{
+	Interval:       time.Hour,
+	BatchSize:      10,
+	MaxAttempts:    3,
+	RetryBaseDelay: time.Second,
+	RetryMaxDelay:  time.Minute,
+	LeaseTimeout:   time.Minute,
+}
+
+func TestCommunicationsDispatcher_dispatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	communicationsService := mockservices.NewMockCommunicationsService(ctrl)
+	emailSender := mocksenders.NewMockEmailSender(ctrl)
+	traceProvider := mocktracing.NewMockProvider(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+	dispatcher := NewCommunicationsDispatcher(
+		communicationsService,
+		interfaces.Senders{Email: emailSender},
+		dispatcherConfig,
+		traceProvider,
+		tracing.SpanConfig{},
+		logger,
+	)
+
+	pending := entities.Communication{
+		ID:        1,
+		Recipient: "test@example.com",
+		Subject:   "Subject",
+		Body:      "Content",
+		Status:    entities.CommunicationStatusPending,
+		Attempts:  0,
+		Channel:   entities.CommunicationChannelEmail,
+	}
+
+	testCases := []struct {
+		name       string
+		setupMocks func(
+			communicationsService *mockservices.MockCommunicationsService,
+			emailSender *mocksenders.MockEmailSender,
+			traceProvider *mocktracing.MockProvider,
+			logger *mocklogging.MockLogger,
+		)
+	}{
+		{
+			name: "sent successfully",
+			setupMocks: func(
+				communicationsService *mockservices.MockCommunicationsService,
+				emailSender *mocksenders.MockEmailSender,
+				traceProvider *mocktracing.MockProvider,
+				_ *mocklogging.MockLogger,
+			) {
+				communicationsService.
+					EXPECT().
+					GetPendingCommunications(gomock.Any(), uint64(10)).
+					Return([]entities.Communication{pending}, nil).
+					Times(1)
+
+				traceProvider.
+					EXPECT().
+					Span(gomock.Any(), gomock.Any()).
+					Return(context.Background(), mocktracing.NewMockSpan()).
+					Times(1)
+
+				communicationsService.
+					EXPECT().
+					ClaimCommunication(gomock.Any(), pending, gomock.Any()).
+					Return(true, nil).
+					Times(1)
+
+				emailSender.
+					EXPECT().
+					Send(gomock.Any(), "Subject", "Content", "", []string{"test@example.com"}).
+					Return("<message-id>", nil).
+					Times(1)
+
+				communicationsService.
+					EXPECT().
+					MarkCommunicationSent(gomock.Any(), uint64(1), uint32(1), "<message-id>").
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name: "claimed by another dispatcher",
+			setupMocks: func(
+				communicationsService *mockservices.MockCommunicationsService,
+				_ *mocksenders.MockEmailSender,
+				traceProvider *mocktracing.MockProvider,
+				_ *mocklogging.MockLogger,
+			) {
+				communicationsService.
+					EXPECT().
+					GetPendingCommunications(gomock.Any(), uint64(10)).
+					Return([]entities.Communication{pending}, nil).
+					Times(1)
+
+				traceProvider.
+					EXPECT().
+					Span(gomock.Any(), gomock.Any()).
+					Return(context.Background(), mocktracing.NewMockSpan()).
+					Times(1)
+
+				communicationsService.
+					EXPECT().
+					ClaimCommunication(gomock.Any(), pending, gomock.Any()).
+					Return(false, nil).
+					Times(1)
+			},
+		},
+		{
+			name: "send error with remaining attempts",
+			setupMocks: func(
+				communicationsService *mockservices.MockCommunicationsService,
+				emailSender *mocksenders.MockEmailSender,
+				traceProvider *mocktracing.MockProvider,
+				_ *mocklogging.MockLogger,
+			) {
+				communicationsService.
+					EXPECT().
+					GetPendingCommunications(gomock.Any(), uint64(10)).
+					Return([]entities.Communication{pending}, nil).
+					Times(1)
+
+				traceProvider.
+					EXPECT().
+					Span(gomock.Any(), gomock.Any()).
+					Return(context.Background(), mocktracing.NewMockSpan()).
+					Times(1)
+
+				communicationsService.
+					EXPECT().
+					ClaimCommunication(gomock.Any(), pending, gomock.Any()).
+					Return(true, nil).
+					Times(1)
+
+				emailSender.
+					EXPECT().
+					Send(gomock.Any(), "Subject", "Content", "", []string{"test@example.com"}).
+					Return("", errors.New("smtp error")).
+					Times(1)
+
+				communicationsService.
+					EXPECT().
+					RescheduleCommunication(gomock.Any(), uint64(1), uint32(1), "smtp error", gomock.Any()).
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name: "send error without remaining attempts",
+			setupMocks: func(
+				communicationsService *mockservices.MockCommunicationsService,
+				emailSender *mocksenders.MockEmailSender,
+				traceProvider *mocktracing.MockProvider,
+				logger *mocklogging.MockLogger,
+			) {
+				lastAttempt := pending
+				lastAttempt.Attempts = 2
+
+				communicationsService.
+					EXPECT().
+					GetPendingCommunications(gomock.Any(), uint64(10)).
+					Return([]entities.Communication{lastAttempt}, nil).
+					Times(1)
+
+				traceProvider.
+					EXPECT().
+					Span(gomock.Any(), gomock.Any()).
+					Return(context.Background(), mocktracing.NewMockSpan()).
+					Times(1)
+
+				communicationsService.
+					EXPECT().
+					ClaimCommunication(gomock.Any(), lastAttempt, gomock.Any()).
+					Return(true, nil).
+					Times(1)
+
+				emailSender.
+					EXPECT().
+					Send(gomock.Any(), "Subject", "Content", "", []string{"test@example.com"}).
+					Return("", errors.New("smtp error")).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+
+				communicationsService.
+					EXPECT().
+					MarkCommunicationFailed(gomock.Any(), uint64(1), uint32(3), "smtp error").
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name: "permanent send error",
+			setupMocks: func(
+				communicationsService *mockservices.MockCommunicationsService,
+				emailSender *mocksenders.MockEmailSender,
+				traceProvider *mocktracing.MockProvider,
+				logger *mocklogging.MockLogger,
+			) {
+				communicationsService.
+					EXPECT().
+					GetPendingCommunications(gomock.Any(), uint64(10)).
+					Return([]entities.Communication{pending}, nil).
+					Times(1)
+
+				traceProvider.
+					EXPECT().
+					Span(gomock.Any(), gomock.Any()).
+					Return(context.Background(), mocktracing.NewMockSpan()).
+					Times(1)
+
+				communicationsService.
+					EXPECT().
+					ClaimCommunication(gomock.Any(), pending, gomock.Any()).
+					Return(true, nil).
+					Times(1)
+
+				emailSender.
+					EXPECT().
+					Send(gomock.Any(), "Subject", "Content", "", []string{"test@example.com"}).
+					Return("", &senders.PermanentError{BaseErr: errors.New("invalid recipient")}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+
+				communicationsService.
+					EXPECT().
+					MarkCommunicationFailed(
+						gomock.Any(),
+						uint64(1),
+						uint32(1),
+						"permanent sending error: invalid recipient",
+					).
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name: "rate limited send",
+			setupMocks: func(
+				communicationsService *mockservices.MockCommunicationsService,
+				emailSender *mocksenders.MockEmailSender,
+				traceProvider *mocktracing.MockProvider,
+				_ *mocklogging.MockLogger,
+			) {
+				communicationsService.
+					EXPECT().
+					GetPendingCommunications(gomock.Any(), uint64(10)).
+					Return([]entities.Communication{pending}, nil).
+					Times(1)
+
+				traceProvider.
+					EXPECT().
+					Span(gomock.Any(), gomock.Any()).
+					Return(context.Background(), mocktracing.NewMockSpan()).
+					Times(1)
+
+				communicationsService.
+					EXPECT().
+					ClaimCommunication(gomock.Any(), pending, gomock.Any()).
+					Return(true, nil).
+					Times(1)
+
+				emailSender.
+					EXPECT().
+					Send(gomock.Any(), "Subject", "Content", "", []string{"test@example.com"}).
+					Return("", &senders.RateLimitedError{RetryAfter: time.Minute}).
+					Times(1)
+
+				communicationsService.
+					EXPECT().
+					DeferCommunication(gomock.Any(), uint64(1), uint32(1), gomock.Any()).
+					DoAndReturn(
+						func(_ context.Context, _ uint64, _ uint32, nextAttemptAt time.Time) error {
+							require.WithinDuration(t, time.Now().Add(time.Minute), nextAttemptAt, time.Second)
+
+							return nil
+						},
+					).
+					Times(1)
+			},
+		},
+		{
+			name: "claim error",
+			setupMocks: func(
+				communicationsService *mockservices.MockCommunicationsService,
+				_ *mocksenders.MockEmailSender,
+				traceProvider *mocktracing.MockProvider,
+				logger *mocklogging.MockLogger,
+			) {
+				communicationsService.
+					EXPECT().
+					GetPendingCommunications(gomock.Any(), uint64(10)).
+					Return([]entities.Communication{pending}, nil).
+					Times(1)
+
+				traceProvider.
+					EXPECT().
+					Span(gomock.Any(), gomock.Any()).
+					Return(context.Background(), mocktracing.NewMockSpan()).
+					Times(1)
+
+				communicationsService.
+					EXPECT().
+					ClaimCommunication(gomock.Any(), pending, gomock.Any()).
+					Return(false, errors.New("db error")).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+		},
+		{
+			name: "get pending communications error",
+			setupMocks: func(
+				communicationsService *mockservices.MockCommunicationsService,
+				_ *mocksenders.MockEmailSender,
+				_ *mocktracing.MockProvider,
+				logger *mocklogging.MockLogger,
+			) {
+				communicationsService.
+					EXPECT().
+					GetPendingCommunications(gomock.Any(), uint64(10)).
+					Return(nil, errors.New("db error")).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(communicationsService, emailSender, traceProvider, logger)
+			}
+
+			dispatcher.dispatch(context.Background())
+		})
+	}
+}
+
+func TestCommunicationsDispatcher_processTelegramParts(t *testing.T) {
+	pending := entities.Communication{
+		ID:        1,
+		Recipient: "100500",
+		Body:      "Content",
+		Status:    entities.CommunicationStatusPending,
+		Channel:   entities.CommunicationChannelTelegram,
+	}
+
+	resumed := pending
+	resumed.Attempts = 1
+	resumed.SentParts = 1
+	resumed.ProviderMessageID = pointers.New("41")
+
+	testCases := []struct {
+		name          string
+		communication entities.Communication
+		setupMocks    func(
+			communicationsService *mockservices.MockCommunicationsService,
+			telegramSender *mocksenders.MockTelegramSender,
+		)
+	}{
+		{
+			name:          "partially sent",
+			communication: pending,
+			setupMocks: func(
+				communicationsService *mockservices.MockCommunicationsService,
+				telegramSender *mocksenders.MockTelegramSender,
+			) {
+				telegramSender.
+					EXPECT().
+					Send(gomock.Any(), "100500", "Content", uint32(0)).
+					Return("", &senders.PartiallySentError{SentParts: 1, MessageID: "41", BaseErr: errors.New("502")}).
+					Times(1)
+
+				communicationsService.
+					EXPECT().
+					SaveCommunicationSentParts(gomock.Any(), uint64(1), uint32(1), uint32(1), "41").
+					Return(nil).
+					Times(1)
+
+				communicationsService.
+					EXPECT().
+					RescheduleCommunication(gomock.Any(), uint64(1), uint32(1), gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name:          "resumed from the first unsent part",
+			communication: resumed,
+			setupMocks: func(
+				communicationsService *mockservices.MockCommunicationsService,
+				telegramSender *mocksenders.MockTelegramSender,
+			) {
+				telegramSender.
+					EXPECT().
+					Send(gomock.Any(), "100500", "Content", uint32(1)).
+					Return("42", nil).
+					Times(1)
+
+				// ID of the first message, sent by previous attempt, is kept:
+				communicationsService.
+					EXPECT().
+					MarkCommunicationSent(gomock.Any(), uint64(1), uint32(2), "41").
+					Return(nil).
+					Times(1)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			communicationsService := mockservices.NewMockCommunicationsService(ctrl)
+			telegramSender := mocksenders.NewMockTelegramSender(ctrl)
+			traceProvider := mocktracing.NewMockProvider(ctrl)
+			dispatcher := NewCommunicationsDispatcher(
+				communicationsService,
+				interfaces.Senders{Telegram: telegramSender},
+				dispatcherConfig,
+				traceProvider,
+				tracing.SpanConfig{},
+				mocklogging.NewMockLogger(ctrl),
+			)
+
+			traceProvider.
+				EXPECT().
+				Span(gomock.Any(), gomock.Any()).
+				Return(context.Background(), mocktracing.NewMockSpan()).
+				Times(1)
+
+			communicationsService.
+				EXPECT().
+				ClaimCommunication(gomock.Any(), tc.communication, gomock.Any()).
+				Return(true, nil).
+				Times(1)
+
+			tc.setupMocks(communicationsService, telegramSender)
+
+			dispatcher.process(context.Background(), tc.communication)
+		})
+	}
+}
+
+func TestCommunicationsDispatcher_retryDelay(t *testing.T) {
+	dispatcher := NewCommunicationsDispatcher(
+		nil,
+		interfaces.Senders{},
+		dispatcherConfig,
+		nil,
+		tracing.SpanConfig{},
+		nil,
+	)
+
+	testCases := []struct {
+		attempts uint32
+		expected time.Duration
+	}{
+		{attempts: 1, expected: time.Second},
+		{attempts: 2, expected: 2 * time.Second},
+		{attempts: 4, expected: 8 * time.Second},
+		{attempts: 100, expected: time.Minute},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expected, dispatcher.retryDelay(tc.attempts))
+	}
+}
+
+func TestCommunicationsDispatcher_RunAndStop(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	communicationsService := mockservices.NewMockCommunicationsService(ctrl)
+	dispatcher := NewCommunicationsDispatcher(
+		communicationsService,
+		interfaces.Senders{Email: mocksenders.NewMockEmailSender(ctrl)},
+		dispatcherConfig,
+		mocktracing.NewMockProvider(ctrl),
+		tracing.SpanConfig{},
+		mocklogging.NewMockLogger(ctrl),
+	)
+
+	communicationsService.
+		EXPECT().
+		GetPendingCommunications(gomock.Any(), uint64(10)).
+		Return(nil, nil).
+		MaxTimes(1)
+
+	require.ErrorIs(t, dispatcher.Stop(), runners.ErrRunnerAlreadyStopped)
+	require.NoError(t, dispatcher.Run())
+	require.ErrorIs(t, dispatcher.Run(), runners.ErrRunnerAlreadyRunning)
+	require.NoError(t, dispatcher.Stop())
+	require.ErrorIs(t, dispatcher.Stop(), runners.ErrRunnerAlreadyStopped)
+}
+
+func TestCommunicationsDispatcher_send(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	emailSender := mocksenders.NewMockEmailSender(ctrl)
+	telegramSender := mocksenders.NewMockTelegramSender(ctrl)
+	smsSender := mocksenders.NewMockSMSSender(ctrl)
+	dispatcher := NewCommunicationsDispatcher(
+		nil,
+		interfaces.Senders{
+			Email:    emailSender,
+			Telegram: telegramSender,
+			SMS:      smsSender,
+		},
+		dispatcherConfig,
+		nil,
+		tracing.SpanConfig{},
+		nil,
+	)
+
+	testCases := []struct {
+		name              string
+		communication     entities.Communication
+		setupMocks        func()
+		expectedMessageID string
+		permanentExpected bool
+	}{
+		{
+			name: "email channel",
+			communication: entities.Communication{
+				Recipient: "test@example.com",
+				Subject:   "Subject",
+				Body:      "Content",
+				Channel:   entities.CommunicationChannelEmail,
+			},
+			setupMocks: func() {
+				emailSender.
+					EXPECT().
+					Send(gomock.Any(), "Subject", "Content", "", []string{"test@example.com"}).
+					Return("<message-id>", nil).
+					Times(1)
+			},
+			expectedMessageID: "<message-id>",
+		},
+		{
+			name: "email channel with text",
+			communication: entities.Communication{
+				Recipient: "test@example.com",
+				Subject:   "Subject",
+				Body:      "Content",
+				TextBody:  pointers.New("Text"),
+				Channel:   entities.CommunicationChannelEmail,
+			},
+			setupMocks: func() {
+				emailSender.
+					EXPECT().
+					Send(gomock.Any(), "Subject", "Content", "Text", []string{"test@example.com"}).
+					Return("<message-id>", nil).
+					Times(1)
+			},
+			expectedMessageID: "<message-id>",
+		},
+		{
+			name: "telegram channel",
+			communication: entities.Communication{
+				Recipient: "100500",
+				Subject:   "Subject",
+				Body:      "Content",
+				Channel:   entities.CommunicationChannelTelegram,
+			},
+			setupMocks: func() {
+				telegramSender.
+					EXPECT().
+					Send(gomock.Any(), "100500", "Content", uint32(0)).
+					Return("42", nil).
+					Times(1)
+			},
+			expectedMessageID: "42",
+		},
+		{
+			name: "sms channel",
+			communication: entities.Communication{
+				Recipient: "+79990000000",
+				Subject:   "Subject",
+				Body:      "Content",
+				Channel:   entities.CommunicationChannelSMS,
+			},
+			setupMocks: func() {
+				smsSender.
+					EXPECT().
+					Send(gomock.Any(), "+79990000000", "Content").
+					Return("sms-1", nil).
+					Times(1)
+			},
+			expectedMessageID: "sms-1",
+		},
+		{
+			name: "unknown channel",
+			communication: entities.Communication{
+				Recipient: "test@example.com",
+				Body:      "Content",
+				Channel:   "pigeon",
+			},
+			setupMocks:        func() {},
+			permanentExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMocks()
+
+			messageID, err := dispatcher.send(context.Background(), tc.communication)
+			if tc.permanentExpected {
+				require.ErrorAs(t, err, new(*senders.PermanentError))
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedMessageID, messageID)
+		})
+	}
+}
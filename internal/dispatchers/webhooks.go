@@ -0,0 +1,223 @@
+package dispatchers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/DKhorkov/libs/logging"
+	"github.com/DKhorkov/libs/tracing"
+
+	"github.com/DKhorkov/hmtm-notifications/internal/config"
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-notifications/internal/errors"
+	"github.com/DKhorkov/hmtm-notifications/internal/interfaces"
+	"github.com/DKhorkov/hmtm-notifications/internal/runners"
+	"github.com/DKhorkov/hmtm-notifications/internal/senders"
+)
+
+var ErrWebhookDisabled = errors.New("webhook is disabled")
+
+// WebhooksDispatcher sends pending webhook deliveries, stored by usecases, and keeps deliveries log.
+// Webhook is disabled, when disableThreshold deliveries in a row have failed after all attempts.
+// Rate-limited attempts are not counted, until delivery was deferred maxDeferrals times, so that receiver,
+// which always responds with 429, could not keep delivery pending forever.
+// Several dispatchers can work concurrently, because every delivery is claimed before sending.
+type WebhooksDispatcher struct {
+	*runners.PeriodicRunner
+
+	webhooksService  interfaces.WebhooksService
+	webhookSender    interfaces.WebhookSender
+	config           config.DispatcherConfig
+	disableThreshold int
+	maxDeferrals     int
+	traceProvider    tracing.Provider
+	spanConfig       tracing.SpanConfig
+	logger           logging.Logger
+}
+
+func NewWebhooksDispatcher(
+	webhooksService interfaces.WebhooksService,
+	webhookSender interfaces.WebhookSender,
+	config config.DispatcherConfig,
+	disableThreshold int,
+	maxDeferrals int,
+	traceProvider tracing.Provider,
+	spanConfig tracing.SpanConfig,
+	logger logging.Logger,
+) *WebhooksDispatcher {
+	dispatcher := &WebhooksDispatcher{
+		webhooksService:  webhooksService,
+		webhookSender:    webhookSender,
+		config:           config,
+		disableThreshold: disableThreshold,
+		maxDeferrals:     maxDeferrals,
+		traceProvider:    traceProvider,
+		spanConfig:       spanConfig,
+		logger:           logger,
+	}
+
+	dispatcher.PeriodicRunner = runners.NewPeriodicRunner(config.Interval, dispatcher.dispatch)
+
+	return dispatcher
+}
+
+// dispatch processes one batch of pending webhook deliveries.
+func (d *WebhooksDispatcher) dispatch(ctx context.Context) {
+	deliveries, err := d.webhooksService.GetPendingWebhookDeliveries(ctx, uint64(d.config.BatchSize))
+	if err != nil {
+		logging.LogErrorContext(ctx, d.logger, "Failed to get pending webhook deliveries", err)
+
+		return
+	}
+
+	for _, delivery := range deliveries {
+		// Stop processing of current batch on shutdown. Not processed deliveries will be sent by next launch:
+		if ctx.Err() != nil {
+			return
+		}
+
+		d.process(ctx, delivery)
+	}
+}
+
+func (d *WebhooksDispatcher) process(ctx context.Context, delivery entities.WebhookDelivery) {
+	ctx, span := d.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(d.spanConfig.Events.Start.Name, d.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(d.spanConfig.Events.End.Name, d.spanConfig.Events.End.Opts...)
+
+	claimed, err := d.webhooksService.ClaimWebhookDelivery(
+		ctx,
+		delivery,
+		time.Now().UTC().Add(d.config.LeaseTimeout),
+	)
+	if err != nil {
+		logging.LogErrorContext(
+			ctx,
+			d.logger,
+			fmt.Sprintf("Failed to claim webhook delivery with ID=%d", delivery.ID),
+			err,
+		)
+
+		return
+	}
+
+	// Delivery was already claimed by another dispatcher:
+	if !claimed {
+		return
+	}
+
+	attempts := delivery.Attempts + 1
+
+	// Context is not used for sending to prevent interruption of request on shutdown:
+	sendErr := d.send(context.WithoutCancel(ctx), delivery)
+
+	var (
+		permanentErr   *senders.PermanentError
+		rateLimitedErr *senders.RateLimitedError
+	)
+
+	switch {
+	case sendErr == nil:
+		err = d.webhooksService.MarkWebhookDeliveryDelivered(context.WithoutCancel(ctx), delivery.ID, attempts)
+		if err == nil {
+			err = d.webhooksService.ResetWebhookFailures(context.WithoutCancel(ctx), delivery.WebhookID)
+		}
+	// Receiver asked to slow down, so attempt is not consumed, unless deferrals are exhausted:
+	case errors.As(sendErr, &rateLimitedErr) && int(delivery.Deferrals) < d.maxDeferrals:
+		err = d.webhooksService.DeferWebhookDelivery(
+			context.WithoutCancel(ctx),
+			delivery.ID,
+			attempts,
+			time.Now().UTC().Add(rateLimitedErr.RetryAfter),
+		)
+	// Permanent errors will be repeated on next attempts, so there is no reason to retry:
+	case errors.As(sendErr, &permanentErr), int(attempts) >= d.config.MaxAttempts:
+		logging.LogErrorContext(
+			ctx,
+			d.logger,
+			fmt.Sprintf(
+				"Failed to send webhook delivery with ID=%d after %d attempts",
+				delivery.ID,
+				attempts,
+			),
+			sendErr,
+		)
+
+		err = d.webhooksService.MarkWebhookDeliveryFailed(
+			context.WithoutCancel(ctx),
+			delivery.ID,
+			attempts,
+			sendErr.Error(),
+		)
+		if err == nil && !errors.Is(sendErr, ErrWebhookDisabled) {
+			err = d.registerFailure(context.WithoutCancel(ctx), delivery.WebhookID, sendErr)
+		}
+	default:
+		err = d.webhooksService.RescheduleWebhookDelivery(
+			context.WithoutCancel(ctx),
+			delivery.ID,
+			attempts,
+			sendErr.Error(),
+			time.Now().UTC().Add(d.retryDelay(attempts)),
+		)
+	}
+
+	if err != nil {
+		logging.LogErrorContext(
+			ctx,
+			d.logger,
+			fmt.Sprintf("Failed to update webhook delivery with ID=%d", delivery.ID),
+			err,
+		)
+	}
+}
+
+// send delivers event to webhook. Deliveries of disabled and deleted webhooks fail permanently.
+func (d *WebhooksDispatcher) send(ctx context.Context, delivery entities.WebhookDelivery) error {
+	webhook, err := d.webhooksService.GetWebhookByID(ctx, delivery.WebhookID)
+	if err != nil {
+		if errors.As(err, new(*customerrors.WebhookNotFoundError)) {
+			return &senders.PermanentError{BaseErr: err}
+		}
+
+		return err
+	}
+
+	if !webhook.Enabled {
+		return &senders.PermanentError{BaseErr: ErrWebhookDisabled}
+	}
+
+	return d.webhookSender.Send(ctx, *webhook, delivery)
+}
+
+// registerFailure counts failed delivery and disables webhook, if too many deliveries in a row have failed.
+func (d *WebhooksDispatcher) registerFailure(ctx context.Context, webhookID uint64, sendErr error) error {
+	disabled, err := d.webhooksService.RegisterWebhookFailure(ctx, webhookID, d.disableThreshold)
+	if err != nil {
+		return err
+	}
+
+	if disabled {
+		logging.LogErrorContext(
+			ctx,
+			d.logger,
+			fmt.Sprintf(
+				"Webhook with ID=%d was disabled after %d failed deliveries in a row",
+				webhookID,
+				d.disableThreshold,
+			),
+			sendErr,
+		)
+	}
+
+	return nil
+}
+
+// retryDelay calculates exponential backoff delay for provided number of already made attempts.
+func (d *WebhooksDispatcher) retryDelay(attempts uint32) time.Duration {
+	return retryDelay(attempts, d.config.RetryBaseDelay, d.config.RetryMaxDelay)
+}
@@ -0,0 +1,284 @@
+package dispatchers
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	mocklogging "github.com/DKhorkov/libs/logging/mocks"
+	"github.com/DKhorkov/libs/tracing"
+	mocktracing "github.com/DKhorkov/libs/tracing/mocks"
+
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-notifications/internal/errors"
+	"github.com/DKhorkov/hmtm-notifications/internal/senders"
+	mocksenders "github.com/DKhorkov/hmtm-notifications/mocks/senders"
+	mockservices "github.com/DKhorkov/hmtm-notifications/mocks/services"
+)
+
+func TestWebhooksDispatcher_process(t *testing.T) {
+	webhook := entities.Webhook{ID: 2, URL: "http://example.com/hooks", Secret: "secret", Enabled: true}
+	pending := entities.WebhookDelivery{
+		ID:         1,
+		WebhookID:  2,
+		DeliveryID: "delivery",
+		EventType:  entities.NotificationTypeVerifyEmail,
+		Payload:    "{}",
+		Status:     entities.WebhookDeliveryStatusPending,
+	}
+
+	lastAttempt := pending
+	lastAttempt.Attempts = 2
+
+	exhaustedDeferrals := pending
+	exhaustedDeferrals.Deferrals = 10
+
+	lastAttemptExhaustedDeferrals := lastAttempt
+	lastAttemptExhaustedDeferrals.Deferrals = 10
+
+	testCases := []struct {
+		name       string
+		delivery   entities.WebhookDelivery
+		setupMocks func(
+			webhooksService *mockservices.MockWebhooksService,
+			webhookSender *mocksenders.MockWebhookSender,
+			logger *mocklogging.MockLogger,
+		)
+	}{
+		{
+			name:     "delivered successfully",
+			delivery: pending,
+			setupMocks: func(
+				webhooksService *mockservices.MockWebhooksService,
+				webhookSender *mocksenders.MockWebhookSender,
+				_ *mocklogging.MockLogger,
+			) {
+				webhooksService.EXPECT().ClaimWebhookDelivery(gomock.Any(), pending, gomock.Any()).Return(true, nil)
+				webhooksService.EXPECT().GetWebhookByID(gomock.Any(), uint64(2)).Return(&webhook, nil)
+				webhookSender.EXPECT().Send(gomock.Any(), webhook, pending).Return(nil)
+				webhooksService.EXPECT().MarkWebhookDeliveryDelivered(gomock.Any(), uint64(1), uint32(1)).Return(nil)
+				webhooksService.EXPECT().ResetWebhookFailures(gomock.Any(), uint64(2)).Return(nil)
+			},
+		},
+		{
+			name:     "claimed by another dispatcher",
+			delivery: pending,
+			setupMocks: func(
+				webhooksService *mockservices.MockWebhooksService,
+				_ *mocksenders.MockWebhookSender,
+				_ *mocklogging.MockLogger,
+			) {
+				webhooksService.EXPECT().ClaimWebhookDelivery(gomock.Any(), pending, gomock.Any()).Return(false, nil)
+			},
+		},
+		{
+			name:     "transient error rescheduled",
+			delivery: pending,
+			setupMocks: func(
+				webhooksService *mockservices.MockWebhooksService,
+				webhookSender *mocksenders.MockWebhookSender,
+				_ *mocklogging.MockLogger,
+			) {
+				webhooksService.EXPECT().ClaimWebhookDelivery(gomock.Any(), pending, gomock.Any()).Return(true, nil)
+				webhooksService.EXPECT().GetWebhookByID(gomock.Any(), uint64(2)).Return(&webhook, nil)
+				webhookSender.EXPECT().Send(gomock.Any(), webhook, pending).Return(errors.New("503"))
+				webhooksService.
+					EXPECT().
+					RescheduleWebhookDelivery(gomock.Any(), uint64(1), uint32(1), "503", gomock.Any()).
+					DoAndReturn(
+						func(_ context.Context, _ uint64, _ uint32, _ string, nextAttemptAt time.Time) error {
+							if nextAttemptAt.Before(time.Now().Add(dispatcherConfig.RetryBaseDelay / 2)) {
+								t.Errorf("unexpected next attempt time %s", nextAttemptAt)
+							}
+
+							return nil
+						},
+					)
+			},
+		},
+		{
+			name:     "rate limited delivery deferred",
+			delivery: pending,
+			setupMocks: func(
+				webhooksService *mockservices.MockWebhooksService,
+				webhookSender *mocksenders.MockWebhookSender,
+				_ *mocklogging.MockLogger,
+			) {
+				webhooksService.EXPECT().ClaimWebhookDelivery(gomock.Any(), pending, gomock.Any()).Return(true, nil)
+				webhooksService.EXPECT().GetWebhookByID(gomock.Any(), uint64(2)).Return(&webhook, nil)
+				webhookSender.
+					EXPECT().
+					Send(gomock.Any(), webhook, pending).
+					Return(&senders.RateLimitedError{RetryAfter: time.Minute})
+				webhooksService.EXPECT().DeferWebhookDelivery(gomock.Any(), uint64(1), uint32(1), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:     "rate limited delivery with exhausted deferrals rescheduled",
+			delivery: exhaustedDeferrals,
+			setupMocks: func(
+				webhooksService *mockservices.MockWebhooksService,
+				webhookSender *mocksenders.MockWebhookSender,
+				_ *mocklogging.MockLogger,
+			) {
+				webhooksService.
+					EXPECT().
+					ClaimWebhookDelivery(gomock.Any(), exhaustedDeferrals, gomock.Any()).
+					Return(true, nil)
+				webhooksService.EXPECT().GetWebhookByID(gomock.Any(), uint64(2)).Return(&webhook, nil)
+				webhookSender.
+					EXPECT().
+					Send(gomock.Any(), webhook, exhaustedDeferrals).
+					Return(&senders.RateLimitedError{RetryAfter: time.Minute})
+				webhooksService.
+					EXPECT().
+					RescheduleWebhookDelivery(gomock.Any(), uint64(1), uint32(1), gomock.Any(), gomock.Any()).
+					Return(nil)
+			},
+		},
+		{
+			name:     "rate limited last attempt with exhausted deferrals failed",
+			delivery: lastAttemptExhaustedDeferrals,
+			setupMocks: func(
+				webhooksService *mockservices.MockWebhooksService,
+				webhookSender *mocksenders.MockWebhookSender,
+				logger *mocklogging.MockLogger,
+			) {
+				webhooksService.
+					EXPECT().
+					ClaimWebhookDelivery(gomock.Any(), lastAttemptExhaustedDeferrals, gomock.Any()).
+					Return(true, nil)
+				webhooksService.EXPECT().GetWebhookByID(gomock.Any(), uint64(2)).Return(&webhook, nil)
+				webhookSender.
+					EXPECT().
+					Send(gomock.Any(), webhook, lastAttemptExhaustedDeferrals).
+					Return(&senders.RateLimitedError{RetryAfter: time.Minute})
+				webhooksService.
+					EXPECT().
+					MarkWebhookDeliveryFailed(gomock.Any(), uint64(1), uint32(3), gomock.Any()).
+					Return(nil)
+				webhooksService.EXPECT().RegisterWebhookFailure(gomock.Any(), uint64(2), 5).Return(false, nil)
+				logger.EXPECT().ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
+			},
+		},
+		{
+			name:     "last attempt failed and webhook disabled",
+			delivery: lastAttempt,
+			setupMocks: func(
+				webhooksService *mockservices.MockWebhooksService,
+				webhookSender *mocksenders.MockWebhookSender,
+				logger *mocklogging.MockLogger,
+			) {
+				webhooksService.EXPECT().ClaimWebhookDelivery(gomock.Any(), lastAttempt, gomock.Any()).Return(true, nil)
+				webhooksService.EXPECT().GetWebhookByID(gomock.Any(), uint64(2)).Return(&webhook, nil)
+				webhookSender.EXPECT().Send(gomock.Any(), webhook, lastAttempt).Return(errors.New("500"))
+				webhooksService.EXPECT().MarkWebhookDeliveryFailed(gomock.Any(), uint64(1), uint32(3), "500").Return(nil)
+				webhooksService.EXPECT().RegisterWebhookFailure(gomock.Any(), uint64(2), 5).Return(true, nil)
+				logger.EXPECT().ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).Times(2)
+			},
+		},
+		{
+			name:     "disabled webhook",
+			delivery: pending,
+			setupMocks: func(
+				webhooksService *mockservices.MockWebhooksService,
+				_ *mocksenders.MockWebhookSender,
+				logger *mocklogging.MockLogger,
+			) {
+				disabled := webhook
+				disabled.Enabled = false
+
+				webhooksService.EXPECT().ClaimWebhookDelivery(gomock.Any(), pending, gomock.Any()).Return(true, nil)
+				webhooksService.EXPECT().GetWebhookByID(gomock.Any(), uint64(2)).Return(&disabled, nil)
+				webhooksService.
+					EXPECT().
+					MarkWebhookDeliveryFailed(gomock.Any(), uint64(1), uint32(1), gomock.Any()).
+					Return(nil)
+				logger.EXPECT().ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
+			},
+		},
+		{
+			name:     "deleted webhook",
+			delivery: pending,
+			setupMocks: func(
+				webhooksService *mockservices.MockWebhooksService,
+				_ *mocksenders.MockWebhookSender,
+				logger *mocklogging.MockLogger,
+			) {
+				webhooksService.EXPECT().ClaimWebhookDelivery(gomock.Any(), pending, gomock.Any()).Return(true, nil)
+				webhooksService.
+					EXPECT().
+					GetWebhookByID(gomock.Any(), uint64(2)).
+					Return(nil, &customerrors.WebhookNotFoundError{})
+				webhooksService.
+					EXPECT().
+					MarkWebhookDeliveryFailed(gomock.Any(), uint64(1), uint32(1), gomock.Any()).
+					Return(nil)
+				webhooksService.EXPECT().RegisterWebhookFailure(gomock.Any(), uint64(2), 5).Return(false, nil)
+				logger.EXPECT().ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			webhooksService := mockservices.NewMockWebhooksService(ctrl)
+			webhookSender := mocksenders.NewMockWebhookSender(ctrl)
+			traceProvider := mocktracing.NewMockProvider(ctrl)
+			logger := mocklogging.NewMockLogger(ctrl)
+			dispatcher := NewWebhooksDispatcher(
+				webhooksService,
+				webhookSender,
+				dispatcherConfig,
+				5,
+				10,
+				traceProvider,
+				tracing.SpanConfig{},
+				logger,
+			)
+
+			traceProvider.
+				EXPECT().
+				Span(gomock.Any(), gomock.Any()).
+				Return(context.Background(), mocktracing.NewMockSpan()).
+				Times(1)
+
+			tc.setupMocks(webhooksService, webhookSender, logger)
+
+			dispatcher.process(context.Background(), tc.delivery)
+		})
+	}
+}
+
+func TestWebhooksDispatcher_dispatchGetPendingError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	webhooksService := mockservices.NewMockWebhooksService(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+	dispatcher := NewWebhooksDispatcher(
+		webhooksService,
+		mocksenders.NewMockWebhookSender(ctrl),
+		dispatcherConfig,
+		5,
+		10,
+		mocktracing.NewMockProvider(ctrl),
+		tracing.SpanConfig{},
+		logger,
+	)
+
+	webhooksService.
+		EXPECT().
+		GetPendingWebhookDeliveries(gomock.Any(), uint64(10)).
+		Return(nil, errors.New("db error")).
+		Times(1)
+
+	logger.
+		EXPECT().
+		ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(1)
+
+	dispatcher.dispatch(context.Background())
+}
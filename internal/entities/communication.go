@@ -0,0 +1,57 @@
+package entities
+
+import "time"
+
+type CommunicationStatus string
+
+const (
+	CommunicationStatusPending    CommunicationStatus = "pending"
+	CommunicationStatusProcessing CommunicationStatus = "processing"
+	CommunicationStatusSent       CommunicationStatus = "sent"
+	CommunicationStatusFailed     CommunicationStatus = "failed"
+
+	// CommunicationStatusStandby is a status of fallback communication, which is sent only if previous
+	// communication of the same routing chain has failed.
+	CommunicationStatusStandby CommunicationStatus = "standby"
+	// CommunicationStatusCancelled is a status of fallback communication, which is not needed, because previous
+	// communication of the same routing chain was sent.
+	CommunicationStatusCancelled CommunicationStatus = "cancelled"
+)
+
+// CommunicationChannel is a channel, through which outbox communication is delivered. Recipient address
+// depends on channel: email address, Telegram chat ID or phone number.
+type CommunicationChannel string
+
+const (
+	CommunicationChannelEmail    CommunicationChannel = "email"
+	CommunicationChannelTelegram CommunicationChannel = "telegram"
+	CommunicationChannelSMS      CommunicationChannel = "sms"
+)
+
+// Communication is a message of provided notification type, sent to user through single channel.
+// Communication fields order must be the same as columns order in communications table for db.GetEntityColumns
+// purpose. FallbackFor is an ID of primary communication of routing chain, if communication is a fallback one.
+// ProviderMessageID is an ID of message, assigned by channel provider on sending, if provider returns one.
+// TextBody is a plain text alternative of email body, supplied by content builder. If it is not set, plain text
+// is generated from Body on sending.
+// SentParts is a number of already sent messages of communication, which is split into several messages
+// (for example, long Telegram message), so that retry resumes from the first unsent message.
+type Communication struct {
+	ID                uint64               `json:"id"`
+	UserID            uint64               `json:"userId"`
+	Recipient         string               `json:"recipient"`
+	Body              string               `json:"body"`
+	CreatedAt         time.Time            `json:"createdAt"`
+	Subject           string               `json:"subject"`
+	Status            CommunicationStatus  `json:"status"`
+	Attempts          uint32               `json:"attempts"`
+	LastError         *string              `json:"lastError,omitempty"`
+	NextAttemptAt     *time.Time           `json:"nextAttemptAt,omitempty"`
+	SentAt            *time.Time           `json:"sentAt,omitempty"`
+	Channel           CommunicationChannel `json:"channel"`
+	FallbackFor       *uint64              `json:"fallbackFor,omitempty"`
+	Type              NotificationType     `json:"type"`
+	ProviderMessageID *string              `json:"providerMessageId,omitempty"`
+	TextBody          *string              `json:"textBody,omitempty"`
+	SentParts         uint32               `json:"sentParts"`
+}
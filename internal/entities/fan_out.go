@@ -0,0 +1,50 @@
+package entities
+
+type DeliveryStatus string
+
+const (
+	DeliveryStatusSent    DeliveryStatus = "sent"
+	DeliveryStatusSkipped DeliveryStatus = "skipped"
+	DeliveryStatusFailed  DeliveryStatus = "failed"
+)
+
+// RecipientResult represents result of communication sending to single recipient of fan-out.
+// UserID is zero, if recipient could not be determined.
+type RecipientResult struct {
+	MasterID uint64         `json:"masterId"`
+	UserID   uint64         `json:"userId"`
+	EmailID  uint64         `json:"emailId,omitempty"`
+	Status   DeliveryStatus `json:"status"`
+	Reason   string         `json:"reason,omitempty"`
+}
+
+// FanOutResult represents results of communication sending to all recipients of fan-out.
+type FanOutResult struct {
+	Recipients []RecipientResult `json:"recipients"`
+}
+
+// EmailIDs returns IDs of emails, which were created for recipients during current or previous fan-outs.
+func (r FanOutResult) EmailIDs() []uint64 {
+	var emailIDs []uint64
+
+	for _, recipient := range r.Recipients {
+		if recipient.EmailID != 0 {
+			emailIDs = append(emailIDs, recipient.EmailID)
+		}
+	}
+
+	return emailIDs
+}
+
+// Failed returns results of recipients, communications to which were not sent.
+func (r FanOutResult) Failed() []RecipientResult {
+	var failed []RecipientResult
+
+	for _, recipient := range r.Recipients {
+		if recipient.Status == DeliveryStatusFailed {
+			failed = append(failed, recipient)
+		}
+	}
+
+	return failed
+}
@@ -0,0 +1,33 @@
+package entities
+
+import "time"
+
+type NotificationType string
+
+const (
+	NotificationTypeVerifyEmail    NotificationType = "verify_email"
+	NotificationTypeForgetPassword NotificationType = "forget_password"
+	NotificationTypeTicketUpdated  NotificationType = "ticket_updated"
+	NotificationTypeTicketDeleted  NotificationType = "ticket_deleted"
+)
+
+// NotificationTypes contains all known notification types.
+var NotificationTypes = []NotificationType{
+	NotificationTypeVerifyEmail,
+	NotificationTypeForgetPassword,
+	NotificationTypeTicketUpdated,
+	NotificationTypeTicketDeleted,
+}
+
+// Notification represents entry of user in-app inbox. Link is a deep link to related page and could be empty.
+// Notification fields order must be the same as columns order in notifications table for db.GetEntityColumns purpose.
+type Notification struct {
+	ID        uint64           `json:"id"`
+	UserID    uint64           `json:"userId"`
+	Type      NotificationType `json:"type"`
+	Title     string           `json:"title"`
+	Text      string           `json:"text"`
+	Link      string           `json:"link"`
+	CreatedAt time.Time        `json:"createdAt"`
+	ReadAt    *time.Time       `json:"readAt,omitempty"`
+}
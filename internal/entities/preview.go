@@ -0,0 +1,9 @@
+package entities
+
+// NotificationPreview contains email content of notification, which is rendered for recipient without sending.
+type NotificationPreview struct {
+	RecipientID uint64 `json:"recipientId"`
+	Subject     string `json:"subject"`
+	HTML        string `json:"html"`
+	Text        string `json:"text"`
+}
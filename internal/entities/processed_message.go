@@ -0,0 +1,12 @@
+package entities
+
+import "time"
+
+// ProcessedMessage stores result of message processing by its idempotency key to prevent
+// repeated processing of redelivered messages. Message is being processed, while ProcessedAt is nil.
+type ProcessedMessage struct {
+	IdempotencyKey string     `json:"idempotencyKey"`
+	EmailIDs       []uint64   `json:"emailIds"`
+	CreatedAt      time.Time  `json:"createdAt"`
+	ProcessedAt    *time.Time `json:"processedAt,omitempty"`
+}
@@ -1,6 +1,9 @@
 package entities
 
-import "time"
+import (
+	"strconv"
+	"time"
+)
 
 type User struct {
 	ID                uint64    `json:"id"`
@@ -12,6 +15,30 @@ type User struct {
 	Telegram          *string   `json:"telegram,omitempty"`
 	TelegramConfirmed bool      `json:"telegramConfirmed"`
 	Avatar            *string   `json:"avatar,omitempty"`
+	Locale            string    `json:"locale,omitempty"`
 	CreatedAt         time.Time `json:"createdAt"`
 	UpdatedAt         time.Time `json:"updatedAt"`
 }
+
+// TelegramChatID returns ID of private chat with user, if user has confirmed Telegram account. Bot API can not
+// start chat by username, so Telegram, which is not a numeric chat ID, is not suitable for sending.
+func (u User) TelegramChatID() (string, bool) {
+	if !u.TelegramConfirmed || u.Telegram == nil {
+		return "", false
+	}
+
+	if _, err := strconv.ParseInt(*u.Telegram, 10, 64); err != nil {
+		return "", false
+	}
+
+	return *u.Telegram, true
+}
+
+// ConfirmedPhone returns phone of user, if it was confirmed.
+func (u User) ConfirmedPhone() (string, bool) {
+	if !u.PhoneConfirmed || u.Phone == nil || *u.Phone == "" {
+		return "", false
+	}
+
+	return *u.Phone, true
+}
@@ -0,0 +1,48 @@
+package entities
+
+import "time"
+
+type TemplateState string
+
+const (
+	TemplateStateDraft     TemplateState = "draft"
+	TemplateStatePublished TemplateState = "published"
+	// Archived versions were published before and could be published again to roll back changes:
+	TemplateStateArchived TemplateState = "archived"
+)
+
+// Template represents version of notification template for locale, which is stored in database and is rendered
+// instead of embedded one, while it is published. Only drafts could be changed and only one version of notification
+// type and locale is published at the same time. HTML and Text are "content" blocks of email bodies, which are
+// rendered by embedded layout of locale, while Subject is a message with the same fields as catalog messages.
+// Empty Subject or Text means that embedded one is used.
+// Template fields order must be the same as columns order in templates table for db.GetEntityColumns purpose.
+type Template struct {
+	ID               uint64           `json:"id"`
+	NotificationType NotificationType `json:"notificationType"`
+	Locale           string           `json:"locale"`
+	Version          uint32           `json:"version"`
+	State            TemplateState    `json:"state"`
+	Subject          string           `json:"subject"`
+	HTML             string           `json:"html"`
+	Text             string           `json:"text"`
+	CreatedAt        time.Time        `json:"createdAt"`
+	UpdatedAt        time.Time        `json:"updatedAt"`
+	PublishedAt      *time.Time       `json:"publishedAt,omitempty"`
+}
+
+// TemplatesFilters limits listed templates by notification type and locale, if they are set.
+type TemplatesFilters struct {
+	NotificationType *NotificationType
+	Locale           *string
+}
+
+// TemplateDiff contains line-based differences of subject, HTML and plain text between two versions of template.
+// Unchanged lines are prefixed by space, removed lines - by "-" and added lines - by "+".
+type TemplateDiff struct {
+	From    Template `json:"from"`
+	To      Template `json:"to"`
+	Subject string   `json:"subject"`
+	HTML    string   `json:"html"`
+	Text    string   `json:"text"`
+}
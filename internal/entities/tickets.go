@@ -47,3 +47,22 @@ type Respond struct {
 	CreatedAt time.Time `json:"createdAt"`
 	UpdatedAt time.Time `json:"updatedAt"`
 }
+
+// TicketSnapshot is a state of ticket with names of category and tags, which is shown in ticket-updated
+// notification. Attachments are links to attached files.
+type TicketSnapshot struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Price       *float32 `json:"price,omitempty"`
+	Quantity    uint32   `json:"quantity"`
+	Category    string   `json:"category"`
+	Tags        []string `json:"tags"`
+	Attachments []string `json:"attachments,omitempty"`
+}
+
+// TicketChanges contains states of ticket before and after update. Category and tags are resolved only if they
+// were changed, so their names are empty otherwise.
+type TicketChanges struct {
+	Before TicketSnapshot `json:"before"`
+	After  TicketSnapshot `json:"after"`
+}
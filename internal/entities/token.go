@@ -0,0 +1,31 @@
+package entities
+
+import "time"
+
+type TokenPurpose string
+
+const (
+	TokenPurposeVerifyEmail    TokenPurpose = "verify_email"
+	TokenPurposeForgetPassword TokenPurpose = "forget_password"
+)
+
+// TokenClaims are signed data of token, which is sent to user in verify-email or forget-password link.
+// Nonce identifies issued token, so that it could be used only once.
+type TokenClaims struct {
+	UserID    uint64
+	Purpose   TokenPurpose
+	Nonce     string
+	ExpiresAt time.Time
+}
+
+// Token represents issued token, which is valid until ExpiresAt and could be consumed only once.
+// Token fields order must be the same as columns order in tokens table for db.GetEntityColumns purpose.
+type Token struct {
+	ID         uint64       `json:"id"`
+	Nonce      string       `json:"-"`
+	UserID     uint64       `json:"userId"`
+	Purpose    TokenPurpose `json:"purpose"`
+	ExpiresAt  time.Time    `json:"expiresAt"`
+	ConsumedAt *time.Time   `json:"consumedAt,omitempty"`
+	CreatedAt  time.Time    `json:"createdAt"`
+}
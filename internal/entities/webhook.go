@@ -0,0 +1,63 @@
+package entities
+
+import (
+	"slices"
+	"time"
+)
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryStatusPending    WebhookDeliveryStatus = "pending"
+	WebhookDeliveryStatusProcessing WebhookDeliveryStatus = "processing"
+	WebhookDeliveryStatusDelivered  WebhookDeliveryStatus = "delivered"
+	WebhookDeliveryStatusFailed     WebhookDeliveryStatus = "failed"
+)
+
+// Webhook represents registered endpoint, which receives notification events, signed with Secret.
+// Webhook receives events of all types, if EventTypes is empty. Webhook is disabled automatically
+// after several failed deliveries in a row, which are counted by ConsecutiveFailures.
+type Webhook struct {
+	ID                  uint64             `json:"id"`
+	URL                 string             `json:"url"`
+	Secret              string             `json:"-"`
+	EventTypes          []NotificationType `json:"eventTypes"`
+	Enabled             bool               `json:"enabled"`
+	ConsecutiveFailures uint32             `json:"consecutiveFailures"`
+	CreatedAt           time.Time          `json:"createdAt"`
+	UpdatedAt           time.Time          `json:"updatedAt"`
+	DisabledAt          *time.Time         `json:"disabledAt,omitempty"`
+}
+
+// Subscribed returns true, if webhook should receive events of provided type.
+func (w Webhook) Subscribed(eventType NotificationType) bool {
+	return len(w.EventTypes) == 0 || slices.Contains(w.EventTypes, eventType)
+}
+
+// WebhookDelivery represents single event, which should be sent to webhook. DeliveryID is sent to receiver
+// and stays the same for all attempts, so that receiver could deduplicate retried deliveries.
+// Deferrals counts attempts, which were not consumed, because receiver asked to slow down.
+// WebhookDelivery fields order must be the same as columns order in webhook_deliveries table for
+// db.GetEntityColumns purpose.
+type WebhookDelivery struct {
+	ID            uint64                `json:"id"`
+	WebhookID     uint64                `json:"webhookId"`
+	DeliveryID    string                `json:"deliveryId"`
+	EventType     NotificationType      `json:"eventType"`
+	Payload       string                `json:"payload"`
+	Status        WebhookDeliveryStatus `json:"status"`
+	Attempts      uint32                `json:"attempts"`
+	LastError     *string               `json:"lastError,omitempty"`
+	NextAttemptAt *time.Time            `json:"nextAttemptAt,omitempty"`
+	CreatedAt     time.Time             `json:"createdAt"`
+	DeliveredAt   *time.Time            `json:"deliveredAt,omitempty"`
+	Deferrals     uint32                `json:"deferrals"`
+}
+
+// WebhookEvent is a body of webhook delivery request.
+type WebhookEvent struct {
+	ID           string           `json:"id"`
+	Type         NotificationType `json:"type"`
+	CreatedAt    time.Time        `json:"createdAt"`
+	Notification Notification     `json:"notification"`
+}
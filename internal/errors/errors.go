@@ -0,0 +1,303 @@
+package errors
+
+import "fmt"
+
+type NotificationNotFoundError struct {
+	Message string
+	BaseErr error
+}
+
+func (e NotificationNotFoundError) Error() string {
+	template := "notification not found"
+	if e.Message != "" {
+		template = e.Message
+	}
+
+	if e.BaseErr != nil {
+		return fmt.Sprintf(template+". Base error: %v", e.BaseErr)
+	}
+
+	return template
+}
+
+func (e NotificationNotFoundError) Unwrap() error {
+	return e.BaseErr
+}
+
+// NotificationsStreamClosedError represents live notifications stream, which was closed by server due to
+// shutdown or slow reading. Client should resubscribe, providing ID of last received notification.
+type NotificationsStreamClosedError struct {
+	Message string
+	BaseErr error
+}
+
+func (e NotificationsStreamClosedError) Error() string {
+	template := "notifications stream is closed"
+	if e.Message != "" {
+		template = e.Message
+	}
+
+	if e.BaseErr != nil {
+		return fmt.Sprintf(template+". Base error: %v", e.BaseErr)
+	}
+
+	return template
+}
+
+func (e NotificationsStreamClosedError) Unwrap() error {
+	return e.BaseErr
+}
+
+// CommunicationLeaseLostError represents update of claimed communication, which was retaken by another
+// dispatcher after expiration of lease.
+type CommunicationLeaseLostError struct {
+	Message string
+	BaseErr error
+}
+
+func (e CommunicationLeaseLostError) Error() string {
+	template := "communication lease was lost"
+	if e.Message != "" {
+		template = e.Message
+	}
+
+	if e.BaseErr != nil {
+		return fmt.Sprintf(template+". Base error: %v", e.BaseErr)
+	}
+
+	return template
+}
+
+func (e CommunicationLeaseLostError) Unwrap() error {
+	return e.BaseErr
+}
+
+type WebhookNotFoundError struct {
+	Message string
+	BaseErr error
+}
+
+func (e WebhookNotFoundError) Error() string {
+	template := "webhook not found"
+	if e.Message != "" {
+		template = e.Message
+	}
+
+	if e.BaseErr != nil {
+		return fmt.Sprintf(template+". Base error: %v", e.BaseErr)
+	}
+
+	return template
+}
+
+func (e WebhookNotFoundError) Unwrap() error {
+	return e.BaseErr
+}
+
+// InvalidWebhookError represents webhook with URL, which events could not be delivered to,
+// or with unknown event types.
+type InvalidWebhookError struct {
+	Message string
+	BaseErr error
+}
+
+func (e InvalidWebhookError) Error() string {
+	template := "webhook is invalid"
+	if e.Message != "" {
+		template = e.Message
+	}
+
+	if e.BaseErr != nil {
+		return fmt.Sprintf(template+". Base error: %v", e.BaseErr)
+	}
+
+	return template
+}
+
+func (e InvalidWebhookError) Unwrap() error {
+	return e.BaseErr
+}
+
+// InvalidTokenError represents token, which is malformed, has wrong signature, was signed with unknown key
+// or was issued for another purpose.
+type InvalidTokenError struct {
+	Message string
+	BaseErr error
+}
+
+func (e InvalidTokenError) Error() string {
+	template := "token is invalid"
+	if e.Message != "" {
+		template = e.Message
+	}
+
+	if e.BaseErr != nil {
+		return fmt.Sprintf(template+". Base error: %v", e.BaseErr)
+	}
+
+	return template
+}
+
+func (e InvalidTokenError) Unwrap() error {
+	return e.BaseErr
+}
+
+type TokenNotFoundError struct {
+	Message string
+	BaseErr error
+}
+
+func (e TokenNotFoundError) Error() string {
+	template := "token not found"
+	if e.Message != "" {
+		template = e.Message
+	}
+
+	if e.BaseErr != nil {
+		return fmt.Sprintf(template+". Base error: %v", e.BaseErr)
+	}
+
+	return template
+}
+
+func (e TokenNotFoundError) Unwrap() error {
+	return e.BaseErr
+}
+
+type TokenExpiredError struct {
+	Message string
+	BaseErr error
+}
+
+func (e TokenExpiredError) Error() string {
+	template := "token has expired"
+	if e.Message != "" {
+		template = e.Message
+	}
+
+	if e.BaseErr != nil {
+		return fmt.Sprintf(template+". Base error: %v", e.BaseErr)
+	}
+
+	return template
+}
+
+func (e TokenExpiredError) Unwrap() error {
+	return e.BaseErr
+}
+
+type TokenConsumedError struct {
+	Message string
+	BaseErr error
+}
+
+func (e TokenConsumedError) Error() string {
+	template := "token has already been used"
+	if e.Message != "" {
+		template = e.Message
+	}
+
+	if e.BaseErr != nil {
+		return fmt.Sprintf(template+". Base error: %v", e.BaseErr)
+	}
+
+	return template
+}
+
+func (e TokenConsumedError) Unwrap() error {
+	return e.BaseErr
+}
+
+type TemplateNotFoundError struct {
+	Message string
+	BaseErr error
+}
+
+func (e TemplateNotFoundError) Error() string {
+	template := "template not found"
+	if e.Message != "" {
+		template = e.Message
+	}
+
+	if e.BaseErr != nil {
+		return fmt.Sprintf(template+". Base error: %v", e.BaseErr)
+	}
+
+	return template
+}
+
+func (e TemplateNotFoundError) Unwrap() error {
+	return e.BaseErr
+}
+
+// InvalidTemplateError represents template of unknown notification type or locale or template, which could not
+// be parsed or executed with view model of its notification type.
+type InvalidTemplateError struct {
+	Message string
+	BaseErr error
+}
+
+func (e InvalidTemplateError) Error() string {
+	template := "template is invalid"
+	if e.Message != "" {
+		template = e.Message
+	}
+
+	if e.BaseErr != nil {
+		return fmt.Sprintf(template+". Base error: %v", e.BaseErr)
+	}
+
+	return template
+}
+
+func (e InvalidTemplateError) Unwrap() error {
+	return e.BaseErr
+}
+
+// TemplateNotDraftError represents change of template version, which was already published. Published and
+// archived versions are immutable, so new version should be created instead.
+type TemplateNotDraftError struct {
+	Message string
+	BaseErr error
+}
+
+func (e TemplateNotDraftError) Error() string {
+	template := "only draft version of template could be changed"
+	if e.Message != "" {
+		template = e.Message
+	}
+
+	if e.BaseErr != nil {
+		return fmt.Sprintf(template+". Base error: %v", e.BaseErr)
+	}
+
+	return template
+}
+
+func (e TemplateNotDraftError) Unwrap() error {
+	return e.BaseErr
+}
+
+// PreviewRecipientNotFoundError represents preview of ticket notification without master, owner of which
+// could be a recipient: neither master is provided nor ticket has responds.
+type PreviewRecipientNotFoundError struct {
+	Message string
+	BaseErr error
+}
+
+func (e PreviewRecipientNotFoundError) Error() string {
+	template := "recipient of notification preview not found"
+	if e.Message != "" {
+		template = e.Message
+	}
+
+	if e.BaseErr != nil {
+		return fmt.Sprintf(template+". Base error: %v", e.BaseErr)
+	}
+
+	return template
+}
+
+func (e PreviewRecipientNotFoundError) Unwrap() error {
+	return e.BaseErr
+}
@@ -0,0 +1,13 @@
+package interfaces
+
+import (
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+)
+
+// NotificationsBroadcaster delivers created in-app notifications to live subscribers of their recipients.
+//
+//go:generate mockgen -source=broadcasters.go -destination=../../mocks/broadcasters/notifications_broadcaster.go -package=mockbroadcasters
+type NotificationsBroadcaster interface {
+	Publish(notification entities.Notification)
+	Subscribe(userID uint64) (notifications <-chan entities.Notification, unsubscribe func(), err error)
+}
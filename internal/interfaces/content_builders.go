@@ -10,28 +10,131 @@ type ContentBuilders struct {
 	ForgetPassword ForgetPasswordContentBuilder
 	TicketUpdated  TicketUpdatedContentBuilder
 	TicketDeleted  TicketDeletedContentBuilder
+	Text           EmailTextContentBuilders
+	SMS            SMSContentBuilders
+	Inbox          InboxContentBuilders
+	Templates      ContentTemplates
 }
 
-//go:generate mockgen -source=content_builders.go -destination=../../mocks/contentbuilders/verify_email_content_builder.go -package=mockcontentbuilders -exclude_interfaces=ForgetPasswordContentBuilder,TicketUpdatedContentBuilder,TicketDeletedContentBuilder
+// EmailTextContentBuilders build plain text alternatives of email bodies. Every builder is optional: if it is not
+// set, plain text alternative is generated from HTML body on sending.
+type EmailTextContentBuilders struct {
+	VerifyEmail    VerifyEmailTextContentBuilder
+	ForgetPassword ForgetPasswordTextContentBuilder
+	TicketUpdated  TicketUpdatedTextContentBuilder
+	TicketDeleted  TicketDeletedTextContentBuilder
+}
+
+// SMSContentBuilders build short plain text messages, which fit into SMS length limits.
+type SMSContentBuilders struct {
+	ForgetPassword ForgetPasswordSMSContentBuilder
+	TicketUpdated  TicketUpdatedSMSContentBuilder
+	TicketDeleted  TicketDeletedSMSContentBuilder
+}
+
+// InboxContentBuilders build title, short plain text and deep link of in-app notifications.
+type InboxContentBuilders struct {
+	VerifyEmail    VerifyEmailInboxContentBuilder
+	ForgetPassword ForgetPasswordInboxContentBuilder
+	TicketUpdated  TicketUpdatedInboxContentBuilder
+	TicketDeleted  TicketDeletedInboxContentBuilder
+}
+
+//go:generate mockgen -source=content_builders.go -destination=../../mocks/contentbuilders/verify_email_content_builder.go -package=mockcontentbuilders -exclude_interfaces=ForgetPasswordContentBuilder,TicketUpdatedContentBuilder,TicketDeletedContentBuilder,VerifyEmailTextContentBuilder,ForgetPasswordTextContentBuilder,TicketUpdatedTextContentBuilder,TicketDeletedTextContentBuilder,ForgetPasswordSMSContentBuilder,TicketUpdatedSMSContentBuilder,TicketDeletedSMSContentBuilder,VerifyEmailInboxContentBuilder,ForgetPasswordInboxContentBuilder,TicketUpdatedInboxContentBuilder,TicketDeletedInboxContentBuilder,ContentTemplates
 type VerifyEmailContentBuilder interface {
-	Subject() string
-	Body(user entities.User) string
+	Subject(user entities.User) string
+	Body(user entities.User, token string) string
 }
 
-//go:generate mockgen -source=content_builders.go -destination=../../mocks/contentbuilders/forget_password_content_builder.go -package=mockcontentbuilders -exclude_interfaces=VerifyEmailContentBuilder,TicketUpdatedContentBuilder,TicketDeletedContentBuilder
+//go:generate mockgen -source=content_builders.go -destination=../../mocks/contentbuilders/forget_password_content_builder.go -package=mockcontentbuilders -exclude_interfaces=VerifyEmailContentBuilder,TicketUpdatedContentBuilder,TicketDeletedContentBuilder,VerifyEmailTextContentBuilder,ForgetPasswordTextContentBuilder,TicketUpdatedTextContentBuilder,TicketDeletedTextContentBuilder,ForgetPasswordSMSContentBuilder,TicketUpdatedSMSContentBuilder,TicketDeletedSMSContentBuilder,VerifyEmailInboxContentBuilder,ForgetPasswordInboxContentBuilder,TicketUpdatedInboxContentBuilder,TicketDeletedInboxContentBuilder,ContentTemplates
 type ForgetPasswordContentBuilder interface {
-	Subject() string
-	Body(user entities.User) string
+	Subject(user entities.User) string
+	Body(user entities.User, token string) string
 }
 
-//go:generate mockgen -source=content_builders.go -destination=../../mocks/contentbuilders/ticket_updated_content_builder.go -package=mockcontentbuilders -exclude_interfaces=ForgetPasswordContentBuilder,VerifyEmailContentBuilder,TicketDeletedContentBuilder
+//go:generate mockgen -source=content_builders.go -destination=../../mocks/contentbuilders/ticket_updated_content_builder.go -package=mockcontentbuilders -exclude_interfaces=VerifyEmailContentBuilder,ForgetPasswordContentBuilder,TicketDeletedContentBuilder,VerifyEmailTextContentBuilder,ForgetPasswordTextContentBuilder,TicketUpdatedTextContentBuilder,TicketDeletedTextContentBuilder,ForgetPasswordSMSContentBuilder,TicketUpdatedSMSContentBuilder,TicketDeletedSMSContentBuilder,VerifyEmailInboxContentBuilder,ForgetPasswordInboxContentBuilder,TicketUpdatedInboxContentBuilder,TicketDeletedInboxContentBuilder,ContentTemplates
 type TicketUpdatedContentBuilder interface {
-	Subject(ticket entities.RawTicket) string
-	Body(ticket entities.RawTicket, respondOwner entities.User) string
+	Subject(ticket entities.RawTicket, respondOwner entities.User) string
+	// Body shows changed fields of ticket, if changes are not nil, and only its current state otherwise.
+	Body(ticket entities.RawTicket, changes *entities.TicketChanges, respondOwner entities.User) string
 }
 
-//go:generate mockgen -source=content_builders.go -destination=../../mocks/contentbuilders/ticket_deleted_content_builder.go -package=mockcontentbuilders -exclude_interfaces=ForgetPasswordContentBuilder,TicketUpdatedContentBuilder,VerifyEmailContentBuilder
+//go:generate mockgen -source=content_builders.go -destination=../../mocks/contentbuilders/ticket_deleted_content_builder.go -package=mockcontentbuilders -exclude_interfaces=VerifyEmailContentBuilder,ForgetPasswordContentBuilder,TicketUpdatedContentBuilder,VerifyEmailTextContentBuilder,ForgetPasswordTextContentBuilder,TicketUpdatedTextContentBuilder,TicketDeletedTextContentBuilder,ForgetPasswordSMSContentBuilder,TicketUpdatedSMSContentBuilder,TicketDeletedSMSContentBuilder,VerifyEmailInboxContentBuilder,ForgetPasswordInboxContentBuilder,TicketUpdatedInboxContentBuilder,TicketDeletedInboxContentBuilder,ContentTemplates
 type TicketDeletedContentBuilder interface {
-	Subject(ticketData dto.TicketDeletedDTO) string
+	Subject(ticketData dto.TicketDeletedDTO, respondOwner entities.User) string
 	Body(ticketData dto.TicketDeletedDTO, ticketOwner, respondOwner entities.User) string
 }
+
+//go:generate mockgen -source=content_builders.go -destination=../../mocks/contentbuilders/verify_email_text_content_builder.go -package=mockcontentbuilders -exclude_interfaces=VerifyEmailContentBuilder,ForgetPasswordContentBuilder,TicketUpdatedContentBuilder,TicketDeletedContentBuilder,ForgetPasswordTextContentBuilder,TicketUpdatedTextContentBuilder,TicketDeletedTextContentBuilder,ForgetPasswordSMSContentBuilder,TicketUpdatedSMSContentBuilder,TicketDeletedSMSContentBuilder,VerifyEmailInboxContentBuilder,ForgetPasswordInboxContentBuilder,TicketUpdatedInboxContentBuilder,TicketDeletedInboxContentBuilder,ContentTemplates
+type VerifyEmailTextContentBuilder interface {
+	Text(user entities.User, token string) string
+}
+
+//go:generate mockgen -source=content_builders.go -destination=../../mocks/contentbuilders/forget_password_text_content_builder.go -package=mockcontentbuilders -exclude_interfaces=VerifyEmailContentBuilder,ForgetPasswordContentBuilder,TicketUpdatedContentBuilder,TicketDeletedContentBuilder,VerifyEmailTextContentBuilder,TicketUpdatedTextContentBuilder,TicketDeletedTextContentBuilder,ForgetPasswordSMSContentBuilder,TicketUpdatedSMSContentBuilder,TicketDeletedSMSContentBuilder,VerifyEmailInboxContentBuilder,ForgetPasswordInboxContentBuilder,TicketUpdatedInboxContentBuilder,TicketDeletedInboxContentBuilder,ContentTemplates
+type ForgetPasswordTextContentBuilder interface {
+	Text(user entities.User, token string) string
+}
+
+//go:generate mockgen -source=content_builders.go -destination=../../mocks/contentbuilders/ticket_updated_text_content_builder.go -package=mockcontentbuilders -exclude_interfaces=VerifyEmailContentBuilder,ForgetPasswordContentBuilder,TicketUpdatedContentBuilder,TicketDeletedContentBuilder,VerifyEmailTextContentBuilder,ForgetPasswordTextContentBuilder,TicketDeletedTextContentBuilder,ForgetPasswordSMSContentBuilder,TicketUpdatedSMSContentBuilder,TicketDeletedSMSContentBuilder,VerifyEmailInboxContentBuilder,ForgetPasswordInboxContentBuilder,TicketUpdatedInboxContentBuilder,TicketDeletedInboxContentBuilder,ContentTemplates
+type TicketUpdatedTextContentBuilder interface {
+	Text(ticket entities.RawTicket, changes *entities.TicketChanges, respondOwner entities.User) string
+}
+
+//go:generate mockgen -source=content_builders.go -destination=../../mocks/contentbuilders/ticket_deleted_text_content_builder.go -package=mockcontentbuilders -exclude_interfaces=VerifyEmailContentBuilder,ForgetPasswordContentBuilder,TicketUpdatedContentBuilder,TicketDeletedContentBuilder,VerifyEmailTextContentBuilder,ForgetPasswordTextContentBuilder,TicketUpdatedTextContentBuilder,ForgetPasswordSMSContentBuilder,TicketUpdatedSMSContentBuilder,TicketDeletedSMSContentBuilder,VerifyEmailInboxContentBuilder,ForgetPasswordInboxContentBuilder,TicketUpdatedInboxContentBuilder,TicketDeletedInboxContentBuilder,ContentTemplates
+type TicketDeletedTextContentBuilder interface {
+	Text(ticketData dto.TicketDeletedDTO, ticketOwner, respondOwner entities.User) string
+}
+
+//go:generate mockgen -source=content_builders.go -destination=../../mocks/contentbuilders/forget_password_sms_content_builder.go -package=mockcontentbuilders -exclude_interfaces=VerifyEmailContentBuilder,ForgetPasswordContentBuilder,TicketUpdatedContentBuilder,TicketDeletedContentBuilder,VerifyEmailTextContentBuilder,ForgetPasswordTextContentBuilder,TicketUpdatedTextContentBuilder,TicketDeletedTextContentBuilder,TicketUpdatedSMSContentBuilder,TicketDeletedSMSContentBuilder,VerifyEmailInboxContentBuilder,ForgetPasswordInboxContentBuilder,TicketUpdatedInboxContentBuilder,TicketDeletedInboxContentBuilder,ContentTemplates
+type ForgetPasswordSMSContentBuilder interface {
+	Text(user entities.User, token string) string
+}
+
+//go:generate mockgen -source=content_builders.go -destination=../../mocks/contentbuilders/ticket_updated_sms_content_builder.go -package=mockcontentbuilders -exclude_interfaces=VerifyEmailContentBuilder,ForgetPasswordContentBuilder,TicketUpdatedContentBuilder,TicketDeletedContentBuilder,VerifyEmailTextContentBuilder,ForgetPasswordTextContentBuilder,TicketUpdatedTextContentBuilder,TicketDeletedTextContentBuilder,ForgetPasswordSMSContentBuilder,TicketDeletedSMSContentBuilder,VerifyEmailInboxContentBuilder,ForgetPasswordInboxContentBuilder,TicketUpdatedInboxContentBuilder,TicketDeletedInboxContentBuilder,ContentTemplates
+type TicketUpdatedSMSContentBuilder interface {
+	Text(ticket entities.RawTicket, respondOwner entities.User) string
+}
+
+//go:generate mockgen -source=content_builders.go -destination=../../mocks/contentbuilders/ticket_deleted_sms_content_builder.go -package=mockcontentbuilders -exclude_interfaces=VerifyEmailContentBuilder,ForgetPasswordContentBuilder,TicketUpdatedContentBuilder,TicketDeletedContentBuilder,VerifyEmailTextContentBuilder,ForgetPasswordTextContentBuilder,TicketUpdatedTextContentBuilder,TicketDeletedTextContentBuilder,ForgetPasswordSMSContentBuilder,TicketUpdatedSMSContentBuilder,VerifyEmailInboxContentBuilder,ForgetPasswordInboxContentBuilder,TicketUpdatedInboxContentBuilder,TicketDeletedInboxContentBuilder,ContentTemplates
+type TicketDeletedSMSContentBuilder interface {
+	Text(ticketData dto.TicketDeletedDTO, respondOwner entities.User) string
+}
+
+//go:generate mockgen -source=content_builders.go -destination=../../mocks/contentbuilders/verify_email_inbox_content_builder.go -package=mockcontentbuilders -exclude_interfaces=VerifyEmailContentBuilder,ForgetPasswordContentBuilder,TicketUpdatedContentBuilder,TicketDeletedContentBuilder,VerifyEmailTextContentBuilder,ForgetPasswordTextContentBuilder,TicketUpdatedTextContentBuilder,TicketDeletedTextContentBuilder,ForgetPasswordSMSContentBuilder,TicketUpdatedSMSContentBuilder,TicketDeletedSMSContentBuilder,ForgetPasswordInboxContentBuilder,TicketUpdatedInboxContentBuilder,TicketDeletedInboxContentBuilder,ContentTemplates
+type VerifyEmailInboxContentBuilder interface {
+	Title(user entities.User) string
+	Text(user entities.User) string
+	Link() string
+}
+
+//go:generate mockgen -source=content_builders.go -destination=../../mocks/contentbuilders/forget_password_inbox_content_builder.go -package=mockcontentbuilders -exclude_interfaces=VerifyEmailContentBuilder,ForgetPasswordContentBuilder,TicketUpdatedContentBuilder,TicketDeletedContentBuilder,VerifyEmailTextContentBuilder,ForgetPasswordTextContentBuilder,TicketUpdatedTextContentBuilder,TicketDeletedTextContentBuilder,ForgetPasswordSMSContentBuilder,TicketUpdatedSMSContentBuilder,TicketDeletedSMSContentBuilder,VerifyEmailInboxContentBuilder,TicketUpdatedInboxContentBuilder,TicketDeletedInboxContentBuilder,ContentTemplates
+type ForgetPasswordInboxContentBuilder interface {
+	Title(user entities.User) string
+	Text(user entities.User) string
+	Link() string
+}
+
+//go:generate mockgen -source=content_builders.go -destination=../../mocks/contentbuilders/ticket_updated_inbox_content_builder.go -package=mockcontentbuilders -exclude_interfaces=VerifyEmailContentBuilder,ForgetPasswordContentBuilder,TicketUpdatedContentBuilder,TicketDeletedContentBuilder,VerifyEmailTextContentBuilder,ForgetPasswordTextContentBuilder,TicketUpdatedTextContentBuilder,TicketDeletedTextContentBuilder,ForgetPasswordSMSContentBuilder,TicketUpdatedSMSContentBuilder,TicketDeletedSMSContentBuilder,VerifyEmailInboxContentBuilder,ForgetPasswordInboxContentBuilder,TicketDeletedInboxContentBuilder,ContentTemplates
+type TicketUpdatedInboxContentBuilder interface {
+	Title(ticket entities.RawTicket, respondOwner entities.User) string
+	Text(ticket entities.RawTicket, respondOwner entities.User) string
+	Link(ticket entities.RawTicket) string
+}
+
+//go:generate mockgen -source=content_builders.go -destination=../../mocks/contentbuilders/ticket_deleted_inbox_content_builder.go -package=mockcontentbuilders -exclude_interfaces=VerifyEmailContentBuilder,ForgetPasswordContentBuilder,TicketUpdatedContentBuilder,TicketDeletedContentBuilder,VerifyEmailTextContentBuilder,ForgetPasswordTextContentBuilder,TicketUpdatedTextContentBuilder,TicketDeletedTextContentBuilder,ForgetPasswordSMSContentBuilder,TicketUpdatedSMSContentBuilder,TicketDeletedSMSContentBuilder,VerifyEmailInboxContentBuilder,ForgetPasswordInboxContentBuilder,TicketUpdatedInboxContentBuilder,ContentTemplates
+type TicketDeletedInboxContentBuilder interface {
+	Title(ticketData dto.TicketDeletedDTO, respondOwner entities.User) string
+	Text(ticketData dto.TicketDeletedDTO, ticketOwner, respondOwner entities.User) string
+	Link(ticketData dto.TicketDeletedDTO, ticketOwner entities.User) string
+}
+
+// ContentTemplates validates templates, stored in database, and renders published ones instead of embedded
+// templates of the same notification type and locale.
+//
+//go:generate mockgen -source=content_builders.go -destination=../../mocks/contentbuilders/content_templates.go -package=mockcontentbuilders -exclude_interfaces=VerifyEmailContentBuilder,ForgetPasswordContentBuilder,TicketUpdatedContentBuilder,TicketDeletedContentBuilder,VerifyEmailTextContentBuilder,ForgetPasswordTextContentBuilder,TicketUpdatedTextContentBuilder,TicketDeletedTextContentBuilder,ForgetPasswordSMSContentBuilder,TicketUpdatedSMSContentBuilder,TicketDeletedSMSContentBuilder,VerifyEmailInboxContentBuilder,ForgetPasswordInboxContentBuilder,TicketUpdatedInboxContentBuilder,TicketDeletedInboxContentBuilder
+type ContentTemplates interface {
+	Validate(template entities.Template) error
+	// SetPublished replaces previously published templates. Invalid templates are skipped and reported by error,
+	// so embedded ones are rendered instead of them.
+	SetPublished(templates []entities.Template) error
+}
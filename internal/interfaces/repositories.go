@@ -2,24 +2,53 @@ package interfaces
 
 import (
 	"context"
+	"time"
 
 	"github.com/DKhorkov/hmtm-notifications/internal/entities"
 )
 
-//go:generate mockgen -source=repositories.go -destination=../../mocks/repositories/emails_repository.go -exclude_interfaces=ToysRepository,SsoRepository,TicketsRepository -package=mockrepositories
-type EmailsRepository interface {
-	GetUserCommunications(ctx context.Context, userID uint64, pagination *entities.Pagination) ([]entities.Email, error)
-	CountUserCommunications(ctx context.Context, userID uint64) (uint64, error)
-	SaveCommunication(ctx context.Context, email entities.Email) (communicationID uint64, err error)
+//go:generate mockgen -source=repositories.go -destination=../../mocks/repositories/communications_repository.go -exclude_interfaces=ToysRepository,SsoRepository,TicketsRepository,ProcessedMessagesRepository,NotificationsRepository,WebhooksRepository,TokensRepository,TemplatesRepository -package=mockrepositories
+type CommunicationsRepository interface {
+	GetUserCommunications(
+		ctx context.Context,
+		userID uint64,
+		channel entities.CommunicationChannel,
+		pagination *entities.Pagination,
+	) ([]entities.Communication, error)
+	CountUserCommunications(ctx context.Context, userID uint64, channel entities.CommunicationChannel) (uint64, error)
+	SaveCommunication(ctx context.Context, communication entities.Communication) (communicationID uint64, err error)
+	GetPendingCommunications(ctx context.Context, limit uint64) ([]entities.Communication, error)
+	ClaimCommunication(
+		ctx context.Context,
+		communication entities.Communication,
+		leaseUntil time.Time,
+	) (claimed bool, err error)
+	MarkCommunicationSent(ctx context.Context, id uint64, attempts uint32, providerMessageID string) error
+	RescheduleCommunication(
+		ctx context.Context,
+		id uint64,
+		attempts uint32,
+		lastError string,
+		nextAttemptAt time.Time,
+	) error
+	DeferCommunication(ctx context.Context, id uint64, attempts uint32, nextAttemptAt time.Time) error
+	SaveCommunicationSentParts(
+		ctx context.Context,
+		id uint64,
+		attempts uint32,
+		sentParts uint32,
+		providerMessageID string,
+	) error
+	MarkCommunicationFailed(ctx context.Context, id uint64, attempts uint32, lastError string) error
 }
 
-//go:generate mockgen -source=repositories.go -destination=../../mocks/repositories/sso_repository.go -exclude_interfaces=ToysRepository,EmailsRepository,TicketsRepository -package=mockrepositories
+//go:generate mockgen -source=repositories.go -destination=../../mocks/repositories/sso_repository.go -exclude_interfaces=ToysRepository,CommunicationsRepository,TicketsRepository,ProcessedMessagesRepository,NotificationsRepository,WebhooksRepository,TokensRepository,TemplatesRepository -package=mockrepositories
 type SsoRepository interface {
 	GetUserByID(ctx context.Context, id uint64) (*entities.User, error)
 	GetUserByEmail(ctx context.Context, email string) (*entities.User, error)
 }
 
-//go:generate mockgen -source=repositories.go -destination=../../mocks/repositories/tickets_repository.go -exclude_interfaces=ToysRepository,EmailsRepository,SsoRepository -package=mockrepositories
+//go:generate mockgen -source=repositories.go -destination=../../mocks/repositories/tickets_repository.go -exclude_interfaces=ToysRepository,CommunicationsRepository,SsoRepository,ProcessedMessagesRepository,NotificationsRepository,WebhooksRepository,TokensRepository,TemplatesRepository -package=mockrepositories
 type TicketsRepository interface {
 	GetTicketByID(ctx context.Context, id uint64) (*entities.RawTicket, error)
 	GetAllTickets(ctx context.Context) ([]entities.RawTicket, error)
@@ -29,7 +58,7 @@ type TicketsRepository interface {
 	GetUserResponds(ctx context.Context, userID uint64) ([]entities.Respond, error)
 }
 
-//go:generate mockgen -source=repositories.go -destination=../../mocks/repositories/toys_repository.go -exclude_interfaces=TicketsRepository,EmailsRepository,SsoRepository -package=mockrepositories
+//go:generate mockgen -source=repositories.go -destination=../../mocks/repositories/toys_repository.go -exclude_interfaces=TicketsRepository,CommunicationsRepository,SsoRepository,ProcessedMessagesRepository,NotificationsRepository,WebhooksRepository,TokensRepository,TemplatesRepository -package=mockrepositories
 type ToysRepository interface {
 	GetAllToys(ctx context.Context) ([]entities.Toy, error)
 	GetToyByID(ctx context.Context, id uint64) (*entities.Toy, error)
@@ -43,3 +72,87 @@ type ToysRepository interface {
 	GetTagByID(ctx context.Context, id uint32) (*entities.Tag, error)
 	GetMasterByUser(ctx context.Context, userID uint64) (*entities.Master, error)
 }
+
+//go:generate mockgen -source=repositories.go -destination=../../mocks/repositories/processed_messages_repository.go -exclude_interfaces=ToysRepository,CommunicationsRepository,SsoRepository,TicketsRepository,NotificationsRepository,WebhooksRepository,TokensRepository,TemplatesRepository -package=mockrepositories
+type ProcessedMessagesRepository interface {
+	ReserveProcessedMessage(ctx context.Context, idempotencyKey string) (reserved bool, err error)
+	GetProcessedMessage(ctx context.Context, idempotencyKey string) (*entities.ProcessedMessage, error)
+	CompleteProcessedMessage(ctx context.Context, idempotencyKey string, emailIDs []uint64) error
+	ReleaseProcessedMessage(ctx context.Context, idempotencyKey string) error
+	DeleteExpiredProcessedMessages(
+		ctx context.Context,
+		processedBefore time.Time,
+		reservedBefore time.Time,
+	) (deleted uint64, err error)
+}
+
+//go:generate mockgen -source=repositories.go -destination=../../mocks/repositories/notifications_repository.go -exclude_interfaces=ToysRepository,CommunicationsRepository,SsoRepository,TicketsRepository,ProcessedMessagesRepository,WebhooksRepository,TokensRepository,TemplatesRepository -package=mockrepositories
+type NotificationsRepository interface {
+	GetUserNotifications(
+		ctx context.Context,
+		userID uint64,
+		pagination *entities.Pagination,
+	) ([]entities.Notification, error)
+	GetUserNotificationsAfterID(
+		ctx context.Context,
+		userID uint64,
+		lastSeenID uint64,
+		limit uint64,
+	) ([]entities.Notification, error)
+	CountUserUnreadNotifications(ctx context.Context, userID uint64) (uint64, error)
+	SaveNotification(ctx context.Context, notification entities.Notification) (notificationID uint64, err error)
+	MarkNotificationAsRead(ctx context.Context, id, userID uint64) error
+	MarkAllNotificationsAsRead(ctx context.Context, userID uint64) error
+	DeleteNotification(ctx context.Context, id, userID uint64) error
+}
+
+//go:generate mockgen -source=repositories.go -destination=../../mocks/repositories/webhooks_repository.go -exclude_interfaces=ToysRepository,CommunicationsRepository,SsoRepository,TicketsRepository,ProcessedMessagesRepository,NotificationsRepository,TokensRepository,TemplatesRepository -package=mockrepositories
+type WebhooksRepository interface {
+	GetWebhooks(ctx context.Context) ([]entities.Webhook, error)
+	GetEnabledWebhooks(ctx context.Context) ([]entities.Webhook, error)
+	GetWebhookByID(ctx context.Context, id uint64) (*entities.Webhook, error)
+	SaveWebhook(ctx context.Context, webhook entities.Webhook) (webhookID uint64, err error)
+	UpdateWebhook(ctx context.Context, webhook entities.Webhook) error
+	DeleteWebhook(ctx context.Context, id uint64) error
+	RegisterWebhookFailure(ctx context.Context, id uint64, disableThreshold int) (disabled bool, err error)
+	ResetWebhookFailures(ctx context.Context, id uint64) error
+	GetWebhookDeliveries(
+		ctx context.Context,
+		webhookID uint64,
+		pagination *entities.Pagination,
+	) ([]entities.WebhookDelivery, error)
+	SaveWebhookDelivery(ctx context.Context, delivery entities.WebhookDelivery) (deliveryID uint64, err error)
+	GetPendingWebhookDeliveries(ctx context.Context, limit uint64) ([]entities.WebhookDelivery, error)
+	ClaimWebhookDelivery(
+		ctx context.Context,
+		delivery entities.WebhookDelivery,
+		leaseUntil time.Time,
+	) (claimed bool, err error)
+	MarkWebhookDeliveryDelivered(ctx context.Context, id uint64, attempts uint32) error
+	RescheduleWebhookDelivery(
+		ctx context.Context,
+		id uint64,
+		attempts uint32,
+		lastError string,
+		nextAttemptAt time.Time,
+	) error
+	DeferWebhookDelivery(ctx context.Context, id uint64, attempts uint32, nextAttemptAt time.Time) error
+	MarkWebhookDeliveryFailed(ctx context.Context, id uint64, attempts uint32, lastError string) error
+}
+
+//go:generate mockgen -source=repositories.go -destination=../../mocks/repositories/tokens_repository.go -exclude_interfaces=CommunicationsRepository,SsoRepository,TicketsRepository,ToysRepository,ProcessedMessagesRepository,NotificationsRepository,WebhooksRepository,TemplatesRepository -package=mockrepositories
+type TokensRepository interface {
+	SaveToken(ctx context.Context, token entities.Token) (tokenID uint64, err error)
+	GetTokenByNonce(ctx context.Context, nonce string) (*entities.Token, error)
+	ConsumeToken(ctx context.Context, nonce string, consumedAt time.Time) (consumed bool, err error)
+}
+
+//go:generate mockgen -source=repositories.go -destination=../../mocks/repositories/templates_repository.go -exclude_interfaces=CommunicationsRepository,SsoRepository,TicketsRepository,ToysRepository,ProcessedMessagesRepository,NotificationsRepository,WebhooksRepository,TokensRepository -package=mockrepositories
+type TemplatesRepository interface {
+	SaveTemplate(ctx context.Context, template entities.Template) (templateID uint64, err error)
+	GetTemplateByID(ctx context.Context, id uint64) (*entities.Template, error)
+	GetTemplates(ctx context.Context, filters entities.TemplatesFilters) ([]entities.Template, error)
+	GetPublishedTemplates(ctx context.Context) ([]entities.Template, error)
+	UpdateTemplate(ctx context.Context, template entities.Template) (updated bool, err error)
+	PublishTemplate(ctx context.Context, id uint64, publishedAt time.Time) error
+}
@@ -1,12 +1,46 @@
 package interfaces
 
-import "context"
+import (
+	"context"
+
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+)
 
 type Senders struct {
-	Email EmailSender
+	Email    EmailSender
+	Telegram TelegramSender
+	SMS      SMSSender
 }
 
-//go:generate mockgen -source=senders.go -destination=../../mocks/senders/email_sender.go -package=mocksenders -exclude_interfaces=
+// EmailSender sends email with HTML body and its plain text alternative to recipients and returns ID of sent
+// message. If text is empty, it is generated from HTML body.
+//
+//go:generate mockgen -source=senders.go -destination=../../mocks/senders/email_sender.go -package=mocksenders -exclude_interfaces=TelegramSender,SMSSender,SMSProvider,WebhookSender
 type EmailSender interface {
-	Send(ctx context.Context, subject, body string, recipients []string) error
+	Send(ctx context.Context, subject, body, text string, recipients []string) (messageID string, err error)
+}
+
+//go:generate mockgen -source=senders.go -destination=../../mocks/senders/telegram_sender.go -package=mocksenders -exclude_interfaces=EmailSender,SMSSender,SMSProvider,WebhookSender
+type TelegramSender interface {
+	Send(ctx context.Context, chatID, content string, sentParts uint32) (messageID string, err error)
+}
+
+//go:generate mockgen -source=senders.go -destination=../../mocks/senders/sms_sender.go -package=mocksenders -exclude_interfaces=EmailSender,TelegramSender,SMSProvider,WebhookSender
+type SMSSender interface {
+	Send(ctx context.Context, phone, content string) (messageID string, err error)
+}
+
+// SMSProvider delivers plain text message to phone number via SMS gateway and returns ID of message, assigned
+// by gateway, if gateway returns one.
+//
+//go:generate mockgen -source=senders.go -destination=../../mocks/senders/sms_provider.go -package=mocksenders -exclude_interfaces=EmailSender,TelegramSender,SMSSender,WebhookSender
+type SMSProvider interface {
+	Send(ctx context.Context, phone, text string) (messageID string, err error)
+}
+
+// WebhookSender delivers signed event payload to webhook endpoint.
+//
+//go:generate mockgen -source=senders.go -destination=../../mocks/senders/webhook_sender.go -package=mocksenders -exclude_interfaces=EmailSender,TelegramSender,SMSSender,SMSProvider
+type WebhookSender interface {
+	Send(ctx context.Context, webhook entities.Webhook, delivery entities.WebhookDelivery) error
 }
@@ -1,21 +1,46 @@
 package interfaces
 
-//go:generate mockgen -source=services.go -destination=../../mocks/services/email_service.go -package=mockservices -exclude_interfaces=ToysService,TicketsService,SsoService
-type EmailsService interface {
-	EmailsRepository
+//go:generate mockgen -source=services.go -destination=../../mocks/services/communications_service.go -package=mockservices -exclude_interfaces=ToysService,TicketsService,SsoService,ProcessedMessagesService,NotificationsService,WebhooksService,TokensService,TemplatesService
+type CommunicationsService interface {
+	CommunicationsRepository
 }
 
-//go:generate mockgen -source=services.go -destination=../../mocks/services/sso_service.go -package=mockservices -exclude_interfaces=ToysService,EmailsService,TicketsService
+//go:generate mockgen -source=services.go -destination=../../mocks/services/sso_service.go -package=mockservices -exclude_interfaces=ToysService,CommunicationsService,TicketsService,ProcessedMessagesService,NotificationsService,WebhooksService,TokensService,TemplatesService
 type SsoService interface {
 	SsoRepository
 }
 
-//go:generate mockgen -source=services.go -destination=../../mocks/services/tickets_service.go -package=mockservices -exclude_interfaces=ToysService,EmailsService,SsoService
+//go:generate mockgen -source=services.go -destination=../../mocks/services/tickets_service.go -package=mockservices -exclude_interfaces=ToysService,CommunicationsService,SsoService,ProcessedMessagesService,NotificationsService,WebhooksService,TokensService,TemplatesService
 type TicketsService interface {
 	TicketsRepository
 }
 
-//go:generate mockgen -source=services.go -destination=../../mocks/services/toys_service.go -package=mockservices -exclude_interfaces=SsoService,EmailsService,TicketsService
+//go:generate mockgen -source=services.go -destination=../../mocks/services/toys_service.go -package=mockservices -exclude_interfaces=SsoService,CommunicationsService,TicketsService,ProcessedMessagesService,NotificationsService,WebhooksService,TokensService,TemplatesService
 type ToysService interface {
 	ToysRepository
 }
+
+//go:generate mockgen -source=services.go -destination=../../mocks/services/processed_messages_service.go -package=mockservices -exclude_interfaces=ToysService,CommunicationsService,SsoService,TicketsService,NotificationsService,WebhooksService,TokensService,TemplatesService
+type ProcessedMessagesService interface {
+	ProcessedMessagesRepository
+}
+
+//go:generate mockgen -source=services.go -destination=../../mocks/services/notifications_service.go -package=mockservices -exclude_interfaces=ToysService,CommunicationsService,SsoService,TicketsService,ProcessedMessagesService,WebhooksService,TokensService,TemplatesService
+type NotificationsService interface {
+	NotificationsRepository
+}
+
+//go:generate mockgen -source=services.go -destination=../../mocks/services/webhooks_service.go -package=mockservices -exclude_interfaces=ToysService,CommunicationsService,SsoService,TicketsService,ProcessedMessagesService,NotificationsService,TokensService,TemplatesService
+type WebhooksService interface {
+	WebhooksRepository
+}
+
+//go:generate mockgen -source=services.go -destination=../../mocks/services/tokens_service.go -package=mockservices -exclude_interfaces=CommunicationsService,SsoService,TicketsService,ToysService,ProcessedMessagesService,NotificationsService,WebhooksService,TemplatesService
+type TokensService interface {
+	TokensRepository
+}
+
+//go:generate mockgen -source=services.go -destination=../../mocks/services/templates_service.go -package=mockservices -exclude_interfaces=CommunicationsService,SsoService,TicketsService,ToysService,ProcessedMessagesService,NotificationsService,WebhooksService,TokensService
+type TemplatesService interface {
+	TemplatesRepository
+}
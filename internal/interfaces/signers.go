@@ -0,0 +1,14 @@
+package interfaces
+
+import (
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+)
+
+// TokenSigner converts claims to signed token and back. Parse returns InvalidTokenError for malformed token,
+// token with wrong signature or token, signed with unknown key. Expiration is not checked by TokenSigner.
+//
+//go:generate mockgen -source=signers.go -destination=../../mocks/signers/token_signer.go -package=mocksigners
+type TokenSigner interface {
+	Sign(claims entities.TokenClaims) (token string, err error)
+	Parse(token string) (*entities.TokenClaims, error)
+}
@@ -9,14 +9,73 @@ import (
 
 //go:generate mockgen -source=usecases.go -destination=../../mocks/usecases/usecases.go -package=mockusecases
 type UseCases interface {
-	GetUserEmailCommunications(
+	GetUserCommunications(
 		ctx context.Context,
 		userID uint64,
+		channel entities.CommunicationChannel,
 		pagination *entities.Pagination,
-	) ([]entities.Email, error)
-	CountUserEmailCommunications(ctx context.Context, userID uint64) (uint64, error)
-	SendVerifyEmailCommunication(ctx context.Context, userID uint64) (emailID uint64, err error)
-	SendForgetPasswordEmailCommunication(ctx context.Context, userID uint64) (emailID uint64, err error)
-	SendTicketUpdatedEmailCommunication(ctx context.Context, ticketID uint64) (emailIDs []uint64, err error)
-	SendTicketDeletedEmailCommunication(ctx context.Context, ticketData dto.TicketDeletedDTO) (emailIDs []uint64, err error)
+	) ([]entities.Communication, error)
+	CountUserCommunications(ctx context.Context, userID uint64, channel entities.CommunicationChannel) (uint64, error)
+	SendVerifyEmailCommunication(ctx context.Context, verifyEmailData dto.VerifyEmailDTO) (emailID uint64, err error)
+	SendForgetPasswordEmailCommunication(ctx context.Context, forgetPasswordData dto.ForgetPasswordDTO) (emailID uint64, err error)
+	SendTicketUpdatedEmailCommunication(
+		ctx context.Context,
+		ticketData dto.TicketUpdatedDTO,
+	) (result *entities.FanOutResult, err error)
+	SendTicketDeletedEmailCommunication(
+		ctx context.Context,
+		ticketData dto.TicketDeletedDTO,
+	) (result *entities.FanOutResult, err error)
+	GetUserNotifications(
+		ctx context.Context,
+		userID uint64,
+		pagination *entities.Pagination,
+	) ([]entities.Notification, error)
+	CountUserUnreadNotifications(ctx context.Context, userID uint64) (uint64, error)
+	MarkNotificationAsRead(ctx context.Context, id, userID uint64) error
+	MarkAllNotificationsAsRead(ctx context.Context, userID uint64) error
+	DeleteNotification(ctx context.Context, id, userID uint64) error
+	SubscribeToUserNotifications(
+		ctx context.Context,
+		userID uint64,
+		lastSeenID *uint64,
+	) (notifications <-chan entities.Notification, err error)
+	CreateWebhook(
+		ctx context.Context,
+		webhookURL string,
+		eventTypes []entities.NotificationType,
+	) (*entities.Webhook, error)
+	GetWebhooks(ctx context.Context) ([]entities.Webhook, error)
+	UpdateWebhook(ctx context.Context, webhook entities.Webhook) error
+	DeleteWebhook(ctx context.Context, id uint64) error
+	GetWebhookDeliveries(
+		ctx context.Context,
+		webhookID uint64,
+		pagination *entities.Pagination,
+	) ([]entities.WebhookDelivery, error)
+	VerifyToken(ctx context.Context, rawToken string, purpose entities.TokenPurpose) (*entities.Token, error)
+	ConsumeToken(ctx context.Context, rawToken string, purpose entities.TokenPurpose) (*entities.Token, error)
+	CreateTemplate(ctx context.Context, template entities.Template) (*entities.Template, error)
+	UpdateTemplate(ctx context.Context, id uint64, subject, html, text string) (*entities.Template, error)
+	PublishTemplate(ctx context.Context, id uint64) (*entities.Template, error)
+	GetTemplates(ctx context.Context, filters entities.TemplatesFilters) ([]entities.Template, error)
+	DiffTemplates(ctx context.Context, fromID, toID uint64) (*entities.TemplateDiff, error)
+	PreviewVerifyEmailCommunication(
+		ctx context.Context,
+		verifyEmailData dto.VerifyEmailDTO,
+	) (*entities.NotificationPreview, error)
+	PreviewForgetPasswordEmailCommunication(
+		ctx context.Context,
+		forgetPasswordData dto.ForgetPasswordDTO,
+	) (*entities.NotificationPreview, error)
+	PreviewTicketUpdatedEmailCommunication(
+		ctx context.Context,
+		ticketData dto.TicketUpdatedDTO,
+		masterID *uint64,
+	) (*entities.NotificationPreview, error)
+	PreviewTicketDeletedEmailCommunication(
+		ctx context.Context,
+		ticketData dto.TicketDeletedDTO,
+		masterID *uint64,
+	) (*entities.NotificationPreview, error)
 }
@@ -0,0 +1,66 @@
+package reloaders
+
+import (
+	"context"
+
+	"github.com/DKhorkov/libs/logging"
+	"github.com/DKhorkov/libs/tracing"
+
+	"github.com/DKhorkov/hmtm-notifications/internal/config"
+	"github.com/DKhorkov/hmtm-notifications/internal/interfaces"
+	"github.com/DKhorkov/hmtm-notifications/internal/runners"
+)
+
+// TemplatesReloader periodically loads published templates from database into content builders, so template,
+// which was published via another service instance, is rendered by this instance too.
+type TemplatesReloader struct {
+	*runners.PeriodicRunner
+
+	templatesService interfaces.TemplatesService
+	contentTemplates interfaces.ContentTemplates
+	traceProvider    tracing.Provider
+	spanConfig       tracing.SpanConfig
+	logger           logging.Logger
+}
+
+func NewTemplatesReloader(
+	templatesService interfaces.TemplatesService,
+	contentTemplates interfaces.ContentTemplates,
+	config config.ReloaderConfig,
+	traceProvider tracing.Provider,
+	spanConfig tracing.SpanConfig,
+	logger logging.Logger,
+) *TemplatesReloader {
+	reloader := &TemplatesReloader{
+		templatesService: templatesService,
+		contentTemplates: contentTemplates,
+		traceProvider:    traceProvider,
+		spanConfig:       spanConfig,
+		logger:           logger,
+	}
+
+	reloader.PeriodicRunner = runners.NewPeriodicRunner(config.Interval, reloader.reload)
+
+	return reloader
+}
+
+func (r *TemplatesReloader) reload(ctx context.Context) {
+	ctx, span := r.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(r.spanConfig.Events.Start.Name, r.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(r.spanConfig.Events.End.Name, r.spanConfig.Events.End.Opts...)
+
+	templates, err := r.templatesService.GetPublishedTemplates(ctx)
+	if err != nil {
+		// Previously loaded templates are still rendered:
+		logging.LogErrorContext(ctx, r.logger, "Failed to get published templates", err)
+
+		return
+	}
+
+	// Valid templates are set even if some of them are invalid:
+	if err = r.contentTemplates.SetPublished(templates); err != nil {
+		logging.LogErrorContext(ctx, r.logger, "Failed to set some of published templates", err)
+	}
+}
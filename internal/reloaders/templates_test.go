@@ -0,0 +1,167 @@
+package reloaders
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	mocklogging "github.com/DKhorkov/libs/logging/mocks"
+	"github.com/DKhorkov/libs/tracing"
+	mocktracing "github.com/DKhorkov/libs/tracing/mocks"
+
+	"github.com/DKhorkov/hmtm-notifications/internal/config"
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+	"github.com/DKhorkov/hmtm-notifications/internal/runners"
+	mockcontentbuilders "github.com/DKhorkov/hmtm-notifications/mocks/contentbuilders"
+	mockservices "github.com/DKhorkov/hmtm-notifications/mocks/services"
+)
+
+var reloaderConfig = config.ReloaderConfig{Interval: time.Hour}
+
+func TestTemplatesReloader_reload(t *testing.T) {
+	published := []entities.Template{{ID: 1, State: entities.TemplateStatePublished}}
+
+	testCases := []struct {
+		name       string
+		setupMocks func(
+			templatesService *mockservices.MockTemplatesService,
+			contentTemplates *mockcontentbuilders.MockContentTemplates,
+			logger *mocklogging.MockLogger,
+		)
+	}{
+		{
+			name: "published templates reloaded",
+			setupMocks: func(
+				templatesService *mockservices.MockTemplatesService,
+				contentTemplates *mockcontentbuilders.MockContentTemplates,
+				_ *mocklogging.MockLogger,
+			) {
+				templatesService.
+					EXPECT().
+					GetPublishedTemplates(gomock.Any()).
+					Return(published, nil).
+					Times(1)
+
+				contentTemplates.
+					EXPECT().
+					SetPublished(published).
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name: "invalid published template",
+			setupMocks: func(
+				templatesService *mockservices.MockTemplatesService,
+				contentTemplates *mockcontentbuilders.MockContentTemplates,
+				logger *mocklogging.MockLogger,
+			) {
+				templatesService.
+					EXPECT().
+					GetPublishedTemplates(gomock.Any()).
+					Return(published, nil).
+					Times(1)
+
+				contentTemplates.
+					EXPECT().
+					SetPublished(published).
+					Return(errors.New("invalid template")).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+		},
+		{
+			name: "get error",
+			setupMocks: func(
+				templatesService *mockservices.MockTemplatesService,
+				_ *mockcontentbuilders.MockContentTemplates,
+				logger *mocklogging.MockLogger,
+			) {
+				templatesService.
+					EXPECT().
+					GetPublishedTemplates(gomock.Any()).
+					Return(nil, errors.New("db error")).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			templatesService := mockservices.NewMockTemplatesService(ctrl)
+			contentTemplates := mockcontentbuilders.NewMockContentTemplates(ctrl)
+			traceProvider := mocktracing.NewMockProvider(ctrl)
+			logger := mocklogging.NewMockLogger(ctrl)
+			reloader := NewTemplatesReloader(
+				templatesService,
+				contentTemplates,
+				reloaderConfig,
+				traceProvider,
+				tracing.SpanConfig{},
+				logger,
+			)
+
+			traceProvider.
+				EXPECT().
+				Span(gomock.Any(), gomock.Any()).
+				Return(context.Background(), mocktracing.NewMockSpan()).
+				Times(1)
+
+			tc.setupMocks(templatesService, contentTemplates, logger)
+			reloader.reload(context.Background())
+		})
+	}
+}
+
+func TestTemplatesReloader_RunAndStop(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	templatesService := mockservices.NewMockTemplatesService(ctrl)
+	contentTemplates := mockcontentbuilders.NewMockContentTemplates(ctrl)
+	traceProvider := mocktracing.NewMockProvider(ctrl)
+	reloader := NewTemplatesReloader(
+		templatesService,
+		contentTemplates,
+		reloaderConfig,
+		traceProvider,
+		tracing.SpanConfig{},
+		mocklogging.NewMockLogger(ctrl),
+	)
+
+	traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		MaxTimes(1)
+
+	templatesService.
+		EXPECT().
+		GetPublishedTemplates(gomock.Any()).
+		Return(nil, nil).
+		MaxTimes(1)
+
+	contentTemplates.
+		EXPECT().
+		SetPublished(gomock.Any()).
+		Return(nil).
+		MaxTimes(1)
+
+	require.ErrorIs(t, reloader.Stop(), runners.ErrRunnerAlreadyStopped)
+	require.NoError(t, reloader.Run())
+	require.ErrorIs(t, reloader.Run(), runners.ErrRunnerAlreadyRunning)
+	require.NoError(t, reloader.Stop())
+	require.ErrorIs(t, reloader.Stop(), runners.ErrRunnerAlreadyStopped)
+}
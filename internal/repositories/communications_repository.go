@@ -0,0 +1,675 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/DKhorkov/libs/db"
+	"github.com/DKhorkov/libs/logging"
+	"github.com/DKhorkov/libs/tracing"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-notifications/internal/errors"
+)
+
+const (
+	selectAllColumns                         = "*"
+	selectCount                              = "COUNT(*)"
+	communicationsTableName                  = "communications"
+	idColumnName                             = "id"
+	userIDColumnName                         = "user_id"
+	communicationRecipientColumnName         = "recipient"
+	communicationBodyColumnName              = "body"
+	communicationCreatedAtColumnName         = "created_at"
+	communicationSubjectColumnName           = "subject"
+	communicationStatusColumnName            = "status"
+	communicationAttemptsColumnName          = "attempts"
+	communicationLastErrorColumnName         = "last_error"
+	communicationNextAttemptAtColumnName     = "next_attempt_at"
+	communicationSentAtColumnName            = "sent_at"
+	communicationChannelColumnName           = "channel"
+	communicationFallbackForColumnName       = "fallback_for"
+	communicationTypeColumnName              = "type"
+	communicationProviderMessageIDColumnName = "provider_message_id"
+	communicationTextBodyColumnName          = "text_body"
+	communicationSentPartsColumnName         = "sent_parts"
+	returningIDSuffix                        = "RETURNING id"
+	DESC                                     = "DESC"
+	ASC                                      = "ASC"
+
+	// communicationFallbacksConditionTemplate selects fallback communications of the same routing chain as provided
+	// communication, which could be both primary and fallback one:
+	communicationFallbacksConditionTemplate = "%s = COALESCE((SELECT %s FROM %s WHERE %s = ?), ?)"
+	// communicationNextFallbackConditionTemplate selects the earliest fallback communication, waiting for activation:
+	communicationNextFallbackConditionTemplate = "%s = (SELECT MIN(%s) FROM %s WHERE %s = ? AND ?)"
+)
+
+type CommunicationsRepository struct {
+	dbConnector   db.Connector
+	logger        logging.Logger
+	traceProvider tracing.Provider
+	spanConfig    tracing.SpanConfig
+	mutex         *sync.RWMutex
+}
+
+func NewCommunicationsRepository(
+	dbConnector db.Connector,
+	logger logging.Logger,
+	traceProvider tracing.Provider,
+	spanConfig tracing.SpanConfig,
+) *CommunicationsRepository {
+	return &CommunicationsRepository{
+		dbConnector:   dbConnector,
+		logger:        logger,
+		traceProvider: traceProvider,
+		spanConfig:    spanConfig,
+		mutex:         new(sync.RWMutex),
+	}
+}
+
+// GetUserCommunications returns only sent communications of user through provided channel, since pending
+// and failed ones were not delivered to user.
+func (repo *CommunicationsRepository) GetUserCommunications(
+	ctx context.Context,
+	userID uint64,
+	channel entities.CommunicationChannel,
+	pagination *entities.Pagination,
+) ([]entities.Communication, error) {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	connection, err := repo.dbConnector.Connection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer db.CloseConnectionContext(ctx, connection, repo.logger)
+
+	builder := sq.
+		Select(selectAllColumns).
+		From(communicationsTableName).
+		Where(
+			sq.Eq{
+				userIDColumnName:               userID,
+				communicationStatusColumnName:  entities.CommunicationStatusSent,
+				communicationChannelColumnName: channel,
+			},
+		).
+		OrderBy(fmt.Sprintf("%s %s", idColumnName, DESC)).
+		PlaceholderFormat(sq.Dollar)
+
+	if pagination != nil && pagination.Limit != nil {
+		builder = builder.Limit(*pagination.Limit)
+	}
+
+	if pagination != nil && pagination.Offset != nil {
+		builder = builder.Offset(*pagination.Offset)
+	}
+
+	stmt, params, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	// Using mutex for concurrent-safety purpose of using via workers:
+	repo.mutex.RLock()
+	defer repo.mutex.RUnlock()
+
+	rows, err := connection.QueryContext(
+		ctx,
+		stmt,
+		params...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err = rows.Close(); err != nil {
+			logging.LogErrorContext(
+				ctx,
+				repo.logger,
+				"error during closing SQL rows",
+				err,
+			)
+		}
+	}()
+
+	var communications []entities.Communication
+
+	for rows.Next() {
+		communication := entities.Communication{}
+		columns := db.GetEntityColumns(&communication) // Only pointer to use rows.Scan() successfully
+
+		err = rows.Scan(columns...)
+		if err != nil {
+			return nil, err
+		}
+
+		communications = append(communications, communication)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return communications, nil
+}
+
+// CountUserCommunications counts only sent communications of user through provided channel.
+func (repo *CommunicationsRepository) CountUserCommunications(
+	ctx context.Context,
+	userID uint64,
+	channel entities.CommunicationChannel,
+) (uint64, error) {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	connection, err := repo.dbConnector.Connection(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	defer db.CloseConnectionContext(ctx, connection, repo.logger)
+
+	builder := sq.
+		Select(selectCount).
+		From(communicationsTableName).
+		Where(
+			sq.Eq{
+				userIDColumnName:               userID,
+				communicationStatusColumnName:  entities.CommunicationStatusSent,
+				communicationChannelColumnName: channel,
+			},
+		).
+		PlaceholderFormat(sq.Dollar)
+
+	stmt, params, err := builder.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	// Using mutex for concurrent-safety purpose of using via workers:
+	repo.mutex.RLock()
+	defer repo.mutex.RUnlock()
+
+	var count uint64
+	if err = connection.QueryRowContext(ctx, stmt, params...).Scan(&count); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (repo *CommunicationsRepository) SaveCommunication(
+	ctx context.Context,
+	communication entities.Communication,
+) (uint64, error) {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	connection, err := repo.dbConnector.Connection(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	defer db.CloseConnectionContext(ctx, connection, repo.logger)
+
+	stmt, params, err := sq.
+		Insert(communicationsTableName).
+		Columns(
+			userIDColumnName,
+			communicationRecipientColumnName,
+			communicationBodyColumnName,
+			communicationCreatedAtColumnName,
+			communicationSubjectColumnName,
+			communicationStatusColumnName,
+			communicationAttemptsColumnName,
+			communicationLastErrorColumnName,
+			communicationNextAttemptAtColumnName,
+			communicationSentAtColumnName,
+			communicationChannelColumnName,
+			communicationFallbackForColumnName,
+			communicationTypeColumnName,
+			communicationProviderMessageIDColumnName,
+			communicationTextBodyColumnName,
+		).
+		Values(
+			communication.UserID,
+			communication.Recipient,
+			communication.Body,
+			communication.CreatedAt,
+			communication.Subject,
+			communication.Status,
+			communication.Attempts,
+			communication.LastError,
+			communication.NextAttemptAt,
+			communication.SentAt,
+			communication.Channel,
+			communication.FallbackFor,
+			communication.Type,
+			communication.ProviderMessageID,
+			communication.TextBody,
+		).
+		Suffix(returningIDSuffix).
+		PlaceholderFormat(sq.Dollar). // pq postgres driver works only with $ placeholders
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	// Using mutex for concurrent-safety purpose of using via workers:
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	var communicationID uint64
+	if err = connection.QueryRowContext(ctx, stmt, params...).Scan(&communicationID); err != nil {
+		return 0, err
+	}
+
+	return communicationID, nil
+}
+
+func (repo *CommunicationsRepository) GetPendingCommunications(
+	ctx context.Context,
+	limit uint64,
+) ([]entities.Communication, error) {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	connection, err := repo.dbConnector.Connection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer db.CloseConnectionContext(ctx, connection, repo.logger)
+
+	// Processing communications are also selected to be able to retake them after expiration of
+	// their lease, for example, if dispatcher crashed during sending:
+	stmt, params, err := sq.
+		Select(selectAllColumns).
+		From(communicationsTableName).
+		Where(
+			sq.Eq{
+				communicationStatusColumnName: []entities.CommunicationStatus{
+					entities.CommunicationStatusPending,
+					entities.CommunicationStatusProcessing,
+				},
+			},
+		).
+		Where(sq.LtOrEq{communicationNextAttemptAtColumnName: time.Now().UTC()}).
+		OrderBy(
+			fmt.Sprintf("%s %s", communicationNextAttemptAtColumnName, ASC),
+			fmt.Sprintf("%s %s", idColumnName, ASC),
+		).
+		Limit(limit).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	// Using mutex for concurrent-safety purpose of using via workers:
+	repo.mutex.RLock()
+	defer repo.mutex.RUnlock()
+
+	rows, err := connection.QueryContext(
+		ctx,
+		stmt,
+		params...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err = rows.Close(); err != nil {
+			logging.LogErrorContext(
+				ctx,
+				repo.logger,
+				"error during closing SQL rows",
+				err,
+			)
+		}
+	}()
+
+	var communications []entities.Communication
+
+	for rows.Next() {
+		communication := entities.Communication{}
+		columns := db.GetEntityColumns(&communication) // Only pointer to use rows.Scan() successfully
+
+		err = rows.Scan(columns...)
+		if err != nil {
+			return nil, err
+		}
+
+		communications = append(communications, communication)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return communications, nil
+}
+
+// ClaimCommunication takes communication for sending, if it was not taken by someone else after it was read.
+// Claimed communication will not be returned by GetPendingCommunications until leaseUntil.
+func (repo *CommunicationsRepository) ClaimCommunication(
+	ctx context.Context,
+	communication entities.Communication,
+	leaseUntil time.Time,
+) (bool, error) {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	connection, err := repo.dbConnector.Connection(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	defer db.CloseConnectionContext(ctx, connection, repo.logger)
+
+	// Status and attempts are used as optimistic lock, so only one dispatcher could claim communication:
+	stmt, params, err := sq.
+		Update(communicationsTableName).
+		Set(communicationStatusColumnName, entities.CommunicationStatusProcessing).
+		Set(communicationAttemptsColumnName, communication.Attempts+1).
+		Set(communicationNextAttemptAtColumnName, leaseUntil).
+		Where(
+			sq.Eq{
+				idColumnName:                    communication.ID,
+				communicationStatusColumnName:   communication.Status,
+				communicationAttemptsColumnName: communication.Attempts,
+			},
+		).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return false, err
+	}
+
+	// Using mutex for concurrent-safety purpose of using via workers:
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	result, err := connection.ExecContext(ctx, stmt, params...)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected == 1, nil
+}
+
+// MarkCommunicationSent marks claimed communication as sent. Attempts is a number of attempts, set on claiming,
+// and providerMessageID is an ID of sent message, returned by channel provider, if any. Fallback communications
+// of the same routing chain are cancelled, since they are not needed anymore.
+// Returns CommunicationLeaseLostError, if communication was retaken by another dispatcher after lease expiration.
+func (repo *CommunicationsRepository) MarkCommunicationSent(
+	ctx context.Context,
+	id uint64,
+	attempts uint32,
+	providerMessageID string,
+) error {
+	var messageID *string
+	if providerMessageID != "" {
+		messageID = &providerMessageID
+	}
+
+	cancelFallbacks := sq.
+		Update(communicationsTableName).
+		Set(communicationStatusColumnName, entities.CommunicationStatusCancelled).
+		Where(sq.Eq{communicationStatusColumnName: entities.CommunicationStatusStandby}).
+		Where(fallbacksCondition(id))
+
+	return repo.updateClaimedCommunication(
+		ctx,
+		id,
+		attempts,
+		map[string]any{
+			communicationStatusColumnName:            entities.CommunicationStatusSent,
+			communicationLastErrorColumnName:         nil,
+			communicationNextAttemptAtColumnName:     nil,
+			communicationSentAtColumnName:            time.Now().UTC(),
+			communicationProviderMessageIDColumnName: messageID,
+		},
+		&cancelFallbacks,
+	)
+}
+
+func (repo *CommunicationsRepository) RescheduleCommunication(
+	ctx context.Context,
+	id uint64,
+	attempts uint32,
+	lastError string,
+	nextAttemptAt time.Time,
+) error {
+	return repo.updateClaimedCommunication(
+		ctx,
+		id,
+		attempts,
+		map[string]any{
+			communicationStatusColumnName:        entities.CommunicationStatusPending,
+			communicationLastErrorColumnName:     lastError,
+			communicationNextAttemptAtColumnName: nextAttemptAt,
+		},
+		nil,
+	)
+}
+
+// DeferCommunication returns communication to pending status without consuming sending attempt.
+// Used, when communication was not sent due to reasons, not related to communication itself.
+func (repo *CommunicationsRepository) DeferCommunication(
+	ctx context.Context,
+	id uint64,
+	attempts uint32,
+	nextAttemptAt time.Time,
+) error {
+	return repo.updateClaimedCommunication(
+		ctx,
+		id,
+		attempts,
+		map[string]any{
+			communicationStatusColumnName:        entities.CommunicationStatusPending,
+			communicationAttemptsColumnName:      attempts - 1,
+			communicationNextAttemptAtColumnName: nextAttemptAt,
+		},
+		nil,
+	)
+}
+
+// SaveCommunicationSentParts saves number of sent messages of claimed communication, which failed after part of
+// messages was sent, without changing its status, so that retry does not send them again. Provider message ID
+// is an ID of the first sent message of communication.
+func (repo *CommunicationsRepository) SaveCommunicationSentParts(
+	ctx context.Context,
+	id uint64,
+	attempts uint32,
+	sentParts uint32,
+	providerMessageID string,
+) error {
+	var messageID *string
+	if providerMessageID != "" {
+		messageID = &providerMessageID
+	}
+
+	return repo.updateClaimedCommunication(
+		ctx,
+		id,
+		attempts,
+		map[string]any{
+			communicationSentPartsColumnName:         sentParts,
+			communicationProviderMessageIDColumnName: messageID,
+		},
+		nil,
+	)
+}
+
+// MarkCommunicationFailed marks claimed communication as failed and activates the next fallback communication
+// of the same routing chain, if there is one.
+func (repo *CommunicationsRepository) MarkCommunicationFailed(
+	ctx context.Context,
+	id uint64,
+	attempts uint32,
+	lastError string,
+) error {
+	activateNextFallback := sq.
+		Update(communicationsTableName).
+		Set(communicationStatusColumnName, entities.CommunicationStatusPending).
+		Set(communicationNextAttemptAtColumnName, time.Now().UTC()).
+		Where(
+			sq.Expr(
+				fmt.Sprintf(
+					communicationNextFallbackConditionTemplate,
+					idColumnName,
+					idColumnName,
+					communicationsTableName,
+					communicationStatusColumnName,
+				),
+				entities.CommunicationStatusStandby,
+				fallbacksCondition(id),
+			),
+		)
+
+	return repo.updateClaimedCommunication(
+		ctx,
+		id,
+		attempts,
+		map[string]any{
+			communicationStatusColumnName:        entities.CommunicationStatusFailed,
+			communicationLastErrorColumnName:     lastError,
+			communicationNextAttemptAtColumnName: nil,
+		},
+		&activateNextFallback,
+	)
+}
+
+// updateClaimedCommunication updates communication only if it is still claimed with provided attempts.
+// Status and attempts are used as optimistic lock the same way as in ClaimCommunication, so dispatcher, which
+// lease has expired, could not overwrite result of dispatcher, which has retaken communication. Fallbacks update,
+// if provided, is performed in the same transaction only if communication was updated.
+func (repo *CommunicationsRepository) updateClaimedCommunication(
+	ctx context.Context,
+	id uint64,
+	attempts uint32,
+	values map[string]any,
+	fallbacksUpdate *sq.UpdateBuilder,
+) error {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel+1))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	connection, err := repo.dbConnector.Connection(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer db.CloseConnectionContext(ctx, connection, repo.logger)
+
+	stmt, params, err := sq.
+		Update(communicationsTableName).
+		SetMap(values).
+		Where(
+			sq.Eq{
+				idColumnName:                    id,
+				communicationStatusColumnName:   entities.CommunicationStatusProcessing,
+				communicationAttemptsColumnName: attempts,
+			},
+		).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	var (
+		fallbacksStmt   string
+		fallbacksParams []any
+	)
+
+	if fallbacksUpdate != nil {
+		fallbacksStmt, fallbacksParams, err = fallbacksUpdate.PlaceholderFormat(sq.Dollar).ToSql()
+		if err != nil {
+			return err
+		}
+	}
+
+	// Using mutex for concurrent-safety purpose of using via workers:
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	transaction, err := connection.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	// Rollback after commit does nothing:
+	defer func() {
+		_ = transaction.Rollback()
+	}()
+
+	result, err := transaction.ExecContext(ctx, stmt, params...)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return &customerrors.CommunicationLeaseLostError{}
+	}
+
+	if fallbacksUpdate != nil {
+		if _, err = transaction.ExecContext(ctx, fallbacksStmt, fallbacksParams...); err != nil {
+			return err
+		}
+	}
+
+	return transaction.Commit()
+}
+
+// fallbacksCondition selects communications of routing chain, which provided communication belongs to.
+// Primary communication of chain has no FallbackFor, so its own ID is used.
+func fallbacksCondition(id uint64) sq.Sqlizer {
+	return sq.Expr(
+		fmt.Sprintf(
+			communicationFallbacksConditionTemplate,
+			communicationFallbackForColumnName,
+			communicationFallbackForColumnName,
+			communicationsTableName,
+			idColumnName,
+		),
+		id,
+		id,
+	)
+}
@@ -0,0 +1,755 @@
+//go:build integration
+
+package repositories_test
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3" // Must be imported for correct work
+
+	"github.com/pressly/goose/v3"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/DKhorkov/libs/db"
+	mocklogging "github.com/DKhorkov/libs/logging/mocks"
+	"github.com/DKhorkov/libs/pointers"
+	"github.com/DKhorkov/libs/tracing"
+	mocktracing "github.com/DKhorkov/libs/tracing/mocks"
+
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-notifications/internal/errors"
+	"github.com/DKhorkov/hmtm-notifications/internal/repositories"
+)
+
+const (
+	driver = "sqlite3"
+	//dsn    = "file::memory:?cache=shared"
+	dsn              = "../../test.db"
+	migrationsDir    = "/migrations"
+	gooseZeroVersion = 0
+)
+
+func TestCommunicationsRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(CommunicationsRepositoryTestSuite))
+}
+
+type CommunicationsRepositoryTestSuite struct {
+	suite.Suite
+
+	cwd                      string
+	ctx                      context.Context
+	dbConnector              db.Connector
+	connection               *sql.Conn
+	communicationsRepository *repositories.CommunicationsRepository
+	logger                   *mocklogging.MockLogger
+	traceProvider            *mocktracing.MockProvider
+	spanConfig               tracing.SpanConfig
+}
+
+func (s *CommunicationsRepositoryTestSuite) SetupSuite() {
+	s.NoError(goose.SetDialect(driver))
+
+	ctrl := gomock.NewController(s.T())
+	s.ctx = context.Background()
+	s.logger = mocklogging.NewMockLogger(ctrl)
+	dbConnector, err := db.New(dsn, driver, s.logger)
+	s.NoError(err)
+
+	cwd, err := os.Getwd()
+	s.NoError(err)
+
+	s.cwd = cwd
+	s.dbConnector = dbConnector
+	s.traceProvider = mocktracing.NewMockProvider(ctrl)
+	s.spanConfig = tracing.SpanConfig{}
+	s.communicationsRepository = repositories.NewCommunicationsRepository(
+		s.dbConnector,
+		s.logger,
+		s.traceProvider,
+		s.spanConfig,
+	)
+}
+
+func (s *CommunicationsRepositoryTestSuite) SetupTest() {
+	s.NoError(
+		goose.Up(
+			s.dbConnector.Pool(),
+			path.Dir(
+				path.Dir(s.cwd),
+			)+migrationsDir,
+		),
+	)
+
+	connection, err := s.dbConnector.Connection(s.ctx)
+	s.NoError(err)
+
+	s.connection = connection
+}
+
+func (s *CommunicationsRepositoryTestSuite) TearDownTest() {
+	s.NoError(
+		goose.DownTo(
+			s.dbConnector.Pool(),
+			path.Dir(
+				path.Dir(s.cwd),
+			)+migrationsDir,
+			gooseZeroVersion,
+		),
+	)
+
+	s.NoError(s.connection.Close())
+}
+
+func (s *CommunicationsRepositoryTestSuite) TearDownSuite() {
+	s.NoError(s.dbConnector.Close())
+}
+
+func (s *CommunicationsRepositoryTestSuite) TestGetUserCommunicationsWithExistingCommunications() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	id := 1
+	userID := uint64(1)
+	sentAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		`
+			INSERT INTO communications (id, user_id, recipient, body, sent_at) 
+			VALUES ($1, $2, $3, $4, $5)
+		`,
+		id,
+		userID,
+		"test@example.com",
+		"Test email content",
+		sentAt,
+	)
+	s.NoError(err)
+
+	communications, err := s.communicationsRepository.GetUserCommunications(
+		s.ctx,
+		userID,
+		entities.CommunicationChannelEmail,
+		nil,
+	)
+	s.NoError(err)
+	s.NotEmpty(communications)
+	s.Equal(1, len(communications))
+	s.Equal(userID, communications[0].UserID)
+	s.Equal("test@example.com", communications[0].Recipient)
+	s.Equal("Test email content", communications[0].Body)
+	s.NotNil(communications[0].SentAt)
+	s.WithinDuration(sentAt, *communications[0].SentAt, time.Second)
+}
+
+func (s *CommunicationsRepositoryTestSuite) TestCountUserCommunications() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	id := 1
+	userID := uint64(1)
+	sentAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		`
+			INSERT INTO communications (id, user_id, recipient, body, sent_at) 
+			VALUES ($1, $2, $3, $4, $5)
+		`,
+		id,
+		userID,
+		"test@example.com",
+		"Test email content",
+		sentAt,
+	)
+	s.NoError(err)
+
+	// Not sent communications are not counted:
+	_, err = s.connection.ExecContext(
+		s.ctx,
+		`
+			INSERT INTO communications (id, user_id, recipient, body, status) 
+			VALUES ($1, $2, $3, $4, $5)
+		`,
+		2,
+		userID,
+		"test@example.com",
+		"Pending email content",
+		entities.CommunicationStatusPending,
+	)
+	s.NoError(err)
+
+	// Communications of other channels are not counted as communications:
+	_, err = s.connection.ExecContext(
+		s.ctx,
+		`
+			INSERT INTO communications (id, user_id, recipient, body, channel) 
+			VALUES ($1, $2, $3, $4, $5)
+		`,
+		3,
+		userID,
+		"100500",
+		"Telegram content",
+		entities.CommunicationChannelTelegram,
+	)
+	s.NoError(err)
+
+	count, err := s.communicationsRepository.CountUserCommunications(
+		s.ctx,
+		userID,
+		entities.CommunicationChannelEmail,
+	)
+	s.NoError(err)
+	s.NotZero(count)
+	s.Equal(uint64(1), count)
+}
+
+func (s *CommunicationsRepositoryTestSuite) TestGetUserCommunicationsWithExistingCommunicationsAndPagination() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	userID := uint64(1)
+	sentAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		`
+			INSERT INTO communications (id, user_id, recipient, body, sent_at) 
+			VALUES ($1, $2, $3, $4, $5), ($6, $7, $8, $9, $10), ($11, $12, $13, $14, $15)
+		`,
+		1, userID, "test@example.com", "Test email content 1", sentAt,
+		2, userID, "test@example.com", "Test email content 2", sentAt,
+		3, userID, "test@example.com", "Test email content 3", sentAt,
+	)
+	s.NoError(err)
+
+	pagination := &entities.Pagination{
+		Limit:  pointers.New[uint64](1),
+		Offset: pointers.New[uint64](1),
+	}
+
+	communications, err := s.communicationsRepository.GetUserCommunications(
+		s.ctx,
+		userID,
+		entities.CommunicationChannelEmail,
+		pagination,
+	)
+	s.NoError(err)
+	s.NotEmpty(communications)
+	s.Equal(1, len(communications))
+	s.Equal(userID, communications[0].UserID)
+	s.Equal("test@example.com", communications[0].Recipient)
+	s.Equal("Test email content 2", communications[0].Body)
+	s.NotNil(communications[0].SentAt)
+	s.WithinDuration(sentAt, *communications[0].SentAt, time.Second)
+}
+
+func (s *CommunicationsRepositoryTestSuite) TestGetUserCommunicationsWithoutExistingCommunications() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	userID := uint64(2)
+	communications, err := s.communicationsRepository.GetUserCommunications(
+		s.ctx,
+		userID,
+		entities.CommunicationChannelEmail,
+		nil,
+	)
+	s.NoError(err)
+	s.Empty(communications)
+}
+
+func (s *CommunicationsRepositoryTestSuite) TestSaveCommunicationSuccess() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	communication := entities.Communication{
+		UserID:    3,
+		Recipient: "new@example.com",
+		Body:      "New email content",
+		Status:    entities.CommunicationStatusPending,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	// Error and zero id due to returning nil ID after insert operation
+	// SQLite inner realization without AUTO_INCREMENT for SERIAL PRIMARY KEY
+	id, err := s.communicationsRepository.SaveCommunication(s.ctx, communication)
+	s.Error(err)
+	s.Zero(id)
+}
+
+func (s *CommunicationsRepositoryTestSuite) TestSaveCommunicationError() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	communication := entities.Communication{
+		UserID:    4,
+		Recipient: "error@example.com",
+		Body:      "Error case",
+		Status:    entities.CommunicationStatusPending,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	id, err := s.communicationsRepository.SaveCommunication(s.ctx, communication)
+	s.Error(err)
+	s.Zero(id)
+}
+
+func (s *CommunicationsRepositoryTestSuite) TestGetPendingCommunications() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	now := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		`
+			INSERT INTO communications (id, user_id, recipient, body, status, next_attempt_at, sent_at) 
+			VALUES ($1, $2, $3, $4, $5, $6, $7), ($8, $9, $10, $11, $12, $13, $14), 
+			       ($15, $16, $17, $18, $19, $20, $21), ($22, $23, $24, $25, $26, $27, $28)
+		`,
+		1, 1, "test@example.com", "Pending", entities.CommunicationStatusPending, now.Add(-time.Minute), nil,
+		2, 1, "test@example.com", "Delayed", entities.CommunicationStatusPending, now.Add(time.Hour), nil,
+		3, 1, "test@example.com", "Expired lease", entities.CommunicationStatusProcessing, now.Add(-time.Second), nil,
+		4, 1, "test@example.com", "Sent", entities.CommunicationStatusSent, nil, now,
+	)
+	s.NoError(err)
+
+	communications, err := s.communicationsRepository.GetPendingCommunications(s.ctx, 10)
+	s.NoError(err)
+	s.Equal(2, len(communications))
+	s.Equal(uint64(1), communications[0].ID)
+	s.Equal(entities.CommunicationStatusPending, communications[0].Status)
+	s.Equal(uint64(3), communications[1].ID)
+	s.Equal(entities.CommunicationStatusProcessing, communications[1].Status)
+}
+
+func (s *CommunicationsRepositoryTestSuite) TestClaimCommunication() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(2)
+
+	now := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		`
+			INSERT INTO communications (id, user_id, recipient, body, status, next_attempt_at) 
+			VALUES ($1, $2, $3, $4, $5, $6)
+		`,
+		1, 1, "test@example.com", "Pending", entities.CommunicationStatusPending, now,
+	)
+	s.NoError(err)
+
+	communication := entities.Communication{ID: 1, Status: entities.CommunicationStatusPending, Attempts: 0}
+	leaseUntil := now.Add(time.Minute)
+
+	claimed, err := s.communicationsRepository.ClaimCommunication(s.ctx, communication, leaseUntil)
+	s.NoError(err)
+	s.True(claimed)
+
+	// Second claim with the same state must fail, because communication was already taken:
+	claimed, err = s.communicationsRepository.ClaimCommunication(s.ctx, communication, leaseUntil)
+	s.NoError(err)
+	s.False(claimed)
+
+	var (
+		status   string
+		attempts uint32
+	)
+
+	err = s.connection.QueryRowContext(
+		s.ctx,
+		"SELECT status, attempts FROM communications WHERE id = $1",
+		1,
+	).Scan(&status, &attempts)
+	s.NoError(err)
+	s.Equal(string(entities.CommunicationStatusProcessing), status)
+	s.Equal(uint32(1), attempts)
+}
+
+func (s *CommunicationsRepositoryTestSuite) TestMarkCommunicationSent() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		`
+			INSERT INTO communications (id, user_id, recipient, body, status, attempts, last_error, next_attempt_at) 
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		`,
+		1, 1, "test@example.com", "Processing", entities.CommunicationStatusProcessing, 1, "previous error",
+		time.Now().UTC(),
+	)
+	s.NoError(err)
+
+	s.NoError(s.communicationsRepository.MarkCommunicationSent(s.ctx, 1, 1, "<message-id@example.com>"))
+
+	var (
+		status            string
+		lastError         *string
+		sentAt            *time.Time
+		providerMessageID *string
+	)
+
+	err = s.connection.QueryRowContext(
+		s.ctx,
+		"SELECT status, last_error, sent_at, provider_message_id FROM communications WHERE id = $1",
+		1,
+	).Scan(&status, &lastError, &sentAt, &providerMessageID)
+	s.NoError(err)
+	s.Equal(string(entities.CommunicationStatusSent), status)
+	s.Nil(lastError)
+	s.NotNil(sentAt)
+	s.Equal(pointers.New("<message-id@example.com>"), providerMessageID)
+}
+
+func (s *CommunicationsRepositoryTestSuite) TestRescheduleCommunication() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		`
+			INSERT INTO communications (id, user_id, recipient, body, status, attempts, next_attempt_at) 
+			VALUES ($1, $2, $3, $4, $5, $6, $7)
+		`,
+		1, 1, "test@example.com", "Processing", entities.CommunicationStatusProcessing, 1, time.Now().UTC(),
+	)
+	s.NoError(err)
+
+	nextAttemptAt := time.Now().UTC().Add(time.Hour)
+	s.NoError(s.communicationsRepository.RescheduleCommunication(s.ctx, 1, 1, "smtp error", nextAttemptAt))
+
+	var (
+		status         string
+		lastError      string
+		storedAttempAt time.Time
+	)
+
+	err = s.connection.QueryRowContext(
+		s.ctx,
+		"SELECT status, last_error, next_attempt_at FROM communications WHERE id = $1",
+		1,
+	).Scan(&status, &lastError, &storedAttempAt)
+	s.NoError(err)
+	s.Equal(string(entities.CommunicationStatusPending), status)
+	s.Equal("smtp error", lastError)
+	s.WithinDuration(nextAttemptAt, storedAttempAt, time.Second)
+}
+
+func (s *CommunicationsRepositoryTestSuite) TestDeferCommunication() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		`
+			INSERT INTO communications (id, user_id, recipient, body, status, attempts, next_attempt_at) 
+			VALUES ($1, $2, $3, $4, $5, $6, $7)
+		`,
+		1, 1, "test@example.com", "Processing", entities.CommunicationStatusProcessing, 2, time.Now().UTC(),
+	)
+	s.NoError(err)
+
+	nextAttemptAt := time.Now().UTC().Add(time.Minute)
+	s.NoError(s.communicationsRepository.DeferCommunication(s.ctx, 1, 2, nextAttemptAt))
+
+	var (
+		status         string
+		attempts       uint32
+		storedAttempAt time.Time
+	)
+
+	err = s.connection.QueryRowContext(
+		s.ctx,
+		"SELECT status, attempts, next_attempt_at FROM communications WHERE id = $1",
+		1,
+	).Scan(&status, &attempts, &storedAttempAt)
+	s.NoError(err)
+	s.Equal(string(entities.CommunicationStatusPending), status)
+	s.Equal(uint32(1), attempts)
+	s.WithinDuration(nextAttemptAt, storedAttempAt, time.Second)
+}
+
+func (s *CommunicationsRepositoryTestSuite) TestSaveCommunicationSentParts() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		`
+			INSERT INTO communications (id, user_id, recipient, body, status, attempts, next_attempt_at) 
+			VALUES ($1, $2, $3, $4, $5, $6, $7)
+		`,
+		1, 1, "100500", "Processing", entities.CommunicationStatusProcessing, 1, time.Now().UTC(),
+	)
+	s.NoError(err)
+
+	s.NoError(s.communicationsRepository.SaveCommunicationSentParts(s.ctx, 1, 1, 2, "41"))
+
+	var (
+		status            string
+		sentParts         uint32
+		providerMessageID *string
+	)
+
+	// Status is not changed, so communication is updated by dispatcher, which claimed it, after failure:
+	err = s.connection.QueryRowContext(
+		s.ctx,
+		"SELECT status, sent_parts, provider_message_id FROM communications WHERE id = $1",
+		1,
+	).Scan(&status, &sentParts, &providerMessageID)
+	s.NoError(err)
+	s.Equal(string(entities.CommunicationStatusProcessing), status)
+	s.Equal(uint32(2), sentParts)
+	s.Equal(pointers.New("41"), providerMessageID)
+}
+
+func (s *CommunicationsRepositoryTestSuite) TestMarkCommunicationFailed() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		`
+			INSERT INTO communications (id, user_id, recipient, body, status, attempts, next_attempt_at) 
+			VALUES ($1, $2, $3, $4, $5, $6, $7)
+		`,
+		1, 1, "test@example.com", "Processing", entities.CommunicationStatusProcessing, 1, time.Now().UTC(),
+	)
+	s.NoError(err)
+
+	s.NoError(s.communicationsRepository.MarkCommunicationFailed(s.ctx, 1, 1, "smtp error"))
+
+	var (
+		status        string
+		lastError     string
+		nextAttemptAt *time.Time
+	)
+
+	err = s.connection.QueryRowContext(
+		s.ctx,
+		"SELECT status, last_error, next_attempt_at FROM communications WHERE id = $1",
+		1,
+	).Scan(&status, &lastError, &nextAttemptAt)
+	s.NoError(err)
+	s.Equal(string(entities.CommunicationStatusFailed), status)
+	s.Equal("smtp error", lastError)
+	s.Nil(nextAttemptAt)
+}
+
+func (s *CommunicationsRepositoryTestSuite) TestUpdateCommunicationWithLostLease() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(2)
+
+	// Communication was retaken by another dispatcher, so it has more attempts than first dispatcher claimed:
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		`
+			INSERT INTO communications (id, user_id, recipient, body, status, attempts, next_attempt_at) 
+			VALUES ($1, $2, $3, $4, $5, $6, $7)
+		`,
+		1, 1, "test@example.com", "Processing", entities.CommunicationStatusProcessing, 2, time.Now().UTC(),
+	)
+	s.NoError(err)
+
+	err = s.communicationsRepository.MarkCommunicationSent(s.ctx, 1, 1, "")
+	s.ErrorAs(err, new(*customerrors.CommunicationLeaseLostError))
+
+	s.NoError(s.communicationsRepository.MarkCommunicationSent(s.ctx, 1, 2, ""))
+}
+
+func (s *CommunicationsRepositoryTestSuite) TestMarkCommunicationFailedActivatesNextFallback() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(2)
+
+	// Routing chain of primary Telegram communication with email and SMS fallbacks:
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		`
+			INSERT INTO communications 
+			    (id, user_id, recipient, body, status, attempts, next_attempt_at, channel, fallback_for) 
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9), ($10, $11, $12, $13, $14, $15, $16, $17, $18), 
+			       ($19, $20, $21, $22, $23, $24, $25, $26, $27)
+		`,
+		1, 1, "100500", "Telegram", entities.CommunicationStatusProcessing, 1, time.Now().UTC(),
+		entities.CommunicationChannelTelegram, nil,
+		2, 1, "test@example.com", "Email", entities.CommunicationStatusStandby, 0, nil,
+		entities.CommunicationChannelEmail, 1,
+		3, 1, "+79990000000", "SMS", entities.CommunicationStatusStandby, 0, nil,
+		entities.CommunicationChannelSMS, 1,
+	)
+	s.NoError(err)
+
+	s.NoError(s.communicationsRepository.MarkCommunicationFailed(s.ctx, 1, 1, "telegram error"))
+	s.Equal(
+		[]entities.CommunicationStatus{
+			entities.CommunicationStatusFailed,
+			entities.CommunicationStatusPending,
+			entities.CommunicationStatusStandby,
+		},
+		s.communicationStatuses(),
+	)
+
+	// Failure of fallback activates the next one of the same chain:
+	_, err = s.connection.ExecContext(
+		s.ctx,
+		"UPDATE communications SET status = $1, attempts = $2 WHERE id = $3",
+		entities.CommunicationStatusProcessing,
+		1,
+		2,
+	)
+	s.NoError(err)
+
+	s.NoError(s.communicationsRepository.MarkCommunicationFailed(s.ctx, 2, 1, "smtp error"))
+	s.Equal(
+		[]entities.CommunicationStatus{
+			entities.CommunicationStatusFailed,
+			entities.CommunicationStatusFailed,
+			entities.CommunicationStatusPending,
+		},
+		s.communicationStatuses(),
+	)
+}
+
+func (s *CommunicationsRepositoryTestSuite) TestMarkCommunicationSentCancelsFallbacks() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		`
+			INSERT INTO communications 
+			    (id, user_id, recipient, body, status, attempts, next_attempt_at, channel, fallback_for) 
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9), ($10, $11, $12, $13, $14, $15, $16, $17, $18), 
+			       ($19, $20, $21, $22, $23, $24, $25, $26, $27)
+		`,
+		1, 1, "100500", "Telegram", entities.CommunicationStatusProcessing, 1, time.Now().UTC(),
+		entities.CommunicationChannelTelegram, nil,
+		2, 1, "test@example.com", "Email", entities.CommunicationStatusStandby, 0, nil,
+		entities.CommunicationChannelEmail, 1,
+		3, 2, "other@example.com", "Other chain", entities.CommunicationStatusStandby, 0, nil,
+		entities.CommunicationChannelEmail, 4,
+	)
+	s.NoError(err)
+
+	s.NoError(s.communicationsRepository.MarkCommunicationSent(s.ctx, 1, 1, ""))
+	s.Equal(
+		[]entities.CommunicationStatus{
+			entities.CommunicationStatusSent,
+			entities.CommunicationStatusCancelled,
+			entities.CommunicationStatusStandby,
+		},
+		s.communicationStatuses(),
+	)
+}
+
+func (s *CommunicationsRepositoryTestSuite) TestMigrationMovesEmails() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	migrations := path.Dir(path.Dir(s.cwd)) + migrationsDir
+
+	// Rolling back to version before communications table to fill emails table, created by previous migrations:
+	s.NoError(goose.DownTo(s.dbConnector.Pool(), migrations, 20250410120000))
+
+	sentAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		`
+			INSERT INTO emails (id, user_id, email, content, status, sent_at) 
+			VALUES ($1, $2, $3, $4, $5, $6)
+		`,
+		1, 1, "test@example.com", "Migrated email", entities.CommunicationStatusSent, sentAt,
+	)
+	s.NoError(err)
+
+	s.NoError(goose.Up(s.dbConnector.Pool(), migrations))
+
+	communications, err := s.communicationsRepository.GetUserCommunications(
+		s.ctx,
+		1,
+		entities.CommunicationChannelEmail,
+		nil,
+	)
+	s.NoError(err)
+	s.Equal(1, len(communications))
+	s.Equal(uint64(1), communications[0].ID)
+	s.Equal("test@example.com", communications[0].Recipient)
+	s.Equal("Migrated email", communications[0].Body)
+	s.Nil(communications[0].ProviderMessageID)
+}
+
+func (s *CommunicationsRepositoryTestSuite) communicationStatuses() []entities.CommunicationStatus {
+	rows, err := s.connection.QueryContext(s.ctx, "SELECT status FROM communications ORDER BY id")
+	s.NoError(err)
+
+	defer func() {
+		s.NoError(rows.Close())
+	}()
+
+	var statuses []entities.CommunicationStatus
+
+	for rows.Next() {
+		var status entities.CommunicationStatus
+
+		s.NoError(rows.Scan(&status))
+		statuses = append(statuses, status)
+	}
+
+	s.NoError(rows.Err())
+
+	return statuses
+}
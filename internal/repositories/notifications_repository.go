@@ -0,0 +1,443 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/DKhorkov/libs/db"
+	"github.com/DKhorkov/libs/logging"
+	"github.com/DKhorkov/libs/tracing"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-notifications/internal/errors"
+)
+
+const (
+	notificationsTableName               = "notifications"
+	notificationTypeColumnName           = "type"
+	notificationTitleColumnName          = "title"
+	notificationTextColumnName           = "text"
+	notificationLinkColumnName           = "link"
+	notificationCreatedAtColumnName      = "created_at"
+	notificationReadAtColumnName         = "read_at"
+	notificationKeepReadAtExpressionTmpl = "COALESCE(%s, ?)"
+)
+
+type NotificationsRepository struct {
+	dbConnector   db.Connector
+	logger        logging.Logger
+	traceProvider tracing.Provider
+	spanConfig    tracing.SpanConfig
+	mutex         *sync.RWMutex
+}
+
+func NewNotificationsRepository(
+	dbConnector db.Connector,
+	logger logging.Logger,
+	traceProvider tracing.Provider,
+	spanConfig tracing.SpanConfig,
+) *NotificationsRepository {
+	return &NotificationsRepository{
+		dbConnector:   dbConnector,
+		logger:        logger,
+		traceProvider: traceProvider,
+		spanConfig:    spanConfig,
+		mutex:         new(sync.RWMutex),
+	}
+}
+
+func (repo *NotificationsRepository) GetUserNotifications(
+	ctx context.Context,
+	userID uint64,
+	pagination *entities.Pagination,
+) ([]entities.Notification, error) {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	connection, err := repo.dbConnector.Connection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer db.CloseConnectionContext(ctx, connection, repo.logger)
+
+	builder := sq.
+		Select(selectAllColumns).
+		From(notificationsTableName).
+		Where(sq.Eq{userIDColumnName: userID}).
+		OrderBy(fmt.Sprintf("%s %s", idColumnName, DESC)).
+		PlaceholderFormat(sq.Dollar)
+
+	if pagination != nil && pagination.Limit != nil {
+		builder = builder.Limit(*pagination.Limit)
+	}
+
+	if pagination != nil && pagination.Offset != nil {
+		builder = builder.Offset(*pagination.Offset)
+	}
+
+	stmt, params, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	// Using mutex for concurrent-safety purpose of using via workers:
+	repo.mutex.RLock()
+	defer repo.mutex.RUnlock()
+
+	rows, err := connection.QueryContext(
+		ctx,
+		stmt,
+		params...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err = rows.Close(); err != nil {
+			logging.LogErrorContext(
+				ctx,
+				repo.logger,
+				"error during closing SQL rows",
+				err,
+			)
+		}
+	}()
+
+	var notifications []entities.Notification
+
+	for rows.Next() {
+		notification := entities.Notification{}
+		columns := db.GetEntityColumns(&notification) // Only pointer to use rows.Scan() successfully
+
+		err = rows.Scan(columns...)
+		if err != nil {
+			return nil, err
+		}
+
+		notifications = append(notifications, notification)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return notifications, nil
+}
+
+// GetUserNotificationsAfterID returns user notifications, created after notification with provided ID,
+// in creation order. Only limit latest notifications are returned, older ones could be received via
+// GetUserNotifications. Used for resumption of live notifications stream.
+func (repo *NotificationsRepository) GetUserNotificationsAfterID(
+	ctx context.Context,
+	userID uint64,
+	lastSeenID uint64,
+	limit uint64,
+) ([]entities.Notification, error) {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	connection, err := repo.dbConnector.Connection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer db.CloseConnectionContext(ctx, connection, repo.logger)
+
+	stmt, params, err := sq.
+		Select(selectAllColumns).
+		From(notificationsTableName).
+		Where(sq.Eq{userIDColumnName: userID}).
+		Where(sq.Gt{idColumnName: lastSeenID}).
+		OrderBy(fmt.Sprintf("%s %s", idColumnName, DESC)).
+		Limit(limit).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	// Using mutex for concurrent-safety purpose of using via workers:
+	repo.mutex.RLock()
+	defer repo.mutex.RUnlock()
+
+	rows, err := connection.QueryContext(
+		ctx,
+		stmt,
+		params...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err = rows.Close(); err != nil {
+			logging.LogErrorContext(
+				ctx,
+				repo.logger,
+				"error during closing SQL rows",
+				err,
+			)
+		}
+	}()
+
+	var notifications []entities.Notification
+
+	for rows.Next() {
+		notification := entities.Notification{}
+		columns := db.GetEntityColumns(&notification) // Only pointer to use rows.Scan() successfully
+
+		err = rows.Scan(columns...)
+		if err != nil {
+			return nil, err
+		}
+
+		notifications = append(notifications, notification)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// Latest notifications were selected, but should be returned in creation order:
+	slices.Reverse(notifications)
+
+	return notifications, nil
+}
+
+func (repo *NotificationsRepository) CountUserUnreadNotifications(
+	ctx context.Context,
+	userID uint64,
+) (uint64, error) {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	connection, err := repo.dbConnector.Connection(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	defer db.CloseConnectionContext(ctx, connection, repo.logger)
+
+	stmt, params, err := sq.
+		Select(selectCount).
+		From(notificationsTableName).
+		Where(sq.Eq{userIDColumnName: userID}).
+		Where(sq.Eq{notificationReadAtColumnName: nil}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	// Using mutex for concurrent-safety purpose of using via workers:
+	repo.mutex.RLock()
+	defer repo.mutex.RUnlock()
+
+	var count uint64
+	if err = connection.QueryRowContext(ctx, stmt, params...).Scan(&count); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (repo *NotificationsRepository) SaveNotification(
+	ctx context.Context,
+	notification entities.Notification,
+) (uint64, error) {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	connection, err := repo.dbConnector.Connection(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	defer db.CloseConnectionContext(ctx, connection, repo.logger)
+
+	stmt, params, err := sq.
+		Insert(notificationsTableName).
+		Columns(
+			userIDColumnName,
+			notificationTypeColumnName,
+			notificationTitleColumnName,
+			notificationTextColumnName,
+			notificationLinkColumnName,
+			notificationCreatedAtColumnName,
+			notificationReadAtColumnName,
+		).
+		Values(
+			notification.UserID,
+			notification.Type,
+			notification.Title,
+			notification.Text,
+			notification.Link,
+			notification.CreatedAt,
+			notification.ReadAt,
+		).
+		Suffix(returningIDSuffix).
+		PlaceholderFormat(sq.Dollar). // pq postgres driver works only with $ placeholders
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	// Using mutex for concurrent-safety purpose of using via workers:
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	var notificationID uint64
+	if err = connection.QueryRowContext(ctx, stmt, params...).Scan(&notificationID); err != nil {
+		return 0, err
+	}
+
+	return notificationID, nil
+}
+
+// MarkNotificationAsRead sets read time of user notification. Read time of already read notification
+// is kept untouched. Returns NotificationNotFoundError, if user has no notification with provided ID.
+func (repo *NotificationsRepository) MarkNotificationAsRead(ctx context.Context, id, userID uint64) error {
+	affected, err := repo.markNotificationsAsRead(
+		ctx,
+		sq.Eq{
+			idColumnName:     id,
+			userIDColumnName: userID,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return &customerrors.NotificationNotFoundError{}
+	}
+
+	return nil
+}
+
+func (repo *NotificationsRepository) MarkAllNotificationsAsRead(ctx context.Context, userID uint64) error {
+	_, err := repo.markNotificationsAsRead(
+		ctx,
+		sq.Eq{
+			userIDColumnName:             userID,
+			notificationReadAtColumnName: nil,
+		},
+	)
+
+	return err
+}
+
+// DeleteNotification deletes user notification. Returns NotificationNotFoundError, if user has no notification
+// with provided ID.
+func (repo *NotificationsRepository) DeleteNotification(ctx context.Context, id, userID uint64) error {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	connection, err := repo.dbConnector.Connection(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer db.CloseConnectionContext(ctx, connection, repo.logger)
+
+	stmt, params, err := sq.
+		Delete(notificationsTableName).
+		Where(
+			sq.Eq{
+				idColumnName:     id,
+				userIDColumnName: userID,
+			},
+		).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	// Using mutex for concurrent-safety purpose of using via workers:
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	result, err := connection.ExecContext(ctx, stmt, params...)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return &customerrors.NotificationNotFoundError{}
+	}
+
+	return nil
+}
+
+func (repo *NotificationsRepository) markNotificationsAsRead(
+	ctx context.Context,
+	condition sq.Eq,
+) (int64, error) {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel+1))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	connection, err := repo.dbConnector.Connection(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	defer db.CloseConnectionContext(ctx, connection, repo.logger)
+
+	stmt, params, err := sq.
+		Update(notificationsTableName).
+		Set(
+			notificationReadAtColumnName,
+			sq.Expr(
+				fmt.Sprintf(notificationKeepReadAtExpressionTmpl, notificationReadAtColumnName),
+				time.Now().UTC(),
+			),
+		).
+		Where(condition).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	// Using mutex for concurrent-safety purpose of using via workers:
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	result, err := connection.ExecContext(ctx, stmt, params...)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
@@ -0,0 +1,219 @@
+//go:build integration
+
+package repositories_test
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3" // Must be imported for correct work
+
+	"github.com/pressly/goose/v3"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/DKhorkov/libs/db"
+	mocklogging "github.com/DKhorkov/libs/logging/mocks"
+	"github.com/DKhorkov/libs/pointers"
+	"github.com/DKhorkov/libs/tracing"
+	mocktracing "github.com/DKhorkov/libs/tracing/mocks"
+
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-notifications/internal/errors"
+	"github.com/DKhorkov/hmtm-notifications/internal/repositories"
+)
+
+func TestNotificationsRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(NotificationsRepositoryTestSuite))
+}
+
+type NotificationsRepositoryTestSuite struct {
+	suite.Suite
+
+	cwd                     string
+	ctx                     context.Context
+	dbConnector             db.Connector
+	connection              *sql.Conn
+	notificationsRepository *repositories.NotificationsRepository
+	logger                  *mocklogging.MockLogger
+	traceProvider           *mocktracing.MockProvider
+	spanConfig              tracing.SpanConfig
+}
+
+func (s *NotificationsRepositoryTestSuite) SetupSuite() {
+	s.NoError(goose.SetDialect(driver))
+
+	ctrl := gomock.NewController(s.T())
+	s.ctx = context.Background()
+	s.logger = mocklogging.NewMockLogger(ctrl)
+	dbConnector, err := db.New(dsn, driver, s.logger)
+	s.NoError(err)
+
+	cwd, err := os.Getwd()
+	s.NoError(err)
+
+	s.cwd = cwd
+	s.dbConnector = dbConnector
+	s.traceProvider = mocktracing.NewMockProvider(ctrl)
+	s.spanConfig = tracing.SpanConfig{}
+	s.notificationsRepository = repositories.NewNotificationsRepository(
+		s.dbConnector,
+		s.logger,
+		s.traceProvider,
+		s.spanConfig,
+	)
+}
+
+func (s *NotificationsRepositoryTestSuite) SetupTest() {
+	s.NoError(
+		goose.Up(
+			s.dbConnector.Pool(),
+			path.Dir(
+				path.Dir(s.cwd),
+			)+migrationsDir,
+		),
+	)
+
+	connection, err := s.dbConnector.Connection(s.ctx)
+	s.NoError(err)
+
+	s.connection = connection
+}
+
+func (s *NotificationsRepositoryTestSuite) TearDownTest() {
+	s.NoError(
+		goose.DownTo(
+			s.dbConnector.Pool(),
+			path.Dir(
+				path.Dir(s.cwd),
+			)+migrationsDir,
+			gooseZeroVersion,
+		),
+	)
+
+	s.NoError(s.connection.Close())
+}
+
+func (s *NotificationsRepositoryTestSuite) TearDownSuite() {
+	s.NoError(s.dbConnector.Close())
+}
+
+func (s *NotificationsRepositoryTestSuite) expectSpans(times int) {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(times)
+}
+
+// insertNotification inserts notification with explicit ID, since SERIAL columns are not autoincremented by SQLite.
+func (s *NotificationsRepositoryTestSuite) insertNotification(id, userID uint64) {
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		`
+			INSERT INTO notifications (id, user_id, type, title, text, link, created_at) 
+			VALUES ($1, $2, $3, $4, $5, $6, $7)
+		`,
+		id,
+		userID,
+		entities.NotificationTypeTicketUpdated,
+		"title",
+		"text",
+		"http://example.com/tickets/1",
+		time.Now().UTC(),
+	)
+	s.NoError(err)
+}
+
+func (s *NotificationsRepositoryTestSuite) TestGetUserNotifications() {
+	s.expectSpans(1)
+
+	s.insertNotification(1, 1)
+	s.insertNotification(2, 2)
+	s.insertNotification(3, 1)
+
+	notifications, err := s.notificationsRepository.GetUserNotifications(
+		s.ctx,
+		1,
+		&entities.Pagination{Limit: pointers.New[uint64](1)},
+	)
+	s.NoError(err)
+	s.Len(notifications, 1)
+	s.Equal(uint64(3), notifications[0].ID)
+	s.Equal(entities.NotificationTypeTicketUpdated, notifications[0].Type)
+	s.Equal("http://example.com/tickets/1", notifications[0].Link)
+	s.Nil(notifications[0].ReadAt)
+}
+
+func (s *NotificationsRepositoryTestSuite) TestGetUserNotificationsAfterID() {
+	s.expectSpans(1)
+
+	s.insertNotification(1, 1)
+	s.insertNotification(2, 2)
+	s.insertNotification(3, 1)
+	s.insertNotification(4, 1)
+	s.insertNotification(5, 1)
+
+	// Only latest notifications are returned in creation order:
+	notifications, err := s.notificationsRepository.GetUserNotificationsAfterID(s.ctx, 1, 1, 2)
+	s.NoError(err)
+	s.Len(notifications, 2)
+	s.Equal(uint64(4), notifications[0].ID)
+	s.Equal(uint64(5), notifications[1].ID)
+}
+
+func (s *NotificationsRepositoryTestSuite) TestMarkNotificationAsRead() {
+	s.expectSpans(4)
+
+	s.insertNotification(1, 1)
+	s.insertNotification(2, 1)
+
+	s.NoError(s.notificationsRepository.MarkNotificationAsRead(s.ctx, 1, 1))
+
+	// Marking already read notification is not an error:
+	s.NoError(s.notificationsRepository.MarkNotificationAsRead(s.ctx, 1, 1))
+
+	count, err := s.notificationsRepository.CountUserUnreadNotifications(s.ctx, 1)
+	s.NoError(err)
+	s.Equal(uint64(1), count)
+
+	err = s.notificationsRepository.MarkNotificationAsRead(s.ctx, 1, 2)
+	s.ErrorAs(err, new(*customerrors.NotificationNotFoundError))
+}
+
+func (s *NotificationsRepositoryTestSuite) TestMarkAllNotificationsAsRead() {
+	s.expectSpans(3)
+
+	s.insertNotification(1, 1)
+	s.insertNotification(2, 1)
+	s.insertNotification(3, 2)
+
+	s.NoError(s.notificationsRepository.MarkAllNotificationsAsRead(s.ctx, 1))
+
+	count, err := s.notificationsRepository.CountUserUnreadNotifications(s.ctx, 1)
+	s.NoError(err)
+	s.Zero(count)
+
+	count, err = s.notificationsRepository.CountUserUnreadNotifications(s.ctx, 2)
+	s.NoError(err)
+	s.Equal(uint64(1), count)
+}
+
+func (s *NotificationsRepositoryTestSuite) TestDeleteNotification() {
+	s.expectSpans(3)
+
+	s.insertNotification(1, 1)
+
+	err := s.notificationsRepository.DeleteNotification(s.ctx, 1, 2)
+	s.ErrorAs(err, new(*customerrors.NotificationNotFoundError))
+
+	s.NoError(s.notificationsRepository.DeleteNotification(s.ctx, 1, 1))
+
+	notifications, err := s.notificationsRepository.GetUserNotifications(s.ctx, 1, nil)
+	s.NoError(err)
+	s.Empty(notifications)
+}
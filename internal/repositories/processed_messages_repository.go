@@ -0,0 +1,325 @@
+package repositories
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/DKhorkov/libs/db"
+	"github.com/DKhorkov/libs/logging"
+	"github.com/DKhorkov/libs/tracing"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+)
+
+const (
+	processedMessagesTableName               = "processed_messages"
+	processedMessageIdempotencyKeyColumnName = "idempotency_key"
+	processedMessageEmailIDsColumnName       = "email_ids"
+	processedMessageCreatedAtColumnName      = "created_at"
+	processedMessageProcessedAtColumnName    = "processed_at"
+	processedMessageEmailIDsSeparator        = ","
+	onIdempotencyKeyConflictDoNothingSuffix  = "ON CONFLICT (idempotency_key) DO NOTHING"
+	processedMessageEmailIDsParsingBase      = 10
+	processedMessageEmailIDsParsingBitSize   = 64
+)
+
+type ProcessedMessagesRepository struct {
+	dbConnector   db.Connector
+	logger        logging.Logger
+	traceProvider tracing.Provider
+	spanConfig    tracing.SpanConfig
+	mutex         *sync.RWMutex
+}
+
+func NewProcessedMessagesRepository(
+	dbConnector db.Connector,
+	logger logging.Logger,
+	traceProvider tracing.Provider,
+	spanConfig tracing.SpanConfig,
+) *ProcessedMessagesRepository {
+	return &ProcessedMessagesRepository{
+		dbConnector:   dbConnector,
+		logger:        logger,
+		traceProvider: traceProvider,
+		spanConfig:    spanConfig,
+		mutex:         new(sync.RWMutex),
+	}
+}
+
+// ReserveProcessedMessage creates processed message for provided idempotency key, if it does not exist yet.
+// Unique index on idempotency key guarantees, that only one worker could reserve message.
+func (repo *ProcessedMessagesRepository) ReserveProcessedMessage(
+	ctx context.Context,
+	idempotencyKey string,
+) (bool, error) {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	connection, err := repo.dbConnector.Connection(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	defer db.CloseConnectionContext(ctx, connection, repo.logger)
+
+	stmt, params, err := sq.
+		Insert(processedMessagesTableName).
+		Columns(
+			processedMessageIdempotencyKeyColumnName,
+			processedMessageCreatedAtColumnName,
+		).
+		Values(
+			idempotencyKey,
+			time.Now().UTC(),
+		).
+		Suffix(onIdempotencyKeyConflictDoNothingSuffix).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return false, err
+	}
+
+	// Using mutex for concurrent-safety purpose of using via workers:
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	result, err := connection.ExecContext(ctx, stmt, params...)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected == 1, nil
+}
+
+func (repo *ProcessedMessagesRepository) GetProcessedMessage(
+	ctx context.Context,
+	idempotencyKey string,
+) (*entities.ProcessedMessage, error) {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	connection, err := repo.dbConnector.Connection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer db.CloseConnectionContext(ctx, connection, repo.logger)
+
+	stmt, params, err := sq.
+		Select(
+			processedMessageIdempotencyKeyColumnName,
+			processedMessageEmailIDsColumnName,
+			processedMessageCreatedAtColumnName,
+			processedMessageProcessedAtColumnName,
+		).
+		From(processedMessagesTableName).
+		Where(sq.Eq{processedMessageIdempotencyKeyColumnName: idempotencyKey}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	// Using mutex for concurrent-safety purpose of using via workers:
+	repo.mutex.RLock()
+	defer repo.mutex.RUnlock()
+
+	var (
+		processedMessage entities.ProcessedMessage
+		rawEmailIDs      string
+	)
+
+	if err = connection.QueryRowContext(ctx, stmt, params...).Scan(
+		&processedMessage.IdempotencyKey,
+		&rawEmailIDs,
+		&processedMessage.CreatedAt,
+		&processedMessage.ProcessedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	if processedMessage.EmailIDs, err = parseEmailIDs(rawEmailIDs); err != nil {
+		return nil, err
+	}
+
+	return &processedMessage, nil
+}
+
+func (repo *ProcessedMessagesRepository) CompleteProcessedMessage(
+	ctx context.Context,
+	idempotencyKey string,
+	emailIDs []uint64,
+) error {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	connection, err := repo.dbConnector.Connection(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer db.CloseConnectionContext(ctx, connection, repo.logger)
+
+	stmt, params, err := sq.
+		Update(processedMessagesTableName).
+		Set(processedMessageEmailIDsColumnName, formatEmailIDs(emailIDs)).
+		Set(processedMessageProcessedAtColumnName, time.Now().UTC()).
+		Where(sq.Eq{processedMessageIdempotencyKeyColumnName: idempotencyKey}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	// Using mutex for concurrent-safety purpose of using via workers:
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	_, err = connection.ExecContext(ctx, stmt, params...)
+
+	return err
+}
+
+// ReleaseProcessedMessage deletes reservation of not processed message to allow its processing on redelivery.
+func (repo *ProcessedMessagesRepository) ReleaseProcessedMessage(
+	ctx context.Context,
+	idempotencyKey string,
+) error {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	connection, err := repo.dbConnector.Connection(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer db.CloseConnectionContext(ctx, connection, repo.logger)
+
+	stmt, params, err := sq.
+		Delete(processedMessagesTableName).
+		Where(sq.Eq{processedMessageIdempotencyKeyColumnName: idempotencyKey}).
+		Where(sq.Eq{processedMessageProcessedAtColumnName: nil}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	// Using mutex for concurrent-safety purpose of using via workers:
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	_, err = connection.ExecContext(ctx, stmt, params...)
+
+	return err
+}
+
+// DeleteExpiredProcessedMessages deletes processed messages, which were processed before processedBefore,
+// and stale reservations, which were created before reservedBefore, but were not processed, for example,
+// due to worker crash.
+func (repo *ProcessedMessagesRepository) DeleteExpiredProcessedMessages(
+	ctx context.Context,
+	processedBefore time.Time,
+	reservedBefore time.Time,
+) (uint64, error) {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	connection, err := repo.dbConnector.Connection(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	defer db.CloseConnectionContext(ctx, connection, repo.logger)
+
+	stmt, params, err := sq.
+		Delete(processedMessagesTableName).
+		Where(
+			sq.Or{
+				sq.Lt{processedMessageProcessedAtColumnName: processedBefore},
+				sq.And{
+					sq.Eq{processedMessageProcessedAtColumnName: nil},
+					sq.Lt{processedMessageCreatedAtColumnName: reservedBefore},
+				},
+			},
+		).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	// Using mutex for concurrent-safety purpose of using via workers:
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	result, err := connection.ExecContext(ctx, stmt, params...)
+	if err != nil {
+		return 0, err
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return uint64(deleted), nil
+}
+
+func formatEmailIDs(emailIDs []uint64) string {
+	rawEmailIDs := make([]string, 0, len(emailIDs))
+	for _, emailID := range emailIDs {
+		rawEmailIDs = append(rawEmailIDs, strconv.FormatUint(emailID, processedMessageEmailIDsParsingBase))
+	}
+
+	return strings.Join(rawEmailIDs, processedMessageEmailIDsSeparator)
+}
+
+func parseEmailIDs(rawEmailIDs string) ([]uint64, error) {
+	if rawEmailIDs == "" {
+		return nil, nil
+	}
+
+	parts := strings.Split(rawEmailIDs, processedMessageEmailIDsSeparator)
+	emailIDs := make([]uint64, 0, len(parts))
+
+	for _, part := range parts {
+		emailID, err := strconv.ParseUint(
+			part,
+			processedMessageEmailIDsParsingBase,
+			processedMessageEmailIDsParsingBitSize,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		emailIDs = append(emailIDs, emailID)
+	}
+
+	return emailIDs, nil
+}
@@ -0,0 +1,199 @@
+//go:build integration
+
+package repositories_test
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3" // Must be imported for correct work
+
+	"github.com/pressly/goose/v3"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/DKhorkov/libs/db"
+	mocklogging "github.com/DKhorkov/libs/logging/mocks"
+	"github.com/DKhorkov/libs/tracing"
+	mocktracing "github.com/DKhorkov/libs/tracing/mocks"
+
+	"github.com/DKhorkov/hmtm-notifications/internal/repositories"
+)
+
+func TestProcessedMessagesRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(ProcessedMessagesRepositoryTestSuite))
+}
+
+type ProcessedMessagesRepositoryTestSuite struct {
+	suite.Suite
+
+	cwd                         string
+	ctx                         context.Context
+	dbConnector                 db.Connector
+	connection                  *sql.Conn
+	processedMessagesRepository *repositories.ProcessedMessagesRepository
+	logger                      *mocklogging.MockLogger
+	traceProvider               *mocktracing.MockProvider
+	spanConfig                  tracing.SpanConfig
+}
+
+func (s *ProcessedMessagesRepositoryTestSuite) SetupSuite() {
+	s.NoError(goose.SetDialect(driver))
+
+	ctrl := gomock.NewController(s.T())
+	s.ctx = context.Background()
+	s.logger = mocklogging.NewMockLogger(ctrl)
+	dbConnector, err := db.New(dsn, driver, s.logger)
+	s.NoError(err)
+
+	cwd, err := os.Getwd()
+	s.NoError(err)
+
+	s.cwd = cwd
+	s.dbConnector = dbConnector
+	s.traceProvider = mocktracing.NewMockProvider(ctrl)
+	s.spanConfig = tracing.SpanConfig{}
+	s.processedMessagesRepository = repositories.NewProcessedMessagesRepository(
+		s.dbConnector,
+		s.logger,
+		s.traceProvider,
+		s.spanConfig,
+	)
+}
+
+func (s *ProcessedMessagesRepositoryTestSuite) SetupTest() {
+	s.NoError(
+		goose.Up(
+			s.dbConnector.Pool(),
+			path.Dir(
+				path.Dir(s.cwd),
+			)+migrationsDir,
+		),
+	)
+
+	connection, err := s.dbConnector.Connection(s.ctx)
+	s.NoError(err)
+
+	s.connection = connection
+}
+
+func (s *ProcessedMessagesRepositoryTestSuite) TearDownTest() {
+	s.NoError(
+		goose.DownTo(
+			s.dbConnector.Pool(),
+			path.Dir(
+				path.Dir(s.cwd),
+			)+migrationsDir,
+			gooseZeroVersion,
+		),
+	)
+
+	s.NoError(s.connection.Close())
+}
+
+func (s *ProcessedMessagesRepositoryTestSuite) TearDownSuite() {
+	s.NoError(s.dbConnector.Close())
+}
+
+func (s *ProcessedMessagesRepositoryTestSuite) expectSpans(times int) {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(times)
+}
+
+func (s *ProcessedMessagesRepositoryTestSuite) TestReserveProcessedMessageOnlyOnce() {
+	s.expectSpans(3)
+
+	reserved, err := s.processedMessagesRepository.ReserveProcessedMessage(s.ctx, "key")
+	s.NoError(err)
+	s.True(reserved)
+
+	reserved, err = s.processedMessagesRepository.ReserveProcessedMessage(s.ctx, "key")
+	s.NoError(err)
+	s.False(reserved)
+
+	processedMessage, err := s.processedMessagesRepository.GetProcessedMessage(s.ctx, "key")
+	s.NoError(err)
+	s.Equal("key", processedMessage.IdempotencyKey)
+	s.Empty(processedMessage.EmailIDs)
+	s.Nil(processedMessage.ProcessedAt)
+}
+
+func (s *ProcessedMessagesRepositoryTestSuite) TestCompleteProcessedMessage() {
+	s.expectSpans(3)
+
+	reserved, err := s.processedMessagesRepository.ReserveProcessedMessage(s.ctx, "key")
+	s.NoError(err)
+	s.True(reserved)
+
+	err = s.processedMessagesRepository.CompleteProcessedMessage(s.ctx, "key", []uint64{1, 2, 3})
+	s.NoError(err)
+
+	processedMessage, err := s.processedMessagesRepository.GetProcessedMessage(s.ctx, "key")
+	s.NoError(err)
+	s.Equal([]uint64{1, 2, 3}, processedMessage.EmailIDs)
+	s.NotNil(processedMessage.ProcessedAt)
+}
+
+func (s *ProcessedMessagesRepositoryTestSuite) TestGetProcessedMessageNotFound() {
+	s.expectSpans(1)
+
+	processedMessage, err := s.processedMessagesRepository.GetProcessedMessage(s.ctx, "key")
+	s.ErrorIs(err, sql.ErrNoRows)
+	s.Nil(processedMessage)
+}
+
+func (s *ProcessedMessagesRepositoryTestSuite) TestReleaseProcessedMessage() {
+	s.expectSpans(3)
+
+	reserved, err := s.processedMessagesRepository.ReserveProcessedMessage(s.ctx, "key")
+	s.NoError(err)
+	s.True(reserved)
+
+	err = s.processedMessagesRepository.ReleaseProcessedMessage(s.ctx, "key")
+	s.NoError(err)
+
+	reserved, err = s.processedMessagesRepository.ReserveProcessedMessage(s.ctx, "key")
+	s.NoError(err)
+	s.True(reserved)
+}
+
+func (s *ProcessedMessagesRepositoryTestSuite) TestDeleteExpiredProcessedMessages() {
+	s.expectSpans(1)
+
+	now := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		`
+			INSERT INTO processed_messages (id, idempotency_key, email_ids, created_at, processed_at)
+			VALUES
+			    (1, 'expired', '1', $1, $1),
+			    (2, 'actual', '2', $2, $2),
+			    (3, 'stale-reservation', '', $1, NULL),
+			    (4, 'reservation', '', $2, NULL)
+		`,
+		now.Add(-time.Hour*2),
+		now,
+	)
+	s.NoError(err)
+
+	deleted, err := s.processedMessagesRepository.DeleteExpiredProcessedMessages(
+		s.ctx,
+		now.Add(-time.Hour),
+		now.Add(-time.Hour),
+	)
+	s.NoError(err)
+	s.Equal(uint64(2), deleted)
+
+	var count int
+	s.NoError(
+		s.connection.QueryRowContext(s.ctx, "SELECT COUNT(*) FROM processed_messages").Scan(&count),
+	)
+	s.Equal(2, count)
+}
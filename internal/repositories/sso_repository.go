@@ -49,6 +49,7 @@ func (repo *SsoRepository) GetUserByEmail(
 }
 
 func (repo *SsoRepository) processUserResponse(userResponse *sso.GetUserOut) *entities.User {
+	// SSO does not provide locale of user yet, so content is rendered in default locale:
 	return &entities.User{
 		ID:                userResponse.GetID(),
 		DisplayName:       userResponse.GetDisplayName(),
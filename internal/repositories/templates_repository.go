@@ -0,0 +1,376 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/DKhorkov/libs/db"
+	"github.com/DKhorkov/libs/logging"
+	"github.com/DKhorkov/libs/tracing"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-notifications/internal/errors"
+)
+
+const (
+	templatesTableName                 = "templates"
+	templateNotificationTypeColumnName = "notification_type"
+	templateLocaleColumnName           = "locale"
+	templateVersionColumnName          = "version"
+	templateStateColumnName            = "state"
+	templateSubjectColumnName          = "subject"
+	templateHTMLColumnName             = "html"
+	templateTextColumnName             = "text"
+	templateCreatedAtColumnName        = "created_at"
+	templateUpdatedAtColumnName        = "updated_at"
+	templatePublishedAtColumnName      = "published_at"
+
+	// Version of saved template is the next one after the latest version of its notification type and locale:
+	templateNextVersionExpression = "(SELECT COALESCE(MAX(version), 0) + 1 FROM templates " +
+		"WHERE notification_type = ? AND locale = ?)"
+)
+
+type TemplatesRepository struct {
+	dbConnector   db.Connector
+	logger        logging.Logger
+	traceProvider tracing.Provider
+	spanConfig    tracing.SpanConfig
+	mutex         *sync.RWMutex
+}
+
+func NewTemplatesRepository(
+	dbConnector db.Connector,
+	logger logging.Logger,
+	traceProvider tracing.Provider,
+	spanConfig tracing.SpanConfig,
+) *TemplatesRepository {
+	return &TemplatesRepository{
+		dbConnector:   dbConnector,
+		logger:        logger,
+		traceProvider: traceProvider,
+		spanConfig:    spanConfig,
+		mutex:         new(sync.RWMutex),
+	}
+}
+
+// SaveTemplate saves template as the next version of its notification type and locale.
+func (repo *TemplatesRepository) SaveTemplate(ctx context.Context, template entities.Template) (uint64, error) {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	connection, err := repo.dbConnector.Connection(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	defer db.CloseConnectionContext(ctx, connection, repo.logger)
+
+	stmt, params, err := sq.
+		Insert(templatesTableName).
+		Columns(
+			templateNotificationTypeColumnName,
+			templateLocaleColumnName,
+			templateVersionColumnName,
+			templateStateColumnName,
+			templateSubjectColumnName,
+			templateHTMLColumnName,
+			templateTextColumnName,
+			templateCreatedAtColumnName,
+			templateUpdatedAtColumnName,
+		).
+		Values(
+			template.NotificationType,
+			template.Locale,
+			sq.Expr(templateNextVersionExpression, template.NotificationType, template.Locale),
+			template.State,
+			template.Subject,
+			template.HTML,
+			template.Text,
+			template.CreatedAt,
+			template.UpdatedAt,
+		).
+		Suffix(returningIDSuffix).
+		PlaceholderFormat(sq.Dollar). // pq postgres driver works only with $ placeholders
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	// Using mutex for concurrent-safety purpose of using via workers:
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	var templateID uint64
+	if err = connection.QueryRowContext(ctx, stmt, params...).Scan(&templateID); err != nil {
+		return 0, err
+	}
+
+	return templateID, nil
+}
+
+// GetTemplateByID returns template with provided ID or TemplateNotFoundError, if there is no such template.
+func (repo *TemplatesRepository) GetTemplateByID(ctx context.Context, id uint64) (*entities.Template, error) {
+	templates, err := repo.getTemplates(ctx, sq.Eq{idColumnName: id})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(templates) == 0 {
+		return nil, &customerrors.TemplateNotFoundError{}
+	}
+
+	return &templates[0], nil
+}
+
+// GetTemplates returns all versions of templates, which match filters, from the latest version to the first one.
+func (repo *TemplatesRepository) GetTemplates(
+	ctx context.Context,
+	filters entities.TemplatesFilters,
+) ([]entities.Template, error) {
+	condition := sq.And{}
+	if filters.NotificationType != nil {
+		condition = append(condition, sq.Eq{templateNotificationTypeColumnName: *filters.NotificationType})
+	}
+
+	if filters.Locale != nil {
+		condition = append(condition, sq.Eq{templateLocaleColumnName: *filters.Locale})
+	}
+
+	return repo.getTemplates(ctx, condition)
+}
+
+// GetPublishedTemplates returns published version of every notification type and locale, which has one.
+func (repo *TemplatesRepository) GetPublishedTemplates(ctx context.Context) ([]entities.Template, error) {
+	return repo.getTemplates(ctx, sq.Eq{templateStateColumnName: entities.TemplateStatePublished})
+}
+
+// UpdateTemplate changes subject, HTML and plain text of template, if it is a draft. Returns false, if there is
+// no draft with ID of provided template.
+func (repo *TemplatesRepository) UpdateTemplate(ctx context.Context, template entities.Template) (bool, error) {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	connection, err := repo.dbConnector.Connection(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	defer db.CloseConnectionContext(ctx, connection, repo.logger)
+
+	stmt, params, err := sq.
+		Update(templatesTableName).
+		Set(templateSubjectColumnName, template.Subject).
+		Set(templateHTMLColumnName, template.HTML).
+		Set(templateTextColumnName, template.Text).
+		Set(templateUpdatedAtColumnName, template.UpdatedAt).
+		Where(
+			sq.And{
+				sq.Eq{idColumnName: template.ID},
+				sq.Eq{templateStateColumnName: entities.TemplateStateDraft},
+			},
+		).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return false, err
+	}
+
+	// Using mutex for concurrent-safety purpose of using via workers:
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	result, err := connection.ExecContext(ctx, stmt, params...)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected == 1, nil
+}
+
+// PublishTemplate publishes version of template and archives previously published version of the same
+// notification type and locale in one transaction. Archived version could be published again for rollback.
+func (repo *TemplatesRepository) PublishTemplate(ctx context.Context, id uint64, publishedAt time.Time) error {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	connection, err := repo.dbConnector.Connection(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer db.CloseConnectionContext(ctx, connection, repo.logger)
+
+	selectStmt, selectParams, err := sq.
+		Select(templateNotificationTypeColumnName, templateLocaleColumnName).
+		From(templatesTableName).
+		Where(sq.Eq{idColumnName: id}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	// Using mutex for concurrent-safety purpose of using via workers:
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	transaction, err := connection.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	// Rollback after commit does nothing:
+	defer func() {
+		_ = transaction.Rollback()
+	}()
+
+	var (
+		notificationType entities.NotificationType
+		locale           string
+	)
+
+	err = transaction.QueryRowContext(ctx, selectStmt, selectParams...).Scan(&notificationType, &locale)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &customerrors.TemplateNotFoundError{}
+	}
+
+	if err != nil {
+		return err
+	}
+
+	// Previous version is archived before publishing, since only one version could be published:
+	archiveStmt, archiveParams, err := sq.
+		Update(templatesTableName).
+		Set(templateStateColumnName, entities.TemplateStateArchived).
+		Set(templateUpdatedAtColumnName, publishedAt).
+		Where(
+			sq.And{
+				sq.Eq{templateNotificationTypeColumnName: notificationType},
+				sq.Eq{templateLocaleColumnName: locale},
+				sq.Eq{templateStateColumnName: entities.TemplateStatePublished},
+				sq.NotEq{idColumnName: id},
+			},
+		).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	if _, err = transaction.ExecContext(ctx, archiveStmt, archiveParams...); err != nil {
+		return err
+	}
+
+	publishStmt, publishParams, err := sq.
+		Update(templatesTableName).
+		Set(templateStateColumnName, entities.TemplateStatePublished).
+		Set(templateUpdatedAtColumnName, publishedAt).
+		Set(templatePublishedAtColumnName, publishedAt).
+		Where(
+			sq.And{
+				sq.Eq{idColumnName: id},
+				sq.NotEq{templateStateColumnName: entities.TemplateStatePublished},
+			},
+		).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	if _, err = transaction.ExecContext(ctx, publishStmt, publishParams...); err != nil {
+		return err
+	}
+
+	return transaction.Commit()
+}
+
+func (repo *TemplatesRepository) getTemplates(
+	ctx context.Context,
+	condition sq.Sqlizer,
+) ([]entities.Template, error) {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel+1))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	connection, err := repo.dbConnector.Connection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer db.CloseConnectionContext(ctx, connection, repo.logger)
+
+	stmt, params, err := sq.
+		Select(selectAllColumns).
+		From(templatesTableName).
+		Where(condition).
+		OrderBy(
+			fmt.Sprintf("%s %s", templateNotificationTypeColumnName, ASC),
+			fmt.Sprintf("%s %s", templateLocaleColumnName, ASC),
+			fmt.Sprintf("%s %s", templateVersionColumnName, DESC),
+		).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	// Using mutex for concurrent-safety purpose of using via workers:
+	repo.mutex.RLock()
+	defer repo.mutex.RUnlock()
+
+	rows, err := connection.QueryContext(ctx, stmt, params...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err = rows.Close(); err != nil {
+			logging.LogErrorContext(
+				ctx,
+				repo.logger,
+				"error during closing SQL rows",
+				err,
+			)
+		}
+	}()
+
+	var templates []entities.Template
+
+	for rows.Next() {
+		template := entities.Template{}
+		if err = rows.Scan(db.GetEntityColumns(&template)...); err != nil {
+			return nil, err
+		}
+
+		templates = append(templates, template)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return templates, nil
+}
@@ -0,0 +1,273 @@
+//go:build integration
+
+package repositories_test
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3" // Must be imported for correct work
+
+	"github.com/pressly/goose/v3"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/DKhorkov/libs/db"
+	mocklogging "github.com/DKhorkov/libs/logging/mocks"
+	"github.com/DKhorkov/libs/pointers"
+	"github.com/DKhorkov/libs/tracing"
+	mocktracing "github.com/DKhorkov/libs/tracing/mocks"
+
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-notifications/internal/errors"
+	"github.com/DKhorkov/hmtm-notifications/internal/repositories"
+)
+
+func TestTemplatesRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(TemplatesRepositoryTestSuite))
+}
+
+type TemplatesRepositoryTestSuite struct {
+	suite.Suite
+
+	cwd                 string
+	ctx                 context.Context
+	dbConnector         db.Connector
+	connection          *sql.Conn
+	templatesRepository *repositories.TemplatesRepository
+	logger              *mocklogging.MockLogger
+	traceProvider       *mocktracing.MockProvider
+	spanConfig          tracing.SpanConfig
+}
+
+func (s *TemplatesRepositoryTestSuite) SetupSuite() {
+	s.NoError(goose.SetDialect(driver))
+
+	ctrl := gomock.NewController(s.T())
+	s.ctx = context.Background()
+	s.logger = mocklogging.NewMockLogger(ctrl)
+	dbConnector, err := db.New(dsn, driver, s.logger)
+	s.NoError(err)
+
+	cwd, err := os.Getwd()
+	s.NoError(err)
+
+	s.cwd = cwd
+	s.dbConnector = dbConnector
+	s.traceProvider = mocktracing.NewMockProvider(ctrl)
+	s.spanConfig = tracing.SpanConfig{}
+	s.templatesRepository = repositories.NewTemplatesRepository(
+		s.dbConnector,
+		s.logger,
+		s.traceProvider,
+		s.spanConfig,
+	)
+}
+
+func (s *TemplatesRepositoryTestSuite) SetupTest() {
+	s.NoError(
+		goose.Up(
+			s.dbConnector.Pool(),
+			path.Dir(
+				path.Dir(s.cwd),
+			)+migrationsDir,
+		),
+	)
+
+	connection, err := s.dbConnector.Connection(s.ctx)
+	s.NoError(err)
+
+	s.connection = connection
+}
+
+func (s *TemplatesRepositoryTestSuite) TearDownTest() {
+	s.NoError(
+		goose.DownTo(
+			s.dbConnector.Pool(),
+			path.Dir(
+				path.Dir(s.cwd),
+			)+migrationsDir,
+			gooseZeroVersion,
+		),
+	)
+
+	s.NoError(s.connection.Close())
+}
+
+func (s *TemplatesRepositoryTestSuite) TearDownSuite() {
+	s.NoError(s.dbConnector.Close())
+}
+
+func (s *TemplatesRepositoryTestSuite) expectSpans(times int) {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(times)
+}
+
+// insertTemplate inserts template with explicit ID, since SERIAL columns are not autoincremented by SQLite.
+func (s *TemplatesRepositoryTestSuite) insertTemplate(
+	id uint64,
+	notificationType entities.NotificationType,
+	locale string,
+	version uint32,
+	state entities.TemplateState,
+) {
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		`
+			INSERT INTO templates (id, notification_type, locale, version, state, html, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		`,
+		id,
+		notificationType,
+		locale,
+		version,
+		state,
+		"<p>{{ .Username }}</p>",
+		time.Now().UTC(),
+		time.Now().UTC(),
+	)
+	s.NoError(err)
+}
+
+func (s *TemplatesRepositoryTestSuite) TestSaveTemplateError() {
+	s.expectSpans(1)
+
+	// SQLite does not autoincrement SERIAL columns, so saving without explicit ID fails:
+	id, err := s.templatesRepository.SaveTemplate(
+		s.ctx,
+		entities.Template{
+			NotificationType: entities.NotificationTypeVerifyEmail,
+			Locale:           "ru",
+			State:            entities.TemplateStateDraft,
+			HTML:             "<p>{{ .Username }}</p>",
+			CreatedAt:        time.Now().UTC(),
+			UpdatedAt:        time.Now().UTC(),
+		},
+	)
+	s.Error(err)
+	s.Zero(id)
+}
+
+func (s *TemplatesRepositoryTestSuite) TestGetTemplateByID() {
+	s.expectSpans(2)
+
+	s.insertTemplate(1, entities.NotificationTypeVerifyEmail, "ru", 1, entities.TemplateStateDraft)
+
+	template, err := s.templatesRepository.GetTemplateByID(s.ctx, 1)
+	s.NoError(err)
+	s.Equal(uint64(1), template.ID)
+	s.Equal(entities.NotificationTypeVerifyEmail, template.NotificationType)
+	s.Equal("ru", template.Locale)
+	s.Equal(uint32(1), template.Version)
+	s.Equal(entities.TemplateStateDraft, template.State)
+	s.Equal("<p>{{ .Username }}</p>", template.HTML)
+	s.Empty(template.Subject)
+	s.Nil(template.PublishedAt)
+
+	template, err = s.templatesRepository.GetTemplateByID(s.ctx, 2)
+	s.ErrorAs(err, new(*customerrors.TemplateNotFoundError))
+	s.Nil(template)
+}
+
+func (s *TemplatesRepositoryTestSuite) TestGetTemplates() {
+	s.expectSpans(3)
+
+	s.insertTemplate(1, entities.NotificationTypeVerifyEmail, "ru", 1, entities.TemplateStateDraft)
+	s.insertTemplate(2, entities.NotificationTypeVerifyEmail, "ru", 2, entities.TemplateStateDraft)
+	s.insertTemplate(3, entities.NotificationTypeVerifyEmail, "en", 1, entities.TemplateStateDraft)
+	s.insertTemplate(4, entities.NotificationTypeTicketDeleted, "ru", 1, entities.TemplateStateDraft)
+
+	templates, err := s.templatesRepository.GetTemplates(s.ctx, entities.TemplatesFilters{})
+	s.NoError(err)
+	s.Len(templates, 4)
+
+	templates, err = s.templatesRepository.GetTemplates(
+		s.ctx,
+		entities.TemplatesFilters{
+			NotificationType: pointers.New(entities.NotificationTypeVerifyEmail),
+			Locale:           pointers.New("ru"),
+		},
+	)
+	s.NoError(err)
+	s.Len(templates, 2)
+
+	// Latest version goes first:
+	s.Equal(uint64(2), templates[0].ID)
+	s.Equal(uint64(1), templates[1].ID)
+
+	templates, err = s.templatesRepository.GetTemplates(
+		s.ctx,
+		entities.TemplatesFilters{Locale: pointers.New("de")},
+	)
+	s.NoError(err)
+	s.Empty(templates)
+}
+
+func (s *TemplatesRepositoryTestSuite) TestUpdateTemplate() {
+	s.expectSpans(3)
+
+	s.insertTemplate(1, entities.NotificationTypeVerifyEmail, "ru", 1, entities.TemplateStateDraft)
+	s.insertTemplate(2, entities.NotificationTypeVerifyEmail, "ru", 2, entities.TemplateStatePublished)
+
+	template := entities.Template{
+		ID:        1,
+		Subject:   "Subject",
+		HTML:      "<p>Updated</p>",
+		Text:      "Updated",
+		UpdatedAt: time.Now().UTC(),
+	}
+
+	updated, err := s.templatesRepository.UpdateTemplate(s.ctx, template)
+	s.NoError(err)
+	s.True(updated)
+
+	// Only drafts could be updated:
+	template.ID = 2
+	updated, err = s.templatesRepository.UpdateTemplate(s.ctx, template)
+	s.NoError(err)
+	s.False(updated)
+
+	stored, err := s.templatesRepository.GetTemplateByID(s.ctx, 1)
+	s.NoError(err)
+	s.Equal("Subject", stored.Subject)
+	s.Equal("<p>Updated</p>", stored.HTML)
+	s.Equal("Updated", stored.Text)
+}
+
+func (s *TemplatesRepositoryTestSuite) TestPublishTemplate() {
+	s.expectSpans(6)
+
+	s.insertTemplate(1, entities.NotificationTypeVerifyEmail, "ru", 1, entities.TemplateStatePublished)
+	s.insertTemplate(2, entities.NotificationTypeVerifyEmail, "ru", 2, entities.TemplateStateDraft)
+	s.insertTemplate(3, entities.NotificationTypeVerifyEmail, "en", 1, entities.TemplateStatePublished)
+
+	s.NoError(s.templatesRepository.PublishTemplate(s.ctx, 2, time.Now().UTC()))
+
+	published, err := s.templatesRepository.GetPublishedTemplates(s.ctx)
+	s.NoError(err)
+	s.Len(published, 2)
+	s.Equal(uint64(3), published[0].ID)
+	s.Equal(uint64(2), published[1].ID)
+	s.NotNil(published[1].PublishedAt)
+
+	archived, err := s.templatesRepository.GetTemplateByID(s.ctx, 1)
+	s.NoError(err)
+	s.Equal(entities.TemplateStateArchived, archived.State)
+
+	// Rollback to archived version:
+	s.NoError(s.templatesRepository.PublishTemplate(s.ctx, 1, time.Now().UTC()))
+
+	rolledBack, err := s.templatesRepository.GetTemplateByID(s.ctx, 2)
+	s.NoError(err)
+	s.Equal(entities.TemplateStateArchived, rolledBack.State)
+
+	err = s.templatesRepository.PublishTemplate(s.ctx, 10, time.Now().UTC())
+	s.ErrorAs(err, new(*customerrors.TemplateNotFoundError))
+}
@@ -2,8 +2,8 @@ package repositories
 
 import (
 	"context"
-	"fmt"
 	"sync"
+	"time"
 
 	"github.com/DKhorkov/libs/db"
 	"github.com/DKhorkov/libs/logging"
@@ -12,23 +12,19 @@ import (
 	sq "github.com/Masterminds/squirrel"
 
 	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-notifications/internal/errors"
 )
 
 const (
-	selectAllColumns       = "*"
-	selectCount            = "COUNT(*)"
-	emailsTableName        = "emails"
-	idColumnName           = "id"
-	userIDColumnName       = "user_id"
-	emailEmailColumnName   = "email"
-	emailContentColumnName = "content"
-	emailSentAtColumnName  = "sent_at"
-	returningIDSuffix      = "RETURNING id"
-	DESC                   = "DESC"
-	ASC                    = "ASC"
+	tokensTableName           = "tokens"
+	tokenNonceColumnName      = "nonce"
+	tokenPurposeColumnName    = "purpose"
+	tokenExpiresAtColumnName  = "expires_at"
+	tokenConsumedAtColumnName = "consumed_at"
+	tokenCreatedAtColumnName  = "created_at"
 )
 
-type EmailsRepository struct {
+type TokensRepository struct {
 	dbConnector   db.Connector
 	logger        logging.Logger
 	traceProvider tracing.Provider
@@ -36,13 +32,13 @@ type EmailsRepository struct {
 	mutex         *sync.RWMutex
 }
 
-func NewEmailsRepository(
+func NewTokensRepository(
 	dbConnector db.Connector,
 	logger logging.Logger,
 	traceProvider tracing.Provider,
 	spanConfig tracing.SpanConfig,
-) *EmailsRepository {
-	return &EmailsRepository{
+) *TokensRepository {
+	return &TokensRepository{
 		dbConnector:   dbConnector,
 		logger:        logger,
 		traceProvider: traceProvider,
@@ -51,11 +47,7 @@ func NewEmailsRepository(
 	}
 }
 
-func (repo *EmailsRepository) GetUserCommunications(
-	ctx context.Context,
-	userID uint64,
-	pagination *entities.Pagination,
-) ([]entities.Email, error) {
+func (repo *TokensRepository) SaveToken(ctx context.Context, token entities.Token) (uint64, error) {
 	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
 	defer span.End()
 
@@ -64,27 +56,67 @@ func (repo *EmailsRepository) GetUserCommunications(
 
 	connection, err := repo.dbConnector.Connection(ctx)
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
 
 	defer db.CloseConnectionContext(ctx, connection, repo.logger)
 
-	builder := sq.
-		Select(selectAllColumns).
-		From(emailsTableName).
-		Where(sq.Eq{userIDColumnName: userID}).
-		OrderBy(fmt.Sprintf("%s %s", idColumnName, DESC)).
-		PlaceholderFormat(sq.Dollar)
+	stmt, params, err := sq.
+		Insert(tokensTableName).
+		Columns(
+			tokenNonceColumnName,
+			userIDColumnName,
+			tokenPurposeColumnName,
+			tokenExpiresAtColumnName,
+			tokenCreatedAtColumnName,
+		).
+		Values(
+			token.Nonce,
+			token.UserID,
+			token.Purpose,
+			token.ExpiresAt,
+			token.CreatedAt,
+		).
+		Suffix(returningIDSuffix).
+		PlaceholderFormat(sq.Dollar). // pq postgres driver works only with $ placeholders
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
 
-	if pagination != nil && pagination.Limit != nil {
-		builder = builder.Limit(*pagination.Limit)
+	// Using mutex for concurrent-safety purpose of using via workers:
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	var tokenID uint64
+	if err = connection.QueryRowContext(ctx, stmt, params...).Scan(&tokenID); err != nil {
+		return 0, err
 	}
 
-	if pagination != nil && pagination.Offset != nil {
-		builder = builder.Offset(*pagination.Offset)
+	return tokenID, nil
+}
+
+// GetTokenByNonce returns issued token with provided nonce or TokenNotFoundError, if there is no such token.
+func (repo *TokensRepository) GetTokenByNonce(ctx context.Context, nonce string) (*entities.Token, error) {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	connection, err := repo.dbConnector.Connection(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	stmt, params, err := builder.ToSql()
+	defer db.CloseConnectionContext(ctx, connection, repo.logger)
+
+	stmt, params, err := sq.
+		Select(selectAllColumns).
+		From(tokensTableName).
+		Where(sq.Eq{tokenNonceColumnName: nonce}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
 	if err != nil {
 		return nil, err
 	}
@@ -93,11 +125,7 @@ func (repo *EmailsRepository) GetUserCommunications(
 	repo.mutex.RLock()
 	defer repo.mutex.RUnlock()
 
-	rows, err := connection.QueryContext(
-		ctx,
-		stmt,
-		params...,
-	)
+	rows, err := connection.QueryContext(ctx, stmt, params...)
 	if err != nil {
 		return nil, err
 	}
@@ -113,71 +141,26 @@ func (repo *EmailsRepository) GetUserCommunications(
 		}
 	}()
 
-	var emails []entities.Email
-
-	for rows.Next() {
-		email := entities.Email{}
-		columns := db.GetEntityColumns(&email) // Only pointer to use rows.Scan() successfully
-
-		err = rows.Scan(columns...)
-		if err != nil {
+	if !rows.Next() {
+		if err = rows.Err(); err != nil {
 			return nil, err
 		}
 
-		emails = append(emails, email)
+		return nil, &customerrors.TokenNotFoundError{}
 	}
 
-	if err = rows.Err(); err != nil {
+	token := &entities.Token{}
+	if err = rows.Scan(db.GetEntityColumns(token)...); err != nil {
 		return nil, err
 	}
 
-	return emails, nil
-}
-
-func (repo *EmailsRepository) CountUserCommunications(
-	ctx context.Context,
-	userID uint64,
-) (uint64, error) {
-	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
-	defer span.End()
-
-	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
-	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
-
-	connection, err := repo.dbConnector.Connection(ctx)
-	if err != nil {
-		return 0, err
-	}
-
-	defer db.CloseConnectionContext(ctx, connection, repo.logger)
-
-	builder := sq.
-		Select(selectCount).
-		From(emailsTableName).
-		Where(sq.Eq{userIDColumnName: userID}).
-		PlaceholderFormat(sq.Dollar)
-
-	stmt, params, err := builder.ToSql()
-	if err != nil {
-		return 0, err
-	}
-
-	// Using mutex for concurrent-safety purpose of using via workers:
-	repo.mutex.RLock()
-	defer repo.mutex.RUnlock()
-
-	var count uint64
-	if err = connection.QueryRowContext(ctx, stmt, params...).Scan(&count); err != nil {
-		return 0, err
-	}
-
-	return count, nil
+	return token, nil
 }
 
-func (repo *EmailsRepository) SaveCommunication(
-	ctx context.Context,
-	email entities.Email,
-) (uint64, error) {
+// ConsumeToken marks token as consumed at provided time, if it was neither consumed nor expired before.
+// Returns false, if token could not be consumed, so that the same token is consumed only once even by
+// concurrent calls.
+func (repo *TokensRepository) ConsumeToken(ctx context.Context, nonce string, consumedAt time.Time) (bool, error) {
 	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
 	defer span.End()
 
@@ -186,40 +169,40 @@ func (repo *EmailsRepository) SaveCommunication(
 
 	connection, err := repo.dbConnector.Connection(ctx)
 	if err != nil {
-		return 0, err
+		return false, err
 	}
 
 	defer db.CloseConnectionContext(ctx, connection, repo.logger)
 
 	stmt, params, err := sq.
-		Insert(emailsTableName).
-		Columns(
-			userIDColumnName,
-			emailEmailColumnName,
-			emailContentColumnName,
-			emailSentAtColumnName,
+		Update(tokensTableName).
+		Set(tokenConsumedAtColumnName, consumedAt).
+		Where(
+			sq.And{
+				sq.Eq{tokenNonceColumnName: nonce},
+				sq.Eq{tokenConsumedAtColumnName: nil},
+				sq.Gt{tokenExpiresAtColumnName: consumedAt},
+			},
 		).
-		Values(
-			email.UserID,
-			email.Email,
-			email.Content,
-			email.SentAt,
-		).
-		Suffix(returningIDSuffix).
-		PlaceholderFormat(sq.Dollar). // pq postgres driver works only with $ placeholders
+		PlaceholderFormat(sq.Dollar).
 		ToSql()
 	if err != nil {
-		return 0, err
+		return false, err
 	}
 
 	// Using mutex for concurrent-safety purpose of using via workers:
 	repo.mutex.Lock()
 	defer repo.mutex.Unlock()
 
-	var emailCommunicationID uint64
-	if err = connection.QueryRowContext(ctx, stmt, params...).Scan(&emailCommunicationID); err != nil {
-		return 0, err
+	result, err := connection.ExecContext(ctx, stmt, params...)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
 	}
 
-	return emailCommunicationID, nil
+	return affected == 1, nil
 }
@@ -0,0 +1,200 @@
+//go:build integration
+
+package repositories_test
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3" // Must be imported for correct work
+
+	"github.com/pressly/goose/v3"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/DKhorkov/libs/db"
+	mocklogging "github.com/DKhorkov/libs/logging/mocks"
+	"github.com/DKhorkov/libs/tracing"
+	mocktracing "github.com/DKhorkov/libs/tracing/mocks"
+
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-notifications/internal/errors"
+	"github.com/DKhorkov/hmtm-notifications/internal/repositories"
+)
+
+func TestTokensRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(TokensRepositoryTestSuite))
+}
+
+type TokensRepositoryTestSuite struct {
+	suite.Suite
+
+	cwd              string
+	ctx              context.Context
+	dbConnector      db.Connector
+	connection       *sql.Conn
+	tokensRepository *repositories.TokensRepository
+	logger           *mocklogging.MockLogger
+	traceProvider    *mocktracing.MockProvider
+	spanConfig       tracing.SpanConfig
+}
+
+func (s *TokensRepositoryTestSuite) SetupSuite() {
+	s.NoError(goose.SetDialect(driver))
+
+	ctrl := gomock.NewController(s.T())
+	s.ctx = context.Background()
+	s.logger = mocklogging.NewMockLogger(ctrl)
+	dbConnector, err := db.New(dsn, driver, s.logger)
+	s.NoError(err)
+
+	cwd, err := os.Getwd()
+	s.NoError(err)
+
+	s.cwd = cwd
+	s.dbConnector = dbConnector
+	s.traceProvider = mocktracing.NewMockProvider(ctrl)
+	s.spanConfig = tracing.SpanConfig{}
+	s.tokensRepository = repositories.NewTokensRepository(
+		s.dbConnector,
+		s.logger,
+		s.traceProvider,
+		s.spanConfig,
+	)
+}
+
+func (s *TokensRepositoryTestSuite) SetupTest() {
+	s.NoError(
+		goose.Up(
+			s.dbConnector.Pool(),
+			path.Dir(
+				path.Dir(s.cwd),
+			)+migrationsDir,
+		),
+	)
+
+	connection, err := s.dbConnector.Connection(s.ctx)
+	s.NoError(err)
+
+	s.connection = connection
+}
+
+func (s *TokensRepositoryTestSuite) TearDownTest() {
+	s.NoError(
+		goose.DownTo(
+			s.dbConnector.Pool(),
+			path.Dir(
+				path.Dir(s.cwd),
+			)+migrationsDir,
+			gooseZeroVersion,
+		),
+	)
+
+	s.NoError(s.connection.Close())
+}
+
+func (s *TokensRepositoryTestSuite) TearDownSuite() {
+	s.NoError(s.dbConnector.Close())
+}
+
+func (s *TokensRepositoryTestSuite) expectSpans(times int) {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(times)
+}
+
+// insertToken inserts token with explicit ID, since SERIAL columns are not autoincremented by SQLite.
+func (s *TokensRepositoryTestSuite) insertToken(id uint64, nonce string, expiresAt time.Time) {
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		`
+			INSERT INTO tokens (id, nonce, user_id, purpose, expires_at, created_at) 
+			VALUES ($1, $2, $3, $4, $5, $6)
+		`,
+		id,
+		nonce,
+		1,
+		entities.TokenPurposeForgetPassword,
+		expiresAt,
+		time.Now().UTC(),
+	)
+	s.NoError(err)
+}
+
+func (s *TokensRepositoryTestSuite) TestSaveTokenError() {
+	s.expectSpans(1)
+
+	// SQLite does not autoincrement SERIAL columns, so saving without explicit ID fails:
+	id, err := s.tokensRepository.SaveToken(
+		s.ctx,
+		entities.Token{
+			Nonce:     "nonce",
+			UserID:    1,
+			Purpose:   entities.TokenPurposeVerifyEmail,
+			ExpiresAt: time.Now().UTC().Add(time.Hour),
+			CreatedAt: time.Now().UTC(),
+		},
+	)
+	s.Error(err)
+	s.Zero(id)
+}
+
+func (s *TokensRepositoryTestSuite) TestGetTokenByNonce() {
+	s.expectSpans(2)
+
+	expiresAt := time.Now().UTC().Add(time.Hour).Truncate(time.Second)
+	s.insertToken(1, "nonce", expiresAt)
+
+	token, err := s.tokensRepository.GetTokenByNonce(s.ctx, "nonce")
+	s.NoError(err)
+	s.Equal(uint64(1), token.ID)
+	s.Equal(uint64(1), token.UserID)
+	s.Equal(entities.TokenPurposeForgetPassword, token.Purpose)
+	s.True(expiresAt.Equal(token.ExpiresAt))
+	s.Nil(token.ConsumedAt)
+
+	token, err = s.tokensRepository.GetTokenByNonce(s.ctx, "unknown")
+	s.ErrorAs(err, new(*customerrors.TokenNotFoundError))
+	s.Nil(token)
+}
+
+func (s *TokensRepositoryTestSuite) TestConsumeToken() {
+	s.expectSpans(3)
+
+	s.insertToken(1, "nonce", time.Now().UTC().Add(time.Hour))
+
+	consumedAt := time.Now().UTC()
+	consumed, err := s.tokensRepository.ConsumeToken(s.ctx, "nonce", consumedAt)
+	s.NoError(err)
+	s.True(consumed)
+
+	// Token is consumed only once:
+	consumed, err = s.tokensRepository.ConsumeToken(s.ctx, "nonce", time.Now().UTC())
+	s.NoError(err)
+	s.False(consumed)
+
+	token, err := s.tokensRepository.GetTokenByNonce(s.ctx, "nonce")
+	s.NoError(err)
+	s.NotNil(token.ConsumedAt)
+	s.True(consumedAt.Equal(*token.ConsumedAt))
+}
+
+func (s *TokensRepositoryTestSuite) TestConsumeExpiredToken() {
+	s.expectSpans(2)
+
+	s.insertToken(1, "nonce", time.Now().UTC().Add(-time.Minute))
+
+	consumed, err := s.tokensRepository.ConsumeToken(s.ctx, "nonce", time.Now().UTC())
+	s.NoError(err)
+	s.False(consumed)
+
+	consumed, err = s.tokensRepository.ConsumeToken(s.ctx, "unknown", time.Now().UTC())
+	s.NoError(err)
+	s.False(consumed)
+}
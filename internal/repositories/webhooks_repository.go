@@ -0,0 +1,833 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/DKhorkov/libs/db"
+	"github.com/DKhorkov/libs/logging"
+	"github.com/DKhorkov/libs/tracing"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-notifications/internal/errors"
+)
+
+const (
+	webhooksTableName                          = "webhooks"
+	webhookURLColumnName                       = "url"
+	webhookSecretColumnName                    = "secret"
+	webhookEventTypesColumnName                = "event_types"
+	webhookEnabledColumnName                   = "enabled"
+	webhookConsecutiveFailuresColumnName       = "consecutive_failures"
+	webhookCreatedAtColumnName                 = "created_at"
+	webhookUpdatedAtColumnName                 = "updated_at"
+	webhookDisabledAtColumnName                = "disabled_at"
+	webhookEventTypesSeparator                 = ","
+	webhookDeliveriesTableName                 = "webhook_deliveries"
+	webhookDeliveryWebhookIDColumnName         = "webhook_id"
+	webhookDeliveryDeliveryIDColumnName        = "delivery_id"
+	webhookDeliveryEventTypeColumnName         = "event_type"
+	webhookDeliveryPayloadColumnName           = "payload"
+	webhookDeliveryStatusColumnName            = "status"
+	webhookDeliveryAttemptsColumnName          = "attempts"
+	webhookDeliveryLastErrorColumnName         = "last_error"
+	webhookDeliveryNextAttemptAtColumnName     = "next_attempt_at"
+	webhookDeliveryCreatedAtColumnName         = "created_at"
+	webhookDeliveryDeliveredAtColumnName       = "delivered_at"
+	webhookDeliveryDeferralsColumnName         = "deferrals"
+	webhookIncrementFailuresExpression         = "consecutive_failures + 1"
+	webhookIncrementDeferralsExpression        = "deferrals + 1"
+	webhookDisableOnFailuresExpressionTemplate = "CASE WHEN consecutive_failures + 1 >= ? THEN ? ELSE %s END"
+	webhookKeepDisabledAtExpressionTemplate    = "COALESCE(%s, ?)"
+)
+
+type WebhooksRepository struct {
+	dbConnector   db.Connector
+	logger        logging.Logger
+	traceProvider tracing.Provider
+	spanConfig    tracing.SpanConfig
+	mutex         *sync.RWMutex
+}
+
+func NewWebhooksRepository(
+	dbConnector db.Connector,
+	logger logging.Logger,
+	traceProvider tracing.Provider,
+	spanConfig tracing.SpanConfig,
+) *WebhooksRepository {
+	return &WebhooksRepository{
+		dbConnector:   dbConnector,
+		logger:        logger,
+		traceProvider: traceProvider,
+		spanConfig:    spanConfig,
+		mutex:         new(sync.RWMutex),
+	}
+}
+
+func (repo *WebhooksRepository) GetWebhooks(ctx context.Context) ([]entities.Webhook, error) {
+	return repo.getWebhooks(ctx, nil)
+}
+
+// GetEnabledWebhooks returns webhooks, which should receive new events.
+func (repo *WebhooksRepository) GetEnabledWebhooks(ctx context.Context) ([]entities.Webhook, error) {
+	return repo.getWebhooks(ctx, sq.Eq{webhookEnabledColumnName: true})
+}
+
+func (repo *WebhooksRepository) GetWebhookByID(ctx context.Context, id uint64) (*entities.Webhook, error) {
+	webhooks, err := repo.getWebhooks(ctx, sq.Eq{idColumnName: id})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(webhooks) == 0 {
+		return nil, &customerrors.WebhookNotFoundError{}
+	}
+
+	return &webhooks[0], nil
+}
+
+func (repo *WebhooksRepository) SaveWebhook(ctx context.Context, webhook entities.Webhook) (uint64, error) {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	connection, err := repo.dbConnector.Connection(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	defer db.CloseConnectionContext(ctx, connection, repo.logger)
+
+	stmt, params, err := sq.
+		Insert(webhooksTableName).
+		Columns(
+			webhookURLColumnName,
+			webhookSecretColumnName,
+			webhookEventTypesColumnName,
+			webhookEnabledColumnName,
+			webhookConsecutiveFailuresColumnName,
+			webhookCreatedAtColumnName,
+			webhookUpdatedAtColumnName,
+		).
+		Values(
+			webhook.URL,
+			webhook.Secret,
+			formatWebhookEventTypes(webhook.EventTypes),
+			webhook.Enabled,
+			webhook.ConsecutiveFailures,
+			webhook.CreatedAt,
+			webhook.UpdatedAt,
+		).
+		Suffix(returningIDSuffix).
+		PlaceholderFormat(sq.Dollar). // pq postgres driver works only with $ placeholders
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	// Using mutex for concurrent-safety purpose of using via workers:
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	var webhookID uint64
+	if err = connection.QueryRowContext(ctx, stmt, params...).Scan(&webhookID); err != nil {
+		return 0, err
+	}
+
+	return webhookID, nil
+}
+
+// UpdateWebhook updates URL, event types and enabled flag of webhook. Enabling of webhook resets counter of its
+// failed deliveries, so that webhook, which was disabled automatically, gets the same chances as a new one.
+func (repo *WebhooksRepository) UpdateWebhook(ctx context.Context, webhook entities.Webhook) error {
+	values := map[string]any{
+		webhookURLColumnName:        webhook.URL,
+		webhookEventTypesColumnName: formatWebhookEventTypes(webhook.EventTypes),
+		webhookEnabledColumnName:    webhook.Enabled,
+		webhookUpdatedAtColumnName:  time.Now().UTC(),
+	}
+
+	if webhook.Enabled {
+		values[webhookConsecutiveFailuresColumnName] = 0
+		values[webhookDisabledAtColumnName] = nil
+	} else {
+		values[webhookDisabledAtColumnName] = sq.Expr(
+			fmt.Sprintf(webhookKeepDisabledAtExpressionTemplate, webhookDisabledAtColumnName),
+			time.Now().UTC(),
+		)
+	}
+
+	affected, err := repo.updateWebhooks(ctx, sq.Eq{idColumnName: webhook.ID}, values)
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return &customerrors.WebhookNotFoundError{}
+	}
+
+	return nil
+}
+
+// DeleteWebhook deletes webhook with its deliveries log. Returns WebhookNotFoundError, if there is no
+// webhook with provided ID.
+func (repo *WebhooksRepository) DeleteWebhook(ctx context.Context, id uint64) error {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	connection, err := repo.dbConnector.Connection(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer db.CloseConnectionContext(ctx, connection, repo.logger)
+
+	// Deliveries are deleted explicitly, since foreign keys could be not enforced by database:
+	deleteDeliveriesStmt, deleteDeliveriesParams, err := sq.
+		Delete(webhookDeliveriesTableName).
+		Where(sq.Eq{webhookDeliveryWebhookIDColumnName: id}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	stmt, params, err := sq.
+		Delete(webhooksTableName).
+		Where(sq.Eq{idColumnName: id}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	// Using mutex for concurrent-safety purpose of using via workers:
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	transaction, err := connection.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	// Rollback after commit does nothing:
+	defer func() {
+		_ = transaction.Rollback()
+	}()
+
+	if _, err = transaction.ExecContext(ctx, deleteDeliveriesStmt, deleteDeliveriesParams...); err != nil {
+		return err
+	}
+
+	result, err := transaction.ExecContext(ctx, stmt, params...)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return &customerrors.WebhookNotFoundError{}
+	}
+
+	return transaction.Commit()
+}
+
+// RegisterWebhookFailure increments counter of failed deliveries in a row and disables webhook, if counter
+// reaches disableThreshold. Returns true, if webhook was disabled by this call.
+func (repo *WebhooksRepository) RegisterWebhookFailure(
+	ctx context.Context,
+	id uint64,
+	disableThreshold int,
+) (bool, error) {
+	now := time.Now().UTC()
+	affected, err := repo.updateWebhooks(
+		ctx,
+		sq.And{
+			sq.Eq{idColumnName: id},
+			sq.Eq{webhookEnabledColumnName: true},
+		},
+		map[string]any{
+			webhookConsecutiveFailuresColumnName: sq.Expr(webhookIncrementFailuresExpression),
+			webhookEnabledColumnName: sq.Expr(
+				fmt.Sprintf(webhookDisableOnFailuresExpressionTemplate, webhookEnabledColumnName),
+				disableThreshold,
+				false,
+			),
+			webhookDisabledAtColumnName: sq.Expr(
+				fmt.Sprintf(webhookDisableOnFailuresExpressionTemplate, webhookDisabledAtColumnName),
+				disableThreshold,
+				now,
+			),
+		},
+	)
+	if err != nil || affected == 0 {
+		return false, err
+	}
+
+	webhook, err := repo.GetWebhookByID(ctx, id)
+	if err != nil {
+		return false, err
+	}
+
+	return !webhook.Enabled, nil
+}
+
+// ResetWebhookFailures resets counter of failed deliveries in a row after successful delivery.
+func (repo *WebhooksRepository) ResetWebhookFailures(ctx context.Context, id uint64) error {
+	_, err := repo.updateWebhooks(
+		ctx,
+		sq.And{
+			sq.Eq{idColumnName: id},
+			sq.NotEq{webhookConsecutiveFailuresColumnName: 0},
+		},
+		map[string]any{
+			webhookConsecutiveFailuresColumnName: 0,
+		},
+	)
+
+	return err
+}
+
+// GetWebhookDeliveries returns deliveries log of webhook, starting from the latest delivery.
+func (repo *WebhooksRepository) GetWebhookDeliveries(
+	ctx context.Context,
+	webhookID uint64,
+	pagination *entities.Pagination,
+) ([]entities.WebhookDelivery, error) {
+	builder := sq.
+		Select(selectAllColumns).
+		From(webhookDeliveriesTableName).
+		Where(sq.Eq{webhookDeliveryWebhookIDColumnName: webhookID}).
+		OrderBy(fmt.Sprintf("%s %s", idColumnName, DESC)).
+		PlaceholderFormat(sq.Dollar)
+
+	if pagination != nil && pagination.Limit != nil {
+		builder = builder.Limit(*pagination.Limit)
+	}
+
+	if pagination != nil && pagination.Offset != nil {
+		builder = builder.Offset(*pagination.Offset)
+	}
+
+	return repo.getWebhookDeliveries(ctx, builder)
+}
+
+func (repo *WebhooksRepository) SaveWebhookDelivery(
+	ctx context.Context,
+	delivery entities.WebhookDelivery,
+) (uint64, error) {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	connection, err := repo.dbConnector.Connection(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	defer db.CloseConnectionContext(ctx, connection, repo.logger)
+
+	stmt, params, err := sq.
+		Insert(webhookDeliveriesTableName).
+		Columns(
+			webhookDeliveryWebhookIDColumnName,
+			webhookDeliveryDeliveryIDColumnName,
+			webhookDeliveryEventTypeColumnName,
+			webhookDeliveryPayloadColumnName,
+			webhookDeliveryStatusColumnName,
+			webhookDeliveryAttemptsColumnName,
+			webhookDeliveryLastErrorColumnName,
+			webhookDeliveryNextAttemptAtColumnName,
+			webhookDeliveryCreatedAtColumnName,
+			webhookDeliveryDeliveredAtColumnName,
+		).
+		Values(
+			delivery.WebhookID,
+			delivery.DeliveryID,
+			delivery.EventType,
+			delivery.Payload,
+			delivery.Status,
+			delivery.Attempts,
+			delivery.LastError,
+			delivery.NextAttemptAt,
+			delivery.CreatedAt,
+			delivery.DeliveredAt,
+		).
+		Suffix(returningIDSuffix).
+		PlaceholderFormat(sq.Dollar). // pq postgres driver works only with $ placeholders
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	// Using mutex for concurrent-safety purpose of using via workers:
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	var webhookDeliveryID uint64
+	if err = connection.QueryRowContext(ctx, stmt, params...).Scan(&webhookDeliveryID); err != nil {
+		return 0, err
+	}
+
+	return webhookDeliveryID, nil
+}
+
+func (repo *WebhooksRepository) GetPendingWebhookDeliveries(
+	ctx context.Context,
+	limit uint64,
+) ([]entities.WebhookDelivery, error) {
+	// Processing deliveries are also selected to be able to retake them after expiration of
+	// their lease, for example, if dispatcher crashed during sending:
+	return repo.getWebhookDeliveries(
+		ctx,
+		sq.
+			Select(selectAllColumns).
+			From(webhookDeliveriesTableName).
+			Where(
+				sq.Eq{
+					webhookDeliveryStatusColumnName: []entities.WebhookDeliveryStatus{
+						entities.WebhookDeliveryStatusPending,
+						entities.WebhookDeliveryStatusProcessing,
+					},
+				},
+			).
+			Where(sq.LtOrEq{webhookDeliveryNextAttemptAtColumnName: time.Now().UTC()}).
+			OrderBy(
+				fmt.Sprintf("%s %s", webhookDeliveryNextAttemptAtColumnName, ASC),
+				fmt.Sprintf("%s %s", idColumnName, ASC),
+			).
+			Limit(limit).
+			PlaceholderFormat(sq.Dollar),
+	)
+}
+
+// ClaimWebhookDelivery takes delivery for sending, if it was not taken by someone else after it was read.
+// Claimed delivery will not be returned by GetPendingWebhookDeliveries until leaseUntil.
+func (repo *WebhooksRepository) ClaimWebhookDelivery(
+	ctx context.Context,
+	delivery entities.WebhookDelivery,
+	leaseUntil time.Time,
+) (bool, error) {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	connection, err := repo.dbConnector.Connection(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	defer db.CloseConnectionContext(ctx, connection, repo.logger)
+
+	// Status and attempts are used as optimistic lock, so only one dispatcher could claim delivery:
+	stmt, params, err := sq.
+		Update(webhookDeliveriesTableName).
+		Set(webhookDeliveryStatusColumnName, entities.WebhookDeliveryStatusProcessing).
+		Set(webhookDeliveryAttemptsColumnName, delivery.Attempts+1).
+		Set(webhookDeliveryNextAttemptAtColumnName, leaseUntil).
+		Where(
+			sq.Eq{
+				idColumnName:                      delivery.ID,
+				webhookDeliveryStatusColumnName:   delivery.Status,
+				webhookDeliveryAttemptsColumnName: delivery.Attempts,
+			},
+		).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return false, err
+	}
+
+	// Using mutex for concurrent-safety purpose of using via workers:
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	result, err := connection.ExecContext(ctx, stmt, params...)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected == 1, nil
+}
+
+// MarkWebhookDeliveryDelivered marks claimed delivery as delivered. Attempts is a number of attempts, set on
+// claiming. Returns CommunicationLeaseLostError, if delivery was retaken by another dispatcher after lease expiration.
+func (repo *WebhooksRepository) MarkWebhookDeliveryDelivered(ctx context.Context, id uint64, attempts uint32) error {
+	return repo.updateClaimedWebhookDelivery(
+		ctx,
+		id,
+		attempts,
+		map[string]any{
+			webhookDeliveryStatusColumnName:        entities.WebhookDeliveryStatusDelivered,
+			webhookDeliveryLastErrorColumnName:     nil,
+			webhookDeliveryNextAttemptAtColumnName: nil,
+			webhookDeliveryDeliveredAtColumnName:   time.Now().UTC(),
+		},
+	)
+}
+
+func (repo *WebhooksRepository) RescheduleWebhookDelivery(
+	ctx context.Context,
+	id uint64,
+	attempts uint32,
+	lastError string,
+	nextAttemptAt time.Time,
+) error {
+	return repo.updateClaimedWebhookDelivery(
+		ctx,
+		id,
+		attempts,
+		map[string]any{
+			webhookDeliveryStatusColumnName:        entities.WebhookDeliveryStatusPending,
+			webhookDeliveryLastErrorColumnName:     lastError,
+			webhookDeliveryNextAttemptAtColumnName: nextAttemptAt,
+		},
+	)
+}
+
+// DeferWebhookDelivery returns delivery to pending status without consuming sending attempt and counts deferral.
+// Used, when receiver asked to slow down.
+func (repo *WebhooksRepository) DeferWebhookDelivery(
+	ctx context.Context,
+	id uint64,
+	attempts uint32,
+	nextAttemptAt time.Time,
+) error {
+	return repo.updateClaimedWebhookDelivery(
+		ctx,
+		id,
+		attempts,
+		map[string]any{
+			webhookDeliveryStatusColumnName:        entities.WebhookDeliveryStatusPending,
+			webhookDeliveryAttemptsColumnName:      attempts - 1,
+			webhookDeliveryNextAttemptAtColumnName: nextAttemptAt,
+			webhookDeliveryDeferralsColumnName:     sq.Expr(webhookIncrementDeferralsExpression),
+		},
+	)
+}
+
+func (repo *WebhooksRepository) MarkWebhookDeliveryFailed(
+	ctx context.Context,
+	id uint64,
+	attempts uint32,
+	lastError string,
+) error {
+	return repo.updateClaimedWebhookDelivery(
+		ctx,
+		id,
+		attempts,
+		map[string]any{
+			webhookDeliveryStatusColumnName:        entities.WebhookDeliveryStatusFailed,
+			webhookDeliveryLastErrorColumnName:     lastError,
+			webhookDeliveryNextAttemptAtColumnName: nil,
+		},
+	)
+}
+
+func (repo *WebhooksRepository) getWebhooks(ctx context.Context, condition sq.Sqlizer) ([]entities.Webhook, error) {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel+1))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	connection, err := repo.dbConnector.Connection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer db.CloseConnectionContext(ctx, connection, repo.logger)
+
+	builder := sq.
+		Select(
+			idColumnName,
+			webhookURLColumnName,
+			webhookSecretColumnName,
+			webhookEventTypesColumnName,
+			webhookEnabledColumnName,
+			webhookConsecutiveFailuresColumnName,
+			webhookCreatedAtColumnName,
+			webhookUpdatedAtColumnName,
+			webhookDisabledAtColumnName,
+		).
+		From(webhooksTableName).
+		OrderBy(fmt.Sprintf("%s %s", idColumnName, ASC)).
+		PlaceholderFormat(sq.Dollar)
+
+	if condition != nil {
+		builder = builder.Where(condition)
+	}
+
+	stmt, params, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	// Using mutex for concurrent-safety purpose of using via workers:
+	repo.mutex.RLock()
+	defer repo.mutex.RUnlock()
+
+	rows, err := connection.QueryContext(
+		ctx,
+		stmt,
+		params...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err = rows.Close(); err != nil {
+			logging.LogErrorContext(
+				ctx,
+				repo.logger,
+				"error during closing SQL rows",
+				err,
+			)
+		}
+	}()
+
+	var webhooks []entities.Webhook
+
+	for rows.Next() {
+		var (
+			webhook       entities.Webhook
+			rawEventTypes string
+		)
+
+		if err = rows.Scan(
+			&webhook.ID,
+			&webhook.URL,
+			&webhook.Secret,
+			&rawEventTypes,
+			&webhook.Enabled,
+			&webhook.ConsecutiveFailures,
+			&webhook.CreatedAt,
+			&webhook.UpdatedAt,
+			&webhook.DisabledAt,
+		); err != nil {
+			return nil, err
+		}
+
+		webhook.EventTypes = parseWebhookEventTypes(rawEventTypes)
+		webhooks = append(webhooks, webhook)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return webhooks, nil
+}
+
+func (repo *WebhooksRepository) getWebhookDeliveries(
+	ctx context.Context,
+	builder sq.SelectBuilder,
+) ([]entities.WebhookDelivery, error) {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel+1))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	connection, err := repo.dbConnector.Connection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer db.CloseConnectionContext(ctx, connection, repo.logger)
+
+	stmt, params, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	// Using mutex for concurrent-safety purpose of using via workers:
+	repo.mutex.RLock()
+	defer repo.mutex.RUnlock()
+
+	rows, err := connection.QueryContext(
+		ctx,
+		stmt,
+		params...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err = rows.Close(); err != nil {
+			logging.LogErrorContext(
+				ctx,
+				repo.logger,
+				"error during closing SQL rows",
+				err,
+			)
+		}
+	}()
+
+	var deliveries []entities.WebhookDelivery
+
+	for rows.Next() {
+		delivery := entities.WebhookDelivery{}
+		columns := db.GetEntityColumns(&delivery) // Only pointer to use rows.Scan() successfully
+
+		err = rows.Scan(columns...)
+		if err != nil {
+			return nil, err
+		}
+
+		deliveries = append(deliveries, delivery)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+func (repo *WebhooksRepository) updateWebhooks(
+	ctx context.Context,
+	condition sq.Sqlizer,
+	values map[string]any,
+) (int64, error) {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel+1))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	connection, err := repo.dbConnector.Connection(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	defer db.CloseConnectionContext(ctx, connection, repo.logger)
+
+	stmt, params, err := sq.
+		Update(webhooksTableName).
+		SetMap(values).
+		Where(condition).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	// Using mutex for concurrent-safety purpose of using via workers:
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	result, err := connection.ExecContext(ctx, stmt, params...)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
+
+// updateClaimedWebhookDelivery updates delivery only if it is still claimed with provided attempts.
+// Status and attempts are used as optimistic lock the same way as in ClaimWebhookDelivery.
+func (repo *WebhooksRepository) updateClaimedWebhookDelivery(
+	ctx context.Context,
+	id uint64,
+	attempts uint32,
+	values map[string]any,
+) error {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel+1))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	connection, err := repo.dbConnector.Connection(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer db.CloseConnectionContext(ctx, connection, repo.logger)
+
+	stmt, params, err := sq.
+		Update(webhookDeliveriesTableName).
+		SetMap(values).
+		Where(
+			sq.Eq{
+				idColumnName:                      id,
+				webhookDeliveryStatusColumnName:   entities.WebhookDeliveryStatusProcessing,
+				webhookDeliveryAttemptsColumnName: attempts,
+			},
+		).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	// Using mutex for concurrent-safety purpose of using via workers:
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	result, err := connection.ExecContext(ctx, stmt, params...)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return &customerrors.CommunicationLeaseLostError{Message: "webhook delivery lease was lost"}
+	}
+
+	return nil
+}
+
+func formatWebhookEventTypes(eventTypes []entities.NotificationType) string {
+	rawEventTypes := make([]string, 0, len(eventTypes))
+	for _, eventType := range eventTypes {
+		rawEventTypes = append(rawEventTypes, string(eventType))
+	}
+
+	return strings.Join(rawEventTypes, webhookEventTypesSeparator)
+}
+
+func parseWebhookEventTypes(rawEventTypes string) []entities.NotificationType {
+	if rawEventTypes == "" {
+		return nil
+	}
+
+	parts := strings.Split(rawEventTypes, webhookEventTypesSeparator)
+	eventTypes := make([]entities.NotificationType, 0, len(parts))
+
+	for _, part := range parts {
+		eventTypes = append(eventTypes, entities.NotificationType(part))
+	}
+
+	return eventTypes
+}
@@ -0,0 +1,426 @@
+//go:build integration
+
+package repositories_test
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3" // Must be imported for correct work
+
+	"github.com/pressly/goose/v3"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/DKhorkov/libs/db"
+	mocklogging "github.com/DKhorkov/libs/logging/mocks"
+	"github.com/DKhorkov/libs/pointers"
+	"github.com/DKhorkov/libs/tracing"
+	mocktracing "github.com/DKhorkov/libs/tracing/mocks"
+
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-notifications/internal/errors"
+	"github.com/DKhorkov/hmtm-notifications/internal/repositories"
+)
+
+func TestWebhooksRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(WebhooksRepositoryTestSuite))
+}
+
+type WebhooksRepositoryTestSuite struct {
+	suite.Suite
+
+	cwd                string
+	ctx                context.Context
+	dbConnector        db.Connector
+	connection         *sql.Conn
+	webhooksRepository *repositories.WebhooksRepository
+	logger             *mocklogging.MockLogger
+	traceProvider      *mocktracing.MockProvider
+	spanConfig         tracing.SpanConfig
+}
+
+func (s *WebhooksRepositoryTestSuite) SetupSuite() {
+	s.NoError(goose.SetDialect(driver))
+
+	ctrl := gomock.NewController(s.T())
+	s.ctx = context.Background()
+	s.logger = mocklogging.NewMockLogger(ctrl)
+	dbConnector, err := db.New(dsn, driver, s.logger)
+	s.NoError(err)
+
+	cwd, err := os.Getwd()
+	s.NoError(err)
+
+	s.cwd = cwd
+	s.dbConnector = dbConnector
+	s.traceProvider = mocktracing.NewMockProvider(ctrl)
+	s.spanConfig = tracing.SpanConfig{}
+	s.webhooksRepository = repositories.NewWebhooksRepository(
+		s.dbConnector,
+		s.logger,
+		s.traceProvider,
+		s.spanConfig,
+	)
+}
+
+func (s *WebhooksRepositoryTestSuite) SetupTest() {
+	s.NoError(
+		goose.Up(
+			s.dbConnector.Pool(),
+			path.Dir(
+				path.Dir(s.cwd),
+			)+migrationsDir,
+		),
+	)
+
+	connection, err := s.dbConnector.Connection(s.ctx)
+	s.NoError(err)
+
+	s.connection = connection
+}
+
+func (s *WebhooksRepositoryTestSuite) TearDownTest() {
+	s.NoError(
+		goose.DownTo(
+			s.dbConnector.Pool(),
+			path.Dir(
+				path.Dir(s.cwd),
+			)+migrationsDir,
+			gooseZeroVersion,
+		),
+	)
+
+	s.NoError(s.connection.Close())
+}
+
+func (s *WebhooksRepositoryTestSuite) TearDownSuite() {
+	s.NoError(s.dbConnector.Close())
+}
+
+func (s *WebhooksRepositoryTestSuite) expectSpans(times int) {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(times)
+}
+
+// insertWebhook inserts webhook with explicit ID, since SERIAL columns are not autoincremented by SQLite.
+func (s *WebhooksRepositoryTestSuite) insertWebhook(id uint64, eventTypes string, enabled bool, failures uint32) {
+	now := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		`
+			INSERT INTO webhooks (id, url, secret, event_types, enabled, consecutive_failures, created_at, updated_at) 
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		`,
+		id,
+		"https://example.com/hooks",
+		"secret",
+		eventTypes,
+		enabled,
+		failures,
+		now,
+		now,
+	)
+	s.NoError(err)
+}
+
+// insertWebhookDelivery inserts delivery with explicit ID, since SERIAL columns are not autoincremented by SQLite.
+func (s *WebhooksRepositoryTestSuite) insertWebhookDelivery(
+	id uint64,
+	webhookID uint64,
+	status entities.WebhookDeliveryStatus,
+	attempts uint32,
+	nextAttemptAt *time.Time,
+) {
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		`
+			INSERT INTO webhook_deliveries 
+			    (id, webhook_id, delivery_id, event_type, payload, status, attempts, next_attempt_at, created_at) 
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		`,
+		id,
+		webhookID,
+		fmt.Sprintf("delivery-%d", id),
+		entities.NotificationTypeTicketUpdated,
+		"{}",
+		status,
+		attempts,
+		nextAttemptAt,
+		time.Now().UTC(),
+	)
+	s.NoError(err)
+}
+
+func (s *WebhooksRepositoryTestSuite) TestGetWebhooks() {
+	s.expectSpans(2)
+
+	s.insertWebhook(1, "", true, 0)
+	s.insertWebhook(2, "ticket_updated,ticket_deleted", false, 5)
+
+	webhooks, err := s.webhooksRepository.GetWebhooks(s.ctx)
+	s.NoError(err)
+	s.Equal(2, len(webhooks))
+	s.Nil(webhooks[0].EventTypes)
+	s.Equal(
+		[]entities.NotificationType{entities.NotificationTypeTicketUpdated, entities.NotificationTypeTicketDeleted},
+		webhooks[1].EventTypes,
+	)
+	s.Equal(uint32(5), webhooks[1].ConsecutiveFailures)
+
+	webhooks, err = s.webhooksRepository.GetEnabledWebhooks(s.ctx)
+	s.NoError(err)
+	s.Equal(1, len(webhooks))
+	s.Equal(uint64(1), webhooks[0].ID)
+}
+
+func (s *WebhooksRepositoryTestSuite) TestGetWebhookByIDNotFound() {
+	s.expectSpans(1)
+
+	webhook, err := s.webhooksRepository.GetWebhookByID(s.ctx, 1)
+	s.ErrorAs(err, new(*customerrors.WebhookNotFoundError))
+	s.Nil(webhook)
+}
+
+func (s *WebhooksRepositoryTestSuite) TestSaveWebhookError() {
+	s.expectSpans(1)
+
+	// SQLite does not autoincrement SERIAL columns, so saving without explicit ID fails:
+	id, err := s.webhooksRepository.SaveWebhook(
+		s.ctx,
+		entities.Webhook{URL: "https://example.com/hooks", Secret: "secret", Enabled: true},
+	)
+	s.Error(err)
+	s.Zero(id)
+}
+
+func (s *WebhooksRepositoryTestSuite) TestUpdateWebhook() {
+	s.expectSpans(3)
+
+	s.insertWebhook(1, "", false, 5)
+
+	err := s.webhooksRepository.UpdateWebhook(
+		s.ctx,
+		entities.Webhook{
+			ID:         1,
+			URL:        "https://example.com/new",
+			EventTypes: []entities.NotificationType{entities.NotificationTypeVerifyEmail},
+			Enabled:    true,
+		},
+	)
+	s.NoError(err)
+
+	webhook, err := s.webhooksRepository.GetWebhookByID(s.ctx, 1)
+	s.NoError(err)
+	s.Equal("https://example.com/new", webhook.URL)
+	s.Equal([]entities.NotificationType{entities.NotificationTypeVerifyEmail}, webhook.EventTypes)
+	s.True(webhook.Enabled)
+
+	// Enabling webhook gives it a fresh start:
+	s.Zero(webhook.ConsecutiveFailures)
+	s.Nil(webhook.DisabledAt)
+
+	err = s.webhooksRepository.UpdateWebhook(s.ctx, entities.Webhook{ID: 2, URL: "https://example.com/hooks"})
+	s.ErrorAs(err, new(*customerrors.WebhookNotFoundError))
+}
+
+func (s *WebhooksRepositoryTestSuite) TestDeleteWebhook() {
+	s.expectSpans(3)
+
+	now := time.Now().UTC()
+	s.insertWebhook(1, "", true, 0)
+	s.insertWebhookDelivery(1, 1, entities.WebhookDeliveryStatusPending, 0, &now)
+
+	s.NoError(s.webhooksRepository.DeleteWebhook(s.ctx, 1))
+
+	deliveries, err := s.webhooksRepository.GetWebhookDeliveries(s.ctx, 1, nil)
+	s.NoError(err)
+	s.Empty(deliveries)
+
+	err = s.webhooksRepository.DeleteWebhook(s.ctx, 1)
+	s.ErrorAs(err, new(*customerrors.WebhookNotFoundError))
+}
+
+func (s *WebhooksRepositoryTestSuite) TestRegisterWebhookFailure() {
+	// Each registration updates webhook and then reads it to know, whether it was disabled:
+	s.expectSpans(5)
+
+	s.insertWebhook(1, "", true, 0)
+
+	disabled, err := s.webhooksRepository.RegisterWebhookFailure(s.ctx, 1, 2)
+	s.NoError(err)
+	s.False(disabled)
+
+	disabled, err = s.webhooksRepository.RegisterWebhookFailure(s.ctx, 1, 2)
+	s.NoError(err)
+	s.True(disabled)
+
+	// Failures of already disabled webhook are not counted:
+	disabled, err = s.webhooksRepository.RegisterWebhookFailure(s.ctx, 1, 2)
+	s.NoError(err)
+	s.False(disabled)
+
+	var (
+		failures   uint32
+		enabled    bool
+		disabledAt *time.Time
+	)
+
+	err = s.connection.QueryRowContext(
+		s.ctx,
+		"SELECT consecutive_failures, enabled, disabled_at FROM webhooks WHERE id = $1",
+		1,
+	).Scan(&failures, &enabled, &disabledAt)
+	s.NoError(err)
+	s.Equal(uint32(2), failures)
+	s.False(enabled)
+	s.NotNil(disabledAt)
+}
+
+func (s *WebhooksRepositoryTestSuite) TestResetWebhookFailures() {
+	s.expectSpans(2)
+
+	s.insertWebhook(1, "", true, 3)
+
+	s.NoError(s.webhooksRepository.ResetWebhookFailures(s.ctx, 1))
+
+	webhook, err := s.webhooksRepository.GetWebhookByID(s.ctx, 1)
+	s.NoError(err)
+	s.Zero(webhook.ConsecutiveFailures)
+}
+
+func (s *WebhooksRepositoryTestSuite) TestGetWebhookDeliveriesWithPagination() {
+	s.expectSpans(1)
+
+	now := time.Now().UTC()
+	s.insertWebhook(1, "", true, 0)
+	s.insertWebhookDelivery(1, 1, entities.WebhookDeliveryStatusDelivered, 1, nil)
+	s.insertWebhookDelivery(2, 1, entities.WebhookDeliveryStatusFailed, 8, nil)
+	s.insertWebhookDelivery(3, 1, entities.WebhookDeliveryStatusPending, 0, &now)
+
+	deliveries, err := s.webhooksRepository.GetWebhookDeliveries(
+		s.ctx,
+		1,
+		&entities.Pagination{
+			Limit:  pointers.New[uint64](1),
+			Offset: pointers.New[uint64](1),
+		},
+	)
+	s.NoError(err)
+	s.Equal(1, len(deliveries))
+	s.Equal(uint64(2), deliveries[0].ID)
+	s.Equal(entities.WebhookDeliveryStatusFailed, deliveries[0].Status)
+}
+
+func (s *WebhooksRepositoryTestSuite) TestGetPendingWebhookDeliveries() {
+	s.expectSpans(1)
+
+	now := time.Now().UTC()
+	delayed := now.Add(time.Hour)
+	pending := now.Add(-time.Minute)
+	expiredLease := now.Add(-time.Second)
+
+	s.insertWebhook(1, "", true, 0)
+	s.insertWebhookDelivery(1, 1, entities.WebhookDeliveryStatusPending, 0, &pending)
+	s.insertWebhookDelivery(2, 1, entities.WebhookDeliveryStatusPending, 0, &delayed)
+	s.insertWebhookDelivery(3, 1, entities.WebhookDeliveryStatusProcessing, 1, &expiredLease)
+	s.insertWebhookDelivery(4, 1, entities.WebhookDeliveryStatusDelivered, 1, nil)
+
+	deliveries, err := s.webhooksRepository.GetPendingWebhookDeliveries(s.ctx, 10)
+	s.NoError(err)
+	s.Equal(2, len(deliveries))
+	s.Equal(uint64(1), deliveries[0].ID)
+	s.Equal(uint64(3), deliveries[1].ID)
+}
+
+func (s *WebhooksRepositoryTestSuite) TestClaimAndMarkWebhookDeliveryDelivered() {
+	s.expectSpans(3)
+
+	now := time.Now().UTC()
+	s.insertWebhook(1, "", true, 0)
+	s.insertWebhookDelivery(1, 1, entities.WebhookDeliveryStatusPending, 0, &now)
+
+	delivery := entities.WebhookDelivery{ID: 1, Status: entities.WebhookDeliveryStatusPending}
+	leaseUntil := now.Add(time.Minute)
+
+	claimed, err := s.webhooksRepository.ClaimWebhookDelivery(s.ctx, delivery, leaseUntil)
+	s.NoError(err)
+	s.True(claimed)
+
+	// Second claim with the same state must fail, because delivery was already taken:
+	claimed, err = s.webhooksRepository.ClaimWebhookDelivery(s.ctx, delivery, leaseUntil)
+	s.NoError(err)
+	s.False(claimed)
+
+	s.NoError(s.webhooksRepository.MarkWebhookDeliveryDelivered(s.ctx, 1, 1))
+
+	var (
+		status      string
+		attempts    uint32
+		deliveredAt *time.Time
+	)
+
+	err = s.connection.QueryRowContext(
+		s.ctx,
+		"SELECT status, attempts, delivered_at FROM webhook_deliveries WHERE id = $1",
+		1,
+	).Scan(&status, &attempts, &deliveredAt)
+	s.NoError(err)
+	s.Equal(string(entities.WebhookDeliveryStatusDelivered), status)
+	s.Equal(uint32(1), attempts)
+	s.NotNil(deliveredAt)
+}
+
+func (s *WebhooksRepositoryTestSuite) TestDeferWebhookDelivery() {
+	s.expectSpans(2)
+
+	now := time.Now().UTC()
+	s.insertWebhook(1, "", true, 0)
+	s.insertWebhookDelivery(1, 1, entities.WebhookDeliveryStatusPending, 1, &now)
+
+	delivery := entities.WebhookDelivery{ID: 1, Status: entities.WebhookDeliveryStatusPending, Attempts: 1}
+	claimed, err := s.webhooksRepository.ClaimWebhookDelivery(s.ctx, delivery, now.Add(time.Minute))
+	s.NoError(err)
+	s.True(claimed)
+
+	s.NoError(s.webhooksRepository.DeferWebhookDelivery(s.ctx, 1, 2, now.Add(time.Minute)))
+
+	var (
+		status    string
+		attempts  uint32
+		deferrals uint32
+	)
+
+	// Attempt is not consumed, but deferral is counted:
+	err = s.connection.QueryRowContext(
+		s.ctx,
+		"SELECT status, attempts, deferrals FROM webhook_deliveries WHERE id = $1",
+		1,
+	).Scan(&status, &attempts, &deferrals)
+	s.NoError(err)
+	s.Equal(string(entities.WebhookDeliveryStatusPending), status)
+	s.Equal(uint32(1), attempts)
+	s.Equal(uint32(1), deferrals)
+}
+
+func (s *WebhooksRepositoryTestSuite) TestUpdateWebhookDeliveryWithLostLease() {
+	s.expectSpans(2)
+
+	// Delivery was retaken by another dispatcher, so it has more attempts than first dispatcher claimed:
+	now := time.Now().UTC()
+	s.insertWebhook(1, "", true, 0)
+	s.insertWebhookDelivery(1, 1, entities.WebhookDeliveryStatusProcessing, 2, &now)
+
+	err := s.webhooksRepository.MarkWebhookDeliveryFailed(s.ctx, 1, 1, "timeout")
+	s.ErrorAs(err, new(*customerrors.CommunicationLeaseLostError))
+
+	s.NoError(s.webhooksRepository.MarkWebhookDeliveryFailed(s.ctx, 1, 2, "timeout"))
+}
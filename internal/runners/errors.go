@@ -0,0 +1,9 @@
+package runners
+
+import "errors"
+
+var (
+	ErrRunnerAlreadyRunning = errors.New("runner is already running")
+	ErrRunnerAlreadyStopped = errors.New("runner is not running or was already stopped")
+	ErrInvalidInterval      = errors.New("runner interval must be positive")
+)
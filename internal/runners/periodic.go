@@ -0,0 +1,80 @@
+package runners
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// PeriodicRunner runs task in background goroutine right after start and then every interval,
+// until it is stopped. Task receives context, which is canceled on stop.
+type PeriodicRunner struct {
+	interval time.Duration
+	task     func(ctx context.Context)
+	mutex    *sync.Mutex
+	wg       *sync.WaitGroup
+	cancel   context.CancelFunc
+}
+
+func NewPeriodicRunner(interval time.Duration, task func(ctx context.Context)) *PeriodicRunner {
+	return &PeriodicRunner{
+		interval: interval,
+		task:     task,
+		mutex:    new(sync.Mutex),
+		wg:       new(sync.WaitGroup),
+	}
+}
+
+// Run starts periodic running of task.
+func (r *PeriodicRunner) Run() error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.cancel != nil {
+		return ErrRunnerAlreadyRunning
+	}
+
+	if r.interval <= 0 {
+		return ErrInvalidInterval
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+
+	r.wg.Add(1)
+
+	go func() {
+		defer r.wg.Done()
+
+		ticker := time.NewTicker(r.interval)
+		defer ticker.Stop()
+
+		for {
+			r.task(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return nil
+}
+
+// Stop cancels context of task and waits for the current run to finish.
+func (r *PeriodicRunner) Stop() error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.cancel == nil {
+		return ErrRunnerAlreadyStopped
+	}
+
+	r.cancel()
+	r.wg.Wait()
+	r.cancel = nil
+
+	return nil
+}
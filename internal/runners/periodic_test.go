@@ -0,0 +1,60 @@
+package runners
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPeriodicRunner_RunAndStop(t *testing.T) {
+	var runs atomic.Int32
+
+	runner := NewPeriodicRunner(
+		time.Millisecond,
+		func(_ context.Context) {
+			runs.Add(1)
+		},
+	)
+
+	require.ErrorIs(t, runner.Stop(), ErrRunnerAlreadyStopped)
+	require.NoError(t, runner.Run())
+	require.ErrorIs(t, runner.Run(), ErrRunnerAlreadyRunning)
+	require.Eventually(t, func() bool { return runs.Load() > 1 }, time.Second, time.Millisecond)
+	require.NoError(t, runner.Stop())
+	require.ErrorIs(t, runner.Stop(), ErrRunnerAlreadyStopped)
+
+	// Task is not run after stop:
+	stoppedRuns := runs.Load()
+
+	time.Sleep(10 * time.Millisecond)
+	require.Equal(t, stoppedRuns, runs.Load())
+
+	// Runner can be restarted after stop:
+	require.NoError(t, runner.Run())
+	require.NoError(t, runner.Stop())
+}
+
+func TestPeriodicRunner_StopCancelsTask(t *testing.T) {
+	started := make(chan struct{})
+	runner := NewPeriodicRunner(
+		time.Hour,
+		func(ctx context.Context) {
+			close(started)
+			<-ctx.Done()
+		},
+	)
+
+	require.NoError(t, runner.Run())
+	<-started
+	require.NoError(t, runner.Stop())
+}
+
+func TestPeriodicRunner_InvalidInterval(t *testing.T) {
+	runner := NewPeriodicRunner(0, func(_ context.Context) {})
+
+	require.ErrorIs(t, runner.Run(), ErrInvalidInterval)
+	require.ErrorIs(t, runner.Stop(), ErrRunnerAlreadyStopped)
+}
@@ -2,15 +2,35 @@ package senders
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"syscall"
+	"time"
 
 	"github.com/DKhorkov/libs/tracing"
+	"github.com/google/uuid"
 	"gopkg.in/gomail.v2"
 
 	"github.com/DKhorkov/hmtm-notifications/internal/config"
 )
 
+// defaultMessageIDDomain is used in Message-ID, if sender address has no domain:
+const defaultMessageIDDomain = "hmtm-notifications"
+
+// smtpDialer opens SMTP connection. Implemented by *gomail.Dialer.
+type smtpDialer interface {
+	Dial() (gomail.SendCloser, error)
+}
+
 type EmailSender struct {
 	smtpConfig    config.SMTPConfig
+	pool          *smtpPool
 	traceProvider tracing.Provider
 	spanConfig    tracing.SpanConfig
 }
@@ -21,31 +41,165 @@ func NewEmailSender(
 	spanConfig tracing.SpanConfig,
 ) *EmailSender {
 	return &EmailSender{
-		smtpConfig:    smtpConfig,
+		smtpConfig: smtpConfig,
+		pool: newSMTPPool(
+			gomail.NewDialer(
+				smtpConfig.Host,
+				smtpConfig.Port,
+				smtpConfig.Login,
+				smtpConfig.Password,
+			),
+			smtpConfig.PoolSize,
+			smtpConfig.IdleTimeout,
+		),
 		traceProvider: traceProvider,
 		spanConfig:    spanConfig,
 	}
 }
 
-func (s *EmailSender) Send(ctx context.Context, subject, body string, recipients []string) error {
+// Send sends email to recipients as multipart/alternative message with plain text and HTML parts and returns
+// its Message-ID. If text is empty, it is rendered from HTML body. Transient failures are retried with jittered
+// exponential backoff until retries count or retry budget are exhausted. Permanent failures are returned
+// as *PermanentError.
+func (s *EmailSender) Send(
+	ctx context.Context,
+	subject, body, text string,
+	recipients []string,
+) (string, error) {
 	ctx, span := s.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
 	defer span.End()
 
 	span.AddEvent(s.spanConfig.Events.Start.Name, s.spanConfig.Events.Start.Opts...)
 	defer span.AddEvent(s.spanConfig.Events.End.Name, s.spanConfig.Events.End.Opts...)
 
+	for _, recipient := range recipients {
+		if _, err := mail.ParseAddress(recipient); err != nil {
+			return "", &PermanentError{BaseErr: err}
+		}
+	}
+
+	// Message-ID is set explicitly to be able to match message with bounces and complaints later:
+	messageID := newMessageID(s.smtpConfig.Login)
+
 	message := gomail.NewMessage()
+	message.SetHeader("Message-ID", messageID)
 	message.SetHeader("From", s.smtpConfig.Login)
 	message.SetHeader("To", recipients...)
 	message.SetHeader("Subject", subject)
-	message.SetBody("text/html", body)
 
-	smtpClient := gomail.NewDialer(
-		s.smtpConfig.Host,
-		s.smtpConfig.Port,
-		s.smtpConfig.Login,
-		s.smtpConfig.Password,
-	)
+	if text == "" {
+		text = RenderPlainText(body)
+	}
+
+	// Alternatives are ordered by preference, so HTML part, which is preferred by clients, goes last:
+	message.SetBody("text/plain", text)
+	message.AddAlternative("text/html", body)
+
+	deadline := time.Now().Add(s.smtpConfig.RetryBudget)
+
+	for attempt := 0; ; attempt++ {
+		err := s.send(ctx, message, recipients)
+		if err == nil {
+			return messageID, nil
+		}
+
+		// Sending was interrupted by shutdown and should be repeated later:
+		if errors.Is(err, ErrSMTPPoolClosed) || ctx.Err() != nil {
+			return "", err
+		}
+
+		if isPermanent(err) {
+			return "", &PermanentError{BaseErr: err}
+		}
+
+		delay := s.retryDelay(attempt)
+		if attempt >= s.smtpConfig.RetriesCount || time.Now().Add(delay).After(deadline) {
+			return "", err
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			return "", errors.Join(err, ctx.Err())
+		case <-timer.C:
+		}
+	}
+}
+
+// Close closes pooled SMTP connections. Sender could not be used after closing.
+func (s *EmailSender) Close() error {
+	return s.pool.close()
+}
+
+// send sends message via pooled connection. If reused connection turns out to be dropped by server,
+// sending is immediately repeated via another connection without consuming retry attempt.
+func (s *EmailSender) send(ctx context.Context, message *gomail.Message, recipients []string) error {
+	for {
+		connection, err := s.pool.get(ctx)
+		if err != nil {
+			return err
+		}
+
+		// SendCloser is used directly instead of gomail.Send, because last one does not wrap SMTP errors:
+		err = connection.Send(s.smtpConfig.Login, recipients, message)
+		s.pool.put(connection, err != nil)
+
+		if err == nil || !connection.reused || isPermanent(err) {
+			return err
+		}
+	}
+}
+
+// retryDelay calculates exponential backoff delay for provided attempt with jitter in [delay/2, delay] range
+// to prevent simultaneous retries of several senders.
+func (s *EmailSender) retryDelay(attempt int) time.Duration {
+	delay := s.smtpConfig.RetryBaseDelay
+	for i := 0; i < attempt && delay < s.smtpConfig.RetryMaxDelay; i++ {
+		delay *= 2
+	}
+
+	delay = min(delay, s.smtpConfig.RetryMaxDelay)
+	if delay <= 0 {
+		return 0
+	}
+
+	return delay/2 + rand.N(delay/2+1) //nolint:gosec // retry jitter
+}
+
+// isPermanent classifies SMTP error. 5xx responses are permanent, while 4xx responses, timeouts
+// and connection failures are transient. Unknown errors are considered permanent, because they are
+// usually caused by invalid message or configuration and will be repeated on retry.
+func isPermanent(err error) bool {
+	var protocolErr *textproto.Error
+	if errors.As(err, &protocolErr) {
+		return protocolErr.Code >= 500
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return false
+	}
+
+	switch {
+	case errors.Is(err, io.EOF),
+		errors.Is(err, io.ErrUnexpectedEOF),
+		errors.Is(err, syscall.ECONNRESET),
+		errors.Is(err, syscall.ECONNREFUSED),
+		errors.Is(err, syscall.EPIPE):
+		return false
+	default:
+		return true
+	}
+}
+
+// newMessageID generates unique Message-ID with domain of sender address.
+func newMessageID(sender string) string {
+	domain := defaultMessageIDDomain
+	if _, senderDomain, found := strings.Cut(sender, "@"); found && senderDomain != "" {
+		domain = senderDomain
+	}
 
-	return smtpClient.DialAndSend(message)
+	return fmt.Sprintf("<%s@%s>", uuid.NewString(), domain)
 }
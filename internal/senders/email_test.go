@@ -1,11 +1,24 @@
 package senders
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"syscall"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+	"gopkg.in/gomail.v2"
 
 	"github.com/DKhorkov/libs/tracing"
 	mocktracing "github.com/DKhorkov/libs/tracing/mocks"
@@ -67,7 +80,7 @@ func TestEmailSender_Send(t *testing.T) {
 				tc.setupMocks(traceProvider)
 			}
 
-			err := sender.Send(context.Background(), tc.subject, tc.body, tc.recipients)
+			_, err := sender.Send(context.Background(), tc.subject, tc.body, "", tc.recipients)
 			if tc.errorExpected {
 				require.Error(t, err)
 			} else {
@@ -76,3 +89,384 @@ func TestEmailSender_Send(t *testing.T) {
 		})
 	}
 }
+
+type fakeSendCloser struct {
+	sendErr     error
+	sent        int
+	closed      int
+	lastMessage bytes.Buffer
+}
+
+func (c *fakeSendCloser) Send(_ string, _ []string, message io.WriterTo) error {
+	c.sent++
+
+	c.lastMessage.Reset()
+	if _, err := message.WriteTo(&c.lastMessage); err != nil {
+		return err
+	}
+
+	return c.sendErr
+}
+
+func (c *fakeSendCloser) Close() error {
+	c.closed++
+
+	return nil
+}
+
+// fakeDialer returns errors from dialErrs one by one and then successfully opens connection.
+type fakeDialer struct {
+	dialErrs    []error
+	sendErr     error
+	calls       int
+	connections []*fakeSendCloser
+}
+
+func (d *fakeDialer) Dial() (gomail.SendCloser, error) {
+	d.calls++
+	if d.calls <= len(d.dialErrs) {
+		return nil, d.dialErrs[d.calls-1]
+	}
+
+	connection := &fakeSendCloser{sendErr: d.sendErr}
+	d.connections = append(d.connections, connection)
+
+	return connection, nil
+}
+
+func TestEmailSender_SendWithRetries(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	traceProvider := mocktracing.NewMockProvider(ctrl)
+	smtpConfig := config.SMTPConfig{
+		Login:          "sender@example.com",
+		RetriesCount:   2,
+		RetryBaseDelay: time.Millisecond,
+		RetryMaxDelay:  time.Millisecond * 5,
+		RetryBudget:    time.Second,
+	}
+
+	testCases := []struct {
+		name              string
+		recipients        []string
+		dialer            *fakeDialer
+		expectedCalls     int
+		errorExpected     bool
+		permanentExpected bool
+	}{
+		{
+			name:          "success on first attempt",
+			recipients:    []string{"recipient@example.com"},
+			dialer:        &fakeDialer{},
+			expectedCalls: 1,
+		},
+		{
+			name:       "success after transient errors",
+			recipients: []string{"recipient@example.com"},
+			dialer: &fakeDialer{
+				dialErrs: []error{
+					syscall.ECONNRESET,
+					&textproto.Error{Code: 421, Msg: "service not available"},
+				},
+			},
+			expectedCalls: 3,
+		},
+		{
+			name:       "retries count exhausted",
+			recipients: []string{"recipient@example.com"},
+			dialer: &fakeDialer{
+				dialErrs: []error{io.EOF, io.EOF, io.EOF},
+			},
+			expectedCalls: 3,
+			errorExpected: true,
+		},
+		{
+			name:       "permanent error",
+			recipients: []string{"recipient@example.com"},
+			dialer: &fakeDialer{
+				sendErr: &textproto.Error{Code: 550, Msg: "mailbox unavailable"},
+			},
+			expectedCalls:     1,
+			errorExpected:     true,
+			permanentExpected: true,
+		},
+		{
+			name:              "invalid recipient",
+			recipients:        []string{"invalid"},
+			dialer:            &fakeDialer{},
+			expectedCalls:     0,
+			errorExpected:     true,
+			permanentExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			traceProvider.
+				EXPECT().
+				Span(gomock.Any(), gomock.Any()).
+				Return(context.Background(), mocktracing.NewMockSpan()).
+				Times(1)
+
+			sender := NewEmailSender(smtpConfig, traceProvider, tracing.SpanConfig{})
+			sender.pool.dialer = tc.dialer
+
+			messageID, err := sender.Send(context.Background(), "Subject", "Body", "", tc.recipients)
+			require.Equal(t, tc.expectedCalls, tc.dialer.calls)
+
+			if !tc.errorExpected {
+				require.NoError(t, err)
+				require.NotEmpty(t, messageID)
+
+				return
+			}
+
+			var permanentErr *PermanentError
+
+			require.Error(t, err)
+			require.Empty(t, messageID)
+			require.Equal(t, tc.permanentExpected, errors.As(err, &permanentErr))
+		})
+	}
+}
+
+func TestEmailSender_SendRetryBudget(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	traceProvider := mocktracing.NewMockProvider(ctrl)
+	traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	sender := NewEmailSender(
+		config.SMTPConfig{
+			RetriesCount:   10,
+			RetryBaseDelay: time.Hour,
+			RetryMaxDelay:  time.Hour,
+			RetryBudget:    time.Second,
+		},
+		traceProvider,
+		tracing.SpanConfig{},
+	)
+
+	dialer := &fakeDialer{dialErrs: []error{io.EOF}}
+	sender.pool.dialer = dialer
+
+	_, err := sender.Send(context.Background(), "Subject", "Body", "", []string{"recipient@example.com"})
+	require.ErrorIs(t, err, io.EOF)
+	require.Equal(t, 1, dialer.calls)
+}
+
+func TestEmailSender_SendReusesConnections(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	traceProvider := mocktracing.NewMockProvider(ctrl)
+	traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(3)
+
+	sender := NewEmailSender(
+		config.SMTPConfig{PoolSize: 1, IdleTimeout: time.Minute},
+		traceProvider,
+		tracing.SpanConfig{},
+	)
+
+	dialer := &fakeDialer{}
+	sender.pool.dialer = dialer
+
+	recipients := []string{"recipient@example.com"}
+	firstMessageID, err := sender.Send(context.Background(), "Subject", "Body", "", recipients)
+	require.NoError(t, err)
+
+	secondMessageID, err := sender.Send(context.Background(), "Subject", "Body", "", recipients)
+	require.NoError(t, err)
+	require.NotEqual(t, firstMessageID, secondMessageID)
+	require.Equal(t, 1, dialer.calls)
+	require.Equal(t, 2, dialer.connections[0].sent)
+
+	// Connection was dropped by server, so message is sent via new one without retry delay:
+	dialer.connections[0].sendErr = io.EOF
+	_, err = sender.Send(context.Background(), "Subject", "Body", "", recipients)
+	require.NoError(t, err)
+	require.Equal(t, 2, dialer.calls)
+	require.Equal(t, 1, dialer.connections[0].closed)
+	require.Equal(t, 1, dialer.connections[1].sent)
+
+	require.NoError(t, sender.Close())
+	require.Equal(t, 1, dialer.connections[1].closed)
+}
+
+func TestEmailSender_retryDelay(t *testing.T) {
+	sender := NewEmailSender(
+		config.SMTPConfig{
+			RetryBaseDelay: time.Second,
+			RetryMaxDelay:  time.Second * 10,
+		},
+		nil,
+		tracing.SpanConfig{},
+	)
+
+	testCases := []struct {
+		attempt  int
+		maxDelay time.Duration
+	}{
+		{attempt: 0, maxDelay: time.Second},
+		{attempt: 1, maxDelay: time.Second * 2},
+		{attempt: 2, maxDelay: time.Second * 4},
+		{attempt: 10, maxDelay: time.Second * 10},
+	}
+
+	for _, tc := range testCases {
+		delay := sender.retryDelay(tc.attempt)
+		require.GreaterOrEqual(t, delay, tc.maxDelay/2)
+		require.LessOrEqual(t, delay, tc.maxDelay)
+	}
+}
+
+func Test_isPermanent(t *testing.T) {
+	testCases := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{
+			name:     "5xx response",
+			err:      &textproto.Error{Code: 550, Msg: "mailbox unavailable"},
+			expected: true,
+		},
+		{
+			name:     "4xx response",
+			err:      &textproto.Error{Code: 451, Msg: "local error in processing"},
+			expected: false,
+		},
+		{
+			name:     "wrapped 4xx response",
+			err:      fmt.Errorf("send: %w", &textproto.Error{Code: 452, Msg: "insufficient storage"}),
+			expected: false,
+		},
+		{
+			name:     "connection reset",
+			err:      syscall.ECONNRESET,
+			expected: false,
+		},
+		{
+			name:     "timeout",
+			err:      context.DeadlineExceeded,
+			expected: false,
+		},
+		{
+			name:     "unknown error",
+			err:      errors.New("unknown"),
+			expected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, isPermanent(tc.err))
+		})
+	}
+}
+
+func TestNewMessageID(t *testing.T) {
+	testCases := []struct {
+		name     string
+		sender   string
+		expected string
+	}{
+		{
+			name:     "sender with domain",
+			sender:   "noreply@hmtm.ru",
+			expected: `^<[0-9a-f-]{36}@hmtm\.ru>$`,
+		},
+		{
+			name:     "sender without domain",
+			sender:   "noreply",
+			expected: `^<[0-9a-f-]{36}@hmtm-notifications>$`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Regexp(t, tc.expected, newMessageID(tc.sender))
+		})
+	}
+}
+
+func TestEmailSender_SendMultipart(t *testing.T) {
+	testCases := []struct {
+		name         string
+		body         string
+		text         string
+		expectedText string
+	}{
+		{
+			name:         "explicit text",
+			body:         `<p>Перейдите по <a href="https://hmtm.ru/verify">ссылке</a>.</p>`,
+			text:         "Перейдите по ссылке: https://hmtm.ru/verify",
+			expectedText: "Перейдите по ссылке: https://hmtm.ru/verify",
+		},
+		{
+			name:         "generated text",
+			body:         `<p>Перейдите по <a href="https://hmtm.ru/verify">ссылке</a>.</p>`,
+			expectedText: "Перейдите по ссылке [1].\n\n[1] https://hmtm.ru/verify",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			traceProvider := mocktracing.NewMockProvider(ctrl)
+			traceProvider.
+				EXPECT().
+				Span(gomock.Any(), gomock.Any()).
+				Return(context.Background(), mocktracing.NewMockSpan()).
+				Times(1)
+
+			sender := NewEmailSender(config.SMTPConfig{Login: "sender@example.com"}, traceProvider, tracing.SpanConfig{})
+			dialer := &fakeDialer{}
+			sender.pool.dialer = dialer
+
+			_, err := sender.Send(context.Background(), "Subject", tc.body, tc.text, []string{"recipient@example.com"})
+			require.NoError(t, err)
+
+			message, err := mail.ReadMessage(&dialer.connections[0].lastMessage)
+			require.NoError(t, err)
+
+			mediaType, params, err := mime.ParseMediaType(message.Header.Get("Content-Type"))
+			require.NoError(t, err)
+			require.Equal(t, "multipart/alternative", mediaType)
+
+			reader := multipart.NewReader(message.Body, params["boundary"])
+			contents := make(map[string]string)
+			contentTypes := make([]string, 0, 2)
+
+			for {
+				part, partErr := reader.NextPart()
+				if errors.Is(partErr, io.EOF) {
+					break
+				}
+
+				require.NoError(t, partErr)
+
+				content, readErr := io.ReadAll(quotedprintable.NewReader(part))
+				require.NoError(t, readErr)
+
+				contentType := part.Header.Get("Content-Type")
+				contentTypes = append(contentTypes, contentType)
+				// Line breaks are canonicalized to CRLF by quoted-printable encoding:
+				contents[contentType] = strings.ReplaceAll(string(content), "\r\n", "\n")
+			}
+
+			// Plain text part goes first, because the last alternative is preferred by mail clients:
+			require.Equal(
+				t,
+				[]string{"text/plain; charset=UTF-8", "text/html; charset=UTF-8"},
+				contentTypes,
+			)
+			require.Equal(t, tc.expectedText, contents["text/plain; charset=UTF-8"])
+			require.Equal(t, tc.body, contents["text/html; charset=UTF-8"])
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package senders
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// PermanentError represents sending failure, which will be repeated on any further attempt,
+// for example, due to rejected recipient or failed authentication. Such sending must not be retried.
+type PermanentError struct {
+	BaseErr error
+}
+
+func (e *PermanentError) Error() string {
+	return fmt.Sprintf("permanent sending error: %v", e.BaseErr)
+}
+
+func (e *PermanentError) Unwrap() error {
+	return e.BaseErr
+}
+
+var ErrSMTPPoolClosed = errors.New("smtp connections pool is closed")
+
+// RateLimitedError represents sending, which was not performed due to exceeded rate limit.
+// Sending should be repeated after RetryAfter without treating it as failed attempt.
+type RateLimitedError struct {
+	RetryAfter time.Duration
+}
+
+func (e *RateLimitedError) Error() string {
+	return fmt.Sprintf("rate limit exceeded, retry after %s", e.RetryAfter)
+}
+
+// PartiallySentError represents sending of content, split into several messages, which failed after SentParts
+// messages (including ones, sent by previous attempts) were sent. MessageID is an ID of the first message, sent by
+// failed attempt. Further attempts should skip sent messages, so that recipient does not receive them twice.
+type PartiallySentError struct {
+	SentParts uint32
+	MessageID string
+	BaseErr   error
+}
+
+func (e *PartiallySentError) Error() string {
+	return fmt.Sprintf("sending failed after %d sent messages: %v", e.SentParts, e.BaseErr)
+}
+
+func (e *PartiallySentError) Unwrap() error {
+	return e.BaseErr
+}
@@ -0,0 +1,147 @@
+package senders
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"slices"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// RenderPlainText converts HTML content of notification to plain text alternative of email. Block elements are
+// replaced by line breaks, list items are prefixed by dash, and links are kept as numbered footnotes, which are
+// listed after the text. Link, which text is the same as its URL, is kept in place without footnote.
+func RenderPlainText(content string) string {
+	var (
+		builder   strings.Builder
+		footnotes []string
+		link      string
+		linkStart int
+		skip      int
+		preDepth  int
+	)
+
+	tokenizer := html.NewTokenizer(strings.NewReader(content))
+
+	for {
+		tokenType := tokenizer.Next()
+		if tokenType == html.ErrorToken {
+			if tokenizer.Err() != io.EOF {
+				return ""
+			}
+
+			break
+		}
+
+		token := tokenizer.Token()
+
+		switch tokenType {
+		case html.TextToken:
+			if skip > 0 {
+				continue
+			}
+
+			text := token.Data
+			if preDepth == 0 {
+				text = whitespacesRegexp.ReplaceAllString(text, " ")
+			}
+
+			builder.WriteString(text)
+		case html.StartTagToken, html.SelfClosingTagToken:
+			switch token.DataAtom {
+			case atom.Script, atom.Style, atom.Head, atom.Title:
+				if tokenType == html.StartTagToken {
+					skip++
+				}
+			case atom.Br:
+				builder.WriteString("\n")
+			case atom.Li:
+				builder.WriteString("\n- ")
+			case atom.A:
+				if skip == 0 && tokenType == html.StartTagToken {
+					link = footnoteLink(token)
+					linkStart = builder.Len()
+				}
+			case atom.Pre:
+				preDepth++
+
+				builder.WriteString("\n\n")
+			default:
+				if blockTags[token.DataAtom] {
+					builder.WriteString("\n\n")
+				}
+			}
+		case html.EndTagToken:
+			switch token.DataAtom {
+			case atom.Script, atom.Style, atom.Head, atom.Title:
+				if skip > 0 {
+					skip--
+				}
+			case atom.A:
+				if link == "" {
+					continue
+				}
+
+				// Link, which is already shown as its URL, does not need footnote:
+				if strings.TrimSpace(builder.String()[linkStart:]) != link {
+					index := slices.Index(footnotes, link)
+					if index < 0 {
+						footnotes = append(footnotes, link)
+						index = len(footnotes) - 1
+					}
+
+					builder.WriteString(fmt.Sprintf(" [%d]", index+1))
+				}
+
+				link = ""
+			case atom.Pre:
+				if preDepth > 0 {
+					preDepth--
+				}
+
+				builder.WriteString("\n\n")
+			default:
+				if blockTags[token.DataAtom] {
+					builder.WriteString("\n\n")
+				}
+			}
+		}
+	}
+
+	text := normalizeRenderedText(builder.String())
+	if len(footnotes) == 0 {
+		return text
+	}
+
+	builder.Reset()
+	builder.WriteString(text)
+	builder.WriteString("\n\n")
+
+	for i, footnote := range footnotes {
+		builder.WriteString(fmt.Sprintf("[%d] %s\n", i+1, footnote))
+	}
+
+	return strings.TrimSuffix(builder.String(), "\n")
+}
+
+// footnoteLink returns URL of link, which can be shown in plain text, or empty string for links with unsafe
+// or relative URLs.
+func footnoteLink(token html.Token) string {
+	for _, attr := range token.Attr {
+		if attr.Key != "href" {
+			continue
+		}
+
+		link, err := url.Parse(strings.TrimSpace(attr.Val))
+		if err != nil || (link.Scheme != "http" && link.Scheme != "https" && link.Scheme != "mailto") {
+			return ""
+		}
+
+		return link.String()
+	}
+
+	return ""
+}
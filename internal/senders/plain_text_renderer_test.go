@@ -0,0 +1,71 @@
+package senders
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderPlainText(t *testing.T) {
+	testCases := []struct {
+		name     string
+		content  string
+		expected string
+	}{
+		{
+			name: "notification content",
+			content: `<p>Добрый день, Иван!</p>
+<p>Заявка <b>Мишка</b> (<i>плюшевый</i>) изменена.</p>
+<p>Перейдите по <a href="http://localhost/tickets/1">ссылке</a>.</p>
+<p>С уважением,<br>
+команда.</p>
+`,
+			expected: "Добрый день, Иван!\n\n" +
+				"Заявка Мишка (плюшевый) изменена.\n\n" +
+				"Перейдите по ссылке [1].\n\n" +
+				"С уважением,\nкоманда.\n\n" +
+				"[1] http://localhost/tickets/1",
+		},
+		{
+			name:     "special characters are unescaped",
+			content:  `<p>1 &lt; 2 &amp; &#34;3&#34; &gt; 0</p>`,
+			expected: `1 < 2 & "3" > 0`,
+		},
+		{
+			name:     "same links share footnote",
+			content:  `<a href="https://a.ru">first</a>, <a href="https://b.ru">second</a>, <a href="https://a.ru">again</a>`,
+			expected: "first [1], second [2], again [1]\n\n[1] https://a.ru\n[2] https://b.ru",
+		},
+		{
+			name:     "link shown as URL",
+			content:  `<a href="https://a.ru">https://a.ru</a>`,
+			expected: "https://a.ru",
+		},
+		{
+			name:     "unsafe and relative links",
+			content:  `<a href="javascript:alert(1)">click</a> <a href="/tickets/1">ticket</a>`,
+			expected: "click ticket",
+		},
+		{
+			name:     "scripts and styles are dropped with content",
+			content:  `<style>p {color: red}</style><script>alert(1)</script><p>text</p>`,
+			expected: "text",
+		},
+		{
+			name:     "headings and lists",
+			content:  `<h1>Title</h1><ul><li>first</li><li>second</li></ul>`,
+			expected: "Title\n\n- first\n- second",
+		},
+		{
+			name:     "plain text",
+			content:  "Hello",
+			expected: "Hello",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, RenderPlainText(tc.content))
+		})
+	}
+}
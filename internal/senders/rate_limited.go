@@ -0,0 +1,139 @@
+package senders
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/DKhorkov/hmtm-notifications/internal/config"
+	"github.com/DKhorkov/hmtm-notifications/internal/interfaces"
+)
+
+// RateLimitedEmailSender limits number of emails, sent via wrapped sender, by global token bucket of SMTP account
+// and by token bucket of every recipient address. Sending over limit is delayed. If required delay exceeds
+// configured maximum, *RateLimitedError is returned, so sending could be rescheduled instead of blocking.
+type RateLimitedEmailSender struct {
+	sender             interfaces.EmailSender
+	config             config.EmailRateLimitConfig
+	globalLimiter      *rate.Limiter
+	mutex              sync.Mutex
+	recipientsLimiters map[string]*rate.Limiter
+	lastCleanup        time.Time
+}
+
+func NewRateLimitedEmailSender(
+	sender interfaces.EmailSender,
+	rateLimitConfig config.EmailRateLimitConfig,
+) *RateLimitedEmailSender {
+	return &RateLimitedEmailSender{
+		sender:             sender,
+		config:             rateLimitConfig,
+		globalLimiter:      newLimiter(rateLimitConfig.Global),
+		recipientsLimiters: make(map[string]*rate.Limiter),
+		lastCleanup:        time.Now(),
+	}
+}
+
+func (s *RateLimitedEmailSender) Send(
+	ctx context.Context,
+	subject, body, text string,
+	recipients []string,
+) (string, error) {
+	reservations := s.reserve(recipients)
+
+	var delay time.Duration
+	for _, reservation := range reservations {
+		delay = max(delay, reservation.Delay())
+	}
+
+	if delay > s.config.MaxDelay {
+		cancelReservations(reservations)
+
+		return "", &RateLimitedError{RetryAfter: delay}
+	}
+
+	if delay > 0 {
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			cancelReservations(reservations)
+
+			return "", ctx.Err()
+		case <-timer.C:
+		}
+	}
+
+	return s.sender.Send(ctx, subject, body, text, recipients)
+}
+
+// reserve takes tokens from global bucket and from buckets of all recipients.
+func (s *RateLimitedEmailSender) reserve(recipients []string) []*rate.Reservation {
+	now := time.Now()
+	reservations := make([]*rate.Reservation, 0, len(recipients)+1)
+
+	if s.globalLimiter != nil {
+		reservations = append(reservations, s.globalLimiter.ReserveN(now, 1))
+	}
+
+	if s.config.Recipient.Messages <= 0 || s.config.Recipient.Period <= 0 {
+		return reservations
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.cleanup(now)
+
+	for _, recipient := range recipients {
+		address := strings.ToLower(strings.TrimSpace(recipient))
+
+		limiter, ok := s.recipientsLimiters[address]
+		if !ok {
+			limiter = newLimiter(s.config.Recipient)
+			s.recipientsLimiters[address] = limiter
+		}
+
+		reservations = append(reservations, limiter.ReserveN(now, 1))
+	}
+
+	return reservations
+}
+
+// cleanup deletes limiters of recipients, whose buckets are full again. Such limiter is equal to new one,
+// so deletion does not affect limiting, but prevents unbounded growth of recipients limiters.
+func (s *RateLimitedEmailSender) cleanup(now time.Time) {
+	if now.Sub(s.lastCleanup) < s.config.Recipient.Period {
+		return
+	}
+
+	s.lastCleanup = now
+
+	for address, limiter := range s.recipientsLimiters {
+		if limiter.TokensAt(now) >= float64(limiter.Burst()) {
+			delete(s.recipientsLimiters, address)
+		}
+	}
+}
+
+// newLimiter creates token bucket, which allows provided number of messages per period.
+// Returns nil, if limiting is disabled.
+func newLimiter(limitConfig config.RateLimitConfig) *rate.Limiter {
+	if limitConfig.Messages <= 0 || limitConfig.Period <= 0 {
+		return nil
+	}
+
+	return rate.NewLimiter(
+		rate.Every(limitConfig.Period/time.Duration(limitConfig.Messages)),
+		max(limitConfig.Burst, 1),
+	)
+}
+
+func cancelReservations(reservations []*rate.Reservation) {
+	for _, reservation := range reservations {
+		reservation.Cancel()
+	}
+}
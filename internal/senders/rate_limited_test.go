@@ -0,0 +1,129 @@
+package senders
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/DKhorkov/hmtm-notifications/internal/config"
+	mocksenders "github.com/DKhorkov/hmtm-notifications/mocks/senders"
+)
+
+func TestRateLimitedEmailSender_Send(t *testing.T) {
+	testCases := []struct {
+		name            string
+		config          config.EmailRateLimitConfig
+		recipients      [][]string
+		expectedSent    int
+		expectedLimited int
+		minDuration     time.Duration
+	}{
+		{
+			name:         "limits disabled",
+			recipients:   [][]string{{"a@example.com"}, {"a@example.com"}, {"a@example.com"}},
+			expectedSent: 3,
+		},
+		{
+			name: "global limit delays sending",
+			config: config.EmailRateLimitConfig{
+				Global:   config.RateLimitConfig{Messages: 1, Period: time.Millisecond * 50, Burst: 1},
+				MaxDelay: time.Second,
+			},
+			recipients:   [][]string{{"a@example.com"}, {"b@example.com"}},
+			expectedSent: 2,
+			minDuration:  time.Millisecond * 40,
+		},
+		{
+			name: "global limit delay exceeds max delay",
+			config: config.EmailRateLimitConfig{
+				Global:   config.RateLimitConfig{Messages: 1, Period: time.Hour, Burst: 1},
+				MaxDelay: time.Second,
+			},
+			recipients:      [][]string{{"a@example.com"}, {"b@example.com"}},
+			expectedSent:    1,
+			expectedLimited: 1,
+		},
+		{
+			name: "recipient limit",
+			config: config.EmailRateLimitConfig{
+				Recipient: config.RateLimitConfig{Messages: 1, Period: time.Hour, Burst: 1},
+				MaxDelay:  time.Second,
+			},
+			recipients: [][]string{
+				{"a@example.com"},
+				{"b@example.com"},
+				{" A@Example.com"},
+			},
+			expectedSent:    2,
+			expectedLimited: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			emailSender := mocksenders.NewMockEmailSender(ctrl)
+			emailSender.
+				EXPECT().
+				Send(gomock.Any(), "Subject", "Body", "Text", gomock.Any()).
+				Return("<message-id>", nil).
+				Times(tc.expectedSent)
+
+			sender := NewRateLimitedEmailSender(emailSender, tc.config)
+			start := time.Now()
+
+			var limited int
+
+			for _, recipients := range tc.recipients {
+				messageID, err := sender.Send(context.Background(), "Subject", "Body", "Text", recipients)
+
+				var rateLimitedErr *RateLimitedError
+				if errors.As(err, &rateLimitedErr) {
+					require.Greater(t, rateLimitedErr.RetryAfter, tc.config.MaxDelay)
+					require.Empty(t, messageID)
+
+					limited++
+
+					continue
+				}
+
+				require.NoError(t, err)
+				require.Equal(t, "<message-id>", messageID)
+			}
+
+			require.Equal(t, tc.expectedLimited, limited)
+			require.GreaterOrEqual(t, time.Since(start), tc.minDuration)
+		})
+	}
+}
+
+func TestRateLimitedEmailSender_SendCanceledContext(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	emailSender := mocksenders.NewMockEmailSender(ctrl)
+	emailSender.
+		EXPECT().
+		Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return("<message-id>", nil).
+		Times(1)
+
+	sender := NewRateLimitedEmailSender(
+		emailSender,
+		config.EmailRateLimitConfig{
+			Global:   config.RateLimitConfig{Messages: 1, Period: time.Minute, Burst: 1},
+			MaxDelay: time.Minute,
+		},
+	)
+
+	_, err := sender.Send(context.Background(), "Subject", "Body", "", []string{"a@example.com"})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*10)
+	defer cancel()
+
+	_, err = sender.Send(ctx, "Subject", "Body", "", []string{"a@example.com"})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
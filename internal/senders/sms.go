@@ -0,0 +1,47 @@
+package senders
+
+import (
+	"context"
+	"errors"
+
+	"github.com/DKhorkov/libs/tracing"
+
+	"github.com/DKhorkov/hmtm-notifications/internal/interfaces"
+)
+
+var ErrEmptyPhone = errors.New("phone is empty")
+
+// SMSSender sends short plain text notifications to phones via SMS provider.
+type SMSSender struct {
+	provider      interfaces.SMSProvider
+	traceProvider tracing.Provider
+	spanConfig    tracing.SpanConfig
+}
+
+func NewSMSSender(
+	provider interfaces.SMSProvider,
+	traceProvider tracing.Provider,
+	spanConfig tracing.SpanConfig,
+) *SMSSender {
+	return &SMSSender{
+		provider:      provider,
+		traceProvider: traceProvider,
+		spanConfig:    spanConfig,
+	}
+}
+
+// Send sends content to provided phone. Content should be already built by SMS content builder,
+// so that it fits SMS length limits.
+func (s *SMSSender) Send(ctx context.Context, phone, content string) (string, error) {
+	ctx, span := s.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(s.spanConfig.Events.Start.Name, s.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(s.spanConfig.Events.End.Name, s.spanConfig.Events.End.Opts...)
+
+	if phone == "" {
+		return "", &PermanentError{BaseErr: ErrEmptyPhone}
+	}
+
+	return s.provider.Send(ctx, phone, content)
+}
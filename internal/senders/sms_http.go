@@ -0,0 +1,108 @@
+package senders
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/DKhorkov/hmtm-notifications/internal/config"
+)
+
+// Maximum size of error response body, which is added to error:
+const smsErrorBodyMaxSize = 1024
+
+type smsSendResponse struct {
+	ID string `json:"id"`
+}
+
+type smsSendRequest struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+	Text string `json:"text"`
+}
+
+// HTTPSMSProvider sends SMS via JSON HTTP API of SMS gateway, authorizing with Bearer API key.
+type HTTPSMSProvider struct {
+	providerConfig config.SMSProviderConfig
+	client         *http.Client
+}
+
+func NewHTTPSMSProvider(providerConfig config.SMSProviderConfig) *HTTPSMSProvider {
+	return &HTTPSMSProvider{
+		providerConfig: providerConfig,
+		client:         &http.Client{Timeout: providerConfig.Timeout},
+	}
+}
+
+// Send sends text to phone. Throttled requests are returned as *RateLimitedError,
+// rejected ones, for example, due to invalid phone number, as *PermanentError. Message ID is returned,
+// if gateway provides it in response.
+func (p *HTTPSMSProvider) Send(ctx context.Context, phone, text string) (string, error) {
+	body, err := json.Marshal(
+		smsSendRequest{
+			From: p.providerConfig.Sender,
+			To:   phone,
+			Text: text,
+		},
+	)
+	if err != nil {
+		return "", &PermanentError{BaseErr: err}
+	}
+
+	request, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		p.providerConfig.URL,
+		bytes.NewReader(body),
+	)
+	if err != nil {
+		return "", &PermanentError{BaseErr: err}
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("Authorization", "Bearer "+p.providerConfig.APIKey)
+
+	response, err := p.client.Do(request)
+	if err != nil {
+		return "", fmt.Errorf("sms provider request failed: %w", err)
+	}
+
+	defer func() {
+		_ = response.Body.Close()
+	}()
+
+	if response.StatusCode >= http.StatusOK && response.StatusCode < http.StatusMultipleChoices {
+		// Message was accepted, so response without ID should not lead to repeated sending:
+		var smsResp smsSendResponse
+		_ = json.NewDecoder(io.LimitReader(response.Body, smsErrorBodyMaxSize)).Decode(&smsResp)
+
+		return smsResp.ID, nil
+	}
+
+	return "", smsProviderError(response)
+}
+
+// smsProviderError classifies failed response of SMS gateway.
+func smsProviderError(response *http.Response) error {
+	description, _ := io.ReadAll(io.LimitReader(response.Body, smsErrorBodyMaxSize))
+	err := fmt.Errorf("sms provider error %d: %s", response.StatusCode, bytes.TrimSpace(description))
+
+	switch {
+	case response.StatusCode == http.StatusTooManyRequests:
+		retryAfter := time.Second
+		if seconds, parseErr := strconv.Atoi(response.Header.Get("Retry-After")); parseErr == nil && seconds > 0 {
+			retryAfter = time.Second * time.Duration(seconds)
+		}
+
+		return &RateLimitedError{RetryAfter: retryAfter}
+	case response.StatusCode >= http.StatusBadRequest && response.StatusCode < http.StatusInternalServerError:
+		return &PermanentError{BaseErr: err}
+	default:
+		return err
+	}
+}
@@ -0,0 +1,61 @@
+package senders
+
+import (
+	"context"
+	"strconv"
+	"sync"
+)
+
+type SMSMessage struct {
+	Phone string
+	Text  string
+}
+
+// InMemorySMSProvider stores sent messages instead of delivering them. It is intended to be used in tests
+// and local environment without access to SMS gateway.
+type InMemorySMSProvider struct {
+	mutex    sync.Mutex
+	messages []SMSMessage
+	err      error
+}
+
+func NewInMemorySMSProvider() *InMemorySMSProvider {
+	return &InMemorySMSProvider{}
+}
+
+// Send stores message and returns its sequence number as message ID.
+func (p *InMemorySMSProvider) Send(ctx context.Context, phone, text string) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if p.err != nil {
+		return "", p.err
+	}
+
+	p.messages = append(p.messages, SMSMessage{Phone: phone, Text: text})
+
+	return strconv.Itoa(len(p.messages)), nil
+}
+
+// FailWith makes all further sendings fail with provided error. Nil error restores successful sending.
+func (p *InMemorySMSProvider) FailWith(err error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.err = err
+}
+
+// Messages returns copy of all sent messages in order of sending.
+func (p *InMemorySMSProvider) Messages() []SMSMessage {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	messages := make([]SMSMessage, len(p.messages))
+	copy(messages, p.messages)
+
+	return messages
+}
@@ -0,0 +1,200 @@
+package senders
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/DKhorkov/libs/tracing"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	mocktracing "github.com/DKhorkov/libs/tracing/mocks"
+
+	"github.com/DKhorkov/hmtm-notifications/internal/config"
+)
+
+func TestSMSSender_Send(t *testing.T) {
+	providerErr := errors.New("provider error")
+
+	testCases := []struct {
+		name              string
+		phone             string
+		providerErr       error
+		expectedMessages  []SMSMessage
+		expectedMessageID string
+		errorExpected     bool
+		permanentExpected bool
+	}{
+		{
+			name:  "success",
+			phone: "+79990000000",
+			expectedMessages: []SMSMessage{
+				{Phone: "+79990000000", Text: "Hello"},
+			},
+			expectedMessageID: "1",
+		},
+		{
+			name:              "empty phone",
+			expectedMessages:  []SMSMessage{},
+			errorExpected:     true,
+			permanentExpected: true,
+		},
+		{
+			name:             "provider error",
+			phone:            "+79990000000",
+			providerErr:      providerErr,
+			expectedMessages: []SMSMessage{},
+			errorExpected:    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			traceProvider := mocktracing.NewMockProvider(ctrl)
+			traceProvider.
+				EXPECT().
+				Span(gomock.Any(), gomock.Any()).
+				Return(context.Background(), mocktracing.NewMockSpan()).
+				Times(1)
+
+			provider := NewInMemorySMSProvider()
+			provider.FailWith(tc.providerErr)
+
+			sender := NewSMSSender(provider, traceProvider, tracing.SpanConfig{})
+
+			messageID, err := sender.Send(context.Background(), tc.phone, "Hello")
+			require.Equal(t, tc.expectedMessages, provider.Messages())
+			require.Equal(t, tc.expectedMessageID, messageID)
+
+			if !tc.errorExpected {
+				require.NoError(t, err)
+
+				return
+			}
+
+			var permanentErr *PermanentError
+
+			require.Error(t, err)
+			require.Equal(t, tc.permanentExpected, errors.As(err, &permanentErr))
+		})
+	}
+}
+
+func TestHTTPSMSProvider_Send(t *testing.T) {
+	testCases := []struct {
+		name              string
+		statusCode        int
+		response          string
+		retryAfterHeader  string
+		expectedMessageID string
+		errorExpected     bool
+		permanentExpected bool
+		retryAfter        time.Duration
+	}{
+		{
+			name:              "success",
+			statusCode:        http.StatusAccepted,
+			response:          `{"id": "sms-100500"}`,
+			expectedMessageID: "sms-100500",
+		},
+		{
+			name:       "success without message ID",
+			statusCode: http.StatusOK,
+			response:   "OK",
+		},
+		{
+			name:              "invalid phone",
+			statusCode:        http.StatusUnprocessableEntity,
+			response:          `{"error": "invalid phone"}`,
+			errorExpected:     true,
+			permanentExpected: true,
+		},
+		{
+			name:             "too many requests with retry after",
+			statusCode:       http.StatusTooManyRequests,
+			retryAfterHeader: "7",
+			errorExpected:    true,
+			retryAfter:       time.Second * 7,
+		},
+		{
+			name:          "too many requests without retry after",
+			statusCode:    http.StatusTooManyRequests,
+			errorExpected: true,
+			retryAfter:    time.Second,
+		},
+		{
+			name:          "server error",
+			statusCode:    http.StatusServiceUnavailable,
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var requestsCount int
+
+			server := httptest.NewServer(
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					requestsCount++
+
+					require.Equal(t, http.MethodPost, r.Method)
+					require.Equal(t, "Bearer api-key", r.Header.Get("Authorization"))
+
+					var request smsSendRequest
+					require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+					require.Equal(
+						t,
+						smsSendRequest{From: "HMTM", To: "+79990000000", Text: "Hello"},
+						request,
+					)
+
+					if tc.retryAfterHeader != "" {
+						w.Header().Set("Retry-After", tc.retryAfterHeader)
+					}
+
+					w.WriteHeader(tc.statusCode)
+					_, _ = w.Write([]byte(tc.response))
+				}),
+			)
+			defer server.Close()
+
+			provider := NewHTTPSMSProvider(
+				config.SMSProviderConfig{
+					URL:     server.URL,
+					APIKey:  "api-key",
+					Sender:  "HMTM",
+					Timeout: time.Second,
+				},
+			)
+
+			messageID, err := provider.Send(context.Background(), "+79990000000", "Hello")
+			require.Equal(t, 1, requestsCount)
+			require.Equal(t, tc.expectedMessageID, messageID)
+
+			if !tc.errorExpected {
+				require.NoError(t, err)
+
+				return
+			}
+
+			var (
+				permanentErr   *PermanentError
+				rateLimitedErr *RateLimitedError
+			)
+
+			require.Error(t, err)
+			require.Equal(t, tc.permanentExpected, errors.As(err, &permanentErr))
+
+			if tc.retryAfter > 0 {
+				require.ErrorAs(t, err, &rateLimitedErr)
+				require.Equal(t, tc.retryAfter, rateLimitedErr.RetryAfter)
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package senders
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"gopkg.in/gomail.v2"
+)
+
+const (
+	defaultSMTPPoolSize    = 1
+	defaultSMTPIdleTimeout = 30 * time.Second
+)
+
+// smtpConnection represents authenticated SMTP session, which could be used for sending several messages.
+type smtpConnection struct {
+	gomail.SendCloser
+	idleSince time.Time
+	reused    bool
+}
+
+// smtpPool keeps authenticated SMTP connections to avoid TCP, TLS and AUTH handshakes for every message.
+// Number of opened connections is limited by pool size. Connections, which were idle longer than idle timeout,
+// are closed instead of reusing, because SMTP servers usually drop idle sessions on their side.
+type smtpPool struct {
+	dialer      smtpDialer
+	idleTimeout time.Duration
+	slots       chan struct{}
+	mutex       sync.Mutex
+	idle        []*smtpConnection
+	closed      bool
+}
+
+func newSMTPPool(dialer smtpDialer, size int, idleTimeout time.Duration) *smtpPool {
+	if size <= 0 {
+		size = defaultSMTPPoolSize
+	}
+
+	if idleTimeout <= 0 {
+		idleTimeout = defaultSMTPIdleTimeout
+	}
+
+	return &smtpPool{
+		dialer:      dialer,
+		idleTimeout: idleTimeout,
+		slots:       make(chan struct{}, size),
+	}
+}
+
+// get returns idle connection or opens new one. Waits for free slot, if all connections are busy.
+// Every received connection must be returned to pool via put.
+func (p *smtpPool) get(ctx context.Context) (*smtpConnection, error) {
+	select {
+	case p.slots <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	connection, err := p.idleConnection()
+	if err != nil {
+		<-p.slots
+
+		return nil, err
+	}
+
+	if connection != nil {
+		return connection, nil
+	}
+
+	sendCloser, err := p.dialer.Dial()
+	if err != nil {
+		<-p.slots
+
+		return nil, err
+	}
+
+	return &smtpConnection{SendCloser: sendCloser}, nil
+}
+
+// idleConnection returns most recently used idle connection, closing expired ones.
+func (p *smtpPool) idleConnection() (*smtpConnection, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if p.closed {
+		return nil, ErrSMTPPoolClosed
+	}
+
+	for len(p.idle) > 0 {
+		connection := p.idle[len(p.idle)-1]
+		p.idle = p.idle[:len(p.idle)-1]
+
+		if time.Since(connection.idleSince) < p.idleTimeout {
+			connection.reused = true
+
+			return connection, nil
+		}
+
+		_ = connection.Close()
+	}
+
+	return nil, nil
+}
+
+// put returns connection to pool. Connection, which failed to send message, is closed, because session
+// could be left in unknown state or be already dropped by server.
+func (p *smtpPool) put(connection *smtpConnection, broken bool) {
+	defer func() { <-p.slots }()
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if broken || p.closed {
+		_ = connection.Close()
+
+		return
+	}
+
+	connection.idleSince = time.Now()
+	p.idle = append(p.idle, connection)
+}
+
+// close closes all idle connections. Busy connections are closed after they are returned to pool.
+func (p *smtpPool) close() error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if p.closed {
+		return ErrSMTPPoolClosed
+	}
+
+	p.closed = true
+
+	var err error
+	for _, connection := range p.idle {
+		err = errors.Join(err, connection.Close())
+	}
+
+	p.idle = nil
+
+	return err
+}
@@ -0,0 +1,101 @@
+package senders
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSMTPPool_ReusesIdleConnection(t *testing.T) {
+	dialer := &fakeDialer{}
+	pool := newSMTPPool(dialer, 1, time.Minute)
+
+	connection, err := pool.get(context.Background())
+	require.NoError(t, err)
+	require.False(t, connection.reused)
+
+	pool.put(connection, false)
+
+	reusedConnection, err := pool.get(context.Background())
+	require.NoError(t, err)
+	require.True(t, reusedConnection.reused)
+	require.Same(t, connection, reusedConnection)
+	require.Equal(t, 1, dialer.calls)
+}
+
+func TestSMTPPool_ClosesBrokenConnection(t *testing.T) {
+	dialer := &fakeDialer{}
+	pool := newSMTPPool(dialer, 1, time.Minute)
+
+	connection, err := pool.get(context.Background())
+	require.NoError(t, err)
+
+	pool.put(connection, true)
+	require.Equal(t, 1, dialer.connections[0].closed)
+
+	_, err = pool.get(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 2, dialer.calls)
+}
+
+func TestSMTPPool_ClosesExpiredConnection(t *testing.T) {
+	dialer := &fakeDialer{}
+	pool := newSMTPPool(dialer, 1, time.Millisecond)
+
+	connection, err := pool.get(context.Background())
+	require.NoError(t, err)
+
+	pool.put(connection, false)
+	time.Sleep(time.Millisecond * 5)
+
+	connection, err = pool.get(context.Background())
+	require.NoError(t, err)
+	require.False(t, connection.reused)
+	require.Equal(t, 2, dialer.calls)
+	require.Equal(t, 1, dialer.connections[0].closed)
+}
+
+func TestSMTPPool_LimitsConnectionsNumber(t *testing.T) {
+	dialer := &fakeDialer{}
+	pool := newSMTPPool(dialer, 1, time.Minute)
+
+	connection, err := pool.get(context.Background())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*10)
+	defer cancel()
+
+	_, err = pool.get(ctx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	pool.put(connection, false)
+
+	_, err = pool.get(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, dialer.calls)
+}
+
+func TestSMTPPool_Close(t *testing.T) {
+	dialer := &fakeDialer{}
+	pool := newSMTPPool(dialer, 2, time.Minute)
+
+	idleConnection, err := pool.get(context.Background())
+	require.NoError(t, err)
+
+	busyConnection, err := pool.get(context.Background())
+	require.NoError(t, err)
+
+	pool.put(idleConnection, false)
+	require.NoError(t, pool.close())
+	require.Equal(t, 1, dialer.connections[0].closed)
+
+	// Busy connection is closed, when it is returned to closed pool:
+	pool.put(busyConnection, false)
+	require.Equal(t, 1, dialer.connections[1].closed)
+
+	_, err = pool.get(context.Background())
+	require.ErrorIs(t, err, ErrSMTPPoolClosed)
+	require.ErrorIs(t, pool.close(), ErrSMTPPoolClosed)
+}
@@ -0,0 +1,247 @@
+package senders
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/DKhorkov/libs/tracing"
+	"golang.org/x/net/html"
+
+	"github.com/DKhorkov/hmtm-notifications/internal/config"
+)
+
+const (
+	telegramSendMessageMethod = "sendMessage"
+	telegramParseMode         = "HTML"
+
+	// Maximum length of message text in characters after entities parsing, allowed by Telegram Bot API:
+	telegramMessageMaxLength = 4096
+)
+
+var ErrInvalidTelegramChatID = errors.New("telegram chat ID must be numeric")
+
+type telegramSendMessageRequest struct {
+	ChatID                string `json:"chat_id"`
+	Text                  string `json:"text"`
+	ParseMode             string `json:"parse_mode"`
+	DisableWebPagePreview bool   `json:"disable_web_page_preview"`
+}
+
+type telegramResponse struct {
+	OK     bool `json:"ok"`
+	Result *struct {
+		MessageID int64 `json:"message_id"`
+	} `json:"result,omitempty"`
+	ErrorCode   int    `json:"error_code"`
+	Description string `json:"description"`
+	Parameters  *struct {
+		RetryAfter int `json:"retry_after"`
+	} `json:"parameters,omitempty"`
+}
+
+// TelegramSender sends notifications to private chats with users via Telegram Bot API.
+type TelegramSender struct {
+	telegramConfig config.TelegramConfig
+	client         *http.Client
+	traceProvider  tracing.Provider
+	spanConfig     tracing.SpanConfig
+}
+
+func NewTelegramSender(
+	telegramConfig config.TelegramConfig,
+	traceProvider tracing.Provider,
+	spanConfig tracing.SpanConfig,
+) *TelegramSender {
+	return &TelegramSender{
+		telegramConfig: telegramConfig,
+		client:         &http.Client{Timeout: telegramConfig.Timeout},
+		traceProvider:  traceProvider,
+		spanConfig:     spanConfig,
+	}
+}
+
+// Send renders HTML content to Telegram-safe formatting and sends it to chat with provided ID. Content, which
+// exceeds Telegram message length limit, is split into several messages, and first sentParts of them, which were
+// sent by previous attempt, are skipped. Rejected requests and not numeric chat IDs, such as usernames, which
+// Bot API can not send to, are returned as *PermanentError and throttled requests as *RateLimitedError. Failure
+// after some messages were sent is wrapped into *PartiallySentError. ID of the first sent message is returned.
+func (s *TelegramSender) Send(ctx context.Context, chatID, content string, sentParts uint32) (string, error) {
+	ctx, span := s.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(s.spanConfig.Events.Start.Name, s.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(s.spanConfig.Events.End.Name, s.spanConfig.Events.End.Opts...)
+
+	if _, err := strconv.ParseInt(chatID, 10, 64); err != nil {
+		return "", &PermanentError{BaseErr: ErrInvalidTelegramChatID}
+	}
+
+	messages := splitTelegramMessage(RenderTelegramHTML(content), telegramMessageMaxLength)
+
+	var firstMessageID string
+	for i := int(min(sentParts, uint32(len(messages)))); i < len(messages); i++ {
+		messageID, err := s.sendMessage(ctx, chatID, messages[i])
+		if err != nil {
+			if i == int(sentParts) {
+				return "", err
+			}
+
+			return "", &PartiallySentError{SentParts: uint32(i), MessageID: firstMessageID, BaseErr: err}
+		}
+
+		if firstMessageID == "" {
+			firstMessageID = messageID
+		}
+	}
+
+	return firstMessageID, nil
+}
+
+func (s *TelegramSender) sendMessage(ctx context.Context, chatID, text string) (string, error) {
+	body, err := json.Marshal(
+		telegramSendMessageRequest{
+			ChatID:                chatID,
+			Text:                  text,
+			ParseMode:             telegramParseMode,
+			DisableWebPagePreview: true,
+		},
+	)
+	if err != nil {
+		return "", &PermanentError{BaseErr: err}
+	}
+
+	request, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		fmt.Sprintf(
+			"%s/bot%s/%s",
+			strings.TrimRight(s.telegramConfig.BaseURL, "/"),
+			s.telegramConfig.BotToken,
+			telegramSendMessageMethod,
+		),
+		bytes.NewReader(body),
+	)
+	if err != nil {
+		return "", &PermanentError{BaseErr: err}
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := s.client.Do(request)
+	if err != nil {
+		// URL contains bot token, so it must not get to logs:
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+
+		return "", fmt.Errorf("telegram request failed: %w", err)
+	}
+
+	defer func() {
+		_ = response.Body.Close()
+	}()
+
+	var telegramResp telegramResponse
+	if err = json.NewDecoder(response.Body).Decode(&telegramResp); err != nil {
+		return "", fmt.Errorf("failed to decode telegram response with status %d: %w", response.StatusCode, err)
+	}
+
+	if telegramResp.OK {
+		if telegramResp.Result == nil {
+			return "", nil
+		}
+
+		return strconv.FormatInt(telegramResp.Result.MessageID, 10), nil
+	}
+
+	return "", telegramError(response.StatusCode, telegramResp)
+}
+
+// telegramError classifies failed Bot API response. Throttling is returned as *RateLimitedError,
+// other client errors, such as blocked bot or not existing chat, as *PermanentError.
+func telegramError(statusCode int, response telegramResponse) error {
+	err := fmt.Errorf("telegram error %d: %s", response.ErrorCode, response.Description)
+
+	switch {
+	case statusCode == http.StatusTooManyRequests:
+		retryAfter := time.Second
+		if response.Parameters != nil && response.Parameters.RetryAfter > 0 {
+			retryAfter = time.Second * time.Duration(response.Parameters.RetryAfter)
+		}
+
+		return &RateLimitedError{RetryAfter: retryAfter}
+	case statusCode >= http.StatusBadRequest && statusCode < http.StatusInternalServerError:
+		return &PermanentError{BaseErr: err}
+	default:
+		return err
+	}
+}
+
+// splitTelegramMessage splits rendered text into messages, which do not exceed provided length.
+// Text is split by paragraphs to keep formatting tags balanced. Paragraph, which alone exceeds the limit,
+// is sent as plain text, split by characters.
+func splitTelegramMessage(text string, maxLength int) []string {
+	if telegramTextLength(text) <= maxLength {
+		return []string{text}
+	}
+
+	var (
+		messages []string
+		current  string
+	)
+
+	for _, paragraph := range strings.Split(text, "\n\n") {
+		candidate := paragraph
+		if current != "" {
+			candidate = current + "\n\n" + paragraph
+		}
+
+		if telegramTextLength(candidate) <= maxLength {
+			current = candidate
+
+			continue
+		}
+
+		if current != "" {
+			messages = append(messages, current)
+			current = ""
+		}
+
+		if telegramTextLength(paragraph) <= maxLength {
+			current = paragraph
+
+			continue
+		}
+
+		runes := []rune(html.UnescapeString(stripTags(paragraph)))
+		for len(runes) > 0 {
+			chunkLength := min(maxLength, len(runes))
+			messages = append(messages, html.EscapeString(string(runes[:chunkLength])))
+			runes = runes[chunkLength:]
+		}
+	}
+
+	if current != "" {
+		messages = append(messages, current)
+	}
+
+	return messages
+}
+
+// telegramTextLength returns length of text, as it is counted by Telegram: without tags and with parsed entities.
+func telegramTextLength(text string) int {
+	return len([]rune(html.UnescapeString(stripTags(text))))
+}
+
+func stripTags(text string) string {
+	return tagsRegexp.ReplaceAllString(text, "")
+}
@@ -0,0 +1,217 @@
+package senders
+
+import (
+	"io"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// telegramTags maps HTML tags to tags, supported by Telegram Bot API in HTML parse mode.
+var telegramTags = map[atom.Atom]string{
+	atom.B:          "b",
+	atom.Strong:     "b",
+	atom.H1:         "b",
+	atom.H2:         "b",
+	atom.H3:         "b",
+	atom.H4:         "b",
+	atom.H5:         "b",
+	atom.H6:         "b",
+	atom.I:          "i",
+	atom.Em:         "i",
+	atom.U:          "u",
+	atom.Ins:        "u",
+	atom.S:          "s",
+	atom.Strike:     "s",
+	atom.Del:        "s",
+	atom.Code:       "code",
+	atom.Pre:        "pre",
+	atom.Blockquote: "blockquote",
+}
+
+// blockTags are rendered as separate paragraphs, because Telegram does not support block layout.
+var blockTags = map[atom.Atom]bool{
+	atom.P:          true,
+	atom.Div:        true,
+	atom.H1:         true,
+	atom.H2:         true,
+	atom.H3:         true,
+	atom.H4:         true,
+	atom.H5:         true,
+	atom.H6:         true,
+	atom.Ul:         true,
+	atom.Ol:         true,
+	atom.Table:      true,
+	atom.Tr:         true,
+	atom.Pre:        true,
+	atom.Blockquote: true,
+}
+
+var (
+	whitespacesRegexp = regexp.MustCompile(`[ \t\r\n]+`)
+	paragraphsRegexp  = regexp.MustCompile(`\n{3,}`)
+	tagsRegexp        = regexp.MustCompile(`<[^>]*>`)
+)
+
+// RenderTelegramHTML converts HTML content of notification to HTML subset, supported by Telegram Bot API.
+// Supported formatting tags are kept, links are kept only for http and https schemes, block elements are
+// replaced by line breaks, and all other tags are dropped with their text kept and escaped.
+func RenderTelegramHTML(content string) string {
+	var (
+		builder  strings.Builder
+		openTags []string
+		skip     int
+		preDepth int
+	)
+
+	tokenizer := html.NewTokenizer(strings.NewReader(content))
+
+	for {
+		tokenType := tokenizer.Next()
+		if tokenType == html.ErrorToken {
+			if tokenizer.Err() != io.EOF {
+				return ""
+			}
+
+			break
+		}
+
+		token := tokenizer.Token()
+
+		switch tokenType {
+		case html.TextToken:
+			if skip > 0 {
+				continue
+			}
+
+			text := token.Data
+			if preDepth == 0 {
+				text = whitespacesRegexp.ReplaceAllString(text, " ")
+			}
+
+			builder.WriteString(html.EscapeString(text))
+		case html.StartTagToken, html.SelfClosingTagToken:
+			switch token.DataAtom {
+			case atom.Script, atom.Style, atom.Head, atom.Title:
+				if tokenType == html.StartTagToken {
+					skip++
+				}
+
+				continue
+			case atom.Br:
+				builder.WriteString("\n")
+
+				continue
+			case atom.Li:
+				builder.WriteString("\n• ")
+
+				continue
+			}
+
+			if skip > 0 || tokenType == html.SelfClosingTagToken {
+				continue
+			}
+
+			if blockTags[token.DataAtom] {
+				builder.WriteString("\n\n")
+			}
+
+			if token.DataAtom == atom.Pre {
+				preDepth++
+			}
+
+			tag := telegramTag(token)
+			if tag != "" {
+				builder.WriteString(tag)
+			}
+
+			openTags = append(openTags, closingTelegramTag(token, tag))
+		case html.EndTagToken:
+			switch token.DataAtom {
+			case atom.Script, atom.Style, atom.Head, atom.Title:
+				if skip > 0 {
+					skip--
+				}
+
+				continue
+			case atom.Br, atom.Li:
+				continue
+			}
+
+			if skip > 0 || len(openTags) == 0 {
+				continue
+			}
+
+			// Closing tags in reverse order keeps result well-formed even for invalid input:
+			builder.WriteString(openTags[len(openTags)-1])
+			openTags = openTags[:len(openTags)-1]
+
+			if token.DataAtom == atom.Pre && preDepth > 0 {
+				preDepth--
+			}
+
+			if blockTags[token.DataAtom] {
+				builder.WriteString("\n\n")
+			}
+		}
+	}
+
+	for i := len(openTags) - 1; i >= 0; i-- {
+		builder.WriteString(openTags[i])
+	}
+
+	return normalizeRenderedText(builder.String())
+}
+
+// telegramTag returns opening Telegram tag for provided HTML token or empty string, if tag is not supported.
+func telegramTag(token html.Token) string {
+	if token.DataAtom == atom.A {
+		for _, attr := range token.Attr {
+			if attr.Key != "href" {
+				continue
+			}
+
+			link, err := url.Parse(attr.Val)
+			if err != nil || (link.Scheme != "http" && link.Scheme != "https") {
+				return ""
+			}
+
+			return `<a href="` + html.EscapeString(link.String()) + `">`
+		}
+
+		return ""
+	}
+
+	if tag, ok := telegramTags[token.DataAtom]; ok {
+		return "<" + tag + ">"
+	}
+
+	return ""
+}
+
+func closingTelegramTag(token html.Token, openingTag string) string {
+	switch {
+	case openingTag == "":
+		return ""
+	case token.DataAtom == atom.A:
+		return "</a>"
+	default:
+		return "</" + telegramTags[token.DataAtom] + ">"
+	}
+}
+
+// normalizeRenderedText trims spaces around line breaks and collapses empty lines of rendered content.
+func normalizeRenderedText(text string) string {
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSpace(line)
+	}
+
+	text = strings.Join(lines, "\n")
+	text = paragraphsRegexp.ReplaceAllString(text, "\n\n")
+
+	return strings.TrimSpace(text)
+}
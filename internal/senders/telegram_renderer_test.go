@@ -0,0 +1,99 @@
+package senders
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderTelegramHTML(t *testing.T) {
+	testCases := []struct {
+		name     string
+		content  string
+		expected string
+	}{
+		{
+			name: "notification content",
+			content: `<p>Добрый день, Иван!</p>
+<p>Заявка <b>Мишка</b> (<i>плюшевый</i>) изменена.</p>
+<p>Перейдите по <a href="http://localhost/tickets/1">ссылке</a>.</p>
+<p>С уважением,<br>
+команда.</p>
+`,
+			expected: "Добрый день, Иван!\n\n" +
+				"Заявка <b>Мишка</b> (<i>плюшевый</i>) изменена.\n\n" +
+				`Перейдите по <a href="http://localhost/tickets/1">ссылке</a>.` + "\n\n" +
+				"С уважением,\nкоманда.",
+		},
+		{
+			name:     "special characters are escaped",
+			content:  `<p>1 &lt; 2 & "3" > 0</p>`,
+			expected: "1 &lt; 2 &amp; &#34;3&#34; &gt; 0",
+		},
+		{
+			name:     "unsupported tags are dropped",
+			content:  `<span style="color:red">red</span> <strong>bold</strong> <img src="x.png"/>`,
+			expected: "red <b>bold</b>",
+		},
+		{
+			name:     "scripts and styles are dropped with content",
+			content:  `<style>p {color: red}</style><script>alert(1)</script><p>text</p>`,
+			expected: "text",
+		},
+		{
+			name:     "unsafe link",
+			content:  `<a href="javascript:alert(1)">click</a>`,
+			expected: "click",
+		},
+		{
+			name:     "headings and lists",
+			content:  `<h1>Title</h1><ul><li>first</li><li>second</li></ul>`,
+			expected: "<b>Title</b>\n\n• first\n• second",
+		},
+		{
+			name:     "not closed tags are closed",
+			content:  `<b>bold <i>italic`,
+			expected: "<b>bold <i>italic</i></b>",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, RenderTelegramHTML(tc.content))
+		})
+	}
+}
+
+func Test_splitTelegramMessage(t *testing.T) {
+	testCases := []struct {
+		name      string
+		text      string
+		maxLength int
+		expected  []string
+	}{
+		{
+			name:      "short text",
+			text:      "<b>short</b>",
+			maxLength: 10,
+			expected:  []string{"<b>short</b>"},
+		},
+		{
+			name:      "split by paragraphs",
+			text:      "<b>first</b>\n\nsecond\n\nthird",
+			maxLength: 14,
+			expected:  []string{"<b>first</b>\n\nsecond", "third"},
+		},
+		{
+			name:      "long paragraph split as plain text",
+			text:      "<b>abcdef</b> &amp;",
+			maxLength: 4,
+			expected:  []string{"abcd", "ef &amp;"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, splitTelegramMessage(tc.text, tc.maxLength))
+		})
+	}
+}
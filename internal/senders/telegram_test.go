@@ -0,0 +1,300 @@
+package senders
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DKhorkov/libs/tracing"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	mocktracing "github.com/DKhorkov/libs/tracing/mocks"
+
+	"github.com/DKhorkov/hmtm-notifications/internal/config"
+)
+
+const telegramBotToken = "123:secret"
+
+func TestTelegramSender_Send(t *testing.T) {
+	testCases := []struct {
+		name              string
+		chatID            string
+		content           string
+		sentParts         uint32
+		statusCode        int
+		response          string
+		expectedRequests  []telegramSendMessageRequest
+		expectedMessageID string
+		errorExpected     bool
+		permanentExpected bool
+		retryAfter        time.Duration
+	}{
+		{
+			name:       "success",
+			chatID:     "100500",
+			content:    `<p>Hello, <b>user</b>!</p>`,
+			statusCode: http.StatusOK,
+			response:   `{"ok": true, "result": {"message_id": {id}}}`,
+			expectedRequests: []telegramSendMessageRequest{
+				{
+					ChatID:                "100500",
+					Text:                  "Hello, <b>user</b>!",
+					ParseMode:             telegramParseMode,
+					DisableWebPagePreview: true,
+				},
+			},
+			expectedMessageID: "1",
+		},
+		{
+			name:              "username instead of chat ID",
+			chatID:            "@username",
+			content:           "Hello",
+			errorExpected:     true,
+			permanentExpected: true,
+		},
+		{
+			name:       "bot blocked by user",
+			chatID:     "100500",
+			content:    "Hello",
+			statusCode: http.StatusForbidden,
+			response:   `{"ok": false, "error_code": 403, "description": "Forbidden: bot was blocked by the user"}`,
+			expectedRequests: []telegramSendMessageRequest{
+				{
+					ChatID:                "100500",
+					Text:                  "Hello",
+					ParseMode:             telegramParseMode,
+					DisableWebPagePreview: true,
+				},
+			},
+			errorExpected:     true,
+			permanentExpected: true,
+		},
+		{
+			name:       "too many requests",
+			chatID:     "100500",
+			content:    "Hello",
+			statusCode: http.StatusTooManyRequests,
+			response: `{"ok": false, "error_code": 429, "description": "Too Many Requests: retry after 5", ` +
+				`"parameters": {"retry_after": 5}}`,
+			expectedRequests: []telegramSendMessageRequest{
+				{
+					ChatID:                "100500",
+					Text:                  "Hello",
+					ParseMode:             telegramParseMode,
+					DisableWebPagePreview: true,
+				},
+			},
+			errorExpected: true,
+			retryAfter:    time.Second * 5,
+		},
+		{
+			name:       "server error",
+			chatID:     "100500",
+			content:    "Hello",
+			statusCode: http.StatusBadGateway,
+			response:   `{"ok": false, "error_code": 502, "description": "Bad Gateway"}`,
+			expectedRequests: []telegramSendMessageRequest{
+				{
+					ChatID:                "100500",
+					Text:                  "Hello",
+					ParseMode:             telegramParseMode,
+					DisableWebPagePreview: true,
+				},
+			},
+			errorExpected: true,
+		},
+		{
+			name:       "long content split into several messages",
+			chatID:     "100500",
+			content:    "<p>" + strings.Repeat("a", 4000) + "</p><p>" + strings.Repeat("b", 200) + "</p>",
+			statusCode: http.StatusOK,
+			response:   `{"ok": true, "result": {"message_id": {id}}}`,
+			expectedRequests: []telegramSendMessageRequest{
+				{
+					ChatID:                "100500",
+					Text:                  strings.Repeat("a", 4000),
+					ParseMode:             telegramParseMode,
+					DisableWebPagePreview: true,
+				},
+				{
+					ChatID:                "100500",
+					Text:                  strings.Repeat("b", 200),
+					ParseMode:             telegramParseMode,
+					DisableWebPagePreview: true,
+				},
+			},
+			expectedMessageID: "1",
+		},
+		{
+			name:       "long content resumed from the first unsent message",
+			chatID:     "100500",
+			content:    "<p>" + strings.Repeat("a", 4000) + "</p><p>" + strings.Repeat("b", 200) + "</p>",
+			sentParts:  1,
+			statusCode: http.StatusOK,
+			response:   `{"ok": true, "result": {"message_id": {id}}}`,
+			expectedRequests: []telegramSendMessageRequest{
+				{
+					ChatID:                "100500",
+					Text:                  strings.Repeat("b", 200),
+					ParseMode:             telegramParseMode,
+					DisableWebPagePreview: true,
+				},
+			},
+			expectedMessageID: "1",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var requests []telegramSendMessageRequest
+
+			server := httptest.NewServer(
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					require.Equal(t, http.MethodPost, r.Method)
+					require.Equal(t, "/bot"+telegramBotToken+"/sendMessage", r.URL.Path)
+
+					var request telegramSendMessageRequest
+					require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+
+					requests = append(requests, request)
+
+					w.Header().Set("Content-Type", "application/json")
+					w.WriteHeader(tc.statusCode)
+					// Each sent message gets sequential ID:
+					_, _ = w.Write([]byte(strings.ReplaceAll(tc.response, "{id}", strconv.Itoa(len(requests)))))
+				}),
+			)
+			defer server.Close()
+
+			ctrl := gomock.NewController(t)
+			traceProvider := mocktracing.NewMockProvider(ctrl)
+			traceProvider.
+				EXPECT().
+				Span(gomock.Any(), gomock.Any()).
+				Return(context.Background(), mocktracing.NewMockSpan()).
+				Times(1)
+
+			sender := NewTelegramSender(
+				config.TelegramConfig{
+					BotToken: telegramBotToken,
+					BaseURL:  server.URL,
+					Timeout:  time.Second,
+				},
+				traceProvider,
+				tracing.SpanConfig{},
+			)
+
+			messageID, err := sender.Send(context.Background(), tc.chatID, tc.content, tc.sentParts)
+			require.Equal(t, tc.expectedRequests, requests)
+			require.Equal(t, tc.expectedMessageID, messageID)
+
+			if !tc.errorExpected {
+				require.NoError(t, err)
+
+				return
+			}
+
+			var (
+				permanentErr   *PermanentError
+				rateLimitedErr *RateLimitedError
+			)
+
+			require.Error(t, err)
+			require.Equal(t, tc.permanentExpected, errors.As(err, &permanentErr))
+
+			if tc.retryAfter > 0 {
+				require.ErrorAs(t, err, &rateLimitedErr)
+				require.Equal(t, tc.retryAfter, rateLimitedErr.RetryAfter)
+			}
+		})
+	}
+}
+
+func TestTelegramSender_SendPartially(t *testing.T) {
+	var requests int
+
+	// The first message is sent, while the second one is throttled:
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			requests++
+
+			w.Header().Set("Content-Type", "application/json")
+			if requests == 1 {
+				_, _ = w.Write([]byte(`{"ok": true, "result": {"message_id": 7}}`))
+
+				return
+			}
+
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte(`{"ok": false, "error_code": 429, "parameters": {"retry_after": 3}}`))
+		}),
+	)
+	defer server.Close()
+
+	ctrl := gomock.NewController(t)
+	traceProvider := mocktracing.NewMockProvider(ctrl)
+	traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	sender := NewTelegramSender(
+		config.TelegramConfig{
+			BotToken: telegramBotToken,
+			BaseURL:  server.URL,
+			Timeout:  time.Second,
+		},
+		traceProvider,
+		tracing.SpanConfig{},
+	)
+
+	content := "<p>" + strings.Repeat("a", 4000) + "</p><p>" + strings.Repeat("b", 200) + "</p>"
+	messageID, err := sender.Send(context.Background(), "100500", content, 0)
+	require.Empty(t, messageID)
+
+	var (
+		partiallySentErr *PartiallySentError
+		rateLimitedErr   *RateLimitedError
+	)
+
+	require.ErrorAs(t, err, &partiallySentErr)
+	require.Equal(t, uint32(1), partiallySentErr.SentParts)
+	require.Equal(t, "7", partiallySentErr.MessageID)
+	require.ErrorAs(t, err, &rateLimitedErr)
+	require.Equal(t, 3*time.Second, rateLimitedErr.RetryAfter)
+}
+
+func TestTelegramSender_SendHidesBotToken(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+
+	ctrl := gomock.NewController(t)
+	traceProvider := mocktracing.NewMockProvider(ctrl)
+	traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	sender := NewTelegramSender(
+		config.TelegramConfig{
+			BotToken: telegramBotToken,
+			BaseURL:  server.URL,
+			Timeout:  time.Second,
+		},
+		traceProvider,
+		tracing.SpanConfig{},
+	)
+
+	_, err := sender.Send(context.Background(), "100500", "Hello", 0)
+	require.Error(t, err)
+	require.NotContains(t, err.Error(), telegramBotToken)
+}
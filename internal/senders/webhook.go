@@ -0,0 +1,130 @@
+package senders
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/DKhorkov/libs/tracing"
+
+	"github.com/DKhorkov/hmtm-notifications/internal/config"
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+)
+
+const (
+	// WebhookSignatureHeader contains HMAC-SHA256 of "<timestamp>.<body>", signed with webhook secret:
+	WebhookSignatureHeader = "X-HMTM-Signature"
+	// WebhookTimestampHeader contains Unix time of sending attempt, which receivers could use to reject replays:
+	WebhookTimestampHeader = "X-HMTM-Timestamp"
+	// WebhookDeliveryIDHeader contains ID of delivery, which is the same for all attempts:
+	WebhookDeliveryIDHeader = "X-HMTM-Delivery"
+	WebhookEventHeader      = "X-HMTM-Event"
+
+	webhookSignaturePrefix = "sha256="
+
+	// Maximum size of error response body, which is added to error:
+	webhookErrorBodyMaxSize = 1024
+)
+
+// WebhookSender POSTs event payloads to webhook endpoints, signing them with webhook secrets.
+type WebhookSender struct {
+	client        *http.Client
+	traceProvider tracing.Provider
+	spanConfig    tracing.SpanConfig
+}
+
+func NewWebhookSender(
+	webhooksConfig config.WebhooksConfig,
+	traceProvider tracing.Provider,
+	spanConfig tracing.SpanConfig,
+) *WebhookSender {
+	return &WebhookSender{
+		client: &http.Client{
+			Timeout: webhooksConfig.Timeout,
+			// Redirects are not followed to prevent sending signed payloads to not registered URLs:
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		traceProvider: traceProvider,
+		spanConfig:    spanConfig,
+	}
+}
+
+// Send delivers payload of delivery to webhook URL. Any 2xx response is treated as success. Throttled requests
+// are returned as *RateLimitedError, requests to endpoints, which are gone or could not be built, as *PermanentError.
+// Other failures are expected to be retried.
+func (s *WebhookSender) Send(ctx context.Context, webhook entities.Webhook, delivery entities.WebhookDelivery) error {
+	ctx, span := s.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(s.spanConfig.Events.Start.Name, s.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(s.spanConfig.Events.End.Name, s.spanConfig.Events.End.Opts...)
+
+	payload := []byte(delivery.Payload)
+	timestamp := time.Now().UTC().Unix()
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(payload))
+	if err != nil {
+		return &PermanentError{BaseErr: err}
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(WebhookSignatureHeader, SignWebhookPayload(webhook.Secret, timestamp, payload))
+	request.Header.Set(WebhookTimestampHeader, strconv.FormatInt(timestamp, 10))
+	request.Header.Set(WebhookDeliveryIDHeader, delivery.DeliveryID)
+	request.Header.Set(WebhookEventHeader, string(delivery.EventType))
+
+	response, err := s.client.Do(request)
+	if err != nil {
+		return fmt.Errorf("webhook request failed: %w", err)
+	}
+
+	defer func() {
+		_ = response.Body.Close()
+	}()
+
+	if response.StatusCode >= http.StatusOK && response.StatusCode < http.StatusMultipleChoices {
+		return nil
+	}
+
+	return webhookError(response)
+}
+
+// SignWebhookPayload returns value of WebhookSignatureHeader for payload, sent at provided Unix timestamp.
+// Receivers should calculate the same value and compare it with received one in constant time.
+func SignWebhookPayload(secret string, timestamp int64, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+
+	return webhookSignaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// webhookError classifies failed response of webhook endpoint.
+func webhookError(response *http.Response) error {
+	description, _ := io.ReadAll(io.LimitReader(response.Body, webhookErrorBodyMaxSize))
+	err := fmt.Errorf("webhook endpoint responded with %d: %s", response.StatusCode, bytes.TrimSpace(description))
+
+	switch response.StatusCode {
+	case http.StatusTooManyRequests:
+		retryAfter := time.Second
+		if seconds, parseErr := strconv.Atoi(response.Header.Get("Retry-After")); parseErr == nil && seconds > 0 {
+			retryAfter = time.Second * time.Duration(seconds)
+		}
+
+		return &RateLimitedError{RetryAfter: retryAfter}
+	case http.StatusGone:
+		return &PermanentError{BaseErr: err}
+	default:
+		return err
+	}
+}
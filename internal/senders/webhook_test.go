@@ -0,0 +1,188 @@
+package senders
+
+import (
+	"context"
+	"crypto/hmac"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/DKhorkov/libs/tracing"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	mocktracing "github.com/DKhorkov/libs/tracing/mocks"
+
+	"github.com/DKhorkov/hmtm-notifications/internal/config"
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+)
+
+const webhookSecret = "webhook-secret"
+
+func TestWebhookSender_Send(t *testing.T) {
+	delivery := entities.WebhookDelivery{
+		ID:         1,
+		WebhookID:  2,
+		DeliveryID: "2d1f5c1e-5b1a-4a43-9d8c-6f0e0f4d8f3a",
+		EventType:  entities.NotificationTypeTicketUpdated,
+		Payload:    `{"id":"2d1f5c1e-5b1a-4a43-9d8c-6f0e0f4d8f3a","type":"ticket_updated"}`,
+	}
+
+	testCases := []struct {
+		name              string
+		statusCode        int
+		headers           map[string]string
+		errorExpected     bool
+		permanentExpected bool
+		retryAfter        time.Duration
+	}{
+		{
+			name:       "success",
+			statusCode: http.StatusNoContent,
+		},
+		{
+			name:          "server error",
+			statusCode:    http.StatusInternalServerError,
+			errorExpected: true,
+		},
+		{
+			name:          "client error is retried",
+			statusCode:    http.StatusNotFound,
+			errorExpected: true,
+		},
+		{
+			name:          "redirect is not followed",
+			statusCode:    http.StatusFound,
+			headers:       map[string]string{"Location": "http://example.com/other"},
+			errorExpected: true,
+		},
+		{
+			name:              "endpoint is gone",
+			statusCode:        http.StatusGone,
+			errorExpected:     true,
+			permanentExpected: true,
+		},
+		{
+			name:          "too many requests",
+			statusCode:    http.StatusTooManyRequests,
+			headers:       map[string]string{"Retry-After": "7"},
+			errorExpected: true,
+			retryAfter:    time.Second * 7,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			requestsCount := 0
+
+			server := httptest.NewServer(
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					requestsCount++
+
+					require.Equal(t, http.MethodPost, r.Method)
+					require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+					require.Equal(t, delivery.DeliveryID, r.Header.Get(WebhookDeliveryIDHeader))
+					require.Equal(t, string(delivery.EventType), r.Header.Get(WebhookEventHeader))
+
+					body, err := io.ReadAll(r.Body)
+					require.NoError(t, err)
+					require.Equal(t, delivery.Payload, string(body))
+
+					timestamp, err := strconv.ParseInt(r.Header.Get(WebhookTimestampHeader), 10, 64)
+					require.NoError(t, err)
+					require.InDelta(t, time.Now().Unix(), timestamp, 5)
+
+					// Verifying signature the same way, as receiver should do:
+					expectedSignature := SignWebhookPayload(webhookSecret, timestamp, body)
+					require.True(
+						t,
+						hmac.Equal([]byte(expectedSignature), []byte(r.Header.Get(WebhookSignatureHeader))),
+					)
+
+					for key, value := range tc.headers {
+						w.Header().Set(key, value)
+					}
+
+					w.WriteHeader(tc.statusCode)
+				}),
+			)
+			defer server.Close()
+
+			ctrl := gomock.NewController(t)
+			traceProvider := mocktracing.NewMockProvider(ctrl)
+			traceProvider.
+				EXPECT().
+				Span(gomock.Any(), gomock.Any()).
+				Return(context.Background(), mocktracing.NewMockSpan()).
+				Times(1)
+
+			sender := NewWebhookSender(config.WebhooksConfig{Timeout: time.Second}, traceProvider, tracing.SpanConfig{})
+
+			err := sender.Send(
+				context.Background(),
+				entities.Webhook{ID: 2, URL: server.URL, Secret: webhookSecret, Enabled: true},
+				delivery,
+			)
+			require.Equal(t, 1, requestsCount)
+
+			if !tc.errorExpected {
+				require.NoError(t, err)
+
+				return
+			}
+
+			var (
+				permanentErr   *PermanentError
+				rateLimitedErr *RateLimitedError
+			)
+
+			require.Error(t, err)
+			require.Equal(t, tc.permanentExpected, errors.As(err, &permanentErr))
+
+			if tc.retryAfter > 0 {
+				require.ErrorAs(t, err, &rateLimitedErr)
+				require.Equal(t, tc.retryAfter, rateLimitedErr.RetryAfter)
+			}
+		})
+	}
+}
+
+func TestWebhookSender_SendUnreachableEndpoint(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+
+	ctrl := gomock.NewController(t)
+	traceProvider := mocktracing.NewMockProvider(ctrl)
+	traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	sender := NewWebhookSender(config.WebhooksConfig{Timeout: time.Second}, traceProvider, tracing.SpanConfig{})
+
+	err := sender.Send(
+		context.Background(),
+		entities.Webhook{URL: server.URL, Secret: webhookSecret},
+		entities.WebhookDelivery{DeliveryID: "delivery", Payload: "{}"},
+	)
+	require.Error(t, err)
+	require.False(t, errors.As(err, new(*PermanentError)))
+}
+
+func TestSignWebhookPayload(t *testing.T) {
+	payload := []byte(`{"type":"verify_email"}`)
+
+	signature := SignWebhookPayload(webhookSecret, 1700000000, payload)
+	require.Equal(t, signature, SignWebhookPayload(webhookSecret, 1700000000, payload))
+	require.Regexp(t, "^sha256=[0-9a-f]{64}$", signature)
+
+	// Signature depends on secret, timestamp and payload:
+	require.NotEqual(t, signature, SignWebhookPayload("other-secret", 1700000000, payload))
+	require.NotEqual(t, signature, SignWebhookPayload(webhookSecret, 1700000001, payload))
+	require.NotEqual(t, signature, SignWebhookPayload(webhookSecret, 1700000000, []byte(`{}`)))
+}
@@ -0,0 +1,118 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/DKhorkov/libs/logging"
+
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+	"github.com/DKhorkov/hmtm-notifications/internal/interfaces"
+)
+
+type CommunicationsService struct {
+	communicationsRepository interfaces.CommunicationsRepository
+	logger                   logging.Logger
+}
+
+func NewCommunicationsService(
+	communicationsRepository interfaces.CommunicationsRepository,
+	logger logging.Logger,
+) *CommunicationsService {
+	return &CommunicationsService{
+		communicationsRepository: communicationsRepository,
+		logger:                   logger,
+	}
+}
+
+func (service *CommunicationsService) GetUserCommunications(
+	ctx context.Context,
+	userID uint64,
+	channel entities.CommunicationChannel,
+	pagination *entities.Pagination,
+) ([]entities.Communication, error) {
+	return service.communicationsRepository.GetUserCommunications(ctx, userID, channel, pagination)
+}
+
+func (service *CommunicationsService) CountUserCommunications(
+	ctx context.Context,
+	userID uint64,
+	channel entities.CommunicationChannel,
+) (uint64, error) {
+	return service.communicationsRepository.CountUserCommunications(ctx, userID, channel)
+}
+
+func (service *CommunicationsService) SaveCommunication(
+	ctx context.Context,
+	communication entities.Communication,
+) (uint64, error) {
+	return service.communicationsRepository.SaveCommunication(ctx, communication)
+}
+
+func (service *CommunicationsService) GetPendingCommunications(
+	ctx context.Context,
+	limit uint64,
+) ([]entities.Communication, error) {
+	return service.communicationsRepository.GetPendingCommunications(ctx, limit)
+}
+
+func (service *CommunicationsService) ClaimCommunication(
+	ctx context.Context,
+	communication entities.Communication,
+	leaseUntil time.Time,
+) (bool, error) {
+	return service.communicationsRepository.ClaimCommunication(ctx, communication, leaseUntil)
+}
+
+func (service *CommunicationsService) MarkCommunicationSent(
+	ctx context.Context,
+	id uint64,
+	attempts uint32,
+	providerMessageID string,
+) error {
+	return service.communicationsRepository.MarkCommunicationSent(ctx, id, attempts, providerMessageID)
+}
+
+func (service *CommunicationsService) RescheduleCommunication(
+	ctx context.Context,
+	id uint64,
+	attempts uint32,
+	lastError string,
+	nextAttemptAt time.Time,
+) error {
+	return service.communicationsRepository.RescheduleCommunication(ctx, id, attempts, lastError, nextAttemptAt)
+}
+
+func (service *CommunicationsService) DeferCommunication(
+	ctx context.Context,
+	id uint64,
+	attempts uint32,
+	nextAttemptAt time.Time,
+) error {
+	return service.communicationsRepository.DeferCommunication(ctx, id, attempts, nextAttemptAt)
+}
+
+func (service *CommunicationsService) SaveCommunicationSentParts(
+	ctx context.Context,
+	id uint64,
+	attempts uint32,
+	sentParts uint32,
+	providerMessageID string,
+) error {
+	return service.communicationsRepository.SaveCommunicationSentParts(
+		ctx,
+		id,
+		attempts,
+		sentParts,
+		providerMessageID,
+	)
+}
+
+func (service *CommunicationsService) MarkCommunicationFailed(
+	ctx context.Context,
+	id uint64,
+	attempts uint32,
+	lastError string,
+) error {
+	return service.communicationsRepository.MarkCommunicationFailed(ctx, id, attempts, lastError)
+}
@@ -0,0 +1,472 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	mocklogging "github.com/DKhorkov/libs/logging/mocks"
+	"github.com/DKhorkov/libs/pointers"
+
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+	"github.com/DKhorkov/hmtm-notifications/internal/services"
+	mockrepositories "github.com/DKhorkov/hmtm-notifications/mocks/repositories"
+)
+
+var (
+	userID uint64 = 1
+	now           = time.Now()
+)
+
+func TestCommunicationsService_GetUserCommunications(t *testing.T) {
+	testCases := []struct {
+		name       string
+		userID     uint64
+		pagination *entities.Pagination
+		setupMocks func(
+			communicationsRepository *mockrepositories.MockCommunicationsRepository,
+			logger *mocklogging.MockLogger,
+		)
+		expected      []entities.Communication
+		errorExpected bool
+	}{
+		{
+			name:   "get user email communications with existing email communications",
+			userID: userID,
+			pagination: &entities.Pagination{
+				Limit:  pointers.New[uint64](1),
+				Offset: pointers.New[uint64](1),
+			},
+			expected: []entities.Communication{
+				{
+					ID:        1,
+					UserID:    userID,
+					Recipient: "someTestEmail@gmail.com",
+					Body:      "some test content",
+					SentAt:    &now,
+				},
+			},
+			setupMocks: func(
+				communicationsRepository *mockrepositories.MockCommunicationsRepository,
+				_ *mocklogging.MockLogger,
+			) {
+				communicationsRepository.
+					EXPECT().
+					GetUserCommunications(
+						gomock.Any(),
+						userID,
+						entities.CommunicationChannelEmail,
+						&entities.Pagination{
+							Limit:  pointers.New[uint64](1),
+							Offset: pointers.New[uint64](1),
+						},
+					).
+					Return(
+						[]entities.Communication{
+							{
+								ID:        1,
+								UserID:    userID,
+								Recipient: "someTestEmail@gmail.com",
+								Body:      "some test content",
+								SentAt:    &now,
+							},
+						},
+						nil,
+					).
+					Times(1)
+			},
+		},
+		{
+			name:   "get user email communications without existing email communications",
+			userID: userID,
+			pagination: &entities.Pagination{
+				Limit:  pointers.New[uint64](1),
+				Offset: pointers.New[uint64](1),
+			},
+			expected: []entities.Communication{},
+			setupMocks: func(
+				communicationsRepository *mockrepositories.MockCommunicationsRepository,
+				_ *mocklogging.MockLogger,
+			) {
+				communicationsRepository.
+					EXPECT().
+					GetUserCommunications(
+						gomock.Any(),
+						userID,
+						entities.CommunicationChannelEmail,
+						&entities.Pagination{
+							Limit:  pointers.New[uint64](1),
+							Offset: pointers.New[uint64](1),
+						},
+					).
+					Return([]entities.Communication{}, nil).
+					Times(1)
+			},
+		},
+		{
+			name:   "get user email communications fail",
+			userID: userID,
+			pagination: &entities.Pagination{
+				Limit:  pointers.New[uint64](1),
+				Offset: pointers.New[uint64](1),
+			},
+			setupMocks: func(
+				communicationsRepository *mockrepositories.MockCommunicationsRepository,
+				_ *mocklogging.MockLogger,
+			) {
+				communicationsRepository.
+					EXPECT().
+					GetUserCommunications(
+						gomock.Any(),
+						userID,
+						entities.CommunicationChannelEmail,
+						&entities.Pagination{
+							Limit:  pointers.New[uint64](1),
+							Offset: pointers.New[uint64](1),
+						},
+					).
+					Return(nil, errors.New("some error")).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+	}
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	logger := mocklogging.NewMockLogger(ctrl)
+	communicationsRepository := mockrepositories.NewMockCommunicationsRepository(ctrl)
+	communicationsService := services.NewCommunicationsService(communicationsRepository, logger)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(communicationsRepository, logger)
+			}
+
+			actual, err := communicationsService.GetUserCommunications(
+				ctx,
+				tc.userID,
+				entities.CommunicationChannelEmail,
+				tc.pagination,
+			)
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestCommunicationsService_CountUserCommunications(t *testing.T) {
+	testCases := []struct {
+		name       string
+		userID     uint64
+		setupMocks func(
+			communicationsRepository *mockrepositories.MockCommunicationsRepository,
+			logger *mocklogging.MockLogger,
+		)
+		expected      uint64
+		errorExpected bool
+	}{
+		{
+			name:     "success",
+			userID:   userID,
+			expected: 1,
+			setupMocks: func(
+				communicationsRepository *mockrepositories.MockCommunicationsRepository,
+				_ *mocklogging.MockLogger,
+			) {
+				communicationsRepository.
+					EXPECT().
+					CountUserCommunications(gomock.Any(), userID, entities.CommunicationChannelEmail).
+					Return(uint64(1), nil).
+					Times(1)
+			},
+		},
+		{
+			name:   "error",
+			userID: userID,
+			setupMocks: func(
+				communicationsRepository *mockrepositories.MockCommunicationsRepository,
+				_ *mocklogging.MockLogger,
+			) {
+				communicationsRepository.
+					EXPECT().
+					CountUserCommunications(gomock.Any(), userID, entities.CommunicationChannelEmail).
+					Return(uint64(0), errors.New("some error")).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+	}
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	logger := mocklogging.NewMockLogger(ctrl)
+	communicationsRepository := mockrepositories.NewMockCommunicationsRepository(ctrl)
+	communicationsService := services.NewCommunicationsService(communicationsRepository, logger)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(communicationsRepository, logger)
+			}
+
+			actual, err := communicationsService.CountUserCommunications(ctx, tc.userID, entities.CommunicationChannelEmail)
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestCommunicationsService_SaveCommunication(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	logger := mocklogging.NewMockLogger(ctrl)
+	communicationsRepository := mockrepositories.NewMockCommunicationsRepository(ctrl)
+	communicationsService := services.NewCommunicationsService(communicationsRepository, logger)
+
+	testCases := []struct {
+		name          string
+		communication entities.Communication
+		setupMocks    func(communicationsRepository *mockrepositories.MockCommunicationsRepository)
+		expectedID    uint64
+		errorExpected bool
+	}{
+		{
+			name: "success",
+			communication: entities.Communication{
+				// Здесь предполагается структура Communication, заполняем минимально для примера
+				ID:     0, // ID обычно 0 для новой записи
+				UserID: userID,
+				Body:   "Test Subject",
+				SentAt: &now,
+			},
+			setupMocks: func(communicationsRepository *mockrepositories.MockCommunicationsRepository) {
+				communicationsRepository.
+					EXPECT().
+					SaveCommunication(gomock.Any(), entities.Communication{
+						ID:     0,
+						UserID: userID,
+						Body:   "Test Subject",
+						SentAt: &now,
+					}).
+					Return(uint64(1), nil).
+					Times(1)
+			},
+			expectedID:    1,
+			errorExpected: false,
+		},
+		{
+			name: "error",
+			communication: entities.Communication{
+				ID:     0,
+				UserID: userID,
+				Body:   "Test Subject",
+				SentAt: &now,
+			},
+			setupMocks: func(communicationsRepository *mockrepositories.MockCommunicationsRepository) {
+				communicationsRepository.
+					EXPECT().
+					SaveCommunication(gomock.Any(), entities.Communication{
+						ID:     0,
+						UserID: userID,
+						Body:   "Test Subject",
+						SentAt: &now,
+					}).
+					Return(uint64(0), errors.New("save failed")).
+					Times(1)
+			},
+			expectedID:    0,
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(communicationsRepository)
+			}
+
+			actual, err := communicationsService.SaveCommunication(context.Background(), tc.communication)
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.expectedID, actual)
+		})
+	}
+}
+
+func TestCommunicationsService_GetPendingCommunications(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	logger := mocklogging.NewMockLogger(ctrl)
+	communicationsRepository := mockrepositories.NewMockCommunicationsRepository(ctrl)
+	communicationsService := services.NewCommunicationsService(communicationsRepository, logger)
+
+	testCases := []struct {
+		name          string
+		limit         uint64
+		setupMocks    func(communicationsRepository *mockrepositories.MockCommunicationsRepository)
+		expected      []entities.Communication
+		errorExpected bool
+	}{
+		{
+			name:  "success",
+			limit: 10,
+			setupMocks: func(communicationsRepository *mockrepositories.MockCommunicationsRepository) {
+				communicationsRepository.
+					EXPECT().
+					GetPendingCommunications(gomock.Any(), uint64(10)).
+					Return([]entities.Communication{{ID: 1, Status: entities.CommunicationStatusPending}}, nil).
+					Times(1)
+			},
+			expected: []entities.Communication{{ID: 1, Status: entities.CommunicationStatusPending}},
+		},
+		{
+			name:  "error",
+			limit: 10,
+			setupMocks: func(communicationsRepository *mockrepositories.MockCommunicationsRepository) {
+				communicationsRepository.
+					EXPECT().
+					GetPendingCommunications(gomock.Any(), uint64(10)).
+					Return(nil, errors.New("some error")).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(communicationsRepository)
+			}
+
+			actual, err := communicationsService.GetPendingCommunications(context.Background(), tc.limit)
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestCommunicationsService_ClaimCommunication(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	logger := mocklogging.NewMockLogger(ctrl)
+	communicationsRepository := mockrepositories.NewMockCommunicationsRepository(ctrl)
+	communicationsService := services.NewCommunicationsService(communicationsRepository, logger)
+
+	communication := entities.Communication{ID: 1, Status: entities.CommunicationStatusPending}
+	leaseUntil := now.Add(time.Minute)
+
+	communicationsRepository.
+		EXPECT().
+		ClaimCommunication(gomock.Any(), communication, leaseUntil).
+		Return(true, nil).
+		Times(1)
+
+	claimed, err := communicationsService.ClaimCommunication(context.Background(), communication, leaseUntil)
+	require.NoError(t, err)
+	require.True(t, claimed)
+}
+
+func TestCommunicationsService_MarkCommunicationSent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	logger := mocklogging.NewMockLogger(ctrl)
+	communicationsRepository := mockrepositories.NewMockCommunicationsRepository(ctrl)
+	communicationsService := services.NewCommunicationsService(communicationsRepository, logger)
+
+	communicationsRepository.
+		EXPECT().
+		MarkCommunicationSent(gomock.Any(), uint64(1), uint32(1), "message-id").
+		Return(errors.New("some error")).
+		Times(1)
+
+	require.Error(t, communicationsService.MarkCommunicationSent(context.Background(), 1, 1, "message-id"))
+}
+
+func TestCommunicationsService_RescheduleCommunication(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	logger := mocklogging.NewMockLogger(ctrl)
+	communicationsRepository := mockrepositories.NewMockCommunicationsRepository(ctrl)
+	communicationsService := services.NewCommunicationsService(communicationsRepository, logger)
+
+	nextAttemptAt := now.Add(time.Minute)
+
+	communicationsRepository.
+		EXPECT().
+		RescheduleCommunication(gomock.Any(), uint64(1), uint32(1), "smtp error", nextAttemptAt).
+		Return(nil).
+		Times(1)
+
+	require.NoError(
+		t,
+		communicationsService.RescheduleCommunication(context.Background(), 1, 1, "smtp error", nextAttemptAt),
+	)
+}
+
+func TestCommunicationsService_DeferCommunication(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	logger := mocklogging.NewMockLogger(ctrl)
+	communicationsRepository := mockrepositories.NewMockCommunicationsRepository(ctrl)
+	communicationsService := services.NewCommunicationsService(communicationsRepository, logger)
+
+	nextAttemptAt := now.Add(time.Minute)
+
+	communicationsRepository.
+		EXPECT().
+		DeferCommunication(gomock.Any(), uint64(1), uint32(2), nextAttemptAt).
+		Return(nil).
+		Times(1)
+
+	require.NoError(t, communicationsService.DeferCommunication(context.Background(), 1, 2, nextAttemptAt))
+}
+
+func TestCommunicationsService_SaveCommunicationSentParts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	logger := mocklogging.NewMockLogger(ctrl)
+	communicationsRepository := mockrepositories.NewMockCommunicationsRepository(ctrl)
+	communicationsService := services.NewCommunicationsService(communicationsRepository, logger)
+
+	communicationsRepository.
+		EXPECT().
+		SaveCommunicationSentParts(gomock.Any(), uint64(1), uint32(1), uint32(2), "10").
+		Return(nil).
+		Times(1)
+
+	require.NoError(t, communicationsService.SaveCommunicationSentParts(context.Background(), 1, 1, 2, "10"))
+}
+
+func TestCommunicationsService_MarkCommunicationFailed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	logger := mocklogging.NewMockLogger(ctrl)
+	communicationsRepository := mockrepositories.NewMockCommunicationsRepository(ctrl)
+	communicationsService := services.NewCommunicationsService(communicationsRepository, logger)
+
+	communicationsRepository.
+		EXPECT().
+		MarkCommunicationFailed(gomock.Any(), uint64(1), uint32(1), "smtp error").
+		Return(nil).
+		Times(1)
+
+	require.NoError(t, communicationsService.MarkCommunicationFailed(context.Background(), 1, 1, "smtp error"))
+}
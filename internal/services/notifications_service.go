@@ -0,0 +1,68 @@
+package services
+
+import (
+	"context"
+
+	"github.com/DKhorkov/libs/logging"
+
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+	"github.com/DKhorkov/hmtm-notifications/internal/interfaces"
+)
+
+type NotificationsService struct {
+	notificationsRepository interfaces.NotificationsRepository
+	logger                  logging.Logger
+}
+
+func NewNotificationsService(
+	notificationsRepository interfaces.NotificationsRepository,
+	logger logging.Logger,
+) *NotificationsService {
+	return &NotificationsService{
+		notificationsRepository: notificationsRepository,
+		logger:                  logger,
+	}
+}
+
+func (service *NotificationsService) GetUserNotifications(
+	ctx context.Context,
+	userID uint64,
+	pagination *entities.Pagination,
+) ([]entities.Notification, error) {
+	return service.notificationsRepository.GetUserNotifications(ctx, userID, pagination)
+}
+
+func (service *NotificationsService) GetUserNotificationsAfterID(
+	ctx context.Context,
+	userID uint64,
+	lastSeenID uint64,
+	limit uint64,
+) ([]entities.Notification, error) {
+	return service.notificationsRepository.GetUserNotificationsAfterID(ctx, userID, lastSeenID, limit)
+}
+
+func (service *NotificationsService) CountUserUnreadNotifications(
+	ctx context.Context,
+	userID uint64,
+) (uint64, error) {
+	return service.notificationsRepository.CountUserUnreadNotifications(ctx, userID)
+}
+
+func (service *NotificationsService) SaveNotification(
+	ctx context.Context,
+	notification entities.Notification,
+) (uint64, error) {
+	return service.notificationsRepository.SaveNotification(ctx, notification)
+}
+
+func (service *NotificationsService) MarkNotificationAsRead(ctx context.Context, id, userID uint64) error {
+	return service.notificationsRepository.MarkNotificationAsRead(ctx, id, userID)
+}
+
+func (service *NotificationsService) MarkAllNotificationsAsRead(ctx context.Context, userID uint64) error {
+	return service.notificationsRepository.MarkAllNotificationsAsRead(ctx, userID)
+}
+
+func (service *NotificationsService) DeleteNotification(ctx context.Context, id, userID uint64) error {
+	return service.notificationsRepository.DeleteNotification(ctx, id, userID)
+}
@@ -0,0 +1,139 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	mocklogging "github.com/DKhorkov/libs/logging/mocks"
+	"github.com/DKhorkov/libs/pointers"
+
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-notifications/internal/errors"
+	"github.com/DKhorkov/hmtm-notifications/internal/services"
+	mockrepositories "github.com/DKhorkov/hmtm-notifications/mocks/repositories"
+)
+
+func TestNotificationsService_GetUserNotifications(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	logger := mocklogging.NewMockLogger(ctrl)
+	notificationsRepository := mockrepositories.NewMockNotificationsRepository(ctrl)
+	notificationsService := services.NewNotificationsService(notificationsRepository, logger)
+
+	pagination := &entities.Pagination{Limit: pointers.New[uint64](10)}
+	expected := []entities.Notification{{ID: 1, UserID: 1, CreatedAt: now}}
+
+	notificationsRepository.
+		EXPECT().
+		GetUserNotifications(gomock.Any(), uint64(1), pagination).
+		Return(expected, nil).
+		Times(1)
+
+	actual, err := notificationsService.GetUserNotifications(context.Background(), 1, pagination)
+	require.NoError(t, err)
+	require.Equal(t, expected, actual)
+}
+
+func TestNotificationsService_GetUserNotificationsAfterID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	logger := mocklogging.NewMockLogger(ctrl)
+	notificationsRepository := mockrepositories.NewMockNotificationsRepository(ctrl)
+	notificationsService := services.NewNotificationsService(notificationsRepository, logger)
+
+	expected := []entities.Notification{{ID: 6, UserID: 1, CreatedAt: now}}
+
+	notificationsRepository.
+		EXPECT().
+		GetUserNotificationsAfterID(gomock.Any(), uint64(1), uint64(5), uint64(10)).
+		Return(expected, nil).
+		Times(1)
+
+	actual, err := notificationsService.GetUserNotificationsAfterID(context.Background(), 1, 5, 10)
+	require.NoError(t, err)
+	require.Equal(t, expected, actual)
+}
+
+func TestNotificationsService_CountUserUnreadNotifications(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	logger := mocklogging.NewMockLogger(ctrl)
+	notificationsRepository := mockrepositories.NewMockNotificationsRepository(ctrl)
+	notificationsService := services.NewNotificationsService(notificationsRepository, logger)
+
+	notificationsRepository.
+		EXPECT().
+		CountUserUnreadNotifications(gomock.Any(), uint64(1)).
+		Return(uint64(3), nil).
+		Times(1)
+
+	count, err := notificationsService.CountUserUnreadNotifications(context.Background(), 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), count)
+}
+
+func TestNotificationsService_SaveNotification(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	logger := mocklogging.NewMockLogger(ctrl)
+	notificationsRepository := mockrepositories.NewMockNotificationsRepository(ctrl)
+	notificationsService := services.NewNotificationsService(notificationsRepository, logger)
+
+	notification := entities.Notification{UserID: 1, Type: entities.NotificationTypeVerifyEmail}
+
+	notificationsRepository.
+		EXPECT().
+		SaveNotification(gomock.Any(), notification).
+		Return(uint64(1), nil).
+		Times(1)
+
+	notificationID, err := notificationsService.SaveNotification(context.Background(), notification)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), notificationID)
+}
+
+func TestNotificationsService_MarkNotificationAsRead(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	logger := mocklogging.NewMockLogger(ctrl)
+	notificationsRepository := mockrepositories.NewMockNotificationsRepository(ctrl)
+	notificationsService := services.NewNotificationsService(notificationsRepository, logger)
+
+	notificationsRepository.
+		EXPECT().
+		MarkNotificationAsRead(gomock.Any(), uint64(1), uint64(2)).
+		Return(&customerrors.NotificationNotFoundError{}).
+		Times(1)
+
+	err := notificationsService.MarkNotificationAsRead(context.Background(), 1, 2)
+	require.ErrorAs(t, err, new(*customerrors.NotificationNotFoundError))
+}
+
+func TestNotificationsService_MarkAllNotificationsAsRead(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	logger := mocklogging.NewMockLogger(ctrl)
+	notificationsRepository := mockrepositories.NewMockNotificationsRepository(ctrl)
+	notificationsService := services.NewNotificationsService(notificationsRepository, logger)
+
+	notificationsRepository.
+		EXPECT().
+		MarkAllNotificationsAsRead(gomock.Any(), uint64(1)).
+		Return(nil).
+		Times(1)
+
+	require.NoError(t, notificationsService.MarkAllNotificationsAsRead(context.Background(), 1))
+}
+
+func TestNotificationsService_DeleteNotification(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	logger := mocklogging.NewMockLogger(ctrl)
+	notificationsRepository := mockrepositories.NewMockNotificationsRepository(ctrl)
+	notificationsService := services.NewNotificationsService(notificationsRepository, logger)
+
+	notificationsRepository.
+		EXPECT().
+		DeleteNotification(gomock.Any(), uint64(1), uint64(2)).
+		Return(errors.New("db error")).
+		Times(1)
+
+	require.Error(t, notificationsService.DeleteNotification(context.Background(), 1, 2))
+}
@@ -0,0 +1,67 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/DKhorkov/libs/logging"
+
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+	"github.com/DKhorkov/hmtm-notifications/internal/interfaces"
+)
+
+type ProcessedMessagesService struct {
+	processedMessagesRepository interfaces.ProcessedMessagesRepository
+	logger                      logging.Logger
+}
+
+func NewProcessedMessagesService(
+	processedMessagesRepository interfaces.ProcessedMessagesRepository,
+	logger logging.Logger,
+) *ProcessedMessagesService {
+	return &ProcessedMessagesService{
+		processedMessagesRepository: processedMessagesRepository,
+		logger:                      logger,
+	}
+}
+
+func (service *ProcessedMessagesService) ReserveProcessedMessage(
+	ctx context.Context,
+	idempotencyKey string,
+) (bool, error) {
+	return service.processedMessagesRepository.ReserveProcessedMessage(ctx, idempotencyKey)
+}
+
+func (service *ProcessedMessagesService) GetProcessedMessage(
+	ctx context.Context,
+	idempotencyKey string,
+) (*entities.ProcessedMessage, error) {
+	return service.processedMessagesRepository.GetProcessedMessage(ctx, idempotencyKey)
+}
+
+func (service *ProcessedMessagesService) CompleteProcessedMessage(
+	ctx context.Context,
+	idempotencyKey string,
+	emailIDs []uint64,
+) error {
+	return service.processedMessagesRepository.CompleteProcessedMessage(ctx, idempotencyKey, emailIDs)
+}
+
+func (service *ProcessedMessagesService) ReleaseProcessedMessage(
+	ctx context.Context,
+	idempotencyKey string,
+) error {
+	return service.processedMessagesRepository.ReleaseProcessedMessage(ctx, idempotencyKey)
+}
+
+func (service *ProcessedMessagesService) DeleteExpiredProcessedMessages(
+	ctx context.Context,
+	processedBefore time.Time,
+	reservedBefore time.Time,
+) (uint64, error) {
+	return service.processedMessagesRepository.DeleteExpiredProcessedMessages(
+		ctx,
+		processedBefore,
+		reservedBefore,
+	)
+}
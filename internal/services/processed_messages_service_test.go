@@ -0,0 +1,117 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	mocklogging "github.com/DKhorkov/libs/logging/mocks"
+
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+	"github.com/DKhorkov/hmtm-notifications/internal/services"
+	mockrepositories "github.com/DKhorkov/hmtm-notifications/mocks/repositories"
+)
+
+const idempotencyKey = "test-key"
+
+func TestProcessedMessagesService_ReserveProcessedMessage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	logger := mocklogging.NewMockLogger(ctrl)
+	processedMessagesRepository := mockrepositories.NewMockProcessedMessagesRepository(ctrl)
+	processedMessagesService := services.NewProcessedMessagesService(processedMessagesRepository, logger)
+
+	processedMessagesRepository.
+		EXPECT().
+		ReserveProcessedMessage(gomock.Any(), idempotencyKey).
+		Return(true, nil).
+		Times(1)
+
+	reserved, err := processedMessagesService.ReserveProcessedMessage(context.Background(), idempotencyKey)
+	require.NoError(t, err)
+	require.True(t, reserved)
+}
+
+func TestProcessedMessagesService_GetProcessedMessage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	logger := mocklogging.NewMockLogger(ctrl)
+	processedMessagesRepository := mockrepositories.NewMockProcessedMessagesRepository(ctrl)
+	processedMessagesService := services.NewProcessedMessagesService(processedMessagesRepository, logger)
+
+	expected := &entities.ProcessedMessage{
+		IdempotencyKey: idempotencyKey,
+		EmailIDs:       []uint64{1, 2},
+		CreatedAt:      now,
+		ProcessedAt:    &now,
+	}
+
+	processedMessagesRepository.
+		EXPECT().
+		GetProcessedMessage(gomock.Any(), idempotencyKey).
+		Return(expected, nil).
+		Times(1)
+
+	actual, err := processedMessagesService.GetProcessedMessage(context.Background(), idempotencyKey)
+	require.NoError(t, err)
+	require.Equal(t, expected, actual)
+}
+
+func TestProcessedMessagesService_CompleteProcessedMessage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	logger := mocklogging.NewMockLogger(ctrl)
+	processedMessagesRepository := mockrepositories.NewMockProcessedMessagesRepository(ctrl)
+	processedMessagesService := services.NewProcessedMessagesService(processedMessagesRepository, logger)
+
+	processedMessagesRepository.
+		EXPECT().
+		CompleteProcessedMessage(gomock.Any(), idempotencyKey, []uint64{1, 2}).
+		Return(nil).
+		Times(1)
+
+	require.NoError(
+		t,
+		processedMessagesService.CompleteProcessedMessage(context.Background(), idempotencyKey, []uint64{1, 2}),
+	)
+}
+
+func TestProcessedMessagesService_ReleaseProcessedMessage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	logger := mocklogging.NewMockLogger(ctrl)
+	processedMessagesRepository := mockrepositories.NewMockProcessedMessagesRepository(ctrl)
+	processedMessagesService := services.NewProcessedMessagesService(processedMessagesRepository, logger)
+
+	processedMessagesRepository.
+		EXPECT().
+		ReleaseProcessedMessage(gomock.Any(), idempotencyKey).
+		Return(errors.New("some error")).
+		Times(1)
+
+	require.Error(t, processedMessagesService.ReleaseProcessedMessage(context.Background(), idempotencyKey))
+}
+
+func TestProcessedMessagesService_DeleteExpiredProcessedMessages(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	logger := mocklogging.NewMockLogger(ctrl)
+	processedMessagesRepository := mockrepositories.NewMockProcessedMessagesRepository(ctrl)
+	processedMessagesService := services.NewProcessedMessagesService(processedMessagesRepository, logger)
+
+	processedBefore := now.Add(-time.Hour)
+	reservedBefore := now.Add(-time.Minute)
+
+	processedMessagesRepository.
+		EXPECT().
+		DeleteExpiredProcessedMessages(gomock.Any(), processedBefore, reservedBefore).
+		Return(uint64(2), nil).
+		Times(1)
+
+	deleted, err := processedMessagesService.DeleteExpiredProcessedMessages(
+		context.Background(),
+		processedBefore,
+		reservedBefore,
+	)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), deleted)
+}
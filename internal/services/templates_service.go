@@ -0,0 +1,53 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/DKhorkov/libs/logging"
+
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+	"github.com/DKhorkov/hmtm-notifications/internal/interfaces"
+)
+
+type TemplatesService struct {
+	templatesRepository interfaces.TemplatesRepository
+	logger              logging.Logger
+}
+
+func NewTemplatesService(
+	templatesRepository interfaces.TemplatesRepository,
+	logger logging.Logger,
+) *TemplatesService {
+	return &TemplatesService{
+		templatesRepository: templatesRepository,
+		logger:              logger,
+	}
+}
+
+func (service *TemplatesService) SaveTemplate(ctx context.Context, template entities.Template) (uint64, error) {
+	return service.templatesRepository.SaveTemplate(ctx, template)
+}
+
+func (service *TemplatesService) GetTemplateByID(ctx context.Context, id uint64) (*entities.Template, error) {
+	return service.templatesRepository.GetTemplateByID(ctx, id)
+}
+
+func (service *TemplatesService) GetTemplates(
+	ctx context.Context,
+	filters entities.TemplatesFilters,
+) ([]entities.Template, error) {
+	return service.templatesRepository.GetTemplates(ctx, filters)
+}
+
+func (service *TemplatesService) GetPublishedTemplates(ctx context.Context) ([]entities.Template, error) {
+	return service.templatesRepository.GetPublishedTemplates(ctx)
+}
+
+func (service *TemplatesService) UpdateTemplate(ctx context.Context, template entities.Template) (bool, error) {
+	return service.templatesRepository.UpdateTemplate(ctx, template)
+}
+
+func (service *TemplatesService) PublishTemplate(ctx context.Context, id uint64, publishedAt time.Time) error {
+	return service.templatesRepository.PublishTemplate(ctx, id, publishedAt)
+}
@@ -0,0 +1,68 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	mocklogging "github.com/DKhorkov/libs/logging/mocks"
+
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-notifications/internal/errors"
+	"github.com/DKhorkov/hmtm-notifications/internal/services"
+	mockrepositories "github.com/DKhorkov/hmtm-notifications/mocks/repositories"
+)
+
+func TestTemplatesService_GetTemplateByID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	logger := mocklogging.NewMockLogger(ctrl)
+	templatesRepository := mockrepositories.NewMockTemplatesRepository(ctrl)
+	templatesService := services.NewTemplatesService(templatesRepository, logger)
+
+	templatesRepository.
+		EXPECT().
+		GetTemplateByID(gomock.Any(), uint64(1)).
+		Return(nil, &customerrors.TemplateNotFoundError{}).
+		Times(1)
+
+	template, err := templatesService.GetTemplateByID(context.Background(), 1)
+	require.ErrorAs(t, err, new(*customerrors.TemplateNotFoundError))
+	require.Nil(t, template)
+}
+
+func TestTemplatesService_GetPublishedTemplates(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	logger := mocklogging.NewMockLogger(ctrl)
+	templatesRepository := mockrepositories.NewMockTemplatesRepository(ctrl)
+	templatesService := services.NewTemplatesService(templatesRepository, logger)
+
+	expected := []entities.Template{{ID: 1, State: entities.TemplateStatePublished}}
+	templatesRepository.
+		EXPECT().
+		GetPublishedTemplates(gomock.Any()).
+		Return(expected, nil).
+		Times(1)
+
+	templates, err := templatesService.GetPublishedTemplates(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, expected, templates)
+}
+
+func TestTemplatesService_PublishTemplate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	logger := mocklogging.NewMockLogger(ctrl)
+	templatesRepository := mockrepositories.NewMockTemplatesRepository(ctrl)
+	templatesService := services.NewTemplatesService(templatesRepository, logger)
+
+	publishedAt := time.Now().UTC()
+	templatesRepository.
+		EXPECT().
+		PublishTemplate(gomock.Any(), uint64(1), publishedAt).
+		Return(nil).
+		Times(1)
+
+	require.NoError(t, templatesService.PublishTemplate(context.Background(), 1, publishedAt))
+}
@@ -0,0 +1,38 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/DKhorkov/libs/logging"
+
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+	"github.com/DKhorkov/hmtm-notifications/internal/interfaces"
+)
+
+type TokensService struct {
+	tokensRepository interfaces.TokensRepository
+	logger           logging.Logger
+}
+
+func NewTokensService(
+	tokensRepository interfaces.TokensRepository,
+	logger logging.Logger,
+) *TokensService {
+	return &TokensService{
+		tokensRepository: tokensRepository,
+		logger:           logger,
+	}
+}
+
+func (service *TokensService) SaveToken(ctx context.Context, token entities.Token) (uint64, error) {
+	return service.tokensRepository.SaveToken(ctx, token)
+}
+
+func (service *TokensService) GetTokenByNonce(ctx context.Context, nonce string) (*entities.Token, error) {
+	return service.tokensRepository.GetTokenByNonce(ctx, nonce)
+}
+
+func (service *TokensService) ConsumeToken(ctx context.Context, nonce string, consumedAt time.Time) (bool, error) {
+	return service.tokensRepository.ConsumeToken(ctx, nonce, consumedAt)
+}
@@ -0,0 +1,51 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	mocklogging "github.com/DKhorkov/libs/logging/mocks"
+
+	customerrors "github.com/DKhorkov/hmtm-notifications/internal/errors"
+	"github.com/DKhorkov/hmtm-notifications/internal/services"
+	mockrepositories "github.com/DKhorkov/hmtm-notifications/mocks/repositories"
+)
+
+func TestTokensService_GetTokenByNonce(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	logger := mocklogging.NewMockLogger(ctrl)
+	tokensRepository := mockrepositories.NewMockTokensRepository(ctrl)
+	tokensService := services.NewTokensService(tokensRepository, logger)
+
+	tokensRepository.
+		EXPECT().
+		GetTokenByNonce(gomock.Any(), "nonce").
+		Return(nil, &customerrors.TokenNotFoundError{}).
+		Times(1)
+
+	token, err := tokensService.GetTokenByNonce(context.Background(), "nonce")
+	require.ErrorAs(t, err, new(*customerrors.TokenNotFoundError))
+	require.Nil(t, token)
+}
+
+func TestTokensService_ConsumeToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	logger := mocklogging.NewMockLogger(ctrl)
+	tokensRepository := mockrepositories.NewMockTokensRepository(ctrl)
+	tokensService := services.NewTokensService(tokensRepository, logger)
+
+	consumedAt := time.Now().UTC()
+	tokensRepository.
+		EXPECT().
+		ConsumeToken(gomock.Any(), "nonce", consumedAt).
+		Return(true, nil).
+		Times(1)
+
+	consumed, err := tokensService.ConsumeToken(context.Background(), "nonce", consumedAt)
+	require.NoError(t, err)
+	require.True(t, consumed)
+}
@@ -0,0 +1,124 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/DKhorkov/libs/logging"
+
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+	"github.com/DKhorkov/hmtm-notifications/internal/interfaces"
+)
+
+type WebhooksService struct {
+	webhooksRepository interfaces.WebhooksRepository
+	logger             logging.Logger
+}
+
+func NewWebhooksService(
+	webhooksRepository interfaces.WebhooksRepository,
+	logger logging.Logger,
+) *WebhooksService {
+	return &WebhooksService{
+		webhooksRepository: webhooksRepository,
+		logger:             logger,
+	}
+}
+
+func (service *WebhooksService) GetWebhooks(ctx context.Context) ([]entities.Webhook, error) {
+	return service.webhooksRepository.GetWebhooks(ctx)
+}
+
+func (service *WebhooksService) GetEnabledWebhooks(ctx context.Context) ([]entities.Webhook, error) {
+	return service.webhooksRepository.GetEnabledWebhooks(ctx)
+}
+
+func (service *WebhooksService) GetWebhookByID(ctx context.Context, id uint64) (*entities.Webhook, error) {
+	return service.webhooksRepository.GetWebhookByID(ctx, id)
+}
+
+func (service *WebhooksService) SaveWebhook(ctx context.Context, webhook entities.Webhook) (uint64, error) {
+	return service.webhooksRepository.SaveWebhook(ctx, webhook)
+}
+
+func (service *WebhooksService) UpdateWebhook(ctx context.Context, webhook entities.Webhook) error {
+	return service.webhooksRepository.UpdateWebhook(ctx, webhook)
+}
+
+func (service *WebhooksService) DeleteWebhook(ctx context.Context, id uint64) error {
+	return service.webhooksRepository.DeleteWebhook(ctx, id)
+}
+
+func (service *WebhooksService) RegisterWebhookFailure(
+	ctx context.Context,
+	id uint64,
+	disableThreshold int,
+) (bool, error) {
+	return service.webhooksRepository.RegisterWebhookFailure(ctx, id, disableThreshold)
+}
+
+func (service *WebhooksService) ResetWebhookFailures(ctx context.Context, id uint64) error {
+	return service.webhooksRepository.ResetWebhookFailures(ctx, id)
+}
+
+func (service *WebhooksService) GetWebhookDeliveries(
+	ctx context.Context,
+	webhookID uint64,
+	pagination *entities.Pagination,
+) ([]entities.WebhookDelivery, error) {
+	return service.webhooksRepository.GetWebhookDeliveries(ctx, webhookID, pagination)
+}
+
+func (service *WebhooksService) SaveWebhookDelivery(
+	ctx context.Context,
+	delivery entities.WebhookDelivery,
+) (uint64, error) {
+	return service.webhooksRepository.SaveWebhookDelivery(ctx, delivery)
+}
+
+func (service *WebhooksService) GetPendingWebhookDeliveries(
+	ctx context.Context,
+	limit uint64,
+) ([]entities.WebhookDelivery, error) {
+	return service.webhooksRepository.GetPendingWebhookDeliveries(ctx, limit)
+}
+
+func (service *WebhooksService) ClaimWebhookDelivery(
+	ctx context.Context,
+	delivery entities.WebhookDelivery,
+	leaseUntil time.Time,
+) (bool, error) {
+	return service.webhooksRepository.ClaimWebhookDelivery(ctx, delivery, leaseUntil)
+}
+
+func (service *WebhooksService) MarkWebhookDeliveryDelivered(ctx context.Context, id uint64, attempts uint32) error {
+	return service.webhooksRepository.MarkWebhookDeliveryDelivered(ctx, id, attempts)
+}
+
+func (service *WebhooksService) RescheduleWebhookDelivery(
+	ctx context.Context,
+	id uint64,
+	attempts uint32,
+	lastError string,
+	nextAttemptAt time.Time,
+) error {
+	return service.webhooksRepository.RescheduleWebhookDelivery(ctx, id, attempts, lastError, nextAttemptAt)
+}
+
+func (service *WebhooksService) DeferWebhookDelivery(
+	ctx context.Context,
+	id uint64,
+	attempts uint32,
+	nextAttemptAt time.Time,
+) error {
+	return service.webhooksRepository.DeferWebhookDelivery(ctx, id, attempts, nextAttemptAt)
+}
+
+func (service *WebhooksService) MarkWebhookDeliveryFailed(
+	ctx context.Context,
+	id uint64,
+	attempts uint32,
+	lastError string,
+) error {
+	return service.webhooksRepository.MarkWebhookDeliveryFailed(ctx, id, attempts, lastError)
+}
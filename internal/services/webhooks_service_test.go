@@ -0,0 +1,71 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	mocklogging "github.com/DKhorkov/libs/logging/mocks"
+
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-notifications/internal/errors"
+	"github.com/DKhorkov/hmtm-notifications/internal/services"
+	mockrepositories "github.com/DKhorkov/hmtm-notifications/mocks/repositories"
+)
+
+func TestWebhooksService_GetWebhookByID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	logger := mocklogging.NewMockLogger(ctrl)
+	webhooksRepository := mockrepositories.NewMockWebhooksRepository(ctrl)
+	webhooksService := services.NewWebhooksService(webhooksRepository, logger)
+
+	webhooksRepository.
+		EXPECT().
+		GetWebhookByID(gomock.Any(), uint64(1)).
+		Return(nil, &customerrors.WebhookNotFoundError{}).
+		Times(1)
+
+	webhook, err := webhooksService.GetWebhookByID(context.Background(), 1)
+	require.ErrorAs(t, err, new(*customerrors.WebhookNotFoundError))
+	require.Nil(t, webhook)
+}
+
+func TestWebhooksService_RegisterWebhookFailure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	logger := mocklogging.NewMockLogger(ctrl)
+	webhooksRepository := mockrepositories.NewMockWebhooksRepository(ctrl)
+	webhooksService := services.NewWebhooksService(webhooksRepository, logger)
+
+	webhooksRepository.
+		EXPECT().
+		RegisterWebhookFailure(gomock.Any(), uint64(1), 5).
+		Return(true, nil).
+		Times(1)
+
+	disabled, err := webhooksService.RegisterWebhookFailure(context.Background(), 1, 5)
+	require.NoError(t, err)
+	require.True(t, disabled)
+}
+
+func TestWebhooksService_ClaimWebhookDelivery(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	logger := mocklogging.NewMockLogger(ctrl)
+	webhooksRepository := mockrepositories.NewMockWebhooksRepository(ctrl)
+	webhooksService := services.NewWebhooksService(webhooksRepository, logger)
+
+	delivery := entities.WebhookDelivery{ID: 1, Status: entities.WebhookDeliveryStatusPending}
+	leaseUntil := time.Now().Add(time.Minute)
+
+	webhooksRepository.
+		EXPECT().
+		ClaimWebhookDelivery(gomock.Any(), delivery, leaseUntil).
+		Return(true, nil).
+		Times(1)
+
+	claimed, err := webhooksService.ClaimWebhookDelivery(context.Background(), delivery, leaseUntil)
+	require.NoError(t, err)
+	require.True(t, claimed)
+}
@@ -0,0 +1,146 @@
+package signers
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/DKhorkov/hmtm-notifications/internal/config"
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-notifications/internal/errors"
+)
+
+const (
+	// Minimal size of signing key secret in bytes, which is the size of HMAC-SHA256 output:
+	tokenSigningKeyMinSize = sha256.Size
+
+	tokenPartsSeparator = "."
+	tokenPartsCount     = 3
+)
+
+// Key ID is sent in links as is, so it should be URL-safe and should not contain parts separator:
+var tokenKeyIDRegexp = regexp.MustCompile(`^[A-Za-z0-9_-]{1,32}$`)
+
+// Secret, which was used by default in earlier versions of service, is publicly known, so tokens, signed with it,
+// could be forged:
+const tokenKnownDefaultSecret = "local-tokens-signing-key-must-be-changed"
+
+// tokenPayload is a compact JSON representation of entities.TokenClaims.
+type tokenPayload struct {
+	UserID    uint64 `json:"uid"`
+	Purpose   string `json:"pur"`
+	Nonce     string `json:"nonce"`
+	ExpiresAt int64  `json:"exp"`
+}
+
+// TokenSigner signs tokens with HMAC-SHA256. Token looks like "<key ID>.<base64 payload>.<base64 signature>",
+// where signature is calculated for "<key ID>.<base64 payload>". New tokens are signed with the first key,
+// while tokens, signed with any of provided keys, are accepted. To rotate key, new key should be added first,
+// and the previous one should be removed after all tokens, signed with it, expire.
+type TokenSigner struct {
+	signingKeyID string
+	keys         map[string][]byte
+}
+
+func NewTokenSigner(tokensConfig config.TokensConfig) (*TokenSigner, error) {
+	if len(tokensConfig.SigningKeys) == 0 {
+		return nil, errors.New("at least one token signing key is required, set it via TOKENS_SIGNING_KEYS")
+	}
+
+	keys := make(map[string][]byte, len(tokensConfig.SigningKeys))
+	for _, key := range tokensConfig.SigningKeys {
+		if !tokenKeyIDRegexp.MatchString(key.ID) {
+			return nil, fmt.Errorf("invalid token signing key ID %q", key.ID)
+		}
+
+		if len(key.Secret) < tokenSigningKeyMinSize {
+			return nil, fmt.Errorf(
+				"token signing key %q should be at least %d bytes long",
+				key.ID,
+				tokenSigningKeyMinSize,
+			)
+		}
+
+		if key.Secret == tokenKnownDefaultSecret {
+			return nil, fmt.Errorf("token signing key %q uses publicly known default secret", key.ID)
+		}
+
+		if _, exists := keys[key.ID]; exists {
+			return nil, fmt.Errorf("duplicate token signing key ID %q", key.ID)
+		}
+
+		keys[key.ID] = []byte(key.Secret)
+	}
+
+	return &TokenSigner{
+		signingKeyID: tokensConfig.SigningKeys[0].ID,
+		keys:         keys,
+	}, nil
+}
+
+func (s *TokenSigner) Sign(claims entities.TokenClaims) (string, error) {
+	payload, err := json.Marshal(
+		tokenPayload{
+			UserID:    claims.UserID,
+			Purpose:   string(claims.Purpose),
+			Nonce:     claims.Nonce,
+			ExpiresAt: claims.ExpiresAt.Unix(),
+		},
+	)
+	if err != nil {
+		return "", err
+	}
+
+	signed := s.signingKeyID + tokenPartsSeparator + base64.RawURLEncoding.EncodeToString(payload)
+
+	return signed + tokenPartsSeparator + s.signature(s.keys[s.signingKeyID], signed), nil
+}
+
+func (s *TokenSigner) Parse(token string) (*entities.TokenClaims, error) {
+	parts := strings.Split(token, tokenPartsSeparator)
+	if len(parts) != tokenPartsCount {
+		return nil, &customerrors.InvalidTokenError{Message: "token is malformed"}
+	}
+
+	keyID, rawPayload, signature := parts[0], parts[1], parts[2]
+
+	key, ok := s.keys[keyID]
+	if !ok {
+		return nil, &customerrors.InvalidTokenError{Message: "token is signed with unknown key"}
+	}
+
+	expected := s.signature(key, keyID+tokenPartsSeparator+rawPayload)
+	if !hmac.Equal([]byte(signature), []byte(expected)) {
+		return nil, &customerrors.InvalidTokenError{Message: "token signature is invalid"}
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(rawPayload)
+	if err != nil {
+		return nil, &customerrors.InvalidTokenError{Message: "token is malformed", BaseErr: err}
+	}
+
+	var decoded tokenPayload
+	if err = json.Unmarshal(payload, &decoded); err != nil {
+		return nil, &customerrors.InvalidTokenError{Message: "token is malformed", BaseErr: err}
+	}
+
+	return &entities.TokenClaims{
+		UserID:    decoded.UserID,
+		Purpose:   entities.TokenPurpose(decoded.Purpose),
+		Nonce:     decoded.Nonce,
+		ExpiresAt: time.Unix(decoded.ExpiresAt, 0).UTC(),
+	}, nil
+}
+
+func (s *TokenSigner) signature(key []byte, signed string) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(signed))
+
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
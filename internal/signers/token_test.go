@@ -0,0 +1,209 @@
+package signers
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DKhorkov/hmtm-notifications/internal/config"
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-notifications/internal/errors"
+)
+
+var (
+	currentKey  = config.TokenSigningKey{ID: "2025-05", Secret: "current-tokens-signing-key-0123456789"}
+	previousKey = config.TokenSigningKey{ID: "2025-01", Secret: "previous-tokens-signing-key-0123456789"}
+)
+
+func TestNewTokenSigner(t *testing.T) {
+	testCases := []struct {
+		name          string
+		keys          []config.TokenSigningKey
+		errorExpected bool
+	}{
+		{
+			name:          "valid keys",
+			keys:          []config.TokenSigningKey{currentKey, previousKey},
+			errorExpected: false,
+		},
+		{
+			name:          "no keys",
+			keys:          nil,
+			errorExpected: true,
+		},
+		{
+			name:          "known default secret",
+			keys:          []config.TokenSigningKey{{ID: "local", Secret: "local-tokens-signing-key-must-be-changed"}},
+			errorExpected: true,
+		},
+		{
+			name:          "short secret",
+			keys:          []config.TokenSigningKey{{ID: "short", Secret: "secret"}},
+			errorExpected: true,
+		},
+		{
+			name:          "key ID with separator",
+			keys:          []config.TokenSigningKey{{ID: "2025.05", Secret: currentKey.Secret}},
+			errorExpected: true,
+		},
+		{
+			name:          "empty key ID",
+			keys:          []config.TokenSigningKey{{ID: "", Secret: currentKey.Secret}},
+			errorExpected: true,
+		},
+		{
+			name:          "duplicate key IDs",
+			keys:          []config.TokenSigningKey{currentKey, {ID: currentKey.ID, Secret: previousKey.Secret}},
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			signer, err := NewTokenSigner(config.TokensConfig{SigningKeys: tc.keys})
+			if tc.errorExpected {
+				require.Error(t, err)
+				require.Nil(t, signer)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, signer)
+			}
+		})
+	}
+}
+
+func TestTokenSigner_SignAndParse(t *testing.T) {
+	signer, err := NewTokenSigner(config.TokensConfig{SigningKeys: []config.TokenSigningKey{currentKey}})
+	require.NoError(t, err)
+
+	claims := entities.TokenClaims{
+		UserID:    42,
+		Purpose:   entities.TokenPurposeForgetPassword,
+		Nonce:     "nonce",
+		ExpiresAt: time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC),
+	}
+
+	token, err := signer.Sign(claims)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(token, currentKey.ID+"."))
+
+	parsed, err := signer.Parse(token)
+	require.NoError(t, err)
+	require.Equal(t, claims, *parsed)
+}
+
+func TestTokenSigner_Rotation(t *testing.T) {
+	previousSigner, err := NewTokenSigner(config.TokensConfig{SigningKeys: []config.TokenSigningKey{previousKey}})
+	require.NoError(t, err)
+
+	rotatedSigner, err := NewTokenSigner(
+		config.TokensConfig{SigningKeys: []config.TokenSigningKey{currentKey, previousKey}},
+	)
+	require.NoError(t, err)
+
+	currentSigner, err := NewTokenSigner(config.TokensConfig{SigningKeys: []config.TokenSigningKey{currentKey}})
+	require.NoError(t, err)
+
+	claims := entities.TokenClaims{
+		UserID:    1,
+		Purpose:   entities.TokenPurposeVerifyEmail,
+		Nonce:     "nonce",
+		ExpiresAt: time.Now().Add(time.Hour).Truncate(time.Second).UTC(),
+	}
+
+	// Tokens, signed before rotation, are accepted until previous key is removed:
+	oldToken, err := previousSigner.Sign(claims)
+	require.NoError(t, err)
+
+	parsed, err := rotatedSigner.Parse(oldToken)
+	require.NoError(t, err)
+	require.Equal(t, claims, *parsed)
+
+	_, err = currentSigner.Parse(oldToken)
+	require.ErrorAs(t, err, new(*customerrors.InvalidTokenError))
+
+	// New tokens are signed with the first key:
+	newToken, err := rotatedSigner.Sign(claims)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(newToken, currentKey.ID+"."))
+
+	_, err = currentSigner.Parse(newToken)
+	require.NoError(t, err)
+}
+
+func TestTokenSigner_ParseInvalid(t *testing.T) {
+	signer, err := NewTokenSigner(config.TokensConfig{SigningKeys: []config.TokenSigningKey{currentKey}})
+	require.NoError(t, err)
+
+	token, err := signer.Sign(
+		entities.TokenClaims{
+			UserID:    1,
+			Purpose:   entities.TokenPurposeForgetPassword,
+			Nonce:     "nonce",
+			ExpiresAt: time.Now().Add(time.Hour),
+		},
+	)
+	require.NoError(t, err)
+
+	parts := strings.Split(token, ".")
+	forgedSigner, err := NewTokenSigner(
+		config.TokensConfig{
+			SigningKeys: []config.TokenSigningKey{{ID: currentKey.ID, Secret: "forged-tokens-signing-key-0123456789"}},
+		},
+	)
+	require.NoError(t, err)
+
+	forgedToken, err := forgedSigner.Sign(
+		entities.TokenClaims{
+			UserID:    2,
+			Purpose:   entities.TokenPurposeForgetPassword,
+			Nonce:     "nonce",
+			ExpiresAt: time.Now().Add(time.Hour),
+		},
+	)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name  string
+		token string
+	}{
+		{
+			name:  "empty token",
+			token: "",
+		},
+		{
+			name:  "missing signature",
+			token: parts[0] + "." + parts[1],
+		},
+		{
+			name:  "unknown key",
+			token: "unknown." + parts[1] + "." + parts[2],
+		},
+		{
+			name:  "tampered payload",
+			token: parts[0] + "." + strings.Split(forgedToken, ".")[1] + "." + parts[2],
+		},
+		{
+			name:  "signed with another secret",
+			token: forgedToken,
+		},
+		{
+			name:  "not base64 payload",
+			token: parts[0] + ".!!!." + parts[2],
+		},
+		{
+			name:  "too many parts",
+			token: token + ".extra",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			claims, err := signer.Parse(tc.token)
+			require.ErrorAs(t, err, new(*customerrors.InvalidTokenError))
+			require.Nil(t, claims)
+		})
+	}
+}
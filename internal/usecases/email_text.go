@@ -0,0 +1,47 @@
+package usecases
+
+import (
+	"github.com/DKhorkov/hmtm-notifications/dto"
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+)
+
+// Plain text alternatives of email bodies are optional: empty text is generated from HTML body on sending.
+
+func (useCases *UseCases) verifyEmailText(user entities.User, token string) string {
+	if useCases.contentBuilders.Text.VerifyEmail == nil {
+		return ""
+	}
+
+	return useCases.contentBuilders.Text.VerifyEmail.Text(user, token)
+}
+
+func (useCases *UseCases) forgetPasswordText(user entities.User, token string) string {
+	if useCases.contentBuilders.Text.ForgetPassword == nil {
+		return ""
+	}
+
+	return useCases.contentBuilders.Text.ForgetPassword.Text(user, token)
+}
+
+func (useCases *UseCases) ticketUpdatedText(
+	ticket entities.RawTicket,
+	changes *entities.TicketChanges,
+	respondOwner entities.User,
+) string {
+	if useCases.contentBuilders.Text.TicketUpdated == nil {
+		return ""
+	}
+
+	return useCases.contentBuilders.Text.TicketUpdated.Text(ticket, changes, respondOwner)
+}
+
+func (useCases *UseCases) ticketDeletedText(
+	ticketData dto.TicketDeletedDTO,
+	ticketOwner, respondOwner entities.User,
+) string {
+	if useCases.contentBuilders.Text.TicketDeleted == nil {
+		return ""
+	}
+
+	return useCases.contentBuilders.Text.TicketDeleted.Text(ticketData, ticketOwner, respondOwner)
+}
@@ -0,0 +1,40 @@
+package usecases
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+)
+
+// MessageIsBeingProcessedError represents redelivery of message, which processing has not been finished yet.
+// Such message should be redelivered later to get processing result.
+type MessageIsBeingProcessedError struct {
+	IdempotencyKey string
+}
+
+func (e MessageIsBeingProcessedError) Error() string {
+	return fmt.Sprintf("message with idempotency key=%s is being processed", e.IdempotencyKey)
+}
+
+// FanOutError represents fan-out, in which communications were not sent to some recipients.
+// Result contains statuses of all recipients, so failed ones could be found and retried.
+type FanOutError struct {
+	Result *entities.FanOutResult
+}
+
+func (e FanOutError) Error() string {
+	failed := e.Result.Failed()
+	reasons := make([]string, 0, len(failed))
+
+	for _, recipient := range failed {
+		reasons = append(reasons, fmt.Sprintf("master with ID=%d: %s", recipient.MasterID, recipient.Reason))
+	}
+
+	return fmt.Sprintf(
+		"failed to send communications to %d of %d recipients: %s",
+		len(failed),
+		len(e.Result.Recipients),
+		strings.Join(reasons, "; "),
+	)
+}
@@ -0,0 +1,214 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+)
+
+const defaultFanOutConcurrency = 1
+
+// fanOutEmailCommunications sends communications to owners of provided masters. Failure for one
+// recipient does not stop sending to others. If message has idempotency key, every recipient is processed
+// only once, so on redelivery communications are sent only to recipients, which failed before.
+//
+// Recipients are processed concurrently by not more than configured number of goroutines, but results are
+// returned in the same order as provided masters. Recipients, which were not processed before context
+// cancellation, are marked as failed.
+func (useCases *UseCases) fanOutEmailCommunications(
+	ctx context.Context,
+	idempotencyKey string,
+	mastersIDs []uint64,
+	buildContent func(recipient entities.User) communication,
+) (*entities.FanOutResult, error) {
+	concurrency := useCases.config.FanOutConcurrency
+	if concurrency <= 0 {
+		concurrency = defaultFanOutConcurrency
+	}
+
+	result := &entities.FanOutResult{
+		Recipients: make([]entities.RecipientResult, len(mastersIDs)),
+	}
+
+	recipients := newNotifiedRecipients()
+	semaphore := make(chan struct{}, concurrency)
+
+	var wg sync.WaitGroup
+
+	for i, masterID := range mastersIDs {
+		if !acquire(ctx, semaphore) {
+			for j := i; j < len(mastersIDs); j++ {
+				result.Recipients[j] = entities.RecipientResult{
+					MasterID: mastersIDs[j],
+					Status:   entities.DeliveryStatusFailed,
+					Reason:   ctx.Err().Error(),
+				}
+			}
+
+			break
+		}
+
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			// Every goroutine writes only to its own index, so results order does not depend on scheduling:
+			result.Recipients[i] = useCases.fanOutEmailCommunication(
+				ctx,
+				idempotencyKey,
+				masterID,
+				recipients,
+				buildContent,
+			)
+		}()
+	}
+
+	wg.Wait()
+
+	if len(result.Failed()) > 0 {
+		return result, &FanOutError{Result: result}
+	}
+
+	return result, nil
+}
+
+// fanOutEmailCommunication sends communication to owner of provided master.
+func (useCases *UseCases) fanOutEmailCommunication(
+	ctx context.Context,
+	idempotencyKey string,
+	masterID uint64,
+	recipients *notifiedRecipients,
+	buildContent func(recipient entities.User) communication,
+) entities.RecipientResult {
+	recipientResult := entities.RecipientResult{MasterID: masterID}
+
+	var alreadyNotified bool
+
+	emailIDs, duplicate, err := useCases.processOnce(
+		ctx,
+		recipientIdempotencyKey(idempotencyKey, masterID),
+		func() ([]uint64, error) {
+			master, err := useCases.toysService.GetMasterByID(ctx, masterID)
+			if err != nil {
+				return nil, err
+			}
+
+			recipientResult.UserID = master.UserID
+
+			// Same user could own several masters, but should get only one communication:
+			unlock := recipients.lock(master.UserID)
+			defer unlock()
+
+			if alreadyNotified = recipients.isNotified(master.UserID); alreadyNotified {
+				return nil, nil
+			}
+
+			recipient, err := useCases.ssoService.GetUserByID(ctx, master.UserID)
+			if err != nil {
+				return nil, err
+			}
+
+			emailID, err := useCases.sendCommunication(ctx, *recipient, buildContent(*recipient))
+			if err != nil {
+				return nil, err
+			}
+
+			recipients.markNotified(master.UserID)
+
+			return []uint64{emailID}, nil
+		},
+	)
+
+	switch {
+	case err != nil:
+		recipientResult.Status = entities.DeliveryStatusFailed
+		recipientResult.Reason = err.Error()
+	case duplicate:
+		recipientResult.Status = entities.DeliveryStatusSkipped
+		recipientResult.Reason = "communication was already sent"
+		recipientResult.EmailID = firstEmailID(emailIDs)
+	case alreadyNotified:
+		recipientResult.Status = entities.DeliveryStatusSkipped
+		recipientResult.Reason = "recipient was already notified"
+	default:
+		recipientResult.Status = entities.DeliveryStatusSent
+		recipientResult.EmailID = firstEmailID(emailIDs)
+	}
+
+	return recipientResult
+}
+
+// acquire takes free slot of semaphore. Returns false, if context was canceled before slot was taken.
+func acquire(ctx context.Context, semaphore chan struct{}) bool {
+	// Checking context first, because select chooses randomly between ready cases:
+	if ctx.Err() != nil {
+		return false
+	}
+
+	select {
+	case semaphore <- struct{}{}:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// recipientIdempotencyKey builds idempotency key for single recipient of fan-out message.
+func recipientIdempotencyKey(idempotencyKey string, masterID uint64) string {
+	if idempotencyKey == "" {
+		return ""
+	}
+
+	return fmt.Sprintf("%s:master:%d", idempotencyKey, masterID)
+}
+
+// notifiedRecipients tracks users, which already got communication during fan-out. Processing of masters,
+// owned by the same user, is serialized by per-user lock, so user is notified only once.
+type notifiedRecipients struct {
+	mutex    sync.Mutex
+	locks    map[uint64]*sync.Mutex
+	notified map[uint64]struct{}
+}
+
+func newNotifiedRecipients() *notifiedRecipients {
+	return &notifiedRecipients{
+		locks:    make(map[uint64]*sync.Mutex),
+		notified: make(map[uint64]struct{}),
+	}
+}
+
+func (r *notifiedRecipients) lock(userID uint64) (unlock func()) {
+	r.mutex.Lock()
+
+	userLock, ok := r.locks[userID]
+	if !ok {
+		userLock = new(sync.Mutex)
+		r.locks[userID] = userLock
+	}
+
+	r.mutex.Unlock()
+
+	userLock.Lock()
+
+	return userLock.Unlock
+}
+
+func (r *notifiedRecipients) isNotified(userID uint64) bool {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	_, ok := r.notified[userID]
+
+	return ok
+}
+
+func (r *notifiedRecipients) markNotified(userID uint64) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.notified[userID] = struct{}{}
+}
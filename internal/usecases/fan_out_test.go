@@ -0,0 +1,419 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/DKhorkov/libs/pointers"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/DKhorkov/hmtm-notifications/internal/config"
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+	"github.com/DKhorkov/hmtm-notifications/internal/interfaces"
+	mockbroadcasters "github.com/DKhorkov/hmtm-notifications/mocks/broadcasters"
+	mockservices "github.com/DKhorkov/hmtm-notifications/mocks/services"
+)
+
+func TestUseCases_fanOutEmailCommunications(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	communicationsService := mockservices.NewMockCommunicationsService(ctrl)
+	processedMessagesService := mockservices.NewMockProcessedMessagesService(ctrl)
+	ssoService := mockservices.NewMockSsoService(ctrl)
+	toysService := mockservices.NewMockToysService(ctrl)
+	useCases := New(
+		communicationsService,
+		processedMessagesService,
+		newAcceptingNotificationsService(ctrl),
+		newAcceptingNotificationsBroadcaster(ctrl),
+		nil,
+		nil,
+		nil,
+		nil,
+		ssoService,
+		toysService,
+		nil,
+		interfaces.ContentBuilders{},
+		config.UseCasesConfig{},
+	)
+
+	buildContent := func(recipient entities.User) communication {
+		return communication{subject: "Subject", body: "Body for " + recipient.Email}
+	}
+
+	testCases := []struct {
+		name           string
+		idempotencyKey string
+		mastersIDs     []uint64
+		setupMocks     func(
+			communicationsService *mockservices.MockCommunicationsService,
+			processedMessagesService *mockservices.MockProcessedMessagesService,
+			ssoService *mockservices.MockSsoService,
+			toysService *mockservices.MockToysService,
+		)
+		expected      *entities.FanOutResult
+		errorExpected bool
+	}{
+		{
+			name:       "failed recipient does not stop fan-out",
+			mastersIDs: []uint64{1, 2},
+			setupMocks: func(
+				communicationsService *mockservices.MockCommunicationsService,
+				_ *mockservices.MockProcessedMessagesService,
+				ssoService *mockservices.MockSsoService,
+				toysService *mockservices.MockToysService,
+			) {
+				toysService.
+					EXPECT().
+					GetMasterByID(gomock.Any(), uint64(1)).
+					Return(nil, errors.New("not found")).
+					Times(1)
+
+				toysService.
+					EXPECT().
+					GetMasterByID(gomock.Any(), uint64(2)).
+					Return(&entities.Master{ID: 2, UserID: 3}, nil).
+					Times(1)
+
+				ssoService.
+					EXPECT().
+					GetUserByID(gomock.Any(), uint64(3)).
+					Return(&entities.User{ID: 3, Email: "master@example.com"}, nil).
+					Times(1)
+
+				communicationsService.
+					EXPECT().
+					SaveCommunication(gomock.Any(), gomock.Any()).
+					Return(uint64(1), nil).
+					Times(1)
+			},
+			expected: &entities.FanOutResult{
+				Recipients: []entities.RecipientResult{
+					{
+						MasterID: 1,
+						Status:   entities.DeliveryStatusFailed,
+						Reason:   "not found",
+					},
+					{
+						MasterID: 2,
+						UserID:   3,
+						EmailID:  1,
+						Status:   entities.DeliveryStatusSent,
+					},
+				},
+			},
+			errorExpected: true,
+		},
+		{
+			name:       "user with several masters notified once",
+			mastersIDs: []uint64{1, 2},
+			setupMocks: func(
+				communicationsService *mockservices.MockCommunicationsService,
+				_ *mockservices.MockProcessedMessagesService,
+				ssoService *mockservices.MockSsoService,
+				toysService *mockservices.MockToysService,
+			) {
+				toysService.
+					EXPECT().
+					GetMasterByID(gomock.Any(), uint64(1)).
+					Return(&entities.Master{ID: 1, UserID: 3}, nil).
+					Times(1)
+
+				toysService.
+					EXPECT().
+					GetMasterByID(gomock.Any(), uint64(2)).
+					Return(&entities.Master{ID: 2, UserID: 3}, nil).
+					Times(1)
+
+				ssoService.
+					EXPECT().
+					GetUserByID(gomock.Any(), uint64(3)).
+					Return(&entities.User{ID: 3, Email: "master@example.com"}, nil).
+					Times(1)
+
+				communicationsService.
+					EXPECT().
+					SaveCommunication(gomock.Any(), gomock.Any()).
+					Return(uint64(1), nil).
+					Times(1)
+			},
+			expected: &entities.FanOutResult{
+				Recipients: []entities.RecipientResult{
+					{
+						MasterID: 1,
+						UserID:   3,
+						EmailID:  1,
+						Status:   entities.DeliveryStatusSent,
+					},
+					{
+						MasterID: 2,
+						UserID:   3,
+						Status:   entities.DeliveryStatusSkipped,
+						Reason:   "recipient was already notified",
+					},
+				},
+			},
+		},
+		{
+			name:           "redelivery sends only to previously failed recipients",
+			idempotencyKey: "key",
+			mastersIDs:     []uint64{1, 2},
+			setupMocks: func(
+				communicationsService *mockservices.MockCommunicationsService,
+				processedMessagesService *mockservices.MockProcessedMessagesService,
+				ssoService *mockservices.MockSsoService,
+				toysService *mockservices.MockToysService,
+			) {
+				processedMessagesService.
+					EXPECT().
+					ReserveProcessedMessage(gomock.Any(), "key:master:1").
+					Return(false, nil).
+					Times(1)
+
+				processedMessagesService.
+					EXPECT().
+					GetProcessedMessage(gomock.Any(), "key:master:1").
+					Return(
+						&entities.ProcessedMessage{
+							IdempotencyKey: "key:master:1",
+							EmailIDs:       []uint64{1},
+							ProcessedAt:    pointers.New(time.Now()),
+						},
+						nil,
+					).
+					Times(1)
+
+				processedMessagesService.
+					EXPECT().
+					ReserveProcessedMessage(gomock.Any(), "key:master:2").
+					Return(true, nil).
+					Times(1)
+
+				toysService.
+					EXPECT().
+					GetMasterByID(gomock.Any(), uint64(2)).
+					Return(&entities.Master{ID: 2, UserID: 4}, nil).
+					Times(1)
+
+				ssoService.
+					EXPECT().
+					GetUserByID(gomock.Any(), uint64(4)).
+					Return(&entities.User{ID: 4, Email: "master@example.com"}, nil).
+					Times(1)
+
+				communicationsService.
+					EXPECT().
+					SaveCommunication(gomock.Any(), gomock.Any()).
+					Return(uint64(2), nil).
+					Times(1)
+
+				processedMessagesService.
+					EXPECT().
+					CompleteProcessedMessage(gomock.Any(), "key:master:2", []uint64{2}).
+					Return(nil).
+					Times(1)
+			},
+			expected: &entities.FanOutResult{
+				Recipients: []entities.RecipientResult{
+					{
+						MasterID: 1,
+						EmailID:  1,
+						Status:   entities.DeliveryStatusSkipped,
+						Reason:   "communication was already sent",
+					},
+					{
+						MasterID: 2,
+						UserID:   4,
+						EmailID:  2,
+						Status:   entities.DeliveryStatusSent,
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(communicationsService, processedMessagesService, ssoService, toysService)
+			}
+
+			actual, err := useCases.fanOutEmailCommunications(
+				context.Background(),
+				tc.idempotencyKey,
+				tc.mastersIDs,
+				buildContent,
+			)
+			if tc.errorExpected {
+				var fanOutErr *FanOutError
+				require.ErrorAs(t, err, &fanOutErr)
+				require.Equal(t, tc.expected, fanOutErr.Result)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestUseCases_fanOutEmailCommunicationsConcurrency(t *testing.T) {
+	const (
+		concurrency   = 3
+		mastersNumber = 20
+	)
+
+	ctrl := gomock.NewController(t)
+	communicationsService := mockservices.NewMockCommunicationsService(ctrl)
+	ssoService := mockservices.NewMockSsoService(ctrl)
+	toysService := mockservices.NewMockToysService(ctrl)
+	useCases := New(
+		communicationsService,
+		mockservices.NewMockProcessedMessagesService(ctrl),
+		newAcceptingNotificationsService(ctrl),
+		newAcceptingNotificationsBroadcaster(ctrl),
+		nil,
+		nil,
+		nil,
+		nil,
+		ssoService,
+		toysService,
+		nil,
+		interfaces.ContentBuilders{},
+		config.UseCasesConfig{FanOutConcurrency: concurrency},
+	)
+
+	var (
+		mutex     sync.Mutex
+		active    int
+		maxActive int
+	)
+
+	toysService.
+		EXPECT().
+		GetMasterByID(gomock.Any(), gomock.Any()).
+		DoAndReturn(
+			func(_ context.Context, masterID uint64) (*entities.Master, error) {
+				mutex.Lock()
+				active++
+				maxActive = max(maxActive, active)
+				mutex.Unlock()
+
+				// Giving other goroutines a chance to start:
+				time.Sleep(time.Millisecond * 5)
+
+				mutex.Lock()
+				active--
+				mutex.Unlock()
+
+				return &entities.Master{ID: masterID, UserID: masterID}, nil
+			},
+		).
+		Times(mastersNumber)
+
+	ssoService.
+		EXPECT().
+		GetUserByID(gomock.Any(), gomock.Any()).
+		DoAndReturn(
+			func(_ context.Context, userID uint64) (*entities.User, error) {
+				return &entities.User{ID: userID}, nil
+			},
+		).
+		Times(mastersNumber)
+
+	communicationsService.
+		EXPECT().
+		SaveCommunication(gomock.Any(), gomock.Any()).
+		DoAndReturn(
+			func(_ context.Context, communication entities.Communication) (uint64, error) {
+				return communication.UserID, nil
+			},
+		).
+		Times(mastersNumber)
+
+	mastersIDs := make([]uint64, 0, mastersNumber)
+	expected := &entities.FanOutResult{
+		Recipients: make([]entities.RecipientResult, 0, mastersNumber),
+	}
+
+	for i := uint64(1); i <= mastersNumber; i++ {
+		mastersIDs = append(mastersIDs, i)
+		expected.Recipients = append(
+			expected.Recipients,
+			entities.RecipientResult{
+				MasterID: i,
+				UserID:   i,
+				EmailID:  i,
+				Status:   entities.DeliveryStatusSent,
+			},
+		)
+	}
+
+	actual, err := useCases.fanOutEmailCommunications(
+		context.Background(),
+		"",
+		mastersIDs,
+		func(entities.User) communication {
+			return communication{subject: "Subject", body: "Body"}
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, expected, actual)
+	require.LessOrEqual(t, maxActive, concurrency)
+	require.Greater(t, maxActive, 1)
+}
+
+func TestUseCases_fanOutEmailCommunicationsCanceledContext(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCases := New(
+		mockservices.NewMockCommunicationsService(ctrl),
+		mockservices.NewMockProcessedMessagesService(ctrl),
+		mockservices.NewMockNotificationsService(ctrl),
+		mockbroadcasters.NewMockNotificationsBroadcaster(ctrl),
+		nil,
+		nil,
+		nil,
+		nil,
+		mockservices.NewMockSsoService(ctrl),
+		mockservices.NewMockToysService(ctrl),
+		nil,
+		interfaces.ContentBuilders{},
+		config.UseCasesConfig{FanOutConcurrency: 2},
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	actual, err := useCases.fanOutEmailCommunications(
+		ctx,
+		"",
+		[]uint64{1, 2},
+		func(entities.User) communication {
+			return communication{subject: "Subject", body: "Body"}
+		},
+	)
+
+	var fanOutErr *FanOutError
+	require.ErrorAs(t, err, &fanOutErr)
+	require.Equal(
+		t,
+		&entities.FanOutResult{
+			Recipients: []entities.RecipientResult{
+				{
+					MasterID: 1,
+					Status:   entities.DeliveryStatusFailed,
+					Reason:   context.Canceled.Error(),
+				},
+				{
+					MasterID: 2,
+					Status:   entities.DeliveryStatusFailed,
+					Reason:   context.Canceled.Error(),
+				},
+			},
+		},
+		actual,
+	)
+}
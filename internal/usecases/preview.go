@@ -0,0 +1,159 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/DKhorkov/hmtm-notifications/dto"
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-notifications/internal/errors"
+	"github.com/DKhorkov/hmtm-notifications/internal/senders"
+)
+
+// Tokens are stored on issue, so preview links contain placeholder instead of signed token:
+const previewToken = "preview"
+
+// PreviewVerifyEmailCommunication renders verify-email content for user in the same way as it is sent, but
+// nothing is sent or saved.
+func (useCases *UseCases) PreviewVerifyEmailCommunication(
+	ctx context.Context,
+	verifyEmailData dto.VerifyEmailDTO,
+) (*entities.NotificationPreview, error) {
+	user, err := useCases.ssoService.GetUserByID(ctx, verifyEmailData.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	setRequestLocale(user, verifyEmailData.Locale)
+
+	return newNotificationPreview(
+		*user,
+		useCases.contentBuilders.VerifyEmail.Subject(*user),
+		useCases.contentBuilders.VerifyEmail.Body(*user, previewToken),
+		useCases.verifyEmailText(*user, previewToken),
+	), nil
+}
+
+// PreviewForgetPasswordEmailCommunication renders forget-password content for user in the same way as it is
+// sent, but nothing is sent or saved.
+func (useCases *UseCases) PreviewForgetPasswordEmailCommunication(
+	ctx context.Context,
+	forgetPasswordData dto.ForgetPasswordDTO,
+) (*entities.NotificationPreview, error) {
+	user, err := useCases.ssoService.GetUserByID(ctx, forgetPasswordData.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	setRequestLocale(user, forgetPasswordData.Locale)
+
+	return newNotificationPreview(
+		*user,
+		useCases.contentBuilders.ForgetPassword.Subject(*user),
+		useCases.contentBuilders.ForgetPassword.Body(*user, previewToken),
+		useCases.forgetPasswordText(*user, previewToken),
+	), nil
+}
+
+// PreviewTicketUpdatedEmailCommunication renders ticket-updated content for owner of provided master or, if
+// master is not provided, for owner of the first responded master, but nothing is sent or saved.
+func (useCases *UseCases) PreviewTicketUpdatedEmailCommunication(
+	ctx context.Context,
+	ticketData dto.TicketUpdatedDTO,
+	masterID *uint64,
+) (*entities.NotificationPreview, error) {
+	rawTicket, err := useCases.ticketsService.GetTicketByID(ctx, ticketData.TicketID)
+	if err != nil {
+		return nil, err
+	}
+
+	var mastersIDs []uint64
+	if masterID == nil {
+		responds, err := useCases.ticketsService.GetTicketResponds(ctx, rawTicket.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, respond := range responds {
+			mastersIDs = append(mastersIDs, respond.MasterID)
+		}
+	}
+
+	respondOwner, err := useCases.previewRecipient(ctx, masterID, mastersIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := useCases.ticketChanges(ctx, *rawTicket, ticketData.Previous)
+	if err != nil {
+		return nil, err
+	}
+
+	return newNotificationPreview(
+		*respondOwner,
+		useCases.contentBuilders.TicketUpdated.Subject(*rawTicket, *respondOwner),
+		useCases.contentBuilders.TicketUpdated.Body(*rawTicket, changes, *respondOwner),
+		useCases.ticketUpdatedText(*rawTicket, changes, *respondOwner),
+	), nil
+}
+
+// PreviewTicketDeletedEmailCommunication renders ticket-deleted content for owner of provided master or, if
+// master is not provided, for owner of the first responded master, but nothing is sent or saved.
+func (useCases *UseCases) PreviewTicketDeletedEmailCommunication(
+	ctx context.Context,
+	ticketData dto.TicketDeletedDTO,
+	masterID *uint64,
+) (*entities.NotificationPreview, error) {
+	ticketOwner, err := useCases.ssoService.GetUserByID(ctx, ticketData.TicketOwnerID)
+	if err != nil {
+		return nil, err
+	}
+
+	respondOwner, err := useCases.previewRecipient(ctx, masterID, ticketData.RespondedMastersIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return newNotificationPreview(
+		*respondOwner,
+		useCases.contentBuilders.TicketDeleted.Subject(ticketData, *respondOwner),
+		useCases.contentBuilders.TicketDeleted.Body(ticketData, *ticketOwner, *respondOwner),
+		useCases.ticketDeletedText(ticketData, *ticketOwner, *respondOwner),
+	), nil
+}
+
+// previewRecipient returns owner of provided master or owner of the first of responded masters.
+func (useCases *UseCases) previewRecipient(
+	ctx context.Context,
+	masterID *uint64,
+	mastersIDs []uint64,
+) (*entities.User, error) {
+	if masterID == nil {
+		if len(mastersIDs) == 0 {
+			return nil, &customerrors.PreviewRecipientNotFoundError{}
+		}
+
+		masterID = &mastersIDs[0]
+	}
+
+	master, err := useCases.toysService.GetMasterByID(ctx, *masterID)
+	if err != nil {
+		return nil, err
+	}
+
+	return useCases.ssoService.GetUserByID(ctx, master.UserID)
+}
+
+// newNotificationPreview generates plain text from HTML body, if text content builder is not set, as email
+// sender does.
+func newNotificationPreview(recipient entities.User, subject, body, text string) *entities.NotificationPreview {
+	if text == "" {
+		text = senders.RenderPlainText(body)
+	}
+
+	return &entities.NotificationPreview{
+		RecipientID: recipient.ID,
+		Subject:     subject,
+		HTML:        body,
+		Text:        text,
+	}
+}
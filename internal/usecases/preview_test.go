@@ -0,0 +1,322 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/DKhorkov/libs/pointers"
+
+	"github.com/DKhorkov/hmtm-notifications/dto"
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-notifications/internal/errors"
+	"github.com/DKhorkov/hmtm-notifications/internal/interfaces"
+	mockcontentbuilders "github.com/DKhorkov/hmtm-notifications/mocks/contentbuilders"
+	mockservices "github.com/DKhorkov/hmtm-notifications/mocks/services"
+)
+
+func TestUseCases_PreviewVerifyEmailCommunication(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ssoService := mockservices.NewMockSsoService(ctrl)
+	verifyEmailBuilder := mockcontentbuilders.NewMockVerifyEmailContentBuilder(ctrl)
+
+	// Services, which save or send anything, are not set, so preview fails on any such call:
+	useCases := newTestUseCases(testUseCasesDeps{
+		ssoService:      ssoService,
+		contentBuilders: interfaces.ContentBuilders{VerifyEmail: verifyEmailBuilder},
+	})
+
+	user := entities.User{ID: 1, Email: "user@example.com"}
+	ssoService.
+		EXPECT().
+		GetUserByID(gomock.Any(), uint64(1)).
+		Return(&user, nil).
+		Times(1)
+
+	verifyEmailBuilder.
+		EXPECT().
+		Subject(user).
+		Return("Subject").
+		Times(1)
+
+	verifyEmailBuilder.
+		EXPECT().
+		Body(user, previewToken).
+		Return("<p>Body</p>").
+		Times(1)
+
+	preview, err := useCases.PreviewVerifyEmailCommunication(context.Background(), dto.VerifyEmailDTO{UserID: 1})
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		&entities.NotificationPreview{RecipientID: 1, Subject: "Subject", HTML: "<p>Body</p>", Text: "Body"},
+		preview,
+	)
+}
+
+func TestUseCases_PreviewForgetPasswordEmailCommunication(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ssoService := mockservices.NewMockSsoService(ctrl)
+	useCases := newTestUseCases(testUseCasesDeps{ssoService: ssoService})
+
+	ssoService.
+		EXPECT().
+		GetUserByID(gomock.Any(), uint64(1)).
+		Return(nil, errors.New("user not found")).
+		Times(1)
+
+	preview, err := useCases.PreviewForgetPasswordEmailCommunication(
+		context.Background(),
+		dto.ForgetPasswordDTO{UserID: 1},
+	)
+	require.Error(t, err)
+	require.Nil(t, preview)
+}
+
+func TestUseCases_PreviewTicketUpdatedEmailCommunication(t *testing.T) {
+	ticket := entities.RawTicket{ID: 1, Name: "Ticket"}
+	recipient := entities.User{ID: 3}
+
+	testCases := []struct {
+		name       string
+		masterID   *uint64
+		setupMocks func(
+			ticketsService *mockservices.MockTicketsService,
+			toysService *mockservices.MockToysService,
+			ssoService *mockservices.MockSsoService,
+			ticketUpdatedBuilder *mockcontentbuilders.MockTicketUpdatedContentBuilder,
+			ticketUpdatedTextBuilder *mockcontentbuilders.MockTicketUpdatedTextContentBuilder,
+		)
+		expected    *entities.NotificationPreview
+		expectedErr error
+	}{
+		{
+			name: "first responded master",
+			setupMocks: func(
+				ticketsService *mockservices.MockTicketsService,
+				toysService *mockservices.MockToysService,
+				ssoService *mockservices.MockSsoService,
+				ticketUpdatedBuilder *mockcontentbuilders.MockTicketUpdatedContentBuilder,
+				ticketUpdatedTextBuilder *mockcontentbuilders.MockTicketUpdatedTextContentBuilder,
+			) {
+				ticketsService.
+					EXPECT().
+					GetTicketByID(gomock.Any(), uint64(1)).
+					Return(&ticket, nil).
+					Times(1)
+
+				ticketsService.
+					EXPECT().
+					GetTicketResponds(gomock.Any(), uint64(1)).
+					Return([]entities.Respond{{MasterID: 2}, {MasterID: 4}}, nil).
+					Times(1)
+
+				toysService.
+					EXPECT().
+					GetMasterByID(gomock.Any(), uint64(2)).
+					Return(&entities.Master{ID: 2, UserID: 3}, nil).
+					Times(1)
+
+				ssoService.
+					EXPECT().
+					GetUserByID(gomock.Any(), uint64(3)).
+					Return(&recipient, nil).
+					Times(1)
+
+				ticketUpdatedBuilder.
+					EXPECT().
+					Subject(ticket, recipient).
+					Return("Subject").
+					Times(1)
+
+				ticketUpdatedBuilder.
+					EXPECT().
+					Body(ticket, gomock.Nil(), recipient).
+					Return("<p>Body</p>").
+					Times(1)
+
+				ticketUpdatedTextBuilder.
+					EXPECT().
+					Text(ticket, gomock.Nil(), recipient).
+					Return("Text").
+					Times(1)
+			},
+			expected: &entities.NotificationPreview{
+				RecipientID: 3,
+				Subject:     "Subject",
+				HTML:        "<p>Body</p>",
+				Text:        "Text",
+			},
+		},
+		{
+			name:     "provided master",
+			masterID: pointers.New[uint64](5),
+			setupMocks: func(
+				ticketsService *mockservices.MockTicketsService,
+				toysService *mockservices.MockToysService,
+				ssoService *mockservices.MockSsoService,
+				ticketUpdatedBuilder *mockcontentbuilders.MockTicketUpdatedContentBuilder,
+				ticketUpdatedTextBuilder *mockcontentbuilders.MockTicketUpdatedTextContentBuilder,
+			) {
+				ticketsService.
+					EXPECT().
+					GetTicketByID(gomock.Any(), uint64(1)).
+					Return(&ticket, nil).
+					Times(1)
+
+				toysService.
+					EXPECT().
+					GetMasterByID(gomock.Any(), uint64(5)).
+					Return(&entities.Master{ID: 5, UserID: 3}, nil).
+					Times(1)
+
+				ssoService.
+					EXPECT().
+					GetUserByID(gomock.Any(), uint64(3)).
+					Return(&recipient, nil).
+					Times(1)
+
+				ticketUpdatedBuilder.
+					EXPECT().
+					Subject(ticket, recipient).
+					Return("Subject").
+					Times(1)
+
+				ticketUpdatedBuilder.
+					EXPECT().
+					Body(ticket, gomock.Nil(), recipient).
+					Return("<p>Body</p>").
+					Times(1)
+
+				ticketUpdatedTextBuilder.
+					EXPECT().
+					Text(ticket, gomock.Nil(), recipient).
+					Return("Text").
+					Times(1)
+			},
+			expected: &entities.NotificationPreview{
+				RecipientID: 3,
+				Subject:     "Subject",
+				HTML:        "<p>Body</p>",
+				Text:        "Text",
+			},
+		},
+		{
+			name: "ticket without responds",
+			setupMocks: func(
+				ticketsService *mockservices.MockTicketsService,
+				_ *mockservices.MockToysService,
+				_ *mockservices.MockSsoService,
+				_ *mockcontentbuilders.MockTicketUpdatedContentBuilder,
+				_ *mockcontentbuilders.MockTicketUpdatedTextContentBuilder,
+			) {
+				ticketsService.
+					EXPECT().
+					GetTicketByID(gomock.Any(), uint64(1)).
+					Return(&ticket, nil).
+					Times(1)
+
+				ticketsService.
+					EXPECT().
+					GetTicketResponds(gomock.Any(), uint64(1)).
+					Return(nil, nil).
+					Times(1)
+			},
+			expectedErr: &customerrors.PreviewRecipientNotFoundError{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			ticketsService := mockservices.NewMockTicketsService(ctrl)
+			toysService := mockservices.NewMockToysService(ctrl)
+			ssoService := mockservices.NewMockSsoService(ctrl)
+			ticketUpdatedBuilder := mockcontentbuilders.NewMockTicketUpdatedContentBuilder(ctrl)
+			ticketUpdatedTextBuilder := mockcontentbuilders.NewMockTicketUpdatedTextContentBuilder(ctrl)
+			useCases := newTestUseCases(testUseCasesDeps{
+				ssoService:     ssoService,
+				toysService:    toysService,
+				ticketsService: ticketsService,
+				contentBuilders: interfaces.ContentBuilders{
+					TicketUpdated: ticketUpdatedBuilder,
+					Text:          interfaces.EmailTextContentBuilders{TicketUpdated: ticketUpdatedTextBuilder},
+				},
+			})
+
+			tc.setupMocks(ticketsService, toysService, ssoService, ticketUpdatedBuilder, ticketUpdatedTextBuilder)
+
+			preview, err := useCases.PreviewTicketUpdatedEmailCommunication(
+				context.Background(),
+				dto.TicketUpdatedDTO{TicketID: 1},
+				tc.masterID,
+			)
+			if tc.expectedErr != nil {
+				require.IsType(t, tc.expectedErr, err)
+				require.Nil(t, preview)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, preview)
+		})
+	}
+}
+
+func TestUseCases_PreviewTicketDeletedEmailCommunication(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ssoService := mockservices.NewMockSsoService(ctrl)
+	toysService := mockservices.NewMockToysService(ctrl)
+	ticketDeletedBuilder := mockcontentbuilders.NewMockTicketDeletedContentBuilder(ctrl)
+	useCases := newTestUseCases(testUseCasesDeps{
+		ssoService:      ssoService,
+		toysService:     toysService,
+		contentBuilders: interfaces.ContentBuilders{TicketDeleted: ticketDeletedBuilder},
+	})
+
+	ticketData := dto.TicketDeletedDTO{TicketOwnerID: 1, Name: "Ticket", RespondedMastersIDs: []uint64{2}}
+	ticketOwner := entities.User{ID: 1}
+	recipient := entities.User{ID: 3}
+
+	ssoService.
+		EXPECT().
+		GetUserByID(gomock.Any(), uint64(1)).
+		Return(&ticketOwner, nil).
+		Times(1)
+
+	toysService.
+		EXPECT().
+		GetMasterByID(gomock.Any(), uint64(2)).
+		Return(&entities.Master{ID: 2, UserID: 3}, nil).
+		Times(1)
+
+	ssoService.
+		EXPECT().
+		GetUserByID(gomock.Any(), uint64(3)).
+		Return(&recipient, nil).
+		Times(1)
+
+	ticketDeletedBuilder.
+		EXPECT().
+		Subject(ticketData, recipient).
+		Return("Subject").
+		Times(1)
+
+	ticketDeletedBuilder.
+		EXPECT().
+		Body(ticketData, ticketOwner, recipient).
+		Return("<p>Body</p>").
+		Times(1)
+
+	preview, err := useCases.PreviewTicketDeletedEmailCommunication(context.Background(), ticketData, nil)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		&entities.NotificationPreview{RecipientID: 3, Subject: "Subject", HTML: "<p>Body</p>", Text: "Body"},
+		preview,
+	)
+}
@@ -0,0 +1,101 @@
+package usecases
+
+import (
+	"slices"
+
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+)
+
+// defaultRoute is used for notification types, which have no configured channels.
+var defaultRoute = []entities.CommunicationChannel{entities.CommunicationChannelEmail}
+
+// routedCommunication is a communication through single channel of routing chain. Text is set only for email
+// channel as plain text alternative of content.
+type routedCommunication struct {
+	channel entities.CommunicationChannel
+	address string
+	content string
+	text    string
+}
+
+// route returns routing chain of communication for recipient: communications through channels of routing policy
+// of notification type in priority order. Channel is used only if it is enabled, communication has content for it
+// and recipient has confirmed contact point for it. If no channel of policy is available, communication is sent
+// to email of recipient, since it is the only contact point every user has.
+func (useCases *UseCases) route(recipient entities.User, content communication) []routedCommunication {
+	channels := useCases.config.Routing[content.notification.Type]
+	if len(channels) == 0 {
+		channels = defaultRoute
+	}
+
+	chain := make([]routedCommunication, 0, len(channels))
+	used := make([]entities.CommunicationChannel, 0, len(channels))
+
+	for _, channel := range channels {
+		if slices.Contains(used, channel) {
+			continue
+		}
+
+		routed, ok := useCases.routeThrough(channel, recipient, content)
+		if !ok {
+			continue
+		}
+
+		used = append(used, channel)
+		chain = append(chain, routed)
+	}
+
+	if len(chain) == 0 {
+		chain = append(
+			chain,
+			routedCommunication{
+				channel: entities.CommunicationChannelEmail,
+				address: recipient.Email,
+				content: content.body,
+				text:    content.text,
+			},
+		)
+	}
+
+	return chain
+}
+
+// routeThrough returns communication through provided channel, if channel is available for recipient.
+func (useCases *UseCases) routeThrough(
+	channel entities.CommunicationChannel,
+	recipient entities.User,
+	content communication,
+) (routedCommunication, bool) {
+	routed := routedCommunication{channel: channel}
+
+	switch channel {
+	case entities.CommunicationChannelEmail:
+		if !recipient.EmailConfirmed || recipient.Email == "" {
+			return routed, false
+		}
+
+		routed.address = recipient.Email
+		routed.content = content.body
+		routed.text = content.text
+	case entities.CommunicationChannelTelegram:
+		chatID, ok := recipient.TelegramChatID()
+		if !ok || !useCases.config.TelegramEnabled || content.telegram == "" {
+			return routed, false
+		}
+
+		routed.address = chatID
+		routed.content = content.telegram
+	case entities.CommunicationChannelSMS:
+		phone, ok := recipient.ConfirmedPhone()
+		if !ok || !useCases.config.SMSEnabled || content.sms == nil {
+			return routed, false
+		}
+
+		routed.address = phone
+		routed.content = content.sms()
+	default:
+		return routed, false
+	}
+
+	return routed, true
+}
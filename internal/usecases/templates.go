@@ -0,0 +1,220 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-notifications/internal/errors"
+)
+
+// Prefixes of lines in diff of template versions:
+const (
+	unchangedLinePrefix = " "
+	removedLinePrefix   = "-"
+	addedLinePrefix     = "+"
+)
+
+// Maximal number of lines of subject, HTML and plain text of template. Diff of versions takes memory, quadratic
+// to number of lines, so larger templates are rejected:
+const templateMaxLines = 1000
+
+// CreateTemplate validates template and saves it as a draft of the next version of its notification type and
+// locale. Draft is not rendered until it is published.
+func (useCases *UseCases) CreateTemplate(
+	ctx context.Context,
+	template entities.Template,
+) (*entities.Template, error) {
+	if err := validateTemplateSize(template); err != nil {
+		return nil, err
+	}
+
+	if err := useCases.contentBuilders.Templates.Validate(template); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	template.State = entities.TemplateStateDraft
+	template.CreatedAt = now
+	template.UpdatedAt = now
+
+	templateID, err := useCases.templatesService.SaveTemplate(ctx, template)
+	if err != nil {
+		return nil, err
+	}
+
+	return useCases.templatesService.GetTemplateByID(ctx, templateID)
+}
+
+// UpdateTemplate changes subject, HTML and plain text of draft. Published and archived versions are immutable.
+func (useCases *UseCases) UpdateTemplate(
+	ctx context.Context,
+	id uint64,
+	subject, html, text string,
+) (*entities.Template, error) {
+	template, err := useCases.templatesService.GetTemplateByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if template.State != entities.TemplateStateDraft {
+		return nil, &customerrors.TemplateNotDraftError{}
+	}
+
+	template.Subject = subject
+	template.HTML = html
+	template.Text = text
+	template.UpdatedAt = time.Now().UTC()
+
+	if err = validateTemplateSize(*template); err != nil {
+		return nil, err
+	}
+
+	if err = useCases.contentBuilders.Templates.Validate(*template); err != nil {
+		return nil, err
+	}
+
+	updated, err := useCases.templatesService.UpdateTemplate(ctx, *template)
+	if err != nil {
+		return nil, err
+	}
+
+	// Template could be published by another request after it was got:
+	if !updated {
+		return nil, &customerrors.TemplateNotDraftError{}
+	}
+
+	return useCases.templatesService.GetTemplateByID(ctx, id)
+}
+
+// PublishTemplate publishes version of template instead of currently published one and reloads published
+// templates, so it is rendered by this instance at once and by other instances after their next reloading.
+// Publishing of archived version rolls back changes of later versions.
+func (useCases *UseCases) PublishTemplate(ctx context.Context, id uint64) (*entities.Template, error) {
+	template, err := useCases.templatesService.GetTemplateByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Embedded templates could be changed since template was saved:
+	if err = useCases.contentBuilders.Templates.Validate(*template); err != nil {
+		return nil, err
+	}
+
+	if err = useCases.templatesService.PublishTemplate(ctx, id, time.Now().UTC()); err != nil {
+		return nil, err
+	}
+
+	published, err := useCases.templatesService.GetPublishedTemplates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = useCases.contentBuilders.Templates.SetPublished(published); err != nil {
+		return nil, err
+	}
+
+	return useCases.templatesService.GetTemplateByID(ctx, id)
+}
+
+func (useCases *UseCases) GetTemplates(
+	ctx context.Context,
+	filters entities.TemplatesFilters,
+) ([]entities.Template, error) {
+	return useCases.templatesService.GetTemplates(ctx, filters)
+}
+
+// DiffTemplates returns line-based differences between two versions of template.
+func (useCases *UseCases) DiffTemplates(ctx context.Context, fromID, toID uint64) (*entities.TemplateDiff, error) {
+	from, err := useCases.templatesService.GetTemplateByID(ctx, fromID)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := useCases.templatesService.GetTemplateByID(ctx, toID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entities.TemplateDiff{
+		From:    *from,
+		To:      *to,
+		Subject: diffLines(from.Subject, to.Subject),
+		HTML:    diffLines(from.HTML, to.HTML),
+		Text:    diffLines(from.Text, to.Text),
+	}, nil
+}
+
+// diffLines compares lines of texts by longest common subsequence. Templates are limited by templateMaxLines,
+// so quadratic complexity of comparison is acceptable.
+func diffLines(from, to string) string {
+	a := splitLines(from)
+	b := splitLines(to)
+
+	// common[i][j] is length of longest common subsequence of a[i:] and b[j:]:
+	common := make([][]int, len(a)+1)
+	for i := range common {
+		common[i] = make([]int, len(b)+1)
+	}
+
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				common[i][j] = common[i+1][j+1] + 1
+			} else {
+				common[i][j] = max(common[i+1][j], common[i][j+1])
+			}
+		}
+	}
+
+	lines := make([]string, 0, len(a)+len(b))
+
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			lines = append(lines, unchangedLinePrefix+a[i])
+			i++
+			j++
+		case common[i+1][j] >= common[i][j+1]:
+			lines = append(lines, removedLinePrefix+a[i])
+			i++
+		default:
+			lines = append(lines, addedLinePrefix+b[j])
+			j++
+		}
+	}
+
+	for ; i < len(a); i++ {
+		lines = append(lines, removedLinePrefix+a[i])
+	}
+
+	for ; j < len(b); j++ {
+		lines = append(lines, addedLinePrefix+b[j])
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func validateTemplateSize(template entities.Template) error {
+	for _, part := range []string{template.Subject, template.HTML, template.Text} {
+		if strings.Count(part, "\n") >= templateMaxLines {
+			return &customerrors.InvalidTemplateError{
+				Message: fmt.Sprintf("template should not contain more than %d lines", templateMaxLines),
+			}
+		}
+	}
+
+	return nil
+}
+
+// splitLines returns no lines for empty text, so empty subject or plain text is not shown as a removed empty line.
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+
+	return strings.Split(text, "\n")
+}
@@ -0,0 +1,378 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-notifications/internal/errors"
+	"github.com/DKhorkov/hmtm-notifications/internal/interfaces"
+	mockcontentbuilders "github.com/DKhorkov/hmtm-notifications/mocks/contentbuilders"
+	mockservices "github.com/DKhorkov/hmtm-notifications/mocks/services"
+)
+
+func TestUseCases_CreateTemplate(t *testing.T) {
+	template := entities.Template{
+		NotificationType: entities.NotificationTypeVerifyEmail,
+		Locale:           "en",
+		HTML:             "{{.Link}}",
+	}
+
+	testCases := []struct {
+		name       string
+		setupMocks func(
+			templatesService *mockservices.MockTemplatesService,
+			contentTemplates *mockcontentbuilders.MockContentTemplates,
+		)
+		errorExpected bool
+	}{
+		{
+			name: "success",
+			setupMocks: func(
+				templatesService *mockservices.MockTemplatesService,
+				contentTemplates *mockcontentbuilders.MockContentTemplates,
+			) {
+				contentTemplates.
+					EXPECT().
+					Validate(template).
+					Return(nil).
+					Times(1)
+
+				templatesService.
+					EXPECT().
+					SaveTemplate(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, saved entities.Template) (uint64, error) {
+						require.Equal(t, entities.TemplateStateDraft, saved.State)
+						require.False(t, saved.CreatedAt.IsZero())
+
+						return 1, nil
+					}).
+					Times(1)
+
+				templatesService.
+					EXPECT().
+					GetTemplateByID(gomock.Any(), uint64(1)).
+					Return(&entities.Template{ID: 1, Version: 1}, nil).
+					Times(1)
+			},
+		},
+		{
+			name: "invalid template",
+			setupMocks: func(
+				_ *mockservices.MockTemplatesService,
+				contentTemplates *mockcontentbuilders.MockContentTemplates,
+			) {
+				contentTemplates.
+					EXPECT().
+					Validate(template).
+					Return(&customerrors.InvalidTemplateError{}).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			templatesService := mockservices.NewMockTemplatesService(ctrl)
+			contentTemplates := mockcontentbuilders.NewMockContentTemplates(ctrl)
+			useCases := newTestUseCases(testUseCasesDeps{
+				templatesService: templatesService,
+				contentBuilders:  interfaces.ContentBuilders{Templates: contentTemplates},
+			})
+
+			tc.setupMocks(templatesService, contentTemplates)
+
+			created, err := useCases.CreateTemplate(context.Background(), template)
+			if tc.errorExpected {
+				require.Error(t, err)
+				require.Nil(t, created)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, uint32(1), created.Version)
+		})
+	}
+}
+
+func TestUseCases_UpdateTemplate(t *testing.T) {
+	testCases := []struct {
+		name       string
+		setupMocks func(
+			templatesService *mockservices.MockTemplatesService,
+			contentTemplates *mockcontentbuilders.MockContentTemplates,
+		)
+		expectedErr error
+	}{
+		{
+			name: "success",
+			setupMocks: func(
+				templatesService *mockservices.MockTemplatesService,
+				contentTemplates *mockcontentbuilders.MockContentTemplates,
+			) {
+				gomock.InOrder(
+					templatesService.
+						EXPECT().
+						GetTemplateByID(gomock.Any(), uint64(1)).
+						Return(&entities.Template{ID: 1, State: entities.TemplateStateDraft}, nil),
+					contentTemplates.
+						EXPECT().
+						Validate(gomock.Any()).
+						Return(nil),
+					templatesService.
+						EXPECT().
+						UpdateTemplate(gomock.Any(), gomock.Any()).
+						DoAndReturn(func(_ context.Context, template entities.Template) (bool, error) {
+							require.Equal(t, "html", template.HTML)
+
+							return true, nil
+						}),
+					templatesService.
+						EXPECT().
+						GetTemplateByID(gomock.Any(), uint64(1)).
+						Return(&entities.Template{ID: 1, HTML: "html"}, nil),
+				)
+			},
+		},
+		{
+			name: "published template",
+			setupMocks: func(
+				templatesService *mockservices.MockTemplatesService,
+				_ *mockcontentbuilders.MockContentTemplates,
+			) {
+				templatesService.
+					EXPECT().
+					GetTemplateByID(gomock.Any(), uint64(1)).
+					Return(&entities.Template{ID: 1, State: entities.TemplateStatePublished}, nil).
+					Times(1)
+			},
+			expectedErr: &customerrors.TemplateNotDraftError{},
+		},
+		{
+			name: "published during update",
+			setupMocks: func(
+				templatesService *mockservices.MockTemplatesService,
+				contentTemplates *mockcontentbuilders.MockContentTemplates,
+			) {
+				templatesService.
+					EXPECT().
+					GetTemplateByID(gomock.Any(), uint64(1)).
+					Return(&entities.Template{ID: 1, State: entities.TemplateStateDraft}, nil).
+					Times(1)
+
+				contentTemplates.
+					EXPECT().
+					Validate(gomock.Any()).
+					Return(nil).
+					Times(1)
+
+				templatesService.
+					EXPECT().
+					UpdateTemplate(gomock.Any(), gomock.Any()).
+					Return(false, nil).
+					Times(1)
+			},
+			expectedErr: &customerrors.TemplateNotDraftError{},
+		},
+		{
+			name: "not found",
+			setupMocks: func(
+				templatesService *mockservices.MockTemplatesService,
+				_ *mockcontentbuilders.MockContentTemplates,
+			) {
+				templatesService.
+					EXPECT().
+					GetTemplateByID(gomock.Any(), uint64(1)).
+					Return(nil, &customerrors.TemplateNotFoundError{}).
+					Times(1)
+			},
+			expectedErr: &customerrors.TemplateNotFoundError{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			templatesService := mockservices.NewMockTemplatesService(ctrl)
+			contentTemplates := mockcontentbuilders.NewMockContentTemplates(ctrl)
+			useCases := newTestUseCases(testUseCasesDeps{
+				templatesService: templatesService,
+				contentBuilders:  interfaces.ContentBuilders{Templates: contentTemplates},
+			})
+
+			tc.setupMocks(templatesService, contentTemplates)
+
+			updated, err := useCases.UpdateTemplate(context.Background(), 1, "", "html", "")
+			if tc.expectedErr != nil {
+				require.IsType(t, tc.expectedErr, err)
+				require.Nil(t, updated)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, "html", updated.HTML)
+		})
+	}
+}
+
+func TestUseCases_PublishTemplate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	templatesService := mockservices.NewMockTemplatesService(ctrl)
+	contentTemplates := mockcontentbuilders.NewMockContentTemplates(ctrl)
+	useCases := newTestUseCases(testUseCasesDeps{
+		templatesService: templatesService,
+		contentBuilders:  interfaces.ContentBuilders{Templates: contentTemplates},
+	})
+
+	draft := &entities.Template{ID: 1, State: entities.TemplateStateDraft}
+	published := []entities.Template{{ID: 1, State: entities.TemplateStatePublished}}
+
+	gomock.InOrder(
+		templatesService.
+			EXPECT().
+			GetTemplateByID(gomock.Any(), uint64(1)).
+			Return(draft, nil),
+		contentTemplates.
+			EXPECT().
+			Validate(*draft).
+			Return(nil),
+		templatesService.
+			EXPECT().
+			PublishTemplate(gomock.Any(), uint64(1), gomock.Any()).
+			Return(nil),
+		templatesService.
+			EXPECT().
+			GetPublishedTemplates(gomock.Any()).
+			Return(published, nil),
+		contentTemplates.
+			EXPECT().
+			SetPublished(published).
+			Return(nil),
+		templatesService.
+			EXPECT().
+			GetTemplateByID(gomock.Any(), uint64(1)).
+			Return(&published[0], nil),
+	)
+
+	template, err := useCases.PublishTemplate(context.Background(), 1)
+	require.NoError(t, err)
+	require.Equal(t, entities.TemplateStatePublished, template.State)
+}
+
+func TestUseCases_PublishTemplateError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	templatesService := mockservices.NewMockTemplatesService(ctrl)
+	contentTemplates := mockcontentbuilders.NewMockContentTemplates(ctrl)
+	useCases := newTestUseCases(testUseCasesDeps{
+		templatesService: templatesService,
+		contentBuilders:  interfaces.ContentBuilders{Templates: contentTemplates},
+	})
+
+	templatesService.
+		EXPECT().
+		GetTemplateByID(gomock.Any(), uint64(1)).
+		Return(&entities.Template{ID: 1}, nil).
+		Times(1)
+
+	contentTemplates.
+		EXPECT().
+		Validate(gomock.Any()).
+		Return(nil).
+		Times(1)
+
+	templatesService.
+		EXPECT().
+		PublishTemplate(gomock.Any(), uint64(1), gomock.Any()).
+		Return(errors.New("db error")).
+		Times(1)
+
+	template, err := useCases.PublishTemplate(context.Background(), 1)
+	require.Error(t, err)
+	require.Nil(t, template)
+}
+
+func TestUseCases_DiffTemplates(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	templatesService := mockservices.NewMockTemplatesService(ctrl)
+	useCases := newTestUseCases(testUseCasesDeps{templatesService: templatesService})
+
+	templatesService.
+		EXPECT().
+		GetTemplateByID(gomock.Any(), uint64(1)).
+		Return(&entities.Template{ID: 1, Subject: "Subject", HTML: "a\nb\nc"}, nil).
+		Times(1)
+
+	templatesService.
+		EXPECT().
+		GetTemplateByID(gomock.Any(), uint64(2)).
+		Return(&entities.Template{ID: 2, Subject: "Subject", HTML: "a\nc\nd", Text: "text"}, nil).
+		Times(1)
+
+	diff, err := useCases.DiffTemplates(context.Background(), 1, 2)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), diff.From.ID)
+	require.Equal(t, uint64(2), diff.To.ID)
+	require.Equal(t, " Subject", diff.Subject)
+	require.Equal(t, " a\n-b\n c\n+d", diff.HTML)
+	require.Equal(t, "+text", diff.Text)
+}
+
+func TestDiffLines(t *testing.T) {
+	testCases := []struct {
+		name     string
+		from     string
+		to       string
+		expected string
+	}{
+		{name: "both empty", expected: ""},
+		{name: "equal", from: "a\nb", to: "a\nb", expected: " a\n b"},
+		{name: "replaced line", from: "a\nb", to: "a\nc", expected: " a\n-b\n+c"},
+		{name: "added lines", from: "b", to: "a\nb\nc", expected: "+a\n b\n+c"},
+		{name: "removed text", from: "a", to: "", expected: "-a"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, diffLines(tc.from, tc.to))
+		})
+	}
+}
+
+func TestUseCases_TemplateMaxLines(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	templatesService := mockservices.NewMockTemplatesService(ctrl)
+	contentTemplates := mockcontentbuilders.NewMockContentTemplates(ctrl)
+	useCases := newTestUseCases(testUseCasesDeps{
+		templatesService: templatesService,
+		contentBuilders:  interfaces.ContentBuilders{Templates: contentTemplates},
+	})
+
+	html := strings.Repeat("<p>line</p>\n", templateMaxLines) + "<p>line</p>"
+
+	// Too large template is rejected before validation, so validation mock is not called:
+	created, err := useCases.CreateTemplate(
+		context.Background(),
+		entities.Template{NotificationType: entities.NotificationTypeVerifyEmail, Locale: "en", HTML: html},
+	)
+	require.IsType(t, &customerrors.InvalidTemplateError{}, err)
+	require.Nil(t, created)
+
+	templatesService.
+		EXPECT().
+		GetTemplateByID(gomock.Any(), uint64(1)).
+		Return(&entities.Template{ID: 1, State: entities.TemplateStateDraft}, nil).
+		Times(1)
+
+	updated, err := useCases.UpdateTemplate(context.Background(), 1, "", html, "")
+	require.IsType(t, &customerrors.InvalidTemplateError{}, err)
+	require.Nil(t, updated)
+}
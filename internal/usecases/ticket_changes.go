@@ -0,0 +1,101 @@
+package usecases
+
+import (
+	"context"
+	"slices"
+	"strconv"
+
+	"github.com/DKhorkov/hmtm-notifications/dto"
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+)
+
+// ticketChanges returns states of ticket before and after update or nil, if publisher did not send previous state
+// of ticket. Names of category and tags are requested only if they were changed.
+func (useCases *UseCases) ticketChanges(
+	ctx context.Context,
+	ticket entities.RawTicket,
+	previous *dto.TicketSnapshotDTO,
+) (*entities.TicketChanges, error) {
+	if previous == nil {
+		return nil, nil
+	}
+
+	attachments := make([]string, 0, len(ticket.Attachments))
+	for _, attachment := range ticket.Attachments {
+		attachments = append(attachments, attachment.Link)
+	}
+
+	changes := &entities.TicketChanges{
+		Before: entities.TicketSnapshot{
+			Name:        previous.Name,
+			Description: previous.Description,
+			Price:       previous.Price,
+			Quantity:    previous.Quantity,
+			Attachments: previous.Attachments,
+		},
+		After: entities.TicketSnapshot{
+			Name:        ticket.Name,
+			Description: ticket.Description,
+			Price:       ticket.Price,
+			Quantity:    ticket.Quantity,
+			Attachments: attachments,
+		},
+	}
+
+	if previous.CategoryID != ticket.CategoryID {
+		changes.Before.Category = useCases.categoryName(ctx, previous.CategoryID)
+		changes.After.Category = useCases.categoryName(ctx, ticket.CategoryID)
+	}
+
+	if !sameTagIDs(previous.TagIDs, ticket.TagIDs) {
+		tags, err := useCases.toysService.GetAllTags(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		names := make(map[uint32]string, len(tags))
+		for _, tag := range tags {
+			names[tag.ID] = tag.Name
+		}
+
+		changes.Before.Tags = tagNames(previous.TagIDs, names)
+		changes.After.Tags = tagNames(ticket.TagIDs, names)
+	}
+
+	return changes, nil
+}
+
+// categoryName shows ID of category, which could not be received (for example, was deleted after ticket update),
+// so that notification is still sent.
+func (useCases *UseCases) categoryName(ctx context.Context, id uint32) string {
+	category, err := useCases.toysService.GetCategoryByID(ctx, id)
+	if err != nil {
+		return strconv.FormatUint(uint64(id), 10)
+	}
+
+	return category.Name
+}
+
+// sameTagIDs ignores order of tags.
+func sameTagIDs(a, b []uint32) bool {
+	a, b = slices.Clone(a), slices.Clone(b)
+	slices.Sort(a)
+	slices.Sort(b)
+
+	return slices.Equal(slices.Compact(a), slices.Compact(b))
+}
+
+// tagNames shows ID of tag, which was deleted after ticket update.
+func tagNames(ids []uint32, names map[uint32]string) []string {
+	result := make([]string, 0, len(ids))
+	for _, id := range ids {
+		name, ok := names[id]
+		if !ok {
+			name = strconv.FormatUint(uint64(id), 10)
+		}
+
+		result = append(result, name)
+	}
+
+	return result
+}
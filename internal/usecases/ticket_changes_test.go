@@ -0,0 +1,174 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/DKhorkov/libs/pointers"
+
+	"github.com/DKhorkov/hmtm-notifications/dto"
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+	mockservices "github.com/DKhorkov/hmtm-notifications/mocks/services"
+)
+
+func TestUseCases_ticketChanges(t *testing.T) {
+	ticket := entities.RawTicket{
+		ID:          1,
+		CategoryID:  2,
+		Name:        "Teddy Bear",
+		Description: "Description",
+		Price:       pointers.New[float32](200),
+		Quantity:    2,
+		TagIDs:      []uint32{1, 2},
+		Attachments: []entities.TicketAttachment{{Link: "https://example.com/1.png"}},
+	}
+
+	testCases := []struct {
+		name          string
+		previous      *dto.TicketSnapshotDTO
+		setupMocks    func(toysService *mockservices.MockToysService)
+		expected      *entities.TicketChanges
+		errorExpected bool
+	}{
+		{
+			name: "old publisher",
+		},
+		{
+			name: "category and tags are not changed",
+			previous: &dto.TicketSnapshotDTO{
+				Name:       "Teddy",
+				Quantity:   1,
+				CategoryID: 2,
+				TagIDs:     []uint32{2, 1},
+			},
+			expected: &entities.TicketChanges{
+				Before: entities.TicketSnapshot{Name: "Teddy", Quantity: 1},
+				After: entities.TicketSnapshot{
+					Name:        "Teddy Bear",
+					Description: "Description",
+					Price:       pointers.New[float32](200),
+					Quantity:    2,
+					Attachments: []string{"https://example.com/1.png"},
+				},
+			},
+		},
+		{
+			name: "category and tags are changed",
+			previous: &dto.TicketSnapshotDTO{
+				Name:        "Teddy Bear",
+				Description: "Description",
+				Price:       pointers.New[float32](200),
+				Quantity:    2,
+				CategoryID:  1,
+				TagIDs:      []uint32{3},
+			},
+			setupMocks: func(toysService *mockservices.MockToysService) {
+				toysService.
+					EXPECT().
+					GetCategoryByID(gomock.Any(), uint32(1)).
+					Return(&entities.Category{ID: 1, Name: "Plush"}, nil).
+					Times(1)
+
+				toysService.
+					EXPECT().
+					GetCategoryByID(gomock.Any(), uint32(2)).
+					Return(&entities.Category{ID: 2, Name: "Bears"}, nil).
+					Times(1)
+
+				// Tag 3 was deleted after ticket update:
+				toysService.
+					EXPECT().
+					GetAllTags(gomock.Any()).
+					Return([]entities.Tag{{ID: 1, Name: "soft"}, {ID: 2, Name: "brown"}}, nil).
+					Times(1)
+			},
+			expected: &entities.TicketChanges{
+				Before: entities.TicketSnapshot{
+					Name:        "Teddy Bear",
+					Description: "Description",
+					Price:       pointers.New[float32](200),
+					Quantity:    2,
+					Category:    "Plush",
+					Tags:        []string{"3"},
+				},
+				After: entities.TicketSnapshot{
+					Name:        "Teddy Bear",
+					Description: "Description",
+					Price:       pointers.New[float32](200),
+					Quantity:    2,
+					Category:    "Bears",
+					Tags:        []string{"soft", "brown"},
+					Attachments: []string{"https://example.com/1.png"},
+				},
+			},
+		},
+		{
+			name:     "category error",
+			previous: &dto.TicketSnapshotDTO{CategoryID: 1, TagIDs: []uint32{1, 2}},
+			setupMocks: func(toysService *mockservices.MockToysService) {
+				// Category 1 was deleted after ticket update:
+				toysService.
+					EXPECT().
+					GetCategoryByID(gomock.Any(), uint32(1)).
+					Return(nil, errors.New("category not found")).
+					Times(1)
+
+				toysService.
+					EXPECT().
+					GetCategoryByID(gomock.Any(), uint32(2)).
+					Return(&entities.Category{ID: 2, Name: "Bears"}, nil).
+					Times(1)
+			},
+			expected: &entities.TicketChanges{
+				Before: entities.TicketSnapshot{Category: "1"},
+				After: entities.TicketSnapshot{
+					Name:        "Teddy Bear",
+					Description: "Description",
+					Price:       pointers.New[float32](200),
+					Quantity:    2,
+					Category:    "Bears",
+					Attachments: []string{"https://example.com/1.png"},
+				},
+			},
+		},
+		{
+			name:     "tags error",
+			previous: &dto.TicketSnapshotDTO{CategoryID: 2},
+			setupMocks: func(toysService *mockservices.MockToysService) {
+				toysService.
+					EXPECT().
+					GetAllTags(gomock.Any()).
+					Return(nil, errors.New("toys service is unavailable")).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			toysService := mockservices.NewMockToysService(ctrl)
+			useCases := newTestUseCases(testUseCasesDeps{toysService: toysService})
+
+			if tc.setupMocks != nil {
+				tc.setupMocks(toysService)
+			}
+
+			changes, err := useCases.ticketChanges(context.Background(), ticket, tc.previous)
+			if tc.errorExpected {
+				require.Error(t, err)
+				require.Nil(t, changes)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, changes)
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package usecases
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-notifications/internal/errors"
+)
+
+// Size of token nonce in bytes before hex encoding:
+const tokenNonceSize = 16
+
+// VerifyToken checks signature, purpose and expiry of token and that it was issued by this service and was not
+// consumed yet. Token stays valid, so it could be checked before showing form and consumed after form submission.
+func (useCases *UseCases) VerifyToken(
+	ctx context.Context,
+	rawToken string,
+	purpose entities.TokenPurpose,
+) (*entities.Token, error) {
+	claims, err := useCases.tokenSigner.Parse(rawToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.Purpose != purpose {
+		return nil, &customerrors.InvalidTokenError{
+			Message: fmt.Sprintf("token was issued for %q, not for %q", claims.Purpose, purpose),
+		}
+	}
+
+	// Signature is already verified, so expired token is rejected without querying database:
+	if !claims.ExpiresAt.After(time.Now()) {
+		return nil, &customerrors.TokenExpiredError{}
+	}
+
+	token, err := useCases.tokensService.GetTokenByNonce(ctx, claims.Nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	if token.UserID != claims.UserID || token.Purpose != claims.Purpose {
+		return nil, &customerrors.InvalidTokenError{Message: "token claims do not match issued token"}
+	}
+
+	if token.ConsumedAt != nil {
+		return nil, &customerrors.TokenConsumedError{}
+	}
+
+	if !token.ExpiresAt.After(time.Now()) {
+		return nil, &customerrors.TokenExpiredError{}
+	}
+
+	return token, nil
+}
+
+// ConsumeToken verifies token and invalidates it, so that action, granted by token, is performed only once.
+// Only one of concurrent calls with the same token succeeds, while others get TokenConsumedError.
+func (useCases *UseCases) ConsumeToken(
+	ctx context.Context,
+	rawToken string,
+	purpose entities.TokenPurpose,
+) (*entities.Token, error) {
+	token, err := useCases.VerifyToken(ctx, rawToken, purpose)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+
+	consumed, err := useCases.tokensService.ConsumeToken(ctx, token.Nonce, now)
+	if err != nil {
+		return nil, err
+	}
+
+	if !consumed {
+		return nil, &customerrors.TokenConsumedError{}
+	}
+
+	token.ConsumedAt = &now
+
+	return token, nil
+}
+
+// issueToken saves single-use token of provided purpose and returns it signed for sending to user.
+func (useCases *UseCases) issueToken(
+	ctx context.Context,
+	userID uint64,
+	purpose entities.TokenPurpose,
+) (string, error) {
+	ttl, ok := useCases.config.TokensTTL[purpose]
+	if !ok || ttl <= 0 {
+		return "", fmt.Errorf("lifetime of %q tokens is not configured", purpose)
+	}
+
+	nonce, err := generateTokenNonce()
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now().UTC()
+	token := entities.Token{
+		Nonce:   nonce,
+		UserID:  userID,
+		Purpose: purpose,
+		// Signed expiry has seconds precision, so stored one is truncated to be the same:
+		ExpiresAt: now.Add(ttl).Truncate(time.Second),
+		CreatedAt: now,
+	}
+
+	signedToken, err := useCases.tokenSigner.Sign(
+		entities.TokenClaims{
+			UserID:    token.UserID,
+			Purpose:   token.Purpose,
+			Nonce:     token.Nonce,
+			ExpiresAt: token.ExpiresAt,
+		},
+	)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err = useCases.tokensService.SaveToken(ctx, token); err != nil {
+		return "", err
+	}
+
+	return signedToken, nil
+}
+
+func generateTokenNonce() (string, error) {
+	nonce := make([]byte, tokenNonceSize)
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(nonce), nil
+}
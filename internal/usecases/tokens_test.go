@@ -0,0 +1,277 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/DKhorkov/libs/pointers"
+
+	"github.com/DKhorkov/hmtm-notifications/internal/config"
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-notifications/internal/errors"
+	mockservices "github.com/DKhorkov/hmtm-notifications/mocks/services"
+	mocksigners "github.com/DKhorkov/hmtm-notifications/mocks/signers"
+)
+
+func TestUseCases_VerifyToken(t *testing.T) {
+	validClaims := entities.TokenClaims{
+		UserID:    1,
+		Purpose:   entities.TokenPurposeForgetPassword,
+		Nonce:     "nonce",
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+
+	issuedToken := entities.Token{
+		ID:        1,
+		Nonce:     validClaims.Nonce,
+		UserID:    validClaims.UserID,
+		Purpose:   validClaims.Purpose,
+		ExpiresAt: validClaims.ExpiresAt,
+	}
+
+	testCases := []struct {
+		name        string
+		purpose     entities.TokenPurpose
+		claims      *entities.TokenClaims
+		parseErr    error
+		stored      *entities.Token
+		getErr      error
+		expectedErr any
+	}{
+		{
+			name:    "success",
+			purpose: entities.TokenPurposeForgetPassword,
+			claims:  &validClaims,
+			stored:  &issuedToken,
+		},
+		{
+			name:        "invalid signature",
+			purpose:     entities.TokenPurposeForgetPassword,
+			parseErr:    &customerrors.InvalidTokenError{},
+			expectedErr: new(*customerrors.InvalidTokenError),
+		},
+		{
+			name:        "another purpose",
+			purpose:     entities.TokenPurposeVerifyEmail,
+			claims:      &validClaims,
+			expectedErr: new(*customerrors.InvalidTokenError),
+		},
+		{
+			name:    "signed expiry has passed",
+			purpose: entities.TokenPurposeForgetPassword,
+			claims: &entities.TokenClaims{
+				UserID:    validClaims.UserID,
+				Purpose:   validClaims.Purpose,
+				Nonce:     validClaims.Nonce,
+				ExpiresAt: time.Now().Add(-time.Minute),
+			},
+			expectedErr: new(*customerrors.TokenExpiredError),
+		},
+		{
+			name:        "not issued",
+			purpose:     entities.TokenPurposeForgetPassword,
+			claims:      &validClaims,
+			getErr:      &customerrors.TokenNotFoundError{},
+			expectedErr: new(*customerrors.TokenNotFoundError),
+		},
+		{
+			name:    "issued for another user",
+			purpose: entities.TokenPurposeForgetPassword,
+			claims:  &validClaims,
+			stored: &entities.Token{
+				Nonce:     issuedToken.Nonce,
+				UserID:    2,
+				Purpose:   issuedToken.Purpose,
+				ExpiresAt: issuedToken.ExpiresAt,
+			},
+			expectedErr: new(*customerrors.InvalidTokenError),
+		},
+		{
+			name:    "consumed",
+			purpose: entities.TokenPurposeForgetPassword,
+			claims:  &validClaims,
+			stored: &entities.Token{
+				Nonce:      issuedToken.Nonce,
+				UserID:     issuedToken.UserID,
+				Purpose:    issuedToken.Purpose,
+				ExpiresAt:  issuedToken.ExpiresAt,
+				ConsumedAt: pointers.New(time.Now()),
+			},
+			expectedErr: new(*customerrors.TokenConsumedError),
+		},
+		{
+			name:    "stored expiry has passed",
+			purpose: entities.TokenPurposeForgetPassword,
+			claims:  &validClaims,
+			stored: &entities.Token{
+				Nonce:     issuedToken.Nonce,
+				UserID:    issuedToken.UserID,
+				Purpose:   issuedToken.Purpose,
+				ExpiresAt: time.Now().Add(-time.Minute),
+			},
+			expectedErr: new(*customerrors.TokenExpiredError),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			tokensService := mockservices.NewMockTokensService(ctrl)
+			tokenSigner := mocksigners.NewMockTokenSigner(ctrl)
+			useCases := newTestUseCases(testUseCasesDeps{
+				tokensService: tokensService,
+				tokenSigner:   tokenSigner,
+				config:        config.UseCasesConfig{TokensTTL: testTokensTTL},
+			})
+
+			tokenSigner.EXPECT().Parse("raw").Return(tc.claims, tc.parseErr).Times(1)
+
+			if tc.stored != nil || tc.getErr != nil {
+				tokensService.
+					EXPECT().
+					GetTokenByNonce(gomock.Any(), validClaims.Nonce).
+					Return(tc.stored, tc.getErr).
+					Times(1)
+			}
+
+			token, err := useCases.VerifyToken(context.Background(), "raw", tc.purpose)
+			if tc.expectedErr != nil {
+				require.ErrorAs(t, err, tc.expectedErr)
+				require.Nil(t, token)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.stored, token)
+			}
+		})
+	}
+}
+
+func TestUseCases_ConsumeToken(t *testing.T) {
+	claims := entities.TokenClaims{
+		UserID:    1,
+		Purpose:   entities.TokenPurposeVerifyEmail,
+		Nonce:     "nonce",
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+
+	testCases := []struct {
+		name          string
+		consumed      bool
+		consumeErr    error
+		errorExpected bool
+		expectedErr   any
+	}{
+		{
+			name:     "success",
+			consumed: true,
+		},
+		{
+			name:          "consumed concurrently",
+			consumed:      false,
+			errorExpected: true,
+			expectedErr:   new(*customerrors.TokenConsumedError),
+		},
+		{
+			name:          "consume error",
+			consumeErr:    errors.New("error"),
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			tokensService := mockservices.NewMockTokensService(ctrl)
+			tokenSigner := mocksigners.NewMockTokenSigner(ctrl)
+			useCases := newTestUseCases(testUseCasesDeps{
+				tokensService: tokensService,
+				tokenSigner:   tokenSigner,
+				config:        config.UseCasesConfig{TokensTTL: testTokensTTL},
+			})
+
+			tokenSigner.EXPECT().Parse("raw").Return(&claims, nil).Times(1)
+			tokensService.
+				EXPECT().
+				GetTokenByNonce(gomock.Any(), claims.Nonce).
+				Return(
+					&entities.Token{
+						Nonce:     claims.Nonce,
+						UserID:    claims.UserID,
+						Purpose:   claims.Purpose,
+						ExpiresAt: claims.ExpiresAt,
+					},
+					nil,
+				).
+				Times(1)
+
+			tokensService.
+				EXPECT().
+				ConsumeToken(gomock.Any(), claims.Nonce, gomock.Any()).
+				Return(tc.consumed, tc.consumeErr).
+				Times(1)
+
+			token, err := useCases.ConsumeToken(context.Background(), "raw", entities.TokenPurposeVerifyEmail)
+			if tc.errorExpected {
+				require.Error(t, err)
+				require.Nil(t, token)
+
+				if tc.expectedErr != nil {
+					require.ErrorAs(t, err, tc.expectedErr)
+				}
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, token.ConsumedAt)
+			}
+		})
+	}
+}
+
+func TestUseCases_issueToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	tokensService := mockservices.NewMockTokensService(ctrl)
+	tokenSigner := mocksigners.NewMockTokenSigner(ctrl)
+	useCases := newTestUseCases(testUseCasesDeps{
+		tokensService: tokensService,
+		tokenSigner:   tokenSigner,
+		config:        config.UseCasesConfig{TokensTTL: testTokensTTL},
+	})
+
+	var signed entities.TokenClaims
+	tokenSigner.
+		EXPECT().
+		Sign(gomock.Any()).
+		DoAndReturn(func(claims entities.TokenClaims) (string, error) {
+			signed = claims
+
+			return "signed", nil
+		}).
+		Times(1)
+
+	tokensService.
+		EXPECT().
+		SaveToken(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, token entities.Token) (uint64, error) {
+			require.Equal(t, signed.Nonce, token.Nonce)
+			require.Len(t, token.Nonce, tokenNonceSize*2)
+			require.Equal(t, uint64(1), token.UserID)
+			require.Equal(t, entities.TokenPurposeVerifyEmail, token.Purpose)
+			require.True(t, signed.ExpiresAt.Equal(token.ExpiresAt))
+			require.WithinDuration(t, time.Now().Add(time.Hour), token.ExpiresAt, time.Minute)
+
+			return 1, nil
+		}).
+		Times(1)
+
+	token, err := useCases.issueToken(context.Background(), 1, entities.TokenPurposeVerifyEmail)
+	require.NoError(t, err)
+	require.Equal(t, "signed", token)
+
+	// Token of purpose without configured lifetime is never issued:
+	_, err = useCases.issueToken(context.Background(), 1, "unknown")
+	require.Error(t, err)
+}
@@ -2,214 +2,530 @@ package usecases
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/DKhorkov/hmtm-notifications/dto"
+	"github.com/DKhorkov/hmtm-notifications/internal/config"
 	"github.com/DKhorkov/hmtm-notifications/internal/entities"
 	"github.com/DKhorkov/hmtm-notifications/internal/interfaces"
 )
 
 func New(
-	emailsService interfaces.EmailsService,
+	communicationsService interfaces.CommunicationsService,
+	processedMessagesService interfaces.ProcessedMessagesService,
+	notificationsService interfaces.NotificationsService,
+	notificationsBroadcaster interfaces.NotificationsBroadcaster,
+	webhooksService interfaces.WebhooksService,
+	tokensService interfaces.TokensService,
+	tokenSigner interfaces.TokenSigner,
+	templatesService interfaces.TemplatesService,
 	ssoService interfaces.SsoService,
 	toysService interfaces.ToysService,
 	ticketsService interfaces.TicketsService,
 	contentBuilders interfaces.ContentBuilders,
-	senders interfaces.Senders,
+	useCasesConfig config.UseCasesConfig,
 ) *UseCases {
 	return &UseCases{
-		emailsService:   emailsService,
-		ssoService:      ssoService,
-		toysService:     toysService,
-		ticketsService:  ticketsService,
-		contentBuilders: contentBuilders,
-		senders:         senders,
+		communicationsService:    communicationsService,
+		processedMessagesService: processedMessagesService,
+		notificationsService:     notificationsService,
+		notificationsBroadcaster: notificationsBroadcaster,
+		webhooksService:          webhooksService,
+		tokensService:            tokensService,
+		tokenSigner:              tokenSigner,
+		templatesService:         templatesService,
+		ssoService:               ssoService,
+		toysService:              toysService,
+		ticketsService:           ticketsService,
+		contentBuilders:          contentBuilders,
+		config:                   useCasesConfig,
 	}
 }
 
 type UseCases struct {
-	emailsService   interfaces.EmailsService
-	ssoService      interfaces.SsoService
-	toysService     interfaces.ToysService
-	ticketsService  interfaces.TicketsService
-	contentBuilders interfaces.ContentBuilders
-	senders         interfaces.Senders
+	communicationsService    interfaces.CommunicationsService
+	processedMessagesService interfaces.ProcessedMessagesService
+	notificationsService     interfaces.NotificationsService
+	notificationsBroadcaster interfaces.NotificationsBroadcaster
+	webhooksService          interfaces.WebhooksService
+	tokensService            interfaces.TokensService
+	tokenSigner              interfaces.TokenSigner
+	templatesService         interfaces.TemplatesService
+	ssoService               interfaces.SsoService
+	toysService              interfaces.ToysService
+	ticketsService           interfaces.TicketsService
+	contentBuilders          interfaces.ContentBuilders
+	config                   config.UseCasesConfig
 }
 
-func (useCases *UseCases) GetUserEmailCommunications(
+// GetUserCommunications returns history of communications, sent to user through provided channel.
+func (useCases *UseCases) GetUserCommunications(
 	ctx context.Context,
 	userID uint64,
+	channel entities.CommunicationChannel,
 	pagination *entities.Pagination,
-) ([]entities.Email, error) {
-	return useCases.emailsService.GetUserCommunications(ctx, userID, pagination)
+) ([]entities.Communication, error) {
+	return useCases.communicationsService.GetUserCommunications(ctx, userID, channel, pagination)
 }
 
-func (useCases *UseCases) CountUserEmailCommunications(
+func (useCases *UseCases) CountUserCommunications(
 	ctx context.Context,
 	userID uint64,
+	channel entities.CommunicationChannel,
 ) (uint64, error) {
-	return useCases.emailsService.CountUserCommunications(ctx, userID)
+	return useCases.communicationsService.CountUserCommunications(ctx, userID, channel)
 }
 
 func (useCases *UseCases) SendVerifyEmailCommunication(
 	ctx context.Context,
-	userID uint64,
+	verifyEmailData dto.VerifyEmailDTO,
 ) (uint64, error) {
-	user, err := useCases.ssoService.GetUserByID(ctx, userID)
+	emailIDs, _, err := useCases.processOnce(
+		ctx,
+		verifyEmailData.IdempotencyKey,
+		func() ([]uint64, error) {
+			user, err := useCases.ssoService.GetUserByID(ctx, verifyEmailData.UserID)
+			if err != nil {
+				return nil, err
+			}
+
+			setRequestLocale(user, verifyEmailData.Locale)
+
+			token, err := useCases.issueToken(ctx, user.ID, entities.TokenPurposeVerifyEmail)
+			if err != nil {
+				return nil, err
+			}
+
+			emailID, err := useCases.sendCommunication(
+				ctx,
+				*user,
+				communication{
+					subject: useCases.contentBuilders.VerifyEmail.Subject(*user),
+					body:    useCases.contentBuilders.VerifyEmail.Body(*user, token),
+					text:    useCases.verifyEmailText(*user, token),
+					notification: entities.Notification{
+						Type:  entities.NotificationTypeVerifyEmail,
+						Title: useCases.contentBuilders.Inbox.VerifyEmail.Title(*user),
+						Text:  useCases.contentBuilders.Inbox.VerifyEmail.Text(*user),
+						Link:  useCases.contentBuilders.Inbox.VerifyEmail.Link(),
+					},
+				},
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			return []uint64{emailID}, nil
+		},
+	)
 	if err != nil {
 		return 0, err
 	}
 
-	if err = useCases.senders.Email.Send(
+	return firstEmailID(emailIDs), nil
+}
+
+func (useCases *UseCases) SendForgetPasswordEmailCommunication(
+	ctx context.Context,
+	forgetPasswordData dto.ForgetPasswordDTO,
+) (uint64, error) {
+	emailIDs, _, err := useCases.processOnce(
 		ctx,
-		useCases.contentBuilders.VerifyEmail.Subject(),
-		useCases.contentBuilders.VerifyEmail.Body(*user),
-		[]string{user.Email},
-	); err != nil {
+		forgetPasswordData.IdempotencyKey,
+		func() ([]uint64, error) {
+			user, err := useCases.ssoService.GetUserByID(ctx, forgetPasswordData.UserID)
+			if err != nil {
+				return nil, err
+			}
+
+			setRequestLocale(user, forgetPasswordData.Locale)
+
+			token, err := useCases.issueToken(ctx, user.ID, entities.TokenPurposeForgetPassword)
+			if err != nil {
+				return nil, err
+			}
+
+			emailID, err := useCases.sendCommunication(
+				ctx,
+				*user,
+				communication{
+					subject: useCases.contentBuilders.ForgetPassword.Subject(*user),
+					body:    useCases.contentBuilders.ForgetPassword.Body(*user, token),
+					text:    useCases.forgetPasswordText(*user, token),
+					sms: func() string {
+						return useCases.contentBuilders.SMS.ForgetPassword.Text(*user, token)
+					},
+					notification: entities.Notification{
+						Type:  entities.NotificationTypeForgetPassword,
+						Title: useCases.contentBuilders.Inbox.ForgetPassword.Title(*user),
+						Text:  useCases.contentBuilders.Inbox.ForgetPassword.Text(*user),
+						Link:  useCases.contentBuilders.Inbox.ForgetPassword.Link(),
+					},
+				},
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			return []uint64{emailID}, nil
+		},
+	)
+	if err != nil {
 		return 0, err
 	}
 
-	emailCommunication := entities.Email{
-		UserID:  user.ID,
-		Email:   user.Email,
-		Content: useCases.contentBuilders.VerifyEmail.Body(*user),
-		SentAt:  time.Now().UTC(),
-	}
-
-	return useCases.emailsService.SaveCommunication(ctx, emailCommunication)
+	return firstEmailID(emailIDs), nil
 }
 
-func (useCases *UseCases) SendForgetPasswordEmailCommunication(
+func (useCases *UseCases) SendTicketUpdatedEmailCommunication(
 	ctx context.Context,
-	userID uint64,
-) (uint64, error) {
-	user, err := useCases.ssoService.GetUserByID(ctx, userID)
+	ticketData dto.TicketUpdatedDTO,
+) (*entities.FanOutResult, error) {
+	rawTicket, err := useCases.ticketsService.GetTicketByID(ctx, ticketData.TicketID)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 
-	if err = useCases.senders.Email.Send(
-		ctx,
-		useCases.contentBuilders.ForgetPassword.Subject(),
-		useCases.contentBuilders.ForgetPassword.Body(*user),
-		[]string{user.Email},
-	); err != nil {
-		return 0, err
+	responds, err := useCases.ticketsService.GetTicketResponds(ctx, rawTicket.ID)
+	if err != nil {
+		return nil, err
 	}
 
-	emailCommunication := entities.Email{
-		UserID:  user.ID,
-		Email:   user.Email,
-		Content: useCases.contentBuilders.ForgetPassword.Body(*user),
-		SentAt:  time.Now().UTC(),
+	mastersIDs := make([]uint64, 0, len(responds))
+	for _, respond := range responds {
+		mastersIDs = append(mastersIDs, respond.MasterID)
 	}
 
-	return useCases.emailsService.SaveCommunication(ctx, emailCommunication)
+	changes, err := useCases.ticketChanges(ctx, *rawTicket, ticketData.Previous)
+	if err != nil {
+		return nil, err
+	}
+
+	return useCases.fanOutEmailCommunications(
+		ctx,
+		ticketData.IdempotencyKey,
+		mastersIDs,
+		func(respondOwner entities.User) communication {
+			body := useCases.contentBuilders.TicketUpdated.Body(*rawTicket, changes, respondOwner)
+
+			return communication{
+				subject: useCases.contentBuilders.TicketUpdated.Subject(*rawTicket, respondOwner),
+				body:    body,
+				text:    useCases.ticketUpdatedText(*rawTicket, changes, respondOwner),
+				// Telegram-safe formatting is rendered from email body by Telegram sender:
+				telegram: body,
+				sms: func() string {
+					return useCases.contentBuilders.SMS.TicketUpdated.Text(*rawTicket, respondOwner)
+				},
+				notification: entities.Notification{
+					Type:  entities.NotificationTypeTicketUpdated,
+					Title: useCases.contentBuilders.Inbox.TicketUpdated.Title(*rawTicket, respondOwner),
+					Text:  useCases.contentBuilders.Inbox.TicketUpdated.Text(*rawTicket, respondOwner),
+					Link:  useCases.contentBuilders.Inbox.TicketUpdated.Link(*rawTicket),
+				},
+			}
+		},
+	)
 }
 
-func (useCases *UseCases) SendTicketUpdatedEmailCommunication(
+func (useCases *UseCases) SendTicketDeletedEmailCommunication(
 	ctx context.Context,
-	ticketID uint64,
-) ([]uint64, error) {
-	rawTicket, err := useCases.ticketsService.GetTicketByID(ctx, ticketID)
+	ticketData dto.TicketDeletedDTO,
+) (*entities.FanOutResult, error) {
+	ticketOwner, err := useCases.ssoService.GetUserByID(ctx, ticketData.TicketOwnerID)
 	if err != nil {
 		return nil, err
 	}
 
-	responds, err := useCases.ticketsService.GetTicketResponds(ctx, rawTicket.ID)
+	return useCases.fanOutEmailCommunications(
+		ctx,
+		ticketData.IdempotencyKey,
+		ticketData.RespondedMastersIDs,
+		func(respondOwner entities.User) communication {
+			body := useCases.contentBuilders.TicketDeleted.Body(ticketData, *ticketOwner, respondOwner)
+
+			return communication{
+				subject: useCases.contentBuilders.TicketDeleted.Subject(ticketData, respondOwner),
+				body:    body,
+				text:    useCases.ticketDeletedText(ticketData, *ticketOwner, respondOwner),
+				// Telegram-safe formatting is rendered from email body by Telegram sender:
+				telegram: body,
+				sms: func() string {
+					return useCases.contentBuilders.SMS.TicketDeleted.Text(ticketData, respondOwner)
+				},
+				notification: entities.Notification{
+					Type:  entities.NotificationTypeTicketDeleted,
+					Title: useCases.contentBuilders.Inbox.TicketDeleted.Title(ticketData, respondOwner),
+					Text:  useCases.contentBuilders.Inbox.TicketDeleted.Text(ticketData, *ticketOwner, respondOwner),
+					Link:  useCases.contentBuilders.Inbox.TicketDeleted.Link(ticketData, *ticketOwner),
+				},
+			}
+		},
+	)
+}
+
+func (useCases *UseCases) GetUserNotifications(
+	ctx context.Context,
+	userID uint64,
+	pagination *entities.Pagination,
+) ([]entities.Notification, error) {
+	return useCases.notificationsService.GetUserNotifications(ctx, userID, pagination)
+}
+
+func (useCases *UseCases) CountUserUnreadNotifications(ctx context.Context, userID uint64) (uint64, error) {
+	return useCases.notificationsService.CountUserUnreadNotifications(ctx, userID)
+}
+
+func (useCases *UseCases) MarkNotificationAsRead(ctx context.Context, id, userID uint64) error {
+	return useCases.notificationsService.MarkNotificationAsRead(ctx, id, userID)
+}
+
+func (useCases *UseCases) MarkAllNotificationsAsRead(ctx context.Context, userID uint64) error {
+	return useCases.notificationsService.MarkAllNotificationsAsRead(ctx, userID)
+}
+
+func (useCases *UseCases) DeleteNotification(ctx context.Context, id, userID uint64) error {
+	return useCases.notificationsService.DeleteNotification(ctx, id, userID)
+}
+
+// SubscribeToUserNotifications returns channel with notifications of provided user, which are created after
+// subscription. If lastSeenID is provided, notifications, created after notification with such ID, are sent
+// first, but no more than StreamResumeLimit latest ones. Channel is closed, when context is done or subscription
+// is closed by broadcaster.
+func (useCases *UseCases) SubscribeToUserNotifications(
+	ctx context.Context,
+	userID uint64,
+	lastSeenID *uint64,
+) (<-chan entities.Notification, error) {
+	// Subscribing before reading missed notifications to not lose ones, created in between:
+	live, unsubscribe, err := useCases.notificationsBroadcaster.Subscribe(userID)
 	if err != nil {
 		return nil, err
 	}
 
-	var emailIDs []uint64
+	var (
+		missed []entities.Notification
+		// Live notifications, which were already seen by client or sent as missed ones, are skipped:
+		lastMissedID uint64
+	)
 
-	for _, respond := range responds {
-		master, err := useCases.toysService.GetMasterByID(ctx, respond.MasterID)
+	if lastSeenID != nil {
+		lastMissedID = *lastSeenID
+
+		missed, err = useCases.notificationsService.GetUserNotificationsAfterID(
+			ctx,
+			userID,
+			*lastSeenID,
+			uint64(useCases.config.StreamResumeLimit),
+		)
 		if err != nil {
+			unsubscribe()
+
 			return nil, err
 		}
+	}
 
-		respondOwner, err := useCases.ssoService.GetUserByID(ctx, master.UserID)
-		if err != nil {
-			return nil, err
+	notifications := make(chan entities.Notification)
+
+	go func() {
+		defer close(notifications)
+		defer unsubscribe()
+
+		for _, notification := range missed {
+			select {
+			case notifications <- notification:
+				lastMissedID = notification.ID
+			case <-ctx.Done():
+				return
+			}
 		}
 
-		if err = useCases.senders.Email.Send(
-			ctx,
-			useCases.contentBuilders.TicketUpdated.Subject(*rawTicket),
-			useCases.contentBuilders.TicketUpdated.Body(*rawTicket, *respondOwner),
-			[]string{respondOwner.Email},
-		); err != nil {
-			return nil, err
+		for {
+			select {
+			case notification, ok := <-live:
+				if !ok {
+					return
+				}
+
+				if notification.ID <= lastMissedID {
+					continue
+				}
+
+				select {
+				case notifications <- notification:
+				case <-ctx.Done():
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
 		}
+	}()
+
+	return notifications, nil
+}
+
+// communication contains content of email and in-app notification for single recipient. Telegram and SMS
+// contents are optional and are used only if routing policy of notification type includes their channels.
+// SMS content is built only for recipients, whose communication is routed through SMS, since it could be
+// not configured. Text is an optional plain text alternative of email body, which is generated from body on
+// sending, if it is empty.
+type communication struct {
+	subject      string
+	body         string
+	text         string
+	telegram     string
+	sms          func() string
+	notification entities.Notification
+}
+
+// sendCommunication creates in-app notification for recipient, enqueues its webhook deliveries and
+// communications of its routing chain. Notification is created first, because failed communication is retried
+// on message redelivery, and duplicated in-app notification is less annoying for user than duplicated email.
+// Returns ID of primary communication of routing chain.
+func (useCases *UseCases) sendCommunication(
+	ctx context.Context,
+	recipient entities.User,
+	content communication,
+) (uint64, error) {
+	notification := content.notification
+	notification.UserID = recipient.ID
+	notification.CreatedAt = time.Now().UTC()
+
+	notificationID, err := useCases.notificationsService.SaveNotification(ctx, notification)
+	if err != nil {
+		return 0, err
+	}
+
+	notification.ID = notificationID
+	useCases.notificationsBroadcaster.Publish(notification)
 
-		emailCommunication := entities.Email{
-			UserID:  respondOwner.ID,
-			Email:   respondOwner.Email,
-			Content: useCases.contentBuilders.TicketUpdated.Body(*rawTicket, *respondOwner),
-			SentAt:  time.Now().UTC(),
+	if useCases.config.WebhooksEnabled {
+		if err = useCases.enqueueWebhookDeliveries(ctx, notification); err != nil {
+			return 0, err
 		}
+	}
 
-		emailID, err := useCases.emailsService.SaveCommunication(ctx, emailCommunication)
-		if err != nil {
-			return nil, err
+	chain := useCases.route(recipient, content)
+
+	primaryID, err := useCases.enqueueCommunication(ctx, recipient, content, chain[0], nil)
+	if err != nil {
+		return 0, err
+	}
+
+	// Fallback communications wait, until dispatcher fails to send previous communication of chain:
+	for _, fallback := range chain[1:] {
+		if _, err = useCases.enqueueCommunication(ctx, recipient, content, fallback, &primaryID); err != nil {
+			return 0, err
 		}
+	}
+
+	return primaryID, nil
+}
+
+// enqueueCommunication saves communication to outbox. Sending is performed asynchronously by communications
+// dispatcher, so communication will be stored even if channel is not available right now. Communication with
+// fallbackFor is a fallback one and is stored in standby status.
+func (useCases *UseCases) enqueueCommunication(
+	ctx context.Context,
+	recipient entities.User,
+	content communication,
+	routed routedCommunication,
+	fallbackFor *uint64,
+) (uint64, error) {
+	now := time.Now().UTC()
+	outboxCommunication := entities.Communication{
+		UserID:        recipient.ID,
+		Recipient:     routed.address,
+		Body:          routed.content,
+		CreatedAt:     now,
+		Subject:       content.subject,
+		Status:        entities.CommunicationStatusPending,
+		NextAttemptAt: &now,
+		Channel:       routed.channel,
+		FallbackFor:   fallbackFor,
+		Type:          content.notification.Type,
+	}
 
-		emailIDs = append(emailIDs, emailID)
+	if routed.text != "" {
+		outboxCommunication.TextBody = &routed.text
 	}
 
-	return emailIDs, nil
+	if fallbackFor != nil {
+		outboxCommunication.Status = entities.CommunicationStatusStandby
+		outboxCommunication.NextAttemptAt = nil
+	}
+
+	return useCases.communicationsService.SaveCommunication(ctx, outboxCommunication)
 }
 
-func (useCases *UseCases) SendTicketDeletedEmailCommunication(
+// processOnce calls process only once for provided idempotency key and returns IDs of created emails.
+// For redelivered message IDs of emails, created during its first processing, are returned without processing
+// and duplicate flag is set. Messages without idempotency key are processed on every delivery.
+func (useCases *UseCases) processOnce(
 	ctx context.Context,
-	ticketData dto.TicketDeletedDTO,
-) ([]uint64, error) {
-	ticketOwner, err := useCases.ssoService.GetUserByID(ctx, ticketData.TicketOwnerID)
-	if err != nil {
-		return nil, err
+	idempotencyKey string,
+	process func() ([]uint64, error),
+) (emailIDs []uint64, duplicate bool, err error) {
+	if idempotencyKey == "" {
+		emailIDs, err = process()
+
+		return emailIDs, false, err
 	}
 
-	var emailIDs []uint64
+	reserved, err := useCases.processedMessagesService.ReserveProcessedMessage(ctx, idempotencyKey)
+	if err != nil {
+		return nil, false, err
+	}
 
-	for _, masterID := range ticketData.RespondedMastersIDs {
-		master, err := useCases.toysService.GetMasterByID(ctx, masterID)
+	if !reserved {
+		processedMessage, err := useCases.processedMessagesService.GetProcessedMessage(ctx, idempotencyKey)
 		if err != nil {
-			return nil, err
+			return nil, false, err
 		}
 
-		respondOwner, err := useCases.ssoService.GetUserByID(ctx, master.UserID)
-		if err != nil {
-			return nil, err
+		if processedMessage.ProcessedAt == nil {
+			return nil, false, &MessageIsBeingProcessedError{IdempotencyKey: idempotencyKey}
 		}
 
-		if err = useCases.senders.Email.Send(
-			ctx,
-			useCases.contentBuilders.TicketDeleted.Subject(ticketData),
-			useCases.contentBuilders.TicketDeleted.Body(ticketData, *ticketOwner, *respondOwner),
-			[]string{respondOwner.Email},
-		); err != nil {
-			return nil, err
-		}
+		return processedMessage.EmailIDs, true, nil
+	}
 
-		emailCommunication := entities.Email{
-			UserID: respondOwner.ID,
-			Email:  respondOwner.Email,
-			Content: useCases.contentBuilders.TicketDeleted.Body(
-				ticketData,
-				*ticketOwner,
-				*respondOwner,
-			),
-			SentAt: time.Now().UTC(),
+	if emailIDs, err = process(); err != nil {
+		// Releasing reservation to allow processing of message on redelivery:
+		if releaseErr := useCases.processedMessagesService.ReleaseProcessedMessage(
+			context.WithoutCancel(ctx),
+			idempotencyKey,
+		); releaseErr != nil {
+			return nil, false, errors.Join(err, releaseErr)
 		}
 
-		emailID, err := useCases.emailsService.SaveCommunication(ctx, emailCommunication)
-		if err != nil {
-			return nil, err
-		}
+		return nil, false, err
+	}
+
+	if err = useCases.processedMessagesService.CompleteProcessedMessage(
+		context.WithoutCancel(ctx),
+		idempotencyKey,
+		emailIDs,
+	); err != nil {
+		return emailIDs, false, err
+	}
+
+	return emailIDs, false, nil
+}
 
-		emailIDs = append(emailIDs, emailID)
+func firstEmailID(emailIDs []uint64) uint64 {
+	if len(emailIDs) == 0 {
+		return 0
 	}
 
-	return emailIDs, nil
+	return emailIDs[0]
+}
+
+// setRequestLocale sets locale, in which user requested notification, since SSO does not provide locales of users.
+func setRequestLocale(user *entities.User, locale string) {
+	if locale != "" {
+		user.Locale = locale
+	}
 }
@@ -5,21 +5,25 @@ import (
 	"errors"
 	"github.com/DKhorkov/libs/pointers"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
 	"github.com/DKhorkov/hmtm-notifications/dto"
+	"github.com/DKhorkov/hmtm-notifications/internal/config"
 	"github.com/DKhorkov/hmtm-notifications/internal/entities"
 	"github.com/DKhorkov/hmtm-notifications/internal/interfaces"
+	mockbroadcasters "github.com/DKhorkov/hmtm-notifications/mocks/broadcasters"
 	mockcontentbuilders "github.com/DKhorkov/hmtm-notifications/mocks/contentbuilders"
-	mocksenders "github.com/DKhorkov/hmtm-notifications/mocks/senders"
 	mockservices "github.com/DKhorkov/hmtm-notifications/mocks/services"
+	mocksigners "github.com/DKhorkov/hmtm-notifications/mocks/signers"
 )
 
-func TestUseCases_GetUserEmailCommunications(t *testing.T) {
+func TestUseCases_GetUserCommunications(t *testing.T) {
 	ctrl := gomock.NewController(t)
-	emailsService := mockservices.NewMockEmailsService(ctrl)
+	communicationsService := mockservices.NewMockCommunicationsService(ctrl)
+	processedMessagesService := mockservices.NewMockProcessedMessagesService(ctrl)
 	ssoService := mockservices.NewMockSsoService(ctrl)
 	toysService := mockservices.NewMockToysService(ctrl)
 	ticketsService := mockservices.NewMockTicketsService(ctrl)
@@ -27,25 +31,31 @@ func TestUseCases_GetUserEmailCommunications(t *testing.T) {
 	forgetPasswordBuilder := mockcontentbuilders.NewMockForgetPasswordContentBuilder(ctrl)
 	ticketUpdatedBuilder := mockcontentbuilders.NewMockTicketUpdatedContentBuilder(ctrl)
 	ticketDeletedBuilder := mockcontentbuilders.NewMockTicketDeletedContentBuilder(ctrl)
-	emailSender := mocksenders.NewMockEmailSender(ctrl)
+
+	notificationsService := newAcceptingNotificationsService(ctrl)
 
 	contentBuilders := interfaces.ContentBuilders{
 		VerifyEmail:    verifyEmailBuilder,
 		ForgetPassword: forgetPasswordBuilder,
 		TicketUpdated:  ticketUpdatedBuilder,
 		TicketDeleted:  ticketDeletedBuilder,
-	}
-	senders := interfaces.Senders{
-		Email: emailSender,
+		Inbox:          newAcceptingInboxContentBuilders(ctrl),
 	}
 
 	useCases := New(
-		emailsService,
+		communicationsService,
+		processedMessagesService,
+		notificationsService,
+		newAcceptingNotificationsBroadcaster(ctrl),
+		nil,
+		nil,
+		nil,
+		nil,
 		ssoService,
 		toysService,
 		ticketsService,
 		contentBuilders,
-		senders,
+		config.UseCasesConfig{},
 	)
 
 	testCases := []struct {
@@ -53,7 +63,7 @@ func TestUseCases_GetUserEmailCommunications(t *testing.T) {
 		pagination *entities.Pagination
 		userID     uint64
 		setupMocks func(
-			emailsService *mockservices.MockEmailsService,
+			communicationsService *mockservices.MockCommunicationsService,
 			ssoService *mockservices.MockSsoService,
 			toysService *mockservices.MockToysService,
 			ticketsService *mockservices.MockTicketsService,
@@ -61,9 +71,8 @@ func TestUseCases_GetUserEmailCommunications(t *testing.T) {
 			forgetPasswordBuilder *mockcontentbuilders.MockForgetPasswordContentBuilder,
 			ticketUpdatedBuilder *mockcontentbuilders.MockTicketUpdatedContentBuilder,
 			ticketDeletedBuilder *mockcontentbuilders.MockTicketDeletedContentBuilder,
-			emailSender *mocksenders.MockEmailSender,
 		)
-		expected      []entities.Email
+		expected      []entities.Communication
 		errorExpected bool
 	}{
 		{
@@ -74,7 +83,7 @@ func TestUseCases_GetUserEmailCommunications(t *testing.T) {
 				Offset: pointers.New[uint64](1),
 			},
 			setupMocks: func(
-				emailsService *mockservices.MockEmailsService,
+				communicationsService *mockservices.MockCommunicationsService,
 				ssoService *mockservices.MockSsoService,
 				toysService *mockservices.MockToysService,
 				ticketsService *mockservices.MockTicketsService,
@@ -82,22 +91,22 @@ func TestUseCases_GetUserEmailCommunications(t *testing.T) {
 				forgetPasswordBuilder *mockcontentbuilders.MockForgetPasswordContentBuilder,
 				ticketUpdatedBuilder *mockcontentbuilders.MockTicketUpdatedContentBuilder,
 				ticketDeletedBuilder *mockcontentbuilders.MockTicketDeletedContentBuilder,
-				emailSender *mocksenders.MockEmailSender,
 			) {
-				emailsService.
+				communicationsService.
 					EXPECT().
 					GetUserCommunications(
 						gomock.Any(),
 						uint64(1),
+						entities.CommunicationChannelTelegram,
 						&entities.Pagination{
 							Limit:  pointers.New[uint64](1),
 							Offset: pointers.New[uint64](1),
 						},
 					).
-					Return([]entities.Email{{ID: 1, UserID: 1}}, nil).
+					Return([]entities.Communication{{ID: 1, UserID: 1}}, nil).
 					Times(1)
 			},
-			expected:      []entities.Email{{ID: 1, UserID: 1}},
+			expected:      []entities.Communication{{ID: 1, UserID: 1}},
 			errorExpected: false,
 		},
 		{
@@ -108,7 +117,7 @@ func TestUseCases_GetUserEmailCommunications(t *testing.T) {
 				Offset: pointers.New[uint64](1),
 			},
 			setupMocks: func(
-				emailsService *mockservices.MockEmailsService,
+				communicationsService *mockservices.MockCommunicationsService,
 				ssoService *mockservices.MockSsoService,
 				toysService *mockservices.MockToysService,
 				ticketsService *mockservices.MockTicketsService,
@@ -116,13 +125,13 @@ func TestUseCases_GetUserEmailCommunications(t *testing.T) {
 				forgetPasswordBuilder *mockcontentbuilders.MockForgetPasswordContentBuilder,
 				ticketUpdatedBuilder *mockcontentbuilders.MockTicketUpdatedContentBuilder,
 				ticketDeletedBuilder *mockcontentbuilders.MockTicketDeletedContentBuilder,
-				emailSender *mocksenders.MockEmailSender,
 			) {
-				emailsService.
+				communicationsService.
 					EXPECT().
 					GetUserCommunications(
 						gomock.Any(),
 						uint64(1),
+						entities.CommunicationChannelTelegram,
 						&entities.Pagination{
 							Limit:  pointers.New[uint64](1),
 							Offset: pointers.New[uint64](1),
@@ -140,7 +149,7 @@ func TestUseCases_GetUserEmailCommunications(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			if tc.setupMocks != nil {
 				tc.setupMocks(
-					emailsService,
+					communicationsService,
 					ssoService,
 					toysService,
 					ticketsService,
@@ -148,24 +157,29 @@ func TestUseCases_GetUserEmailCommunications(t *testing.T) {
 					forgetPasswordBuilder,
 					ticketUpdatedBuilder,
 					ticketDeletedBuilder,
-					emailSender,
 				)
 			}
 
-			emails, err := useCases.GetUserEmailCommunications(context.Background(), tc.userID, tc.pagination)
+			communications, err := useCases.GetUserCommunications(
+				context.Background(),
+				tc.userID,
+				entities.CommunicationChannelTelegram,
+				tc.pagination,
+			)
 			if tc.errorExpected {
 				require.Error(t, err)
 			} else {
 				require.NoError(t, err)
-				require.Equal(t, tc.expected, emails)
+				require.Equal(t, tc.expected, communications)
 			}
 		})
 	}
 }
 
-func TestUseCases_CountUserEmailCommunications(t *testing.T) {
+func TestUseCases_CountUserCommunications(t *testing.T) {
 	ctrl := gomock.NewController(t)
-	emailsService := mockservices.NewMockEmailsService(ctrl)
+	communicationsService := mockservices.NewMockCommunicationsService(ctrl)
+	processedMessagesService := mockservices.NewMockProcessedMessagesService(ctrl)
 	ssoService := mockservices.NewMockSsoService(ctrl)
 	toysService := mockservices.NewMockToysService(ctrl)
 	ticketsService := mockservices.NewMockTicketsService(ctrl)
@@ -173,25 +187,31 @@ func TestUseCases_CountUserEmailCommunications(t *testing.T) {
 	forgetPasswordBuilder := mockcontentbuilders.NewMockForgetPasswordContentBuilder(ctrl)
 	ticketUpdatedBuilder := mockcontentbuilders.NewMockTicketUpdatedContentBuilder(ctrl)
 	ticketDeletedBuilder := mockcontentbuilders.NewMockTicketDeletedContentBuilder(ctrl)
-	emailSender := mocksenders.NewMockEmailSender(ctrl)
+
+	notificationsService := newAcceptingNotificationsService(ctrl)
 
 	contentBuilders := interfaces.ContentBuilders{
 		VerifyEmail:    verifyEmailBuilder,
 		ForgetPassword: forgetPasswordBuilder,
 		TicketUpdated:  ticketUpdatedBuilder,
 		TicketDeleted:  ticketDeletedBuilder,
-	}
-	senders := interfaces.Senders{
-		Email: emailSender,
+		Inbox:          newAcceptingInboxContentBuilders(ctrl),
 	}
 
 	useCases := New(
-		emailsService,
+		communicationsService,
+		processedMessagesService,
+		notificationsService,
+		newAcceptingNotificationsBroadcaster(ctrl),
+		nil,
+		nil,
+		nil,
+		nil,
 		ssoService,
 		toysService,
 		ticketsService,
 		contentBuilders,
-		senders,
+		config.UseCasesConfig{},
 	)
 
 	testCases := []struct {
@@ -199,7 +219,7 @@ func TestUseCases_CountUserEmailCommunications(t *testing.T) {
 		pagination *entities.Pagination
 		userID     uint64
 		setupMocks func(
-			emailsService *mockservices.MockEmailsService,
+			communicationsService *mockservices.MockCommunicationsService,
 			ssoService *mockservices.MockSsoService,
 			toysService *mockservices.MockToysService,
 			ticketsService *mockservices.MockTicketsService,
@@ -207,7 +227,6 @@ func TestUseCases_CountUserEmailCommunications(t *testing.T) {
 			forgetPasswordBuilder *mockcontentbuilders.MockForgetPasswordContentBuilder,
 			ticketUpdatedBuilder *mockcontentbuilders.MockTicketUpdatedContentBuilder,
 			ticketDeletedBuilder *mockcontentbuilders.MockTicketDeletedContentBuilder,
-			emailSender *mocksenders.MockEmailSender,
 		)
 		expected      uint64
 		errorExpected bool
@@ -216,7 +235,7 @@ func TestUseCases_CountUserEmailCommunications(t *testing.T) {
 			name:   "success",
 			userID: 1,
 			setupMocks: func(
-				emailsService *mockservices.MockEmailsService,
+				communicationsService *mockservices.MockCommunicationsService,
 				ssoService *mockservices.MockSsoService,
 				toysService *mockservices.MockToysService,
 				ticketsService *mockservices.MockTicketsService,
@@ -224,11 +243,10 @@ func TestUseCases_CountUserEmailCommunications(t *testing.T) {
 				forgetPasswordBuilder *mockcontentbuilders.MockForgetPasswordContentBuilder,
 				ticketUpdatedBuilder *mockcontentbuilders.MockTicketUpdatedContentBuilder,
 				ticketDeletedBuilder *mockcontentbuilders.MockTicketDeletedContentBuilder,
-				emailSender *mocksenders.MockEmailSender,
 			) {
-				emailsService.
+				communicationsService.
 					EXPECT().
-					CountUserCommunications(gomock.Any(), uint64(1)).
+					CountUserCommunications(gomock.Any(), uint64(1), entities.CommunicationChannelSMS).
 					Return(uint64(1), nil).
 					Times(1)
 			},
@@ -239,7 +257,7 @@ func TestUseCases_CountUserEmailCommunications(t *testing.T) {
 			name:   "error",
 			userID: 1,
 			setupMocks: func(
-				emailsService *mockservices.MockEmailsService,
+				communicationsService *mockservices.MockCommunicationsService,
 				ssoService *mockservices.MockSsoService,
 				toysService *mockservices.MockToysService,
 				ticketsService *mockservices.MockTicketsService,
@@ -247,11 +265,10 @@ func TestUseCases_CountUserEmailCommunications(t *testing.T) {
 				forgetPasswordBuilder *mockcontentbuilders.MockForgetPasswordContentBuilder,
 				ticketUpdatedBuilder *mockcontentbuilders.MockTicketUpdatedContentBuilder,
 				ticketDeletedBuilder *mockcontentbuilders.MockTicketDeletedContentBuilder,
-				emailSender *mocksenders.MockEmailSender,
 			) {
-				emailsService.
+				communicationsService.
 					EXPECT().
-					CountUserCommunications(gomock.Any(), uint64(1)).
+					CountUserCommunications(gomock.Any(), uint64(1), entities.CommunicationChannelSMS).
 					Return(uint64(0), errors.New("not found")).
 					Times(1)
 			},
@@ -263,7 +280,7 @@ func TestUseCases_CountUserEmailCommunications(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			if tc.setupMocks != nil {
 				tc.setupMocks(
-					emailsService,
+					communicationsService,
 					ssoService,
 					toysService,
 					ticketsService,
@@ -271,11 +288,14 @@ func TestUseCases_CountUserEmailCommunications(t *testing.T) {
 					forgetPasswordBuilder,
 					ticketUpdatedBuilder,
 					ticketDeletedBuilder,
-					emailSender,
 				)
 			}
 
-			actual, err := useCases.CountUserEmailCommunications(context.Background(), tc.userID)
+			actual, err := useCases.CountUserCommunications(
+				context.Background(),
+				tc.userID,
+				entities.CommunicationChannelSMS,
+			)
 			if tc.errorExpected {
 				require.Error(t, err)
 			} else {
@@ -288,7 +308,8 @@ func TestUseCases_CountUserEmailCommunications(t *testing.T) {
 
 func TestUseCases_SendVerifyEmailCommunication(t *testing.T) {
 	ctrl := gomock.NewController(t)
-	emailsService := mockservices.NewMockEmailsService(ctrl)
+	communicationsService := mockservices.NewMockCommunicationsService(ctrl)
+	processedMessagesService := mockservices.NewMockProcessedMessagesService(ctrl)
 	ssoService := mockservices.NewMockSsoService(ctrl)
 	toysService := mockservices.NewMockToysService(ctrl)
 	ticketsService := mockservices.NewMockTicketsService(ctrl)
@@ -296,32 +317,38 @@ func TestUseCases_SendVerifyEmailCommunication(t *testing.T) {
 	forgetPasswordBuilder := mockcontentbuilders.NewMockForgetPasswordContentBuilder(ctrl)
 	ticketUpdatedBuilder := mockcontentbuilders.NewMockTicketUpdatedContentBuilder(ctrl)
 	ticketDeletedBuilder := mockcontentbuilders.NewMockTicketDeletedContentBuilder(ctrl)
-	emailSender := mocksenders.NewMockEmailSender(ctrl)
+
+	notificationsService := newAcceptingNotificationsService(ctrl)
 
 	contentBuilders := interfaces.ContentBuilders{
 		VerifyEmail:    verifyEmailBuilder,
 		ForgetPassword: forgetPasswordBuilder,
 		TicketUpdated:  ticketUpdatedBuilder,
 		TicketDeleted:  ticketDeletedBuilder,
-	}
-	senders := interfaces.Senders{
-		Email: emailSender,
+		Inbox:          newAcceptingInboxContentBuilders(ctrl),
 	}
 
 	useCases := New(
-		emailsService,
+		communicationsService,
+		processedMessagesService,
+		notificationsService,
+		newAcceptingNotificationsBroadcaster(ctrl),
+		nil,
+		newAcceptingTokensService(ctrl),
+		newStubTokenSigner(ctrl),
+		nil,
 		ssoService,
 		toysService,
 		ticketsService,
 		contentBuilders,
-		senders,
+		config.UseCasesConfig{TokensTTL: testTokensTTL},
 	)
 
 	testCases := []struct {
 		name       string
 		userID     uint64
 		setupMocks func(
-			emailsService *mockservices.MockEmailsService,
+			communicationsService *mockservices.MockCommunicationsService,
 			ssoService *mockservices.MockSsoService,
 			toysService *mockservices.MockToysService,
 			ticketsService *mockservices.MockTicketsService,
@@ -329,7 +356,6 @@ func TestUseCases_SendVerifyEmailCommunication(t *testing.T) {
 			forgetPasswordBuilder *mockcontentbuilders.MockForgetPasswordContentBuilder,
 			ticketUpdatedBuilder *mockcontentbuilders.MockTicketUpdatedContentBuilder,
 			ticketDeletedBuilder *mockcontentbuilders.MockTicketDeletedContentBuilder,
-			emailSender *mocksenders.MockEmailSender,
 		)
 		expected      uint64
 		errorExpected bool
@@ -338,7 +364,7 @@ func TestUseCases_SendVerifyEmailCommunication(t *testing.T) {
 			name:   "success",
 			userID: 1,
 			setupMocks: func(
-				emailsService *mockservices.MockEmailsService,
+				communicationsService *mockservices.MockCommunicationsService,
 				ssoService *mockservices.MockSsoService,
 				toysService *mockservices.MockToysService,
 				ticketsService *mockservices.MockTicketsService,
@@ -346,7 +372,6 @@ func TestUseCases_SendVerifyEmailCommunication(t *testing.T) {
 				forgetPasswordBuilder *mockcontentbuilders.MockForgetPasswordContentBuilder,
 				ticketUpdatedBuilder *mockcontentbuilders.MockTicketUpdatedContentBuilder,
 				ticketDeletedBuilder *mockcontentbuilders.MockTicketDeletedContentBuilder,
-				emailSender *mocksenders.MockEmailSender,
 			) {
 				user := entities.User{ID: 1, Email: "test@example.com"}
 				ssoService.
@@ -357,23 +382,17 @@ func TestUseCases_SendVerifyEmailCommunication(t *testing.T) {
 
 				verifyEmailBuilder.
 					EXPECT().
-					Subject().
+					Subject(user).
 					Return("Verify Email").
 					Times(1)
 
 				verifyEmailBuilder.
 					EXPECT().
-					Body(user).
+					Body(user, testToken).
 					Return("Verify Email Body").
-					Times(2)
-
-				emailSender.
-					EXPECT().
-					Send(gomock.Any(), "Verify Email", "Verify Email Body", []string{"test@example.com"}).
-					Return(nil).
 					Times(1)
 
-				emailsService.
+				communicationsService.
 					EXPECT().
 					SaveCommunication(gomock.Any(), gomock.Any()).
 					Return(uint64(1), nil).
@@ -386,7 +405,7 @@ func TestUseCases_SendVerifyEmailCommunication(t *testing.T) {
 			name:   "user not found",
 			userID: 1,
 			setupMocks: func(
-				emailsService *mockservices.MockEmailsService,
+				communicationsService *mockservices.MockCommunicationsService,
 				ssoService *mockservices.MockSsoService,
 				toysService *mockservices.MockToysService,
 				ticketsService *mockservices.MockTicketsService,
@@ -394,7 +413,6 @@ func TestUseCases_SendVerifyEmailCommunication(t *testing.T) {
 				forgetPasswordBuilder *mockcontentbuilders.MockForgetPasswordContentBuilder,
 				ticketUpdatedBuilder *mockcontentbuilders.MockTicketUpdatedContentBuilder,
 				ticketDeletedBuilder *mockcontentbuilders.MockTicketDeletedContentBuilder,
-				emailSender *mocksenders.MockEmailSender,
 			) {
 				ssoService.
 					EXPECT().
@@ -406,10 +424,10 @@ func TestUseCases_SendVerifyEmailCommunication(t *testing.T) {
 			errorExpected: true,
 		},
 		{
-			name:   "send error",
+			name:   "save communication error",
 			userID: 1,
 			setupMocks: func(
-				emailsService *mockservices.MockEmailsService,
+				communicationsService *mockservices.MockCommunicationsService,
 				ssoService *mockservices.MockSsoService,
 				toysService *mockservices.MockToysService,
 				ticketsService *mockservices.MockTicketsService,
@@ -417,7 +435,6 @@ func TestUseCases_SendVerifyEmailCommunication(t *testing.T) {
 				forgetPasswordBuilder *mockcontentbuilders.MockForgetPasswordContentBuilder,
 				ticketUpdatedBuilder *mockcontentbuilders.MockTicketUpdatedContentBuilder,
 				ticketDeletedBuilder *mockcontentbuilders.MockTicketDeletedContentBuilder,
-				emailSender *mocksenders.MockEmailSender,
 			) {
 				user := entities.User{ID: 1, Email: "test@example.com"}
 				ssoService.
@@ -428,20 +445,20 @@ func TestUseCases_SendVerifyEmailCommunication(t *testing.T) {
 
 				verifyEmailBuilder.
 					EXPECT().
-					Subject().
+					Subject(user).
 					Return("Verify Email").
 					Times(1)
 
 				verifyEmailBuilder.
 					EXPECT().
-					Body(user).
+					Body(user, testToken).
 					Return("Verify Email Body").
 					Times(1)
 
-				emailSender.
+				communicationsService.
 					EXPECT().
-					Send(gomock.Any(), "Verify Email", "Verify Email Body", []string{"test@example.com"}).
-					Return(errors.New("send failed")).
+					SaveCommunication(gomock.Any(), gomock.Any()).
+					Return(uint64(0), errors.New("save failed")).
 					Times(1)
 			},
 			expected:      0,
@@ -453,7 +470,7 @@ func TestUseCases_SendVerifyEmailCommunication(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			if tc.setupMocks != nil {
 				tc.setupMocks(
-					emailsService,
+					communicationsService,
 					ssoService,
 					toysService,
 					ticketsService,
@@ -461,11 +478,13 @@ func TestUseCases_SendVerifyEmailCommunication(t *testing.T) {
 					forgetPasswordBuilder,
 					ticketUpdatedBuilder,
 					ticketDeletedBuilder,
-					emailSender,
 				)
 			}
 
-			actual, err := useCases.SendVerifyEmailCommunication(context.Background(), tc.userID)
+			actual, err := useCases.SendVerifyEmailCommunication(
+				context.Background(),
+				dto.VerifyEmailDTO{UserID: tc.userID},
+			)
 			if tc.errorExpected {
 				require.Error(t, err)
 			} else {
@@ -476,9 +495,106 @@ func TestUseCases_SendVerifyEmailCommunication(t *testing.T) {
 	}
 }
 
+func TestUseCases_SendVerifyEmailCommunicationText(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	communicationsService := mockservices.NewMockCommunicationsService(ctrl)
+	ssoService := mockservices.NewMockSsoService(ctrl)
+	verifyEmailBuilder := mockcontentbuilders.NewMockVerifyEmailContentBuilder(ctrl)
+	verifyEmailTextBuilder := mockcontentbuilders.NewMockVerifyEmailTextContentBuilder(ctrl)
+
+	useCases := New(
+		communicationsService,
+		mockservices.NewMockProcessedMessagesService(ctrl),
+		newAcceptingNotificationsService(ctrl),
+		newAcceptingNotificationsBroadcaster(ctrl),
+		nil,
+		newAcceptingTokensService(ctrl),
+		newStubTokenSigner(ctrl),
+		nil,
+		ssoService,
+		mockservices.NewMockToysService(ctrl),
+		mockservices.NewMockTicketsService(ctrl),
+		interfaces.ContentBuilders{
+			VerifyEmail: verifyEmailBuilder,
+			Inbox:       newAcceptingInboxContentBuilders(ctrl),
+			Text: interfaces.EmailTextContentBuilders{
+				VerifyEmail: verifyEmailTextBuilder,
+			},
+		},
+		config.UseCasesConfig{TokensTTL: testTokensTTL},
+	)
+
+	user := entities.User{ID: 1, Email: "test@example.com"}
+	ssoService.EXPECT().GetUserByID(gomock.Any(), uint64(1)).Return(&user, nil).Times(1)
+	verifyEmailBuilder.EXPECT().Subject(user).Return("Verify Email").Times(1)
+	verifyEmailBuilder.EXPECT().Body(user, testToken).Return("<p>Verify Email Body</p>").Times(1)
+	verifyEmailTextBuilder.EXPECT().Text(user, testToken).Return("Verify Email Text").Times(1)
+
+	var saved entities.Communication
+	communicationsService.
+		EXPECT().
+		SaveCommunication(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, communication entities.Communication) (uint64, error) {
+			saved = communication
+
+			return 1, nil
+		}).
+		Times(1)
+
+	_, err := useCases.SendVerifyEmailCommunication(context.Background(), dto.VerifyEmailDTO{UserID: 1})
+	require.NoError(t, err)
+	require.Equal(t, "<p>Verify Email Body</p>", saved.Body)
+	require.Equal(t, pointers.New("Verify Email Text"), saved.TextBody)
+}
+
+func TestUseCases_SendVerifyEmailCommunicationLocale(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	communicationsService := mockservices.NewMockCommunicationsService(ctrl)
+	ssoService := mockservices.NewMockSsoService(ctrl)
+	verifyEmailBuilder := mockcontentbuilders.NewMockVerifyEmailContentBuilder(ctrl)
+
+	useCases := New(
+		communicationsService,
+		mockservices.NewMockProcessedMessagesService(ctrl),
+		newAcceptingNotificationsService(ctrl),
+		newAcceptingNotificationsBroadcaster(ctrl),
+		nil,
+		newAcceptingTokensService(ctrl),
+		newStubTokenSigner(ctrl),
+		nil,
+		ssoService,
+		mockservices.NewMockToysService(ctrl),
+		mockservices.NewMockTicketsService(ctrl),
+		interfaces.ContentBuilders{
+			VerifyEmail: verifyEmailBuilder,
+			Inbox:       newAcceptingInboxContentBuilders(ctrl),
+		},
+		config.UseCasesConfig{TokensTTL: testTokensTTL},
+	)
+
+	// Content is built in locale of request, since SSO does not provide locale of user:
+	localized := entities.User{ID: 1, Email: "test@example.com", Locale: "en"}
+	ssoService.
+		EXPECT().
+		GetUserByID(gomock.Any(), uint64(1)).
+		Return(&entities.User{ID: 1, Email: "test@example.com"}, nil).
+		Times(1)
+
+	verifyEmailBuilder.EXPECT().Subject(localized).Return("Verify Email").Times(1)
+	verifyEmailBuilder.EXPECT().Body(localized, testToken).Return("<p>Verify Email Body</p>").Times(1)
+	communicationsService.EXPECT().SaveCommunication(gomock.Any(), gomock.Any()).Return(uint64(1), nil).Times(1)
+
+	_, err := useCases.SendVerifyEmailCommunication(
+		context.Background(),
+		dto.VerifyEmailDTO{UserID: 1, Locale: "en"},
+	)
+	require.NoError(t, err)
+}
+
 func TestUseCases_SendForgetPasswordEmailCommunication(t *testing.T) {
 	ctrl := gomock.NewController(t)
-	emailsService := mockservices.NewMockEmailsService(ctrl)
+	communicationsService := mockservices.NewMockCommunicationsService(ctrl)
+	processedMessagesService := mockservices.NewMockProcessedMessagesService(ctrl)
 	ssoService := mockservices.NewMockSsoService(ctrl)
 	toysService := mockservices.NewMockToysService(ctrl)
 	ticketsService := mockservices.NewMockTicketsService(ctrl)
@@ -486,32 +602,38 @@ func TestUseCases_SendForgetPasswordEmailCommunication(t *testing.T) {
 	forgetPasswordBuilder := mockcontentbuilders.NewMockForgetPasswordContentBuilder(ctrl)
 	ticketUpdatedBuilder := mockcontentbuilders.NewMockTicketUpdatedContentBuilder(ctrl)
 	ticketDeletedBuilder := mockcontentbuilders.NewMockTicketDeletedContentBuilder(ctrl)
-	emailSender := mocksenders.NewMockEmailSender(ctrl)
+
+	notificationsService := newAcceptingNotificationsService(ctrl)
 
 	contentBuilders := interfaces.ContentBuilders{
 		VerifyEmail:    verifyEmailBuilder,
 		ForgetPassword: forgetPasswordBuilder,
 		TicketUpdated:  ticketUpdatedBuilder,
 		TicketDeleted:  ticketDeletedBuilder,
-	}
-	senders := interfaces.Senders{
-		Email: emailSender,
+		Inbox:          newAcceptingInboxContentBuilders(ctrl),
 	}
 
 	useCases := New(
-		emailsService,
+		communicationsService,
+		processedMessagesService,
+		notificationsService,
+		newAcceptingNotificationsBroadcaster(ctrl),
+		nil,
+		newAcceptingTokensService(ctrl),
+		newStubTokenSigner(ctrl),
+		nil,
 		ssoService,
 		toysService,
 		ticketsService,
 		contentBuilders,
-		senders,
+		config.UseCasesConfig{TokensTTL: testTokensTTL},
 	)
 
 	testCases := []struct {
 		name       string
 		userID     uint64
 		setupMocks func(
-			emailsService *mockservices.MockEmailsService,
+			communicationsService *mockservices.MockCommunicationsService,
 			ssoService *mockservices.MockSsoService,
 			toysService *mockservices.MockToysService,
 			ticketsService *mockservices.MockTicketsService,
@@ -519,7 +641,6 @@ func TestUseCases_SendForgetPasswordEmailCommunication(t *testing.T) {
 			forgetPasswordBuilder *mockcontentbuilders.MockForgetPasswordContentBuilder,
 			ticketUpdatedBuilder *mockcontentbuilders.MockTicketUpdatedContentBuilder,
 			ticketDeletedBuilder *mockcontentbuilders.MockTicketDeletedContentBuilder,
-			emailSender *mocksenders.MockEmailSender,
 		)
 		expected      uint64
 		errorExpected bool
@@ -528,7 +649,7 @@ func TestUseCases_SendForgetPasswordEmailCommunication(t *testing.T) {
 			name:   "success",
 			userID: 1,
 			setupMocks: func(
-				emailsService *mockservices.MockEmailsService,
+				communicationsService *mockservices.MockCommunicationsService,
 				ssoService *mockservices.MockSsoService,
 				toysService *mockservices.MockToysService,
 				ticketsService *mockservices.MockTicketsService,
@@ -536,7 +657,6 @@ func TestUseCases_SendForgetPasswordEmailCommunication(t *testing.T) {
 				forgetPasswordBuilder *mockcontentbuilders.MockForgetPasswordContentBuilder,
 				ticketUpdatedBuilder *mockcontentbuilders.MockTicketUpdatedContentBuilder,
 				ticketDeletedBuilder *mockcontentbuilders.MockTicketDeletedContentBuilder,
-				emailSender *mocksenders.MockEmailSender,
 			) {
 				user := entities.User{ID: 1, Email: "test@example.com"}
 				ssoService.
@@ -547,23 +667,17 @@ func TestUseCases_SendForgetPasswordEmailCommunication(t *testing.T) {
 
 				forgetPasswordBuilder.
 					EXPECT().
-					Subject().
+					Subject(user).
 					Return("Forget Password").
 					Times(1)
 
 				forgetPasswordBuilder.
 					EXPECT().
-					Body(user).
+					Body(user, testToken).
 					Return("Forget Password Body").
-					Times(2)
-
-				emailSender.
-					EXPECT().
-					Send(gomock.Any(), "Forget Password", "Forget Password Body", []string{"test@example.com"}).
-					Return(nil).
 					Times(1)
 
-				emailsService.
+				communicationsService.
 					EXPECT().
 					SaveCommunication(gomock.Any(), gomock.Any()).
 					Return(uint64(1), nil).
@@ -576,7 +690,7 @@ func TestUseCases_SendForgetPasswordEmailCommunication(t *testing.T) {
 			name:   "user not found",
 			userID: 1,
 			setupMocks: func(
-				emailsService *mockservices.MockEmailsService,
+				communicationsService *mockservices.MockCommunicationsService,
 				ssoService *mockservices.MockSsoService,
 				toysService *mockservices.MockToysService,
 				ticketsService *mockservices.MockTicketsService,
@@ -584,7 +698,6 @@ func TestUseCases_SendForgetPasswordEmailCommunication(t *testing.T) {
 				forgetPasswordBuilder *mockcontentbuilders.MockForgetPasswordContentBuilder,
 				ticketUpdatedBuilder *mockcontentbuilders.MockTicketUpdatedContentBuilder,
 				ticketDeletedBuilder *mockcontentbuilders.MockTicketDeletedContentBuilder,
-				emailSender *mocksenders.MockEmailSender,
 			) {
 				ssoService.
 					EXPECT().
@@ -595,10 +708,10 @@ func TestUseCases_SendForgetPasswordEmailCommunication(t *testing.T) {
 			expected:      0,
 			errorExpected: true,
 		}, {
-			name:   "send error",
+			name:   "save communication error",
 			userID: 1,
 			setupMocks: func(
-				emailsService *mockservices.MockEmailsService,
+				communicationsService *mockservices.MockCommunicationsService,
 				ssoService *mockservices.MockSsoService,
 				toysService *mockservices.MockToysService,
 				ticketsService *mockservices.MockTicketsService,
@@ -606,7 +719,6 @@ func TestUseCases_SendForgetPasswordEmailCommunication(t *testing.T) {
 				forgetPasswordBuilder *mockcontentbuilders.MockForgetPasswordContentBuilder,
 				ticketUpdatedBuilder *mockcontentbuilders.MockTicketUpdatedContentBuilder,
 				ticketDeletedBuilder *mockcontentbuilders.MockTicketDeletedContentBuilder,
-				emailSender *mocksenders.MockEmailSender,
 			) {
 				user := entities.User{ID: 1, Email: "test@example.com"}
 				ssoService.
@@ -617,20 +729,20 @@ func TestUseCases_SendForgetPasswordEmailCommunication(t *testing.T) {
 
 				forgetPasswordBuilder.
 					EXPECT().
-					Subject().
+					Subject(user).
 					Return("Forget Password").
 					Times(1)
 
 				forgetPasswordBuilder.
 					EXPECT().
-					Body(user).
+					Body(user, testToken).
 					Return("Forget Password Body").
 					Times(1)
 
-				emailSender.
+				communicationsService.
 					EXPECT().
-					Send(gomock.Any(), "Forget Password", "Forget Password Body", []string{"test@example.com"}).
-					Return(errors.New("send failed")).
+					SaveCommunication(gomock.Any(), gomock.Any()).
+					Return(uint64(0), errors.New("save failed")).
 					Times(1)
 			},
 			expected:      0,
@@ -642,7 +754,7 @@ func TestUseCases_SendForgetPasswordEmailCommunication(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			if tc.setupMocks != nil {
 				tc.setupMocks(
-					emailsService,
+					communicationsService,
 					ssoService,
 					toysService,
 					ticketsService,
@@ -650,11 +762,13 @@ func TestUseCases_SendForgetPasswordEmailCommunication(t *testing.T) {
 					forgetPasswordBuilder,
 					ticketUpdatedBuilder,
 					ticketDeletedBuilder,
-					emailSender,
 				)
 			}
 
-			actual, err := useCases.SendForgetPasswordEmailCommunication(context.Background(), tc.userID)
+			actual, err := useCases.SendForgetPasswordEmailCommunication(
+				context.Background(),
+				dto.ForgetPasswordDTO{UserID: tc.userID},
+			)
 			if tc.errorExpected {
 				require.Error(t, err)
 			} else {
@@ -667,7 +781,8 @@ func TestUseCases_SendForgetPasswordEmailCommunication(t *testing.T) {
 
 func TestUseCases_SendTicketUpdatedEmailCommunication(t *testing.T) {
 	ctrl := gomock.NewController(t)
-	emailsService := mockservices.NewMockEmailsService(ctrl)
+	communicationsService := mockservices.NewMockCommunicationsService(ctrl)
+	processedMessagesService := mockservices.NewMockProcessedMessagesService(ctrl)
 	ssoService := mockservices.NewMockSsoService(ctrl)
 	toysService := mockservices.NewMockToysService(ctrl)
 	ticketsService := mockservices.NewMockTicketsService(ctrl)
@@ -675,32 +790,38 @@ func TestUseCases_SendTicketUpdatedEmailCommunication(t *testing.T) {
 	forgetPasswordBuilder := mockcontentbuilders.NewMockForgetPasswordContentBuilder(ctrl)
 	ticketUpdatedBuilder := mockcontentbuilders.NewMockTicketUpdatedContentBuilder(ctrl)
 	ticketDeletedBuilder := mockcontentbuilders.NewMockTicketDeletedContentBuilder(ctrl)
-	emailSender := mocksenders.NewMockEmailSender(ctrl)
+
+	notificationsService := newAcceptingNotificationsService(ctrl)
 
 	contentBuilders := interfaces.ContentBuilders{
 		VerifyEmail:    verifyEmailBuilder,
 		ForgetPassword: forgetPasswordBuilder,
 		TicketUpdated:  ticketUpdatedBuilder,
 		TicketDeleted:  ticketDeletedBuilder,
-	}
-	senders := interfaces.Senders{
-		Email: emailSender,
+		Inbox:          newAcceptingInboxContentBuilders(ctrl),
 	}
 
 	useCases := New(
-		emailsService,
+		communicationsService,
+		processedMessagesService,
+		notificationsService,
+		newAcceptingNotificationsBroadcaster(ctrl),
+		nil,
+		nil,
+		nil,
+		nil,
 		ssoService,
 		toysService,
 		ticketsService,
 		contentBuilders,
-		senders,
+		config.UseCasesConfig{},
 	)
 
 	testCases := []struct {
 		name       string
 		ticketID   uint64
 		setupMocks func(
-			emailsService *mockservices.MockEmailsService,
+			communicationsService *mockservices.MockCommunicationsService,
 			ssoService *mockservices.MockSsoService,
 			toysService *mockservices.MockToysService,
 			ticketsService *mockservices.MockTicketsService,
@@ -708,16 +829,15 @@ func TestUseCases_SendTicketUpdatedEmailCommunication(t *testing.T) {
 			forgetPasswordBuilder *mockcontentbuilders.MockForgetPasswordContentBuilder,
 			ticketUpdatedBuilder *mockcontentbuilders.MockTicketUpdatedContentBuilder,
 			ticketDeletedBuilder *mockcontentbuilders.MockTicketDeletedContentBuilder,
-			emailSender *mocksenders.MockEmailSender,
 		)
-		expected      []uint64
+		expected      *entities.FanOutResult
 		errorExpected bool
 	}{
 		{
 			name:     "success",
 			ticketID: 1,
 			setupMocks: func(
-				emailsService *mockservices.MockEmailsService,
+				communicationsService *mockservices.MockCommunicationsService,
 				ssoService *mockservices.MockSsoService,
 				toysService *mockservices.MockToysService,
 				ticketsService *mockservices.MockTicketsService,
@@ -725,7 +845,6 @@ func TestUseCases_SendTicketUpdatedEmailCommunication(t *testing.T) {
 				forgetPasswordBuilder *mockcontentbuilders.MockForgetPasswordContentBuilder,
 				ticketUpdatedBuilder *mockcontentbuilders.MockTicketUpdatedContentBuilder,
 				ticketDeletedBuilder *mockcontentbuilders.MockTicketDeletedContentBuilder,
-				emailSender *mocksenders.MockEmailSender,
 			) {
 				ticket := entities.RawTicket{ID: 1}
 				ticketsService.
@@ -757,36 +876,39 @@ func TestUseCases_SendTicketUpdatedEmailCommunication(t *testing.T) {
 
 				ticketUpdatedBuilder.
 					EXPECT().
-					Subject(ticket).
+					Subject(ticket, user).
 					Return("Update Ticket").
 					Times(1)
 
 				ticketUpdatedBuilder.
 					EXPECT().
-					Body(ticket, user).
+					Body(ticket, gomock.Nil(), user).
 					Return("Update Ticket Body").
-					Times(2)
-
-				emailSender.
-					EXPECT().
-					Send(gomock.Any(), "Update Ticket", "Update Ticket Body", []string{"master@example.com"}).
-					Return(nil).
 					Times(1)
 
-				emailsService.
+				communicationsService.
 					EXPECT().
 					SaveCommunication(gomock.Any(), gomock.Any()).
 					Return(uint64(1), nil).
 					Times(1)
 			},
-			expected:      []uint64{1},
+			expected: &entities.FanOutResult{
+				Recipients: []entities.RecipientResult{
+					{
+						MasterID: 2,
+						UserID:   3,
+						EmailID:  1,
+						Status:   entities.DeliveryStatusSent,
+					},
+				},
+			},
 			errorExpected: false,
 		},
 		{
 			name:     "ticket not found",
 			ticketID: 1,
 			setupMocks: func(
-				emailsService *mockservices.MockEmailsService,
+				communicationsService *mockservices.MockCommunicationsService,
 				ssoService *mockservices.MockSsoService,
 				toysService *mockservices.MockToysService,
 				ticketsService *mockservices.MockTicketsService,
@@ -794,7 +916,6 @@ func TestUseCases_SendTicketUpdatedEmailCommunication(t *testing.T) {
 				forgetPasswordBuilder *mockcontentbuilders.MockForgetPasswordContentBuilder,
 				ticketUpdatedBuilder *mockcontentbuilders.MockTicketUpdatedContentBuilder,
 				ticketDeletedBuilder *mockcontentbuilders.MockTicketDeletedContentBuilder,
-				emailSender *mocksenders.MockEmailSender,
 			) {
 				ticketsService.
 					EXPECT().
@@ -808,7 +929,7 @@ func TestUseCases_SendTicketUpdatedEmailCommunication(t *testing.T) {
 			name:     "responds fetch error",
 			ticketID: 1,
 			setupMocks: func(
-				emailsService *mockservices.MockEmailsService,
+				communicationsService *mockservices.MockCommunicationsService,
 				ssoService *mockservices.MockSsoService,
 				toysService *mockservices.MockToysService,
 				ticketsService *mockservices.MockTicketsService,
@@ -816,7 +937,6 @@ func TestUseCases_SendTicketUpdatedEmailCommunication(t *testing.T) {
 				forgetPasswordBuilder *mockcontentbuilders.MockForgetPasswordContentBuilder,
 				ticketUpdatedBuilder *mockcontentbuilders.MockTicketUpdatedContentBuilder,
 				ticketDeletedBuilder *mockcontentbuilders.MockTicketDeletedContentBuilder,
-				emailSender *mocksenders.MockEmailSender,
 			) {
 				ticket := entities.RawTicket{ID: 1}
 				ticketsService.
@@ -838,7 +958,7 @@ func TestUseCases_SendTicketUpdatedEmailCommunication(t *testing.T) {
 			name:     "master not found",
 			ticketID: 1,
 			setupMocks: func(
-				emailsService *mockservices.MockEmailsService,
+				communicationsService *mockservices.MockCommunicationsService,
 				ssoService *mockservices.MockSsoService,
 				toysService *mockservices.MockToysService,
 				ticketsService *mockservices.MockTicketsService,
@@ -846,7 +966,6 @@ func TestUseCases_SendTicketUpdatedEmailCommunication(t *testing.T) {
 				forgetPasswordBuilder *mockcontentbuilders.MockForgetPasswordContentBuilder,
 				ticketUpdatedBuilder *mockcontentbuilders.MockTicketUpdatedContentBuilder,
 				ticketDeletedBuilder *mockcontentbuilders.MockTicketDeletedContentBuilder,
-				emailSender *mocksenders.MockEmailSender,
 			) {
 				ticket := entities.RawTicket{ID: 1}
 				ticketsService.
@@ -868,14 +987,22 @@ func TestUseCases_SendTicketUpdatedEmailCommunication(t *testing.T) {
 					Return(nil, errors.New("not found")).
 					Times(1)
 			},
-			expected:      nil,
+			expected: &entities.FanOutResult{
+				Recipients: []entities.RecipientResult{
+					{
+						MasterID: 2,
+						Status:   entities.DeliveryStatusFailed,
+						Reason:   "not found",
+					},
+				},
+			},
 			errorExpected: true,
 		},
 		{
 			name:     "user not found",
 			ticketID: 1,
 			setupMocks: func(
-				emailsService *mockservices.MockEmailsService,
+				communicationsService *mockservices.MockCommunicationsService,
 				ssoService *mockservices.MockSsoService,
 				toysService *mockservices.MockToysService,
 				ticketsService *mockservices.MockTicketsService,
@@ -883,7 +1010,6 @@ func TestUseCases_SendTicketUpdatedEmailCommunication(t *testing.T) {
 				forgetPasswordBuilder *mockcontentbuilders.MockForgetPasswordContentBuilder,
 				ticketUpdatedBuilder *mockcontentbuilders.MockTicketUpdatedContentBuilder,
 				ticketDeletedBuilder *mockcontentbuilders.MockTicketDeletedContentBuilder,
-				emailSender *mocksenders.MockEmailSender,
 			) {
 				ticket := entities.RawTicket{ID: 1}
 				ticketsService.
@@ -912,77 +1038,23 @@ func TestUseCases_SendTicketUpdatedEmailCommunication(t *testing.T) {
 					Return(nil, errors.New("not found")).
 					Times(1)
 			},
-			expected:      nil,
-			errorExpected: true,
-		},
-		{
-			name:     "send error",
-			ticketID: 1,
-			setupMocks: func(
-				emailsService *mockservices.MockEmailsService,
-				ssoService *mockservices.MockSsoService,
-				toysService *mockservices.MockToysService,
-				ticketsService *mockservices.MockTicketsService,
-				verifyEmailBuilder *mockcontentbuilders.MockVerifyEmailContentBuilder,
-				forgetPasswordBuilder *mockcontentbuilders.MockForgetPasswordContentBuilder,
-				ticketUpdatedBuilder *mockcontentbuilders.MockTicketUpdatedContentBuilder,
-				ticketDeletedBuilder *mockcontentbuilders.MockTicketDeletedContentBuilder,
-				emailSender *mocksenders.MockEmailSender,
-			) {
-				ticket := entities.RawTicket{ID: 1}
-				ticketsService.
-					EXPECT().
-					GetTicketByID(gomock.Any(), uint64(1)).
-					Return(&ticket, nil).
-					Times(1)
-
-				respond := entities.Respond{MasterID: 2}
-				ticketsService.
-					EXPECT().
-					GetTicketResponds(gomock.Any(), uint64(1)).
-					Return([]entities.Respond{respond}, nil).
-					Times(1)
-
-				master := entities.Master{ID: 2, UserID: 3}
-				toysService.
-					EXPECT().
-					GetMasterByID(gomock.Any(), uint64(2)).
-					Return(&master, nil).
-					Times(1)
-
-				user := entities.User{ID: 3, Email: "master@example.com"}
-				ssoService.
-					EXPECT().
-					GetUserByID(gomock.Any(), uint64(3)).
-					Return(&user, nil).
-					Times(1)
-
-				ticketUpdatedBuilder.
-					EXPECT().
-					Subject(ticket).
-					Return("Update Ticket").
-					Times(1)
-
-				ticketUpdatedBuilder.
-					EXPECT().
-					Body(ticket, user).
-					Return("Update Ticket Body").
-					Times(1)
-
-				emailSender.
-					EXPECT().
-					Send(gomock.Any(), "Update Ticket", "Update Ticket Body", []string{"master@example.com"}).
-					Return(errors.New("send failed")).
-					Times(1)
+			expected: &entities.FanOutResult{
+				Recipients: []entities.RecipientResult{
+					{
+						MasterID: 2,
+						UserID:   3,
+						Status:   entities.DeliveryStatusFailed,
+						Reason:   "not found",
+					},
+				},
 			},
-			expected:      nil,
 			errorExpected: true,
 		},
 		{
 			name:     "save communication error",
 			ticketID: 1,
 			setupMocks: func(
-				emailsService *mockservices.MockEmailsService,
+				communicationsService *mockservices.MockCommunicationsService,
 				ssoService *mockservices.MockSsoService,
 				toysService *mockservices.MockToysService,
 				ticketsService *mockservices.MockTicketsService,
@@ -990,7 +1062,6 @@ func TestUseCases_SendTicketUpdatedEmailCommunication(t *testing.T) {
 				forgetPasswordBuilder *mockcontentbuilders.MockForgetPasswordContentBuilder,
 				ticketUpdatedBuilder *mockcontentbuilders.MockTicketUpdatedContentBuilder,
 				ticketDeletedBuilder *mockcontentbuilders.MockTicketDeletedContentBuilder,
-				emailSender *mocksenders.MockEmailSender,
 			) {
 				ticket := entities.RawTicket{ID: 1}
 				ticketsService.
@@ -1022,29 +1093,32 @@ func TestUseCases_SendTicketUpdatedEmailCommunication(t *testing.T) {
 
 				ticketUpdatedBuilder.
 					EXPECT().
-					Subject(ticket).
+					Subject(ticket, user).
 					Return("Update Ticket").
 					Times(1)
 
 				ticketUpdatedBuilder.
 					EXPECT().
-					Body(ticket, user).
+					Body(ticket, gomock.Nil(), user).
 					Return("Update Ticket Body").
-					Times(2)
-
-				emailSender.
-					EXPECT().
-					Send(gomock.Any(), "Update Ticket", "Update Ticket Body", []string{"master@example.com"}).
-					Return(nil).
 					Times(1)
 
-				emailsService.
+				communicationsService.
 					EXPECT().
 					SaveCommunication(gomock.Any(), gomock.Any()).
 					Return(uint64(0), errors.New("save failed")).
 					Times(1)
 			},
-			expected:      nil,
+			expected: &entities.FanOutResult{
+				Recipients: []entities.RecipientResult{
+					{
+						MasterID: 2,
+						UserID:   3,
+						Status:   entities.DeliveryStatusFailed,
+						Reason:   "save failed",
+					},
+				},
+			},
 			errorExpected: true,
 		},
 	}
@@ -1053,7 +1127,7 @@ func TestUseCases_SendTicketUpdatedEmailCommunication(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			if tc.setupMocks != nil {
 				tc.setupMocks(
-					emailsService,
+					communicationsService,
 					ssoService,
 					toysService,
 					ticketsService,
@@ -1061,24 +1135,28 @@ func TestUseCases_SendTicketUpdatedEmailCommunication(t *testing.T) {
 					forgetPasswordBuilder,
 					ticketUpdatedBuilder,
 					ticketDeletedBuilder,
-					emailSender,
 				)
 			}
 
-			actual, err := useCases.SendTicketUpdatedEmailCommunication(context.Background(), tc.ticketID)
+			actual, err := useCases.SendTicketUpdatedEmailCommunication(
+				context.Background(),
+				dto.TicketUpdatedDTO{TicketID: tc.ticketID},
+			)
 			if tc.errorExpected {
 				require.Error(t, err)
 			} else {
 				require.NoError(t, err)
-				require.Equal(t, tc.expected, actual)
 			}
+
+			require.Equal(t, tc.expected, actual)
 		})
 	}
 }
 
 func TestUseCases_SendTicketDeletedEmailCommunication(t *testing.T) {
 	ctrl := gomock.NewController(t)
-	emailsService := mockservices.NewMockEmailsService(ctrl)
+	communicationsService := mockservices.NewMockCommunicationsService(ctrl)
+	processedMessagesService := mockservices.NewMockProcessedMessagesService(ctrl)
 	ssoService := mockservices.NewMockSsoService(ctrl)
 	toysService := mockservices.NewMockToysService(ctrl)
 	ticketsService := mockservices.NewMockTicketsService(ctrl)
@@ -1086,32 +1164,38 @@ func TestUseCases_SendTicketDeletedEmailCommunication(t *testing.T) {
 	forgetPasswordBuilder := mockcontentbuilders.NewMockForgetPasswordContentBuilder(ctrl)
 	ticketUpdatedBuilder := mockcontentbuilders.NewMockTicketUpdatedContentBuilder(ctrl)
 	ticketDeletedBuilder := mockcontentbuilders.NewMockTicketDeletedContentBuilder(ctrl)
-	emailSender := mocksenders.NewMockEmailSender(ctrl)
+
+	notificationsService := newAcceptingNotificationsService(ctrl)
 
 	contentBuilders := interfaces.ContentBuilders{
 		VerifyEmail:    verifyEmailBuilder,
 		ForgetPassword: forgetPasswordBuilder,
 		TicketUpdated:  ticketUpdatedBuilder,
 		TicketDeleted:  ticketDeletedBuilder,
-	}
-	senders := interfaces.Senders{
-		Email: emailSender,
+		Inbox:          newAcceptingInboxContentBuilders(ctrl),
 	}
 
 	useCases := New(
-		emailsService,
+		communicationsService,
+		processedMessagesService,
+		notificationsService,
+		newAcceptingNotificationsBroadcaster(ctrl),
+		nil,
+		nil,
+		nil,
+		nil,
 		ssoService,
 		toysService,
 		ticketsService,
 		contentBuilders,
-		senders,
+		config.UseCasesConfig{},
 	)
 
 	testCases := []struct {
 		name       string
 		ticketData dto.TicketDeletedDTO
 		setupMocks func(
-			emailsService *mockservices.MockEmailsService,
+			communicationsService *mockservices.MockCommunicationsService,
 			ssoService *mockservices.MockSsoService,
 			toysService *mockservices.MockToysService,
 			ticketsService *mockservices.MockTicketsService,
@@ -1119,9 +1203,8 @@ func TestUseCases_SendTicketDeletedEmailCommunication(t *testing.T) {
 			forgetPasswordBuilder *mockcontentbuilders.MockForgetPasswordContentBuilder,
 			ticketUpdatedBuilder *mockcontentbuilders.MockTicketUpdatedContentBuilder,
 			ticketDeletedBuilder *mockcontentbuilders.MockTicketDeletedContentBuilder,
-			emailSender *mocksenders.MockEmailSender,
 		)
-		expected      []uint64
+		expected      *entities.FanOutResult
 		errorExpected bool
 	}{
 		{
@@ -1131,7 +1214,7 @@ func TestUseCases_SendTicketDeletedEmailCommunication(t *testing.T) {
 				RespondedMastersIDs: []uint64{2},
 			},
 			setupMocks: func(
-				emailsService *mockservices.MockEmailsService,
+				communicationsService *mockservices.MockCommunicationsService,
 				ssoService *mockservices.MockSsoService,
 				toysService *mockservices.MockToysService,
 				ticketsService *mockservices.MockTicketsService,
@@ -1139,7 +1222,6 @@ func TestUseCases_SendTicketDeletedEmailCommunication(t *testing.T) {
 				forgetPasswordBuilder *mockcontentbuilders.MockForgetPasswordContentBuilder,
 				ticketUpdatedBuilder *mockcontentbuilders.MockTicketUpdatedContentBuilder,
 				ticketDeletedBuilder *mockcontentbuilders.MockTicketDeletedContentBuilder,
-				emailSender *mocksenders.MockEmailSender,
 			) {
 				owner := entities.User{ID: 1, Email: "owner@example.com"}
 				ssoService.
@@ -1165,7 +1247,7 @@ func TestUseCases_SendTicketDeletedEmailCommunication(t *testing.T) {
 				ticketData := dto.TicketDeletedDTO{TicketOwnerID: 1, RespondedMastersIDs: []uint64{2}}
 				ticketDeletedBuilder.
 					EXPECT().
-					Subject(ticketData).
+					Subject(ticketData, respondOwner).
 					Return("Delete Ticket").
 					Times(1)
 
@@ -1173,21 +1255,24 @@ func TestUseCases_SendTicketDeletedEmailCommunication(t *testing.T) {
 					EXPECT().
 					Body(ticketData, owner, respondOwner).
 					Return("Delete Ticket Body").
-					Times(2)
-
-				emailSender.
-					EXPECT().
-					Send(gomock.Any(), "Delete Ticket", "Delete Ticket Body", []string{"master@example.com"}).
-					Return(nil).
 					Times(1)
 
-				emailsService.
+				communicationsService.
 					EXPECT().
 					SaveCommunication(gomock.Any(), gomock.Any()).
 					Return(uint64(1), nil).
 					Times(1)
 			},
-			expected:      []uint64{1},
+			expected: &entities.FanOutResult{
+				Recipients: []entities.RecipientResult{
+					{
+						MasterID: 2,
+						UserID:   3,
+						EmailID:  1,
+						Status:   entities.DeliveryStatusSent,
+					},
+				},
+			},
 			errorExpected: false,
 		},
 		{
@@ -1196,7 +1281,7 @@ func TestUseCases_SendTicketDeletedEmailCommunication(t *testing.T) {
 				TicketOwnerID: 1,
 			},
 			setupMocks: func(
-				emailsService *mockservices.MockEmailsService,
+				communicationsService *mockservices.MockCommunicationsService,
 				ssoService *mockservices.MockSsoService,
 				toysService *mockservices.MockToysService,
 				ticketsService *mockservices.MockTicketsService,
@@ -1204,7 +1289,6 @@ func TestUseCases_SendTicketDeletedEmailCommunication(t *testing.T) {
 				forgetPasswordBuilder *mockcontentbuilders.MockForgetPasswordContentBuilder,
 				ticketUpdatedBuilder *mockcontentbuilders.MockTicketUpdatedContentBuilder,
 				ticketDeletedBuilder *mockcontentbuilders.MockTicketDeletedContentBuilder,
-				emailSender *mocksenders.MockEmailSender,
 			) {
 				ssoService.
 					EXPECT().
@@ -1221,7 +1305,7 @@ func TestUseCases_SendTicketDeletedEmailCommunication(t *testing.T) {
 				RespondedMastersIDs: []uint64{2},
 			},
 			setupMocks: func(
-				emailsService *mockservices.MockEmailsService,
+				communicationsService *mockservices.MockCommunicationsService,
 				ssoService *mockservices.MockSsoService,
 				toysService *mockservices.MockToysService,
 				ticketsService *mockservices.MockTicketsService,
@@ -1229,7 +1313,6 @@ func TestUseCases_SendTicketDeletedEmailCommunication(t *testing.T) {
 				forgetPasswordBuilder *mockcontentbuilders.MockForgetPasswordContentBuilder,
 				ticketUpdatedBuilder *mockcontentbuilders.MockTicketUpdatedContentBuilder,
 				ticketDeletedBuilder *mockcontentbuilders.MockTicketDeletedContentBuilder,
-				emailSender *mocksenders.MockEmailSender,
 			) {
 				owner := entities.User{ID: 1, Email: "owner@example.com"}
 				ssoService.
@@ -1244,7 +1327,15 @@ func TestUseCases_SendTicketDeletedEmailCommunication(t *testing.T) {
 					Return(nil, errors.New("not found")).
 					Times(1)
 			},
-			expected:      nil,
+			expected: &entities.FanOutResult{
+				Recipients: []entities.RecipientResult{
+					{
+						MasterID: 2,
+						Status:   entities.DeliveryStatusFailed,
+						Reason:   "not found",
+					},
+				},
+			},
 			errorExpected: true,
 		},
 		{
@@ -1254,7 +1345,7 @@ func TestUseCases_SendTicketDeletedEmailCommunication(t *testing.T) {
 				RespondedMastersIDs: []uint64{2},
 			},
 			setupMocks: func(
-				emailsService *mockservices.MockEmailsService,
+				communicationsService *mockservices.MockCommunicationsService,
 				ssoService *mockservices.MockSsoService,
 				toysService *mockservices.MockToysService,
 				ticketsService *mockservices.MockTicketsService,
@@ -1262,7 +1353,6 @@ func TestUseCases_SendTicketDeletedEmailCommunication(t *testing.T) {
 				forgetPasswordBuilder *mockcontentbuilders.MockForgetPasswordContentBuilder,
 				ticketUpdatedBuilder *mockcontentbuilders.MockTicketUpdatedContentBuilder,
 				ticketDeletedBuilder *mockcontentbuilders.MockTicketDeletedContentBuilder,
-				emailSender *mocksenders.MockEmailSender,
 			) {
 				owner := entities.User{ID: 1, Email: "owner@example.com"}
 				ssoService.
@@ -1284,17 +1374,26 @@ func TestUseCases_SendTicketDeletedEmailCommunication(t *testing.T) {
 					Return(nil, errors.New("not found")).
 					Times(1)
 			},
-			expected:      nil,
+			expected: &entities.FanOutResult{
+				Recipients: []entities.RecipientResult{
+					{
+						MasterID: 2,
+						UserID:   3,
+						Status:   entities.DeliveryStatusFailed,
+						Reason:   "not found",
+					},
+				},
+			},
 			errorExpected: true,
 		},
 		{
-			name: "send error",
+			name: "save communication error",
 			ticketData: dto.TicketDeletedDTO{
 				TicketOwnerID:       1,
 				RespondedMastersIDs: []uint64{2},
 			},
 			setupMocks: func(
-				emailsService *mockservices.MockEmailsService,
+				communicationsService *mockservices.MockCommunicationsService,
 				ssoService *mockservices.MockSsoService,
 				toysService *mockservices.MockToysService,
 				ticketsService *mockservices.MockTicketsService,
@@ -1302,7 +1401,6 @@ func TestUseCases_SendTicketDeletedEmailCommunication(t *testing.T) {
 				forgetPasswordBuilder *mockcontentbuilders.MockForgetPasswordContentBuilder,
 				ticketUpdatedBuilder *mockcontentbuilders.MockTicketUpdatedContentBuilder,
 				ticketDeletedBuilder *mockcontentbuilders.MockTicketDeletedContentBuilder,
-				emailSender *mocksenders.MockEmailSender,
 			) {
 				owner := entities.User{ID: 1, Email: "owner@example.com"}
 				ssoService.
@@ -1328,7 +1426,7 @@ func TestUseCases_SendTicketDeletedEmailCommunication(t *testing.T) {
 				ticketData := dto.TicketDeletedDTO{TicketOwnerID: 1, RespondedMastersIDs: []uint64{2}}
 				ticketDeletedBuilder.
 					EXPECT().
-					Subject(ticketData).
+					Subject(ticketData, respondOwner).
 					Return("Delete Ticket").
 					Times(1)
 
@@ -1338,79 +1436,183 @@ func TestUseCases_SendTicketDeletedEmailCommunication(t *testing.T) {
 					Return("Delete Ticket Body").
 					Times(1)
 
-				emailSender.
+				communicationsService.
 					EXPECT().
-					Send(gomock.Any(), "Delete Ticket", "Delete Ticket Body", []string{"master@example.com"}).
-					Return(errors.New("send failed")).
+					SaveCommunication(gomock.Any(), gomock.Any()).
+					Return(uint64(0), errors.New("save failed")).
 					Times(1)
 			},
-			expected:      nil,
+			expected: &entities.FanOutResult{
+				Recipients: []entities.RecipientResult{
+					{
+						MasterID: 2,
+						UserID:   3,
+						Status:   entities.DeliveryStatusFailed,
+						Reason:   "save failed",
+					},
+				},
+			},
 			errorExpected: true,
 		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(
+					communicationsService,
+					ssoService,
+					toysService,
+					ticketsService,
+					verifyEmailBuilder,
+					forgetPasswordBuilder,
+					ticketUpdatedBuilder,
+					ticketDeletedBuilder,
+				)
+			}
+
+			actual, err := useCases.SendTicketDeletedEmailCommunication(context.Background(), tc.ticketData)
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestUseCases_processOnce(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	processedMessagesService := mockservices.NewMockProcessedMessagesService(ctrl)
+	useCases := New(
+		nil,
+		processedMessagesService,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		interfaces.ContentBuilders{},
+		config.UseCasesConfig{},
+	)
+
+	testCases := []struct {
+		name           string
+		idempotencyKey string
+		processResult  []uint64
+		processErr     error
+		setupMocks     func(processedMessagesService *mockservices.MockProcessedMessagesService)
+		expected       []uint64
+		duplicate      bool
+		processCalled  bool
+		errorExpected  bool
+	}{
+		{
+			name:          "without idempotency key",
+			processResult: []uint64{1, 2},
+			expected:      []uint64{1, 2},
+			processCalled: true,
+		},
 		{
-			name: "save communication error",
-			ticketData: dto.TicketDeletedDTO{
-				TicketOwnerID:       1,
-				RespondedMastersIDs: []uint64{2},
-			},
-			setupMocks: func(
-				emailsService *mockservices.MockEmailsService,
-				ssoService *mockservices.MockSsoService,
-				toysService *mockservices.MockToysService,
-				ticketsService *mockservices.MockTicketsService,
-				verifyEmailBuilder *mockcontentbuilders.MockVerifyEmailContentBuilder,
-				forgetPasswordBuilder *mockcontentbuilders.MockForgetPasswordContentBuilder,
-				ticketUpdatedBuilder *mockcontentbuilders.MockTicketUpdatedContentBuilder,
-				ticketDeletedBuilder *mockcontentbuilders.MockTicketDeletedContentBuilder,
-				emailSender *mocksenders.MockEmailSender,
-			) {
-				owner := entities.User{ID: 1, Email: "owner@example.com"}
-				ssoService.
+			name:           "first delivery",
+			idempotencyKey: "key",
+			processResult:  []uint64{1, 2},
+			setupMocks: func(processedMessagesService *mockservices.MockProcessedMessagesService) {
+				processedMessagesService.
 					EXPECT().
-					GetUserByID(gomock.Any(), uint64(1)).
-					Return(&owner, nil).
+					ReserveProcessedMessage(gomock.Any(), "key").
+					Return(true, nil).
 					Times(1)
 
-				master := entities.Master{ID: 2, UserID: 3}
-				toysService.
+				processedMessagesService.
 					EXPECT().
-					GetMasterByID(gomock.Any(), uint64(2)).
-					Return(&master, nil).
+					CompleteProcessedMessage(gomock.Any(), "key", []uint64{1, 2}).
+					Return(nil).
 					Times(1)
-
-				respondOwner := entities.User{ID: 3, Email: "master@example.com"}
-				ssoService.
+			},
+			expected:      []uint64{1, 2},
+			processCalled: true,
+		},
+		{
+			name:           "duplicate delivery",
+			idempotencyKey: "key",
+			setupMocks: func(processedMessagesService *mockservices.MockProcessedMessagesService) {
+				processedMessagesService.
 					EXPECT().
-					GetUserByID(gomock.Any(), uint64(3)).
-					Return(&respondOwner, nil).
+					ReserveProcessedMessage(gomock.Any(), "key").
+					Return(false, nil).
 					Times(1)
 
-				ticketData := dto.TicketDeletedDTO{TicketOwnerID: 1, RespondedMastersIDs: []uint64{2}}
-				ticketDeletedBuilder.
+				processedMessagesService.
 					EXPECT().
-					Subject(ticketData).
-					Return("Delete Ticket").
+					GetProcessedMessage(gomock.Any(), "key").
+					Return(
+						&entities.ProcessedMessage{
+							IdempotencyKey: "key",
+							EmailIDs:       []uint64{1, 2},
+							ProcessedAt:    pointers.New(time.Now()),
+						},
+						nil,
+					).
+					Times(1)
+			},
+			expected:  []uint64{1, 2},
+			duplicate: true,
+		},
+		{
+			name:           "duplicate delivery during processing",
+			idempotencyKey: "key",
+			setupMocks: func(processedMessagesService *mockservices.MockProcessedMessagesService) {
+				processedMessagesService.
+					EXPECT().
+					ReserveProcessedMessage(gomock.Any(), "key").
+					Return(false, nil).
 					Times(1)
 
-				ticketDeletedBuilder.
+				processedMessagesService.
 					EXPECT().
-					Body(ticketData, owner, respondOwner).
-					Return("Delete Ticket Body").
-					Times(2)
+					GetProcessedMessage(gomock.Any(), "key").
+					Return(&entities.ProcessedMessage{IdempotencyKey: "key"}, nil).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+		{
+			name:           "process error",
+			idempotencyKey: "key",
+			processErr:     errors.New("process failed"),
+			setupMocks: func(processedMessagesService *mockservices.MockProcessedMessagesService) {
+				processedMessagesService.
+					EXPECT().
+					ReserveProcessedMessage(gomock.Any(), "key").
+					Return(true, nil).
+					Times(1)
 
-				emailSender.
+				processedMessagesService.
 					EXPECT().
-					Send(gomock.Any(), "Delete Ticket", "Delete Ticket Body", []string{"master@example.com"}).
+					ReleaseProcessedMessage(gomock.Any(), "key").
 					Return(nil).
 					Times(1)
-
-				emailsService.
+			},
+			processCalled: true,
+			errorExpected: true,
+		},
+		{
+			name:           "reserve error",
+			idempotencyKey: "key",
+			setupMocks: func(processedMessagesService *mockservices.MockProcessedMessagesService) {
+				processedMessagesService.
 					EXPECT().
-					SaveCommunication(gomock.Any(), gomock.Any()).
-					Return(uint64(0), errors.New("save failed")).
+					ReserveProcessedMessage(gomock.Any(), "key").
+					Return(false, errors.New("db error")).
 					Times(1)
 			},
-			expected:      nil,
 			errorExpected: true,
 		},
 	}
@@ -1418,26 +1620,756 @@ func TestUseCases_SendTicketDeletedEmailCommunication(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			if tc.setupMocks != nil {
-				tc.setupMocks(
-					emailsService,
-					ssoService,
-					toysService,
-					ticketsService,
-					verifyEmailBuilder,
-					forgetPasswordBuilder,
-					ticketUpdatedBuilder,
-					ticketDeletedBuilder,
-					emailSender,
+				tc.setupMocks(processedMessagesService)
+			}
+
+			var processCalled bool
+
+			actual, duplicate, err := useCases.processOnce(
+				context.Background(),
+				tc.idempotencyKey,
+				func() ([]uint64, error) {
+					processCalled = true
+
+					return tc.processResult, tc.processErr
+				},
+			)
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tc.expected, actual)
+			require.Equal(t, tc.duplicate, duplicate)
+			require.Equal(t, tc.processCalled, processCalled)
+		})
+	}
+}
+
+func TestUseCases_sendCommunication(t *testing.T) {
+	testCases := []struct {
+		name       string
+		setupMocks func(
+			communicationsService *mockservices.MockCommunicationsService,
+			notificationsService *mockservices.MockNotificationsService,
+			notificationsBroadcaster *mockbroadcasters.MockNotificationsBroadcaster,
+		)
+		expected      uint64
+		errorExpected bool
+	}{
+		{
+			name: "success",
+			setupMocks: func(
+				communicationsService *mockservices.MockCommunicationsService,
+				notificationsService *mockservices.MockNotificationsService,
+				notificationsBroadcaster *mockbroadcasters.MockNotificationsBroadcaster,
+			) {
+				gomock.InOrder(
+					notificationsService.
+						EXPECT().
+						SaveNotification(gomock.Any(), gomock.Any()).
+						DoAndReturn(
+							func(_ context.Context, notification entities.Notification) (uint64, error) {
+								require.Equal(t, uint64(1), notification.UserID)
+								require.Equal(t, entities.NotificationTypeVerifyEmail, notification.Type)
+								require.Equal(t, "Title", notification.Title)
+								require.Equal(t, "Text", notification.Text)
+								require.Equal(t, "http://example.com/verify-email/MQ", notification.Link)
+								require.False(t, notification.CreatedAt.IsZero())
+								require.Nil(t, notification.ReadAt)
+
+								return uint64(1), nil
+							},
+						).
+						Times(1),
+					notificationsBroadcaster.
+						EXPECT().
+						Publish(gomock.Any()).
+						Do(
+							func(notification entities.Notification) {
+								require.Equal(t, uint64(1), notification.ID)
+								require.Equal(t, uint64(1), notification.UserID)
+							},
+						).
+						Times(1),
+					communicationsService.
+						EXPECT().
+						SaveCommunication(gomock.Any(), gomock.Any()).
+						Return(uint64(2), nil).
+						Times(1),
 				)
+			},
+			expected: 2,
+		},
+		{
+			name: "save notification error",
+			setupMocks: func(
+				communicationsService *mockservices.MockCommunicationsService,
+				notificationsService *mockservices.MockNotificationsService,
+				notificationsBroadcaster *mockbroadcasters.MockNotificationsBroadcaster,
+			) {
+				notificationsService.
+					EXPECT().
+					SaveNotification(gomock.Any(), gomock.Any()).
+					Return(uint64(0), errors.New("db error")).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			communicationsService := mockservices.NewMockCommunicationsService(ctrl)
+			notificationsService := mockservices.NewMockNotificationsService(ctrl)
+			notificationsBroadcaster := mockbroadcasters.NewMockNotificationsBroadcaster(ctrl)
+			useCases := New(
+				communicationsService,
+				nil,
+				notificationsService,
+				notificationsBroadcaster,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				interfaces.ContentBuilders{},
+				config.UseCasesConfig{},
+			)
+
+			if tc.setupMocks != nil {
+				tc.setupMocks(communicationsService, notificationsService, notificationsBroadcaster)
 			}
 
-			actual, err := useCases.SendTicketDeletedEmailCommunication(context.Background(), tc.ticketData)
+			actual, err := useCases.sendCommunication(
+				context.Background(),
+				entities.User{ID: 1, Email: "test@example.com"},
+				communication{
+					subject: "Subject",
+					body:    "Body",
+					notification: entities.Notification{
+						Type:  entities.NotificationTypeVerifyEmail,
+						Title: "Title",
+						Text:  "Text",
+						Link:  "http://example.com/verify-email/MQ",
+					},
+				},
+			)
 			if tc.errorExpected {
 				require.Error(t, err)
 			} else {
 				require.NoError(t, err)
-				require.Equal(t, tc.expected, actual)
 			}
+
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestUseCases_sendCommunicationRouting(t *testing.T) {
+	confirmedUser := entities.User{
+		ID:                1,
+		Email:             "test@example.com",
+		EmailConfirmed:    true,
+		Phone:             pointers.New("+79990000000"),
+		PhoneConfirmed:    true,
+		Telegram:          pointers.New("100500"),
+		TelegramConfirmed: true,
+	}
+
+	routing := config.RoutingConfig{
+		entities.NotificationTypeForgetPassword: {entities.CommunicationChannelEmail},
+		entities.NotificationTypeTicketDeleted: {
+			entities.CommunicationChannelTelegram,
+			entities.CommunicationChannelEmail,
+			entities.CommunicationChannelSMS,
+		},
+	}
+
+	testCases := []struct {
+		name             string
+		recipient        entities.User
+		notificationType entities.NotificationType
+		telegramEnabled  bool
+		smsEnabled       bool
+		expectedChannels []entities.CommunicationChannel
+	}{
+		{
+			name:             "single channel policy",
+			recipient:        confirmedUser,
+			notificationType: entities.NotificationTypeForgetPassword,
+			telegramEnabled:  true,
+			smsEnabled:       true,
+			expectedChannels: []entities.CommunicationChannel{entities.CommunicationChannelEmail},
+		},
+		{
+			name:             "primary channel with fallbacks",
+			recipient:        confirmedUser,
+			notificationType: entities.NotificationTypeTicketDeleted,
+			telegramEnabled:  true,
+			smsEnabled:       true,
+			expectedChannels: []entities.CommunicationChannel{
+				entities.CommunicationChannelTelegram,
+				entities.CommunicationChannelEmail,
+				entities.CommunicationChannelSMS,
+			},
+		},
+		{
+			name:             "disabled channels are skipped",
+			recipient:        confirmedUser,
+			notificationType: entities.NotificationTypeTicketDeleted,
+			expectedChannels: []entities.CommunicationChannel{entities.CommunicationChannelEmail},
+		},
+		{
+			name: "telegram not confirmed",
+			recipient: entities.User{
+				ID:             1,
+				Email:          "test@example.com",
+				EmailConfirmed: true,
+				Telegram:       pointers.New("100500"),
+			},
+			notificationType: entities.NotificationTypeTicketDeleted,
+			telegramEnabled:  true,
+			expectedChannels: []entities.CommunicationChannel{entities.CommunicationChannelEmail},
+		},
+		{
+			name: "telegram username instead of chat ID",
+			recipient: entities.User{
+				ID:                1,
+				Email:             "test@example.com",
+				EmailConfirmed:    true,
+				Telegram:          pointers.New("@username"),
+				TelegramConfirmed: true,
+			},
+			notificationType: entities.NotificationTypeTicketDeleted,
+			telegramEnabled:  true,
+			expectedChannels: []entities.CommunicationChannel{entities.CommunicationChannelEmail},
+		},
+		{
+			name: "email not confirmed",
+			recipient: entities.User{
+				ID:             1,
+				Email:          "test@example.com",
+				Phone:          pointers.New("+79990000000"),
+				PhoneConfirmed: true,
+			},
+			notificationType: entities.NotificationTypeTicketDeleted,
+			smsEnabled:       true,
+			expectedChannels: []entities.CommunicationChannel{entities.CommunicationChannelSMS},
+		},
+		{
+			name: "no confirmed contact points",
+			recipient: entities.User{
+				ID:    1,
+				Email: "test@example.com",
+				Phone: pointers.New("+79990000000"),
+			},
+			notificationType: entities.NotificationTypeTicketDeleted,
+			telegramEnabled:  true,
+			smsEnabled:       true,
+			expectedChannels: []entities.CommunicationChannel{entities.CommunicationChannelEmail},
+		},
+		{
+			name:             "notification type without policy",
+			recipient:        confirmedUser,
+			notificationType: entities.NotificationTypeVerifyEmail,
+			telegramEnabled:  true,
+			smsEnabled:       true,
+			expectedChannels: []entities.CommunicationChannel{entities.CommunicationChannelEmail},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			communicationsService := mockservices.NewMockCommunicationsService(ctrl)
+			notificationsService := mockservices.NewMockNotificationsService(ctrl)
+			notificationsBroadcaster := mockbroadcasters.NewMockNotificationsBroadcaster(ctrl)
+			useCases := New(
+				communicationsService,
+				nil,
+				notificationsService,
+				notificationsBroadcaster,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				interfaces.ContentBuilders{},
+				config.UseCasesConfig{
+					TelegramEnabled: tc.telegramEnabled,
+					SMSEnabled:      tc.smsEnabled,
+					Routing:         routing,
+				},
+			)
+
+			notificationsService.
+				EXPECT().
+				SaveNotification(gomock.Any(), gomock.Any()).
+				Return(uint64(1), nil).
+				Times(1)
+
+			notificationsBroadcaster.
+				EXPECT().
+				Publish(gomock.Any()).
+				Times(1)
+
+			var saved []entities.Communication
+
+			communicationsService.
+				EXPECT().
+				SaveCommunication(gomock.Any(), gomock.Any()).
+				DoAndReturn(
+					func(_ context.Context, communication entities.Communication) (uint64, error) {
+						saved = append(saved, communication)
+
+						return uint64(len(saved)), nil
+					},
+				).
+				Times(len(tc.expectedChannels))
+
+			emailID, err := useCases.sendCommunication(
+				context.Background(),
+				tc.recipient,
+				communication{
+					subject:  "Subject",
+					body:     "Body",
+					telegram: "Telegram",
+					sms: func() string {
+						return "SMS"
+					},
+					notification: entities.Notification{Type: tc.notificationType},
+				},
+			)
+			require.NoError(t, err)
+			require.Equal(t, uint64(1), emailID)
+
+			channels := make([]entities.CommunicationChannel, 0, len(saved))
+			for _, communication := range saved {
+				channels = append(channels, communication.Channel)
+			}
+
+			require.Equal(t, tc.expectedChannels, channels)
+
+			// Only primary communication is sent at once, while others wait for its failure:
+			require.Equal(t, entities.CommunicationStatusPending, saved[0].Status)
+			require.Nil(t, saved[0].FallbackFor)
+
+			for _, communication := range saved[1:] {
+				require.Equal(t, entities.CommunicationStatusStandby, communication.Status)
+				require.Equal(t, pointers.New[uint64](1), communication.FallbackFor)
+				require.Nil(t, communication.NextAttemptAt)
+			}
+
+			for _, communication := range saved {
+				require.Equal(t, tc.notificationType, communication.Type)
+
+				switch communication.Channel {
+				case entities.CommunicationChannelEmail:
+					require.Equal(t, "test@example.com", communication.Recipient)
+					require.Equal(t, "Body", communication.Body)
+				case entities.CommunicationChannelTelegram:
+					require.Equal(t, "100500", communication.Recipient)
+					require.Equal(t, "Telegram", communication.Body)
+				case entities.CommunicationChannelSMS:
+					require.Equal(t, "+79990000000", communication.Recipient)
+					require.Equal(t, "SMS", communication.Body)
+				}
+			}
+		})
+	}
+}
+
+func TestUseCases_Notifications(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	notificationsService := mockservices.NewMockNotificationsService(ctrl)
+	useCases := New(
+		nil,
+		nil,
+		notificationsService,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		interfaces.ContentBuilders{},
+		config.UseCasesConfig{},
+	)
+
+	ctx := context.Background()
+	pagination := &entities.Pagination{Limit: pointers.New[uint64](10)}
+	expected := []entities.Notification{{ID: 1, UserID: 1}}
+
+	notificationsService.
+		EXPECT().
+		GetUserNotifications(gomock.Any(), uint64(1), pagination).
+		Return(expected, nil).
+		Times(1)
+
+	notifications, err := useCases.GetUserNotifications(ctx, 1, pagination)
+	require.NoError(t, err)
+	require.Equal(t, expected, notifications)
+
+	notificationsService.
+		EXPECT().
+		CountUserUnreadNotifications(gomock.Any(), uint64(1)).
+		Return(uint64(1), nil).
+		Times(1)
+
+	count, err := useCases.CountUserUnreadNotifications(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), count)
+
+	notificationsService.
+		EXPECT().
+		MarkNotificationAsRead(gomock.Any(), uint64(1), uint64(1)).
+		Return(nil).
+		Times(1)
+
+	require.NoError(t, useCases.MarkNotificationAsRead(ctx, 1, 1))
+
+	notificationsService.
+		EXPECT().
+		MarkAllNotificationsAsRead(gomock.Any(), uint64(1)).
+		Return(nil).
+		Times(1)
+
+	require.NoError(t, useCases.MarkAllNotificationsAsRead(ctx, 1))
+
+	notificationsService.
+		EXPECT().
+		DeleteNotification(gomock.Any(), uint64(1), uint64(1)).
+		Return(errors.New("db error")).
+		Times(1)
+
+	require.Error(t, useCases.DeleteNotification(ctx, 1, 1))
+}
+
+func TestUseCases_SubscribeToUserNotifications(t *testing.T) {
+	testCases := []struct {
+		name       string
+		lastSeenID *uint64
+		setupMocks func(
+			notificationsService *mockservices.MockNotificationsService,
+			notificationsBroadcaster *mockbroadcasters.MockNotificationsBroadcaster,
+			live chan entities.Notification,
+			unsubscribe func(),
+		)
+		expected             []uint64
+		expectedUnsubscribed bool
+		errorExpected        bool
+	}{
+		{
+			name: "live notifications only",
+			setupMocks: func(
+				_ *mockservices.MockNotificationsService,
+				notificationsBroadcaster *mockbroadcasters.MockNotificationsBroadcaster,
+				live chan entities.Notification,
+				unsubscribe func(),
+			) {
+				notificationsBroadcaster.
+					EXPECT().
+					Subscribe(uint64(1)).
+					Return(live, unsubscribe, nil).
+					Times(1)
+
+				live <- entities.Notification{ID: 5, UserID: 1}
+				close(live)
+			},
+			expected:             []uint64{5},
+			expectedUnsubscribed: true,
+		},
+		{
+			name:       "resumption from last seen notification",
+			lastSeenID: pointers.New[uint64](2),
+			setupMocks: func(
+				notificationsService *mockservices.MockNotificationsService,
+				notificationsBroadcaster *mockbroadcasters.MockNotificationsBroadcaster,
+				live chan entities.Notification,
+				unsubscribe func(),
+			) {
+				notificationsBroadcaster.
+					EXPECT().
+					Subscribe(uint64(1)).
+					Return(live, unsubscribe, nil).
+					Times(1)
+
+				notificationsService.
+					EXPECT().
+					GetUserNotificationsAfterID(gomock.Any(), uint64(1), uint64(2), uint64(10)).
+					Return([]entities.Notification{{ID: 3, UserID: 1}, {ID: 4, UserID: 1}}, nil).
+					Times(1)
+
+				// Notification 4 was created between subscription and reading of missed notifications:
+				live <- entities.Notification{ID: 4, UserID: 1}
+				live <- entities.Notification{ID: 5, UserID: 1}
+				close(live)
+			},
+			expected:             []uint64{3, 4, 5},
+			expectedUnsubscribed: true,
+		},
+		{
+			name:       "missed notifications error",
+			lastSeenID: pointers.New[uint64](2),
+			setupMocks: func(
+				notificationsService *mockservices.MockNotificationsService,
+				notificationsBroadcaster *mockbroadcasters.MockNotificationsBroadcaster,
+				live chan entities.Notification,
+				unsubscribe func(),
+			) {
+				notificationsBroadcaster.
+					EXPECT().
+					Subscribe(uint64(1)).
+					Return(live, unsubscribe, nil).
+					Times(1)
+
+				notificationsService.
+					EXPECT().
+					GetUserNotificationsAfterID(gomock.Any(), uint64(1), uint64(2), uint64(10)).
+					Return(nil, errors.New("db error")).
+					Times(1)
+			},
+			expectedUnsubscribed: true,
+			errorExpected:        true,
+		},
+		{
+			name: "subscribe error",
+			setupMocks: func(
+				_ *mockservices.MockNotificationsService,
+				notificationsBroadcaster *mockbroadcasters.MockNotificationsBroadcaster,
+				_ chan entities.Notification,
+				_ func(),
+			) {
+				notificationsBroadcaster.
+					EXPECT().
+					Subscribe(uint64(1)).
+					Return(nil, nil, errors.New("closed")).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			notificationsService := mockservices.NewMockNotificationsService(ctrl)
+			notificationsBroadcaster := mockbroadcasters.NewMockNotificationsBroadcaster(ctrl)
+			useCases := New(
+				nil,
+				nil,
+				notificationsService,
+				notificationsBroadcaster,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				interfaces.ContentBuilders{},
+				config.UseCasesConfig{StreamResumeLimit: 10},
+			)
+
+			live := make(chan entities.Notification, 2)
+			unsubscribed := false
+
+			if tc.setupMocks != nil {
+				tc.setupMocks(notificationsService, notificationsBroadcaster, live, func() { unsubscribed = true })
+			}
+
+			// Deadline guarantees, that notifications channel is closed even if test case is broken:
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			notifications, err := useCases.SubscribeToUserNotifications(ctx, 1, tc.lastSeenID)
+			if tc.errorExpected {
+				require.Error(t, err)
+				require.Nil(t, notifications)
+				require.Equal(t, tc.expectedUnsubscribed, unsubscribed)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			var actual []uint64
+			for notification := range notifications {
+				actual = append(actual, notification.ID)
+			}
+
+			require.NoError(t, ctx.Err())
+			require.Equal(t, tc.expected, actual)
+
+			// Subscription is closed before closing of notifications channel:
+			require.Equal(t, tc.expectedUnsubscribed, unsubscribed)
 		})
 	}
 }
+
+func TestUseCases_SubscribeToUserNotificationsCanceledContext(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	notificationsBroadcaster := mockbroadcasters.NewMockNotificationsBroadcaster(ctrl)
+	useCases := New(
+		nil,
+		nil,
+		nil,
+		notificationsBroadcaster,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		interfaces.ContentBuilders{},
+		config.UseCasesConfig{},
+	)
+
+	unsubscribed := make(chan struct{})
+
+	notificationsBroadcaster.
+		EXPECT().
+		Subscribe(uint64(1)).
+		Return(make(chan entities.Notification), func() { close(unsubscribed) }, nil).
+		Times(1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	notifications, err := useCases.SubscribeToUserNotifications(ctx, 1, nil)
+	require.NoError(t, err)
+
+	cancel()
+
+	_, ok := <-notifications
+	require.False(t, ok)
+	<-unsubscribed
+}
+
+// newAcceptingNotificationsBroadcaster returns notifications broadcaster, which accepts any notification.
+func newAcceptingNotificationsBroadcaster(ctrl *gomock.Controller) *mockbroadcasters.MockNotificationsBroadcaster {
+	notificationsBroadcaster := mockbroadcasters.NewMockNotificationsBroadcaster(ctrl)
+	notificationsBroadcaster.EXPECT().Publish(gomock.Any()).AnyTimes()
+
+	return notificationsBroadcaster
+}
+
+// newAcceptingNotificationsService returns notifications service, which successfully saves any notification.
+// Used in tests, which do not check in-app notifications.
+func newAcceptingNotificationsService(ctrl *gomock.Controller) *mockservices.MockNotificationsService {
+	notificationsService := mockservices.NewMockNotificationsService(ctrl)
+	notificationsService.
+		EXPECT().
+		SaveNotification(gomock.Any(), gomock.Any()).
+		Return(uint64(1), nil).
+		AnyTimes()
+
+	return notificationsService
+}
+
+// newAcceptingInboxContentBuilders returns in-app notifications content builders, which accept any calls.
+func newAcceptingInboxContentBuilders(ctrl *gomock.Controller) interfaces.InboxContentBuilders {
+	verifyEmail := mockcontentbuilders.NewMockVerifyEmailInboxContentBuilder(ctrl)
+	verifyEmail.EXPECT().Title(gomock.Any()).AnyTimes()
+	verifyEmail.EXPECT().Text(gomock.Any()).AnyTimes()
+	verifyEmail.EXPECT().Link().AnyTimes()
+
+	forgetPassword := mockcontentbuilders.NewMockForgetPasswordInboxContentBuilder(ctrl)
+	forgetPassword.EXPECT().Title(gomock.Any()).AnyTimes()
+	forgetPassword.EXPECT().Text(gomock.Any()).AnyTimes()
+	forgetPassword.EXPECT().Link().AnyTimes()
+
+	ticketUpdated := mockcontentbuilders.NewMockTicketUpdatedInboxContentBuilder(ctrl)
+	ticketUpdated.EXPECT().Title(gomock.Any(), gomock.Any()).AnyTimes()
+	ticketUpdated.EXPECT().Text(gomock.Any(), gomock.Any()).AnyTimes()
+	ticketUpdated.EXPECT().Link(gomock.Any()).AnyTimes()
+
+	ticketDeleted := mockcontentbuilders.NewMockTicketDeletedInboxContentBuilder(ctrl)
+	ticketDeleted.EXPECT().Title(gomock.Any(), gomock.Any()).AnyTimes()
+	ticketDeleted.EXPECT().Text(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	ticketDeleted.EXPECT().Link(gomock.Any(), gomock.Any()).AnyTimes()
+
+	return interfaces.InboxContentBuilders{
+		VerifyEmail:    verifyEmail,
+		ForgetPassword: forgetPassword,
+		TicketUpdated:  ticketUpdated,
+		TicketDeleted:  ticketDeleted,
+	}
+}
+
+// Signed token, returned by stub token signer:
+const testToken = "token"
+
+var testTokensTTL = config.TokensTTLConfig{
+	entities.TokenPurposeVerifyEmail:    time.Hour,
+	entities.TokenPurposeForgetPassword: time.Hour,
+}
+
+// newAcceptingTokensService returns tokens service, which successfully saves any token.
+// Used in tests, which do not check issued tokens.
+func newAcceptingTokensService(ctrl *gomock.Controller) *mockservices.MockTokensService {
+	tokensService := mockservices.NewMockTokensService(ctrl)
+	tokensService.
+		EXPECT().
+		SaveToken(gomock.Any(), gomock.Any()).
+		Return(uint64(1), nil).
+		AnyTimes()
+
+	return tokensService
+}
+
+// newStubTokenSigner returns token signer, which signs any claims as testToken.
+func newStubTokenSigner(ctrl *gomock.Controller) *mocksigners.MockTokenSigner {
+	tokenSigner := mocksigners.NewMockTokenSigner(ctrl)
+	tokenSigner.
+		EXPECT().
+		Sign(gomock.Any()).
+		Return(testToken, nil).
+		AnyTimes()
+
+	return tokenSigner
+}
+
+// testUseCasesDeps contains dependencies of use cases under test. Dependencies, which are not set, stay nil.
+type testUseCasesDeps struct {
+	communicationsService    interfaces.CommunicationsService
+	processedMessagesService interfaces.ProcessedMessagesService
+	notificationsService     interfaces.NotificationsService
+	notificationsBroadcaster interfaces.NotificationsBroadcaster
+	webhooksService          interfaces.WebhooksService
+	tokensService            interfaces.TokensService
+	tokenSigner              interfaces.TokenSigner
+	templatesService         interfaces.TemplatesService
+	ssoService               interfaces.SsoService
+	toysService              interfaces.ToysService
+	ticketsService           interfaces.TicketsService
+	contentBuilders          interfaces.ContentBuilders
+	config                   config.UseCasesConfig
+}
+
+// newTestUseCases returns use cases with provided dependencies.
+func newTestUseCases(deps testUseCasesDeps) *UseCases {
+	return New(
+		deps.communicationsService,
+		deps.processedMessagesService,
+		deps.notificationsService,
+		deps.notificationsBroadcaster,
+		deps.webhooksService,
+		deps.tokensService,
+		deps.tokenSigner,
+		deps.templatesService,
+		deps.ssoService,
+		deps.toysService,
+		deps.ticketsService,
+		deps.contentBuilders,
+		deps.config,
+	)
+}
@@ -0,0 +1,161 @@
+package usecases
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-notifications/internal/errors"
+)
+
+// Size of webhook secret in bytes before hex encoding:
+const webhookSecretSize = 32
+
+// CreateWebhook registers webhook for provided event types and returns it with generated secret. Secret is not
+// returned by other methods, so it should be saved by caller to verify signatures of deliveries.
+func (useCases *UseCases) CreateWebhook(
+	ctx context.Context,
+	webhookURL string,
+	eventTypes []entities.NotificationType,
+) (*entities.Webhook, error) {
+	if err := validateWebhook(webhookURL, eventTypes); err != nil {
+		return nil, err
+	}
+
+	secret, err := generateWebhookSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	webhook := entities.Webhook{
+		URL:        webhookURL,
+		Secret:     secret,
+		EventTypes: eventTypes,
+		Enabled:    true,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+
+	if webhook.ID, err = useCases.webhooksService.SaveWebhook(ctx, webhook); err != nil {
+		return nil, err
+	}
+
+	return &webhook, nil
+}
+
+func (useCases *UseCases) GetWebhooks(ctx context.Context) ([]entities.Webhook, error) {
+	return useCases.webhooksService.GetWebhooks(ctx)
+}
+
+// UpdateWebhook updates URL, event types and enabled flag of webhook. Enabling of webhook, which was disabled
+// after failed deliveries, gives it the same number of failures before next disabling as a new webhook has.
+func (useCases *UseCases) UpdateWebhook(ctx context.Context, webhook entities.Webhook) error {
+	if err := validateWebhook(webhook.URL, webhook.EventTypes); err != nil {
+		return err
+	}
+
+	return useCases.webhooksService.UpdateWebhook(ctx, webhook)
+}
+
+func (useCases *UseCases) DeleteWebhook(ctx context.Context, id uint64) error {
+	return useCases.webhooksService.DeleteWebhook(ctx, id)
+}
+
+func (useCases *UseCases) GetWebhookDeliveries(
+	ctx context.Context,
+	webhookID uint64,
+	pagination *entities.Pagination,
+) ([]entities.WebhookDelivery, error) {
+	if _, err := useCases.webhooksService.GetWebhookByID(ctx, webhookID); err != nil {
+		return nil, err
+	}
+
+	return useCases.webhooksService.GetWebhookDeliveries(ctx, webhookID, pagination)
+}
+
+// enqueueWebhookDeliveries saves pending delivery of created notification for every enabled webhook,
+// subscribed to its type. Deliveries are sent asynchronously by webhooks dispatcher.
+func (useCases *UseCases) enqueueWebhookDeliveries(ctx context.Context, notification entities.Notification) error {
+	webhooks, err := useCases.webhooksService.GetEnabledWebhooks(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, webhook := range webhooks {
+		if !webhook.Subscribed(notification.Type) {
+			continue
+		}
+
+		now := time.Now().UTC()
+		event := entities.WebhookEvent{
+			ID:           uuid.NewString(),
+			Type:         notification.Type,
+			CreatedAt:    now,
+			Notification: notification,
+		}
+
+		payload, err := json.Marshal(event)
+		if err != nil {
+			return err
+		}
+
+		if _, err = useCases.webhooksService.SaveWebhookDelivery(
+			ctx,
+			entities.WebhookDelivery{
+				WebhookID:     webhook.ID,
+				DeliveryID:    event.ID,
+				EventType:     event.Type,
+				Payload:       string(payload),
+				Status:        entities.WebhookDeliveryStatusPending,
+				NextAttemptAt: &now,
+				CreatedAt:     now,
+			},
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateWebhook checks, that events could be delivered to webhook URL and that all event types are known.
+func validateWebhook(webhookURL string, eventTypes []entities.NotificationType) error {
+	parsedURL, err := url.Parse(webhookURL)
+	if err != nil {
+		return &customerrors.InvalidWebhookError{BaseErr: err}
+	}
+
+	if (parsedURL.Scheme != "http" && parsedURL.Scheme != "https") || parsedURL.Host == "" {
+		return &customerrors.InvalidWebhookError{
+			Message: fmt.Sprintf("webhook URL %q must be absolute HTTP(S) URL", webhookURL),
+		}
+	}
+
+	for _, eventType := range eventTypes {
+		if !slices.Contains(entities.NotificationTypes, eventType) {
+			return &customerrors.InvalidWebhookError{
+				Message: fmt.Sprintf("unknown webhook event type %q", eventType),
+			}
+		}
+	}
+
+	return nil
+}
+
+func generateWebhookSecret() (string, error) {
+	secret := make([]byte, webhookSecretSize)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(secret), nil
+}
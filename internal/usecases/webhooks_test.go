@@ -0,0 +1,208 @@
+package usecases
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/DKhorkov/hmtm-notifications/internal/config"
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-notifications/internal/errors"
+	"github.com/DKhorkov/hmtm-notifications/internal/interfaces"
+	mockservices "github.com/DKhorkov/hmtm-notifications/mocks/services"
+)
+
+func TestUseCases_CreateWebhook(t *testing.T) {
+	testCases := []struct {
+		name          string
+		url           string
+		eventTypes    []entities.NotificationType
+		setupMocks    func(webhooksService *mockservices.MockWebhooksService)
+		errorExpected bool
+	}{
+		{
+			name:       "success",
+			url:        "https://example.com/hooks",
+			eventTypes: []entities.NotificationType{entities.NotificationTypeTicketUpdated},
+			setupMocks: func(webhooksService *mockservices.MockWebhooksService) {
+				webhooksService.
+					EXPECT().
+					SaveWebhook(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, webhook entities.Webhook) (uint64, error) {
+						require.Equal(t, "https://example.com/hooks", webhook.URL)
+						require.True(t, webhook.Enabled)
+						require.Len(t, webhook.Secret, webhookSecretSize*2)
+
+						return 1, nil
+					}).
+					Times(1)
+			},
+		},
+		{
+			name:          "relative url",
+			url:           "/hooks",
+			errorExpected: true,
+		},
+		{
+			name:          "not http url",
+			url:           "ftp://example.com/hooks",
+			errorExpected: true,
+		},
+		{
+			name:          "unknown event type",
+			url:           "https://example.com/hooks",
+			eventTypes:    []entities.NotificationType{"unknown"},
+			errorExpected: true,
+		},
+		{
+			name: "save error",
+			url:  "https://example.com/hooks",
+			setupMocks: func(webhooksService *mockservices.MockWebhooksService) {
+				webhooksService.
+					EXPECT().
+					SaveWebhook(gomock.Any(), gomock.Any()).
+					Return(uint64(0), errors.New("error")).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			webhooksService := mockservices.NewMockWebhooksService(ctrl)
+			useCases := New(
+				nil,
+				nil,
+				nil,
+				nil,
+				webhooksService,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				interfaces.ContentBuilders{},
+				config.UseCasesConfig{},
+			)
+
+			if tc.setupMocks != nil {
+				tc.setupMocks(webhooksService)
+			}
+
+			webhook, err := useCases.CreateWebhook(context.Background(), tc.url, tc.eventTypes)
+			if tc.errorExpected {
+				require.Error(t, err)
+				require.Nil(t, webhook)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, uint64(1), webhook.ID)
+			require.NotEmpty(t, webhook.Secret)
+		})
+	}
+}
+
+func TestUseCases_UpdateWebhookInvalid(t *testing.T) {
+	useCases := New(
+		nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+		interfaces.ContentBuilders{},
+		config.UseCasesConfig{},
+	)
+
+	err := useCases.UpdateWebhook(context.Background(), entities.Webhook{ID: 1, URL: "not a url"})
+	require.ErrorAs(t, err, new(*customerrors.InvalidWebhookError))
+}
+
+func TestUseCases_sendCommunicationWebhooks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	communicationsService := mockservices.NewMockCommunicationsService(ctrl)
+	webhooksService := mockservices.NewMockWebhooksService(ctrl)
+	useCases := New(
+		communicationsService,
+		nil,
+		newAcceptingNotificationsService(ctrl),
+		newAcceptingNotificationsBroadcaster(ctrl),
+		webhooksService,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		interfaces.ContentBuilders{},
+		config.UseCasesConfig{WebhooksEnabled: true},
+	)
+
+	webhooksService.
+		EXPECT().
+		GetEnabledWebhooks(gomock.Any()).
+		Return(
+			[]entities.Webhook{
+				{ID: 1, Enabled: true},
+				{ID: 2, Enabled: true, EventTypes: []entities.NotificationType{entities.NotificationTypeTicketDeleted}},
+				{
+					ID:         3,
+					Enabled:    true,
+					EventTypes: []entities.NotificationType{entities.NotificationTypeVerifyEmail},
+				},
+			},
+			nil,
+		).
+		Times(1)
+
+	var deliveries []entities.WebhookDelivery
+
+	webhooksService.
+		EXPECT().
+		SaveWebhookDelivery(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, delivery entities.WebhookDelivery) (uint64, error) {
+			deliveries = append(deliveries, delivery)
+
+			return uint64(len(deliveries)), nil
+		}).
+		Times(2)
+
+	communicationsService.
+		EXPECT().
+		SaveCommunication(gomock.Any(), gomock.Any()).
+		Return(uint64(1), nil).
+		Times(1)
+
+	_, err := useCases.sendCommunication(
+		context.Background(),
+		entities.User{ID: 7, Email: "test@example.com"},
+		communication{
+			subject:      "Subject",
+			body:         "Body",
+			notification: entities.Notification{Type: entities.NotificationTypeVerifyEmail, Title: "Title"},
+		},
+	)
+	require.NoError(t, err)
+
+	// Webhook, subscribed only to ticket deletions, does not receive email verification event:
+	require.Len(t, deliveries, 2)
+	require.Equal(t, uint64(1), deliveries[0].WebhookID)
+	require.Equal(t, uint64(3), deliveries[1].WebhookID)
+	require.NotEqual(t, deliveries[0].DeliveryID, deliveries[1].DeliveryID)
+
+	for _, delivery := range deliveries {
+		require.Equal(t, entities.WebhookDeliveryStatusPending, delivery.Status)
+		require.Equal(t, entities.NotificationTypeVerifyEmail, delivery.EventType)
+		require.NotNil(t, delivery.NextAttemptAt)
+
+		var event entities.WebhookEvent
+		require.NoError(t, json.Unmarshal([]byte(delivery.Payload), &event))
+		require.Equal(t, delivery.DeliveryID, event.ID)
+		require.Equal(t, uint64(7), event.Notification.UserID)
+		require.Equal(t, "Title", event.Notification.Title)
+	}
+}
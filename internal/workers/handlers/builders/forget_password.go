@@ -7,7 +7,7 @@ import (
 
 	"github.com/DKhorkov/libs/logging"
 	"github.com/DKhorkov/libs/tracing"
-	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
 
 	"github.com/DKhorkov/hmtm-notifications/dto"
 	"github.com/DKhorkov/hmtm-notifications/internal/interfaces"
@@ -37,7 +37,7 @@ func NewForgetPasswordBuilder(
 }
 
 func (b *ForgetPasswordBuilder) MessageHandler() handlers.MessageHandler {
-	return func(message *nats.Msg) {
+	return func(message jetstream.Msg) error {
 		ctx, span := b.traceProvider.Span(
 			context.Background(),
 			tracing.CallerName(tracing.DefaultSkipLevel),
@@ -49,14 +49,14 @@ func (b *ForgetPasswordBuilder) MessageHandler() handlers.MessageHandler {
 
 		ctx = helpers.AddTraceIDToContext(ctx, span)
 
-		forgetPasswordDTO := b.natsMessageToDTO(message)
-		if forgetPasswordDTO == nil {
-			return
+		forgetPasswordDTO, err := b.natsMessageToDTO(message)
+		if err != nil {
+			return err
 		}
 
-		if _, err := b.useCases.SendForgetPasswordEmailCommunication(
+		if _, err = b.useCases.SendForgetPasswordEmailCommunication(
 			ctx,
-			forgetPasswordDTO.UserID,
+			*forgetPasswordDTO,
 		); err != nil {
 			logging.LogError(
 				b.logger,
@@ -66,17 +66,26 @@ func (b *ForgetPasswordBuilder) MessageHandler() handlers.MessageHandler {
 				),
 				err,
 			)
+
+			return err
 		}
+
+		return nil
 	}
 }
 
-func (b *ForgetPasswordBuilder) natsMessageToDTO(message *nats.Msg) *dto.ForgetPasswordDTO {
+func (b *ForgetPasswordBuilder) natsMessageToDTO(message jetstream.Msg) (*dto.ForgetPasswordDTO, error) {
 	var forgetPasswordDTO dto.ForgetPasswordDTO
-	if err := json.Unmarshal(message.Data, &forgetPasswordDTO); err != nil {
+	if err := json.Unmarshal(message.Data(), &forgetPasswordDTO); err != nil {
 		logging.LogError(b.logger, "Failed to unmarshal forget-password message", err)
 
-		return nil
+		return nil, &handlers.PoisonMessageError{BaseErr: err}
+	}
+
+	// Without idempotency key partially processed message would be reprocessed for all recipients on redelivery:
+	if forgetPasswordDTO.IdempotencyKey == "" {
+		forgetPasswordDTO.IdempotencyKey = helpers.MessageIdempotencyKey(message)
 	}
 
-	return &forgetPasswordDTO
+	return &forgetPasswordDTO, nil
 }
@@ -6,7 +6,7 @@ import (
 
 	"github.com/DKhorkov/libs/logging"
 	"github.com/DKhorkov/libs/tracing"
-	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
 
 	"github.com/DKhorkov/hmtm-notifications/dto"
 	"github.com/DKhorkov/hmtm-notifications/internal/interfaces"
@@ -36,7 +36,7 @@ func NewTicketDeletedBuilder(
 }
 
 func (b *TicketDeletedBuilder) MessageHandler() handlers.MessageHandler {
-	return func(message *nats.Msg) {
+	return func(message jetstream.Msg) error {
 		ctx, span := b.traceProvider.Span(
 			context.Background(),
 			tracing.CallerName(tracing.DefaultSkipLevel),
@@ -48,12 +48,12 @@ func (b *TicketDeletedBuilder) MessageHandler() handlers.MessageHandler {
 
 		ctx = helpers.AddTraceIDToContext(ctx, span)
 
-		ticketDeletedDTO := b.natsMessageToDTO(message)
-		if ticketDeletedDTO == nil {
-			return
+		ticketDeletedDTO, err := b.natsMessageToDTO(message)
+		if err != nil {
+			return err
 		}
 
-		if _, err := b.useCases.SendTicketDeletedEmailCommunication(
+		if _, err = b.useCases.SendTicketDeletedEmailCommunication(
 			ctx,
 			*ticketDeletedDTO,
 		); err != nil {
@@ -62,17 +62,26 @@ func (b *TicketDeletedBuilder) MessageHandler() handlers.MessageHandler {
 				"Failed to send delete-ticket message",
 				err,
 			)
+
+			return err
 		}
+
+		return nil
 	}
 }
 
-func (b *TicketDeletedBuilder) natsMessageToDTO(message *nats.Msg) *dto.TicketDeletedDTO {
+func (b *TicketDeletedBuilder) natsMessageToDTO(message jetstream.Msg) (*dto.TicketDeletedDTO, error) {
 	var ticketDeletedDTO dto.TicketDeletedDTO
-	if err := json.Unmarshal(message.Data, &ticketDeletedDTO); err != nil {
+	if err := json.Unmarshal(message.Data(), &ticketDeletedDTO); err != nil {
 		logging.LogError(b.logger, "Failed to unmarshal delete-ticket message", err)
 
-		return nil
+		return nil, &handlers.PoisonMessageError{BaseErr: err}
+	}
+
+	// Without idempotency key partially processed message would be reprocessed for all recipients on redelivery:
+	if ticketDeletedDTO.IdempotencyKey == "" {
+		ticketDeletedDTO.IdempotencyKey = helpers.MessageIdempotencyKey(message)
 	}
 
-	return &ticketDeletedDTO
+	return &ticketDeletedDTO, nil
 }
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
@@ -15,6 +16,8 @@ import (
 	mocktracing "github.com/DKhorkov/libs/tracing/mocks"
 
 	"github.com/DKhorkov/hmtm-notifications/dto"
+	"github.com/DKhorkov/hmtm-notifications/internal/workers/handlers"
+	mocknats "github.com/DKhorkov/hmtm-notifications/mocks/nats"
 	mockusecases "github.com/DKhorkov/hmtm-notifications/mocks/usecases"
 )
 
@@ -32,15 +35,14 @@ func TestTicketDeletedBuilder_MessageHandler(t *testing.T) {
 	)
 
 	testCases := []struct {
-		name       string
-		message    *nats.Msg
-		setupMocks func(useCases *mockusecases.MockUseCases, traceProvider *mocktracing.MockProvider, logger *mocklogging.MockLogger)
+		name          string
+		data          []byte
+		errorExpected bool
+		setupMocks    func(useCases *mockusecases.MockUseCases, traceProvider *mocktracing.MockProvider, logger *mocklogging.MockLogger)
 	}{
 		{
 			name: "successful processing",
-			message: &nats.Msg{
-				Data: []byte(`{"name":"Teddy Bear","description":"Soft toy","quantity":5,"price":150.75}`),
-			},
+			data: []byte(`{"name":"Teddy Bear","description":"Soft toy","quantity":5,"price":150.75}`),
 			setupMocks: func(useCases *mockusecases.MockUseCases, traceProvider *mocktracing.MockProvider, logger *mocklogging.MockLogger) {
 				traceProvider.
 					EXPECT().
@@ -49,10 +51,11 @@ func TestTicketDeletedBuilder_MessageHandler(t *testing.T) {
 					Times(1)
 
 				ticketDeletedDTO := dto.TicketDeletedDTO{
-					Name:        "Teddy Bear",
-					Description: "Soft toy",
-					Quantity:    5,
-					Price:       pointers.New[float32](150.75),
+					Name:           "Teddy Bear",
+					Description:    "Soft toy",
+					Quantity:       5,
+					Price:          pointers.New[float32](150.75),
+					IdempotencyKey: "msg-id",
 				}
 
 				useCases.
@@ -63,10 +66,9 @@ func TestTicketDeletedBuilder_MessageHandler(t *testing.T) {
 			},
 		},
 		{
-			name: "invalid message data",
-			message: &nats.Msg{
-				Data: []byte(`{invalid json}`),
-			},
+			name:          "invalid message data",
+			data:          []byte(`{invalid json}`),
+			errorExpected: true,
 			setupMocks: func(useCases *mockusecases.MockUseCases, traceProvider *mocktracing.MockProvider, logger *mocklogging.MockLogger) {
 				traceProvider.
 					EXPECT().
@@ -81,10 +83,9 @@ func TestTicketDeletedBuilder_MessageHandler(t *testing.T) {
 			},
 		},
 		{
-			name: "use case error",
-			message: &nats.Msg{
-				Data: []byte(`{"name":"Wooden Car","description":"Toy car","quantity":1}`),
-			},
+			name:          "use case error",
+			data:          []byte(`{"name":"Wooden Car","description":"Toy car","quantity":1}`),
+			errorExpected: true,
 			setupMocks: func(useCases *mockusecases.MockUseCases, traceProvider *mocktracing.MockProvider, logger *mocklogging.MockLogger) {
 				traceProvider.
 					EXPECT().
@@ -93,10 +94,11 @@ func TestTicketDeletedBuilder_MessageHandler(t *testing.T) {
 					Times(1)
 
 				ticketDeletedDTO := dto.TicketDeletedDTO{
-					Name:        "Wooden Car",
-					Description: "Toy car",
-					Quantity:    1,
-					Price:       nil,
+					Name:           "Wooden Car",
+					Description:    "Toy car",
+					Quantity:       1,
+					Price:          nil,
+					IdempotencyKey: "msg-id",
 				}
 
 				useCases.
@@ -116,8 +118,26 @@ func TestTicketDeletedBuilder_MessageHandler(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			tc.setupMocks(useCases, traceProvider, logger)
+			message := mocknats.NewMockMsg(ctrl)
+			message.
+				EXPECT().
+				Data().
+				Return(tc.data).
+				AnyTimes()
+
+			message.
+				EXPECT().
+				Headers().
+				Return(nats.Header{jetstream.MsgIDHeader: []string{"msg-id"}}).
+				AnyTimes()
+
 			handler := builder.MessageHandler()
-			handler(tc.message)
+			err := handler(message)
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
 		})
 	}
 }
@@ -136,30 +156,39 @@ func TestTicketDeletedBuilder_natsMessageToDTO(t *testing.T) {
 	)
 
 	testCases := []struct {
-		name        string
-		message     *nats.Msg
-		expectedDTO *dto.TicketDeletedDTO
-		setupMocks  func(logger *mocklogging.MockLogger)
+		name          string
+		data          []byte
+		errorExpected bool
+		expectedDTO   *dto.TicketDeletedDTO
+		setupMocks    func(logger *mocklogging.MockLogger)
 	}{
 		{
 			name: "valid message",
-			message: &nats.Msg{
-				Data: []byte(`{"name":"Teddy Bear","description":"Soft toy","quantity":5,"price":150.75}`),
-			},
+			data: []byte(`{"name":"Teddy Bear","description":"Soft toy","quantity":5,"price":150.75}`),
 			expectedDTO: &dto.TicketDeletedDTO{
-				Name:        "Teddy Bear",
-				Description: "Soft toy",
-				Quantity:    5,
-				Price:       pointers.New[float32](150.75),
+				Name:           "Teddy Bear",
+				Description:    "Soft toy",
+				Quantity:       5,
+				Price:          pointers.New[float32](150.75),
+				IdempotencyKey: "msg-id",
 			},
 			setupMocks: func(logger *mocklogging.MockLogger) {},
 		},
 		{
-			name: "invalid message",
-			message: &nats.Msg{
-				Data: []byte(`{invalid json}`),
+			name: "message with idempotency key",
+			data: []byte(`{"name":"Teddy Bear","quantity":5,"idempotencyKey":"key"}`),
+			expectedDTO: &dto.TicketDeletedDTO{
+				Name:           "Teddy Bear",
+				Quantity:       5,
+				IdempotencyKey: "key",
 			},
-			expectedDTO: nil,
+			setupMocks: func(logger *mocklogging.MockLogger) {},
+		},
+		{
+			name:          "invalid message",
+			data:          []byte(`{invalid json}`),
+			errorExpected: true,
+			expectedDTO:   nil,
 			setupMocks: func(logger *mocklogging.MockLogger) {
 				logger.
 					EXPECT().
@@ -168,11 +197,10 @@ func TestTicketDeletedBuilder_natsMessageToDTO(t *testing.T) {
 			},
 		},
 		{
-			name: "empty message",
-			message: &nats.Msg{
-				Data: []byte(``),
-			},
-			expectedDTO: nil,
+			name:          "empty message",
+			data:          []byte(``),
+			errorExpected: true,
+			expectedDTO:   nil,
 			setupMocks: func(logger *mocklogging.MockLogger) {
 				logger.
 					EXPECT().
@@ -185,7 +213,27 @@ func TestTicketDeletedBuilder_natsMessageToDTO(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			tc.setupMocks(logger)
-			result := builder.natsMessageToDTO(tc.message)
+			message := mocknats.NewMockMsg(ctrl)
+			message.
+				EXPECT().
+				Data().
+				Return(tc.data).
+				AnyTimes()
+
+			message.
+				EXPECT().
+				Headers().
+				Return(nats.Header{jetstream.MsgIDHeader: []string{"msg-id"}}).
+				AnyTimes()
+
+			result, err := builder.natsMessageToDTO(message)
+			if tc.errorExpected {
+				var poisonMessageError *handlers.PoisonMessageError
+				require.ErrorAs(t, err, &poisonMessageError)
+			} else {
+				require.NoError(t, err)
+			}
+
 			require.Equal(t, tc.expectedDTO, result)
 		})
 	}
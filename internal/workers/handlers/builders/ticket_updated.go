@@ -7,7 +7,7 @@ import (
 
 	"github.com/DKhorkov/libs/logging"
 	"github.com/DKhorkov/libs/tracing"
-	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
 
 	"github.com/DKhorkov/hmtm-notifications/dto"
 	"github.com/DKhorkov/hmtm-notifications/internal/interfaces"
@@ -37,7 +37,7 @@ func NewTicketUpdatedBuilder(
 }
 
 func (b *TicketUpdatedBuilder) MessageHandler() handlers.MessageHandler {
-	return func(message *nats.Msg) {
+	return func(message jetstream.Msg) error {
 		ctx, span := b.traceProvider.Span(
 			context.Background(),
 			tracing.CallerName(tracing.DefaultSkipLevel),
@@ -49,14 +49,14 @@ func (b *TicketUpdatedBuilder) MessageHandler() handlers.MessageHandler {
 
 		ctx = helpers.AddTraceIDToContext(ctx, span)
 
-		ticketUpdatedDTO := b.natsMessageToDTO(message)
-		if ticketUpdatedDTO == nil {
-			return
+		ticketUpdatedDTO, err := b.natsMessageToDTO(message)
+		if err != nil {
+			return err
 		}
 
-		if _, err := b.useCases.SendTicketUpdatedEmailCommunication(
+		if _, err = b.useCases.SendTicketUpdatedEmailCommunication(
 			ctx,
-			ticketUpdatedDTO.TicketID,
+			*ticketUpdatedDTO,
 		); err != nil {
 			logging.LogError(
 				b.logger,
@@ -66,17 +66,26 @@ func (b *TicketUpdatedBuilder) MessageHandler() handlers.MessageHandler {
 				),
 				err,
 			)
+
+			return err
 		}
+
+		return nil
 	}
 }
 
-func (b *TicketUpdatedBuilder) natsMessageToDTO(message *nats.Msg) *dto.TicketUpdatedDTO {
+func (b *TicketUpdatedBuilder) natsMessageToDTO(message jetstream.Msg) (*dto.TicketUpdatedDTO, error) {
 	var ticketUpdatedDTO dto.TicketUpdatedDTO
-	if err := json.Unmarshal(message.Data, &ticketUpdatedDTO); err != nil {
+	if err := json.Unmarshal(message.Data(), &ticketUpdatedDTO); err != nil {
 		logging.LogError(b.logger, "Failed to unmarshal update-ticket message", err)
 
-		return nil
+		return nil, &handlers.PoisonMessageError{BaseErr: err}
+	}
+
+	// Without idempotency key partially processed message would be reprocessed for all recipients on redelivery:
+	if ticketUpdatedDTO.IdempotencyKey == "" {
+		ticketUpdatedDTO.IdempotencyKey = helpers.MessageIdempotencyKey(message)
 	}
 
-	return &ticketUpdatedDTO
+	return &ticketUpdatedDTO, nil
 }
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
@@ -14,6 +15,9 @@ import (
 	mocktracing "github.com/DKhorkov/libs/tracing/mocks"
 
 	"github.com/DKhorkov/hmtm-notifications/dto"
+	"github.com/DKhorkov/hmtm-notifications/internal/entities"
+	"github.com/DKhorkov/hmtm-notifications/internal/workers/handlers"
+	mocknats "github.com/DKhorkov/hmtm-notifications/mocks/nats"
 	mockusecases "github.com/DKhorkov/hmtm-notifications/mocks/usecases"
 )
 
@@ -31,15 +35,14 @@ func TestTicketUpdatedBuilder_MessageHandler(t *testing.T) {
 	)
 
 	testCases := []struct {
-		name       string
-		message    *nats.Msg
-		setupMocks func(useCases *mockusecases.MockUseCases, traceProvider *mocktracing.MockProvider, logger *mocklogging.MockLogger)
+		name          string
+		data          []byte
+		errorExpected bool
+		setupMocks    func(useCases *mockusecases.MockUseCases, traceProvider *mocktracing.MockProvider, logger *mocklogging.MockLogger)
 	}{
 		{
 			name: "successful processing",
-			message: &nats.Msg{
-				Data: []byte(`{"ticketId":123}`),
-			},
+			data: []byte(`{"ticketId":123}`),
 			setupMocks: func(useCases *mockusecases.MockUseCases, traceProvider *mocktracing.MockProvider, logger *mocklogging.MockLogger) {
 				traceProvider.
 					EXPECT().
@@ -49,16 +52,15 @@ func TestTicketUpdatedBuilder_MessageHandler(t *testing.T) {
 
 				useCases.
 					EXPECT().
-					SendTicketUpdatedEmailCommunication(gomock.Any(), uint64(123)).
-					Return([]uint64{1}, nil).
+					SendTicketUpdatedEmailCommunication(gomock.Any(), dto.TicketUpdatedDTO{TicketID: 123, IdempotencyKey: "msg-id"}).
+					Return(&entities.FanOutResult{}, nil).
 					Times(1)
 			},
 		},
 		{
-			name: "invalid message data",
-			message: &nats.Msg{
-				Data: []byte(`{invalid json}`),
-			},
+			name:          "invalid message data",
+			data:          []byte(`{invalid json}`),
+			errorExpected: true,
 			setupMocks: func(useCases *mockusecases.MockUseCases, traceProvider *mocktracing.MockProvider, logger *mocklogging.MockLogger) {
 				traceProvider.
 					EXPECT().
@@ -73,10 +75,9 @@ func TestTicketUpdatedBuilder_MessageHandler(t *testing.T) {
 			},
 		},
 		{
-			name: "use case error",
-			message: &nats.Msg{
-				Data: []byte(`{"ticketId":456}`),
-			},
+			name:          "use case error",
+			data:          []byte(`{"ticketId":456}`),
+			errorExpected: true,
 			setupMocks: func(useCases *mockusecases.MockUseCases, traceProvider *mocktracing.MockProvider, logger *mocklogging.MockLogger) {
 				traceProvider.
 					EXPECT().
@@ -86,7 +87,7 @@ func TestTicketUpdatedBuilder_MessageHandler(t *testing.T) {
 
 				useCases.
 					EXPECT().
-					SendTicketUpdatedEmailCommunication(gomock.Any(), uint64(456)).
+					SendTicketUpdatedEmailCommunication(gomock.Any(), dto.TicketUpdatedDTO{TicketID: 456, IdempotencyKey: "msg-id"}).
 					Return(nil, errors.New("test")).
 					Times(1)
 
@@ -101,8 +102,26 @@ func TestTicketUpdatedBuilder_MessageHandler(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			tc.setupMocks(useCases, traceProvider, logger)
+			message := mocknats.NewMockMsg(ctrl)
+			message.
+				EXPECT().
+				Data().
+				Return(tc.data).
+				AnyTimes()
+
+			message.
+				EXPECT().
+				Headers().
+				Return(nats.Header{jetstream.MsgIDHeader: []string{"msg-id"}}).
+				AnyTimes()
+
 			handler := builder.MessageHandler()
-			handler(tc.message)
+			err := handler(message)
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
 		})
 	}
 }
@@ -121,27 +140,35 @@ func TestTicketUpdatedBuilder_natsMessageToDTO(t *testing.T) {
 	)
 
 	testCases := []struct {
-		name        string
-		message     *nats.Msg
-		expectedDTO *dto.TicketUpdatedDTO
-		setupMocks  func(logger *mocklogging.MockLogger)
+		name          string
+		data          []byte
+		errorExpected bool
+		expectedDTO   *dto.TicketUpdatedDTO
+		setupMocks    func(logger *mocklogging.MockLogger)
 	}{
 		{
 			name: "valid message",
-			message: &nats.Msg{
-				Data: []byte(`{"ticketId":123}`),
-			},
+			data: []byte(`{"ticketId":123}`),
 			expectedDTO: &dto.TicketUpdatedDTO{
-				TicketID: 123,
+				TicketID:       123,
+				IdempotencyKey: "msg-id",
 			},
 			setupMocks: func(logger *mocklogging.MockLogger) {},
 		},
 		{
-			name: "invalid message",
-			message: &nats.Msg{
-				Data: []byte(`{invalid json}`),
+			name: "message with idempotency key",
+			data: []byte(`{"ticketId":123,"idempotencyKey":"key"}`),
+			expectedDTO: &dto.TicketUpdatedDTO{
+				TicketID:       123,
+				IdempotencyKey: "key",
 			},
-			expectedDTO: nil,
+			setupMocks: func(logger *mocklogging.MockLogger) {},
+		},
+		{
+			name:          "invalid message",
+			data:          []byte(`{invalid json}`),
+			errorExpected: true,
+			expectedDTO:   nil,
 			setupMocks: func(logger *mocklogging.MockLogger) {
 				logger.
 					EXPECT().
@@ -150,11 +177,10 @@ func TestTicketUpdatedBuilder_natsMessageToDTO(t *testing.T) {
 			},
 		},
 		{
-			name: "empty message",
-			message: &nats.Msg{
-				Data: []byte(``),
-			},
-			expectedDTO: nil,
+			name:          "empty message",
+			data:          []byte(``),
+			errorExpected: true,
+			expectedDTO:   nil,
 			setupMocks: func(logger *mocklogging.MockLogger) {
 				logger.
 					EXPECT().
@@ -167,7 +193,27 @@ func TestTicketUpdatedBuilder_natsMessageToDTO(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			tc.setupMocks(logger)
-			result := builder.natsMessageToDTO(tc.message)
+			message := mocknats.NewMockMsg(ctrl)
+			message.
+				EXPECT().
+				Data().
+				Return(tc.data).
+				AnyTimes()
+
+			message.
+				EXPECT().
+				Headers().
+				Return(nats.Header{jetstream.MsgIDHeader: []string{"msg-id"}}).
+				AnyTimes()
+
+			result, err := builder.natsMessageToDTO(message)
+			if tc.errorExpected {
+				var poisonMessageError *handlers.PoisonMessageError
+				require.ErrorAs(t, err, &poisonMessageError)
+			} else {
+				require.NoError(t, err)
+			}
+
 			require.Equal(t, tc.expectedDTO, result)
 		})
 	}
@@ -7,7 +7,7 @@ import (
 
 	"github.com/DKhorkov/libs/logging"
 	"github.com/DKhorkov/libs/tracing"
-	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
 
 	"github.com/DKhorkov/hmtm-notifications/dto"
 	"github.com/DKhorkov/hmtm-notifications/internal/interfaces"
@@ -37,7 +37,7 @@ func NewVerifyEmailBuilder(
 }
 
 func (b *VerifyEmailBuilder) MessageHandler() handlers.MessageHandler {
-	return func(message *nats.Msg) {
+	return func(message jetstream.Msg) error {
 		ctx, span := b.traceProvider.Span(
 			context.Background(),
 			tracing.CallerName(tracing.DefaultSkipLevel),
@@ -49,14 +49,14 @@ func (b *VerifyEmailBuilder) MessageHandler() handlers.MessageHandler {
 
 		ctx = helpers.AddTraceIDToContext(ctx, span)
 
-		verifyEmailDTO := b.natsMessageToDTO(message)
-		if verifyEmailDTO == nil {
-			return
+		verifyEmailDTO, err := b.natsMessageToDTO(message)
+		if err != nil {
+			return err
 		}
 
-		if _, err := b.useCases.SendVerifyEmailCommunication(
+		if _, err = b.useCases.SendVerifyEmailCommunication(
 			ctx,
-			verifyEmailDTO.UserID,
+			*verifyEmailDTO,
 		); err != nil {
 			logging.LogError(
 				b.logger,
@@ -66,17 +66,26 @@ func (b *VerifyEmailBuilder) MessageHandler() handlers.MessageHandler {
 				),
 				err,
 			)
+
+			return err
 		}
+
+		return nil
 	}
 }
 
-func (b *VerifyEmailBuilder) natsMessageToDTO(message *nats.Msg) *dto.VerifyEmailDTO {
+func (b *VerifyEmailBuilder) natsMessageToDTO(message jetstream.Msg) (*dto.VerifyEmailDTO, error) {
 	var verifyEmailDTO dto.VerifyEmailDTO
-	if err := json.Unmarshal(message.Data, &verifyEmailDTO); err != nil {
+	if err := json.Unmarshal(message.Data(), &verifyEmailDTO); err != nil {
 		logging.LogError(b.logger, "Failed to unmarshal verify-email message", err)
 
-		return nil
+		return nil, &handlers.PoisonMessageError{BaseErr: err}
+	}
+
+	// Without idempotency key partially processed message would be reprocessed for all recipients on redelivery:
+	if verifyEmailDTO.IdempotencyKey == "" {
+		verifyEmailDTO.IdempotencyKey = helpers.MessageIdempotencyKey(message)
 	}
 
-	return &verifyEmailDTO
+	return &verifyEmailDTO, nil
 }
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
@@ -14,6 +15,8 @@ import (
 	mocktracing "github.com/DKhorkov/libs/tracing/mocks"
 
 	"github.com/DKhorkov/hmtm-notifications/dto"
+	"github.com/DKhorkov/hmtm-notifications/internal/workers/handlers"
+	mocknats "github.com/DKhorkov/hmtm-notifications/mocks/nats"
 	mockusecases "github.com/DKhorkov/hmtm-notifications/mocks/usecases"
 )
 
@@ -31,15 +34,14 @@ func TestVerifyEmailBuilder_MessageHandler(t *testing.T) {
 	)
 
 	testCases := []struct {
-		name       string
-		message    *nats.Msg
-		setupMocks func(useCases *mockusecases.MockUseCases, traceProvider *mocktracing.MockProvider, logger *mocklogging.MockLogger)
+		name          string
+		data          []byte
+		errorExpected bool
+		setupMocks    func(useCases *mockusecases.MockUseCases, traceProvider *mocktracing.MockProvider, logger *mocklogging.MockLogger)
 	}{
 		{
 			name: "successful processing",
-			message: &nats.Msg{
-				Data: []byte(`{"userId":123}`),
-			},
+			data: []byte(`{"userId":123}`),
 			setupMocks: func(useCases *mockusecases.MockUseCases, traceProvider *mocktracing.MockProvider, logger *mocklogging.MockLogger) {
 				traceProvider.
 					EXPECT().
@@ -49,16 +51,15 @@ func TestVerifyEmailBuilder_MessageHandler(t *testing.T) {
 
 				useCases.
 					EXPECT().
-					SendVerifyEmailCommunication(gomock.Any(), uint64(123)).
+					SendVerifyEmailCommunication(gomock.Any(), dto.VerifyEmailDTO{UserID: 123, IdempotencyKey: "msg-id"}).
 					Return(uint64(1), nil).
 					Times(1)
 			},
 		},
 		{
-			name: "invalid message data",
-			message: &nats.Msg{
-				Data: []byte(`{invalid json}`),
-			},
+			name:          "invalid message data",
+			data:          []byte(`{invalid json}`),
+			errorExpected: true,
 			setupMocks: func(useCases *mockusecases.MockUseCases, traceProvider *mocktracing.MockProvider, logger *mocklogging.MockLogger) {
 				traceProvider.
 					EXPECT().
@@ -73,10 +74,9 @@ func TestVerifyEmailBuilder_MessageHandler(t *testing.T) {
 			},
 		},
 		{
-			name: "use case error",
-			message: &nats.Msg{
-				Data: []byte(`{"userId":456}`),
-			},
+			name:          "use case error",
+			data:          []byte(`{"userId":456}`),
+			errorExpected: true,
 			setupMocks: func(useCases *mockusecases.MockUseCases, traceProvider *mocktracing.MockProvider, logger *mocklogging.MockLogger) {
 				traceProvider.
 					EXPECT().
@@ -86,7 +86,7 @@ func TestVerifyEmailBuilder_MessageHandler(t *testing.T) {
 
 				useCases.
 					EXPECT().
-					SendVerifyEmailCommunication(gomock.Any(), uint64(456)).
+					SendVerifyEmailCommunication(gomock.Any(), dto.VerifyEmailDTO{UserID: 456, IdempotencyKey: "msg-id"}).
 					Return(uint64(0), errors.New("test")).
 					Times(1)
 
@@ -101,8 +101,26 @@ func TestVerifyEmailBuilder_MessageHandler(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			tc.setupMocks(useCases, traceProvider, logger)
+			message := mocknats.NewMockMsg(ctrl)
+			message.
+				EXPECT().
+				Data().
+				Return(tc.data).
+				AnyTimes()
+
+			message.
+				EXPECT().
+				Headers().
+				Return(nats.Header{jetstream.MsgIDHeader: []string{"msg-id"}}).
+				AnyTimes()
+
 			handler := builder.MessageHandler()
-			handler(tc.message)
+			err := handler(message)
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
 		})
 	}
 }
@@ -121,27 +139,35 @@ func TestVerifyEmailBuilder_natsMessageToDTO(t *testing.T) {
 	)
 
 	testCases := []struct {
-		name        string
-		message     *nats.Msg
-		expectedDTO *dto.VerifyEmailDTO
-		setupMocks  func(logger *mocklogging.MockLogger)
+		name          string
+		data          []byte
+		errorExpected bool
+		expectedDTO   *dto.VerifyEmailDTO
+		setupMocks    func(logger *mocklogging.MockLogger)
 	}{
 		{
 			name: "valid message",
-			message: &nats.Msg{
-				Data: []byte(`{"userId":123}`),
-			},
+			data: []byte(`{"userId":123}`),
 			expectedDTO: &dto.VerifyEmailDTO{
-				UserID: 123,
+				UserID:         123,
+				IdempotencyKey: "msg-id",
 			},
 			setupMocks: func(logger *mocklogging.MockLogger) {},
 		},
 		{
-			name: "invalid message",
-			message: &nats.Msg{
-				Data: []byte(`{invalid json}`),
+			name: "message with idempotency key",
+			data: []byte(`{"userId":123,"idempotencyKey":"key"}`),
+			expectedDTO: &dto.VerifyEmailDTO{
+				UserID:         123,
+				IdempotencyKey: "key",
 			},
-			expectedDTO: nil,
+			setupMocks: func(logger *mocklogging.MockLogger) {},
+		},
+		{
+			name:          "invalid message",
+			data:          []byte(`{invalid json}`),
+			errorExpected: true,
+			expectedDTO:   nil,
 			setupMocks: func(logger *mocklogging.MockLogger) {
 				logger.
 					EXPECT().
@@ -150,11 +176,10 @@ func TestVerifyEmailBuilder_natsMessageToDTO(t *testing.T) {
 			},
 		},
 		{
-			name: "empty message",
-			message: &nats.Msg{
-				Data: []byte(``),
-			},
-			expectedDTO: nil,
+			name:          "empty message",
+			data:          []byte(``),
+			errorExpected: true,
+			expectedDTO:   nil,
 			setupMocks: func(logger *mocklogging.MockLogger) {
 				logger.
 					EXPECT().
@@ -167,7 +192,27 @@ func TestVerifyEmailBuilder_natsMessageToDTO(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			tc.setupMocks(logger)
-			result := builder.natsMessageToDTO(tc.message)
+			message := mocknats.NewMockMsg(ctrl)
+			message.
+				EXPECT().
+				Data().
+				Return(tc.data).
+				AnyTimes()
+
+			message.
+				EXPECT().
+				Headers().
+				Return(nats.Header{jetstream.MsgIDHeader: []string{"msg-id"}}).
+				AnyTimes()
+
+			result, err := builder.natsMessageToDTO(message)
+			if tc.errorExpected {
+				var poisonMessageError *handlers.PoisonMessageError
+				require.ErrorAs(t, err, &poisonMessageError)
+			} else {
+				require.NoError(t, err)
+			}
+
 			require.Equal(t, tc.expectedDTO, result)
 		})
 	}
@@ -0,0 +1,17 @@
+package handlers
+
+import "fmt"
+
+// PoisonMessageError represents message, which can not be processed on any delivery attempt,
+// for example, due to invalid payload. Such messages must not be redelivered.
+type PoisonMessageError struct {
+	BaseErr error
+}
+
+func (e PoisonMessageError) Error() string {
+	return fmt.Sprintf("poison message: %v", e.BaseErr)
+}
+
+func (e PoisonMessageError) Unwrap() error {
+	return e.BaseErr
+}
@@ -0,0 +1,23 @@
+package helpers
+
+import (
+	"fmt"
+
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// MessageIdempotencyKey returns key, which is the same for all deliveries of message. Used, when publisher has
+// not provided idempotency key in payload. Nats-Msg-Id header is preferred, since it is also used by JetStream
+// for deduplication of published messages, otherwise stream sequence of message is used.
+func MessageIdempotencyKey(message jetstream.Msg) string {
+	if msgID := message.Headers().Get(jetstream.MsgIDHeader); msgID != "" {
+		return msgID
+	}
+
+	metadata, err := message.Metadata()
+	if err != nil {
+		return ""
+	}
+
+	return fmt.Sprintf("%s:%d", metadata.Stream, metadata.Sequence.Stream)
+}
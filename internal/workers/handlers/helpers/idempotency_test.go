@@ -0,0 +1,83 @@
+package helpers
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	mocknats "github.com/DKhorkov/hmtm-notifications/mocks/nats"
+)
+
+func TestMessageIdempotencyKey(t *testing.T) {
+	testCases := []struct {
+		name       string
+		setupMocks func(message *mocknats.MockMsg)
+		expected   string
+	}{
+		{
+			name: "message ID header",
+			setupMocks: func(message *mocknats.MockMsg) {
+				message.
+					EXPECT().
+					Headers().
+					Return(nats.Header{jetstream.MsgIDHeader: []string{"msg-id"}}).
+					Times(1)
+			},
+			expected: "msg-id",
+		},
+		{
+			name: "stream sequence",
+			setupMocks: func(message *mocknats.MockMsg) {
+				message.
+					EXPECT().
+					Headers().
+					Return(nil).
+					Times(1)
+
+				message.
+					EXPECT().
+					Metadata().
+					Return(
+						&jetstream.MsgMetadata{
+							Stream:   "notifications",
+							Sequence: jetstream.SequencePair{Stream: 42, Consumer: 7},
+						},
+						nil,
+					).
+					Times(1)
+			},
+			expected: "notifications:42",
+		},
+		{
+			name: "metadata error",
+			setupMocks: func(message *mocknats.MockMsg) {
+				message.
+					EXPECT().
+					Headers().
+					Return(nil).
+					Times(1)
+
+				message.
+					EXPECT().
+					Metadata().
+					Return(nil, errors.New("test")).
+					Times(1)
+			},
+			expected: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			message := mocknats.NewMockMsg(ctrl)
+			tc.setupMocks(message)
+
+			require.Equal(t, tc.expected, MessageIdempotencyKey(message))
+		})
+	}
+}
@@ -1,5 +1,9 @@
 package handlers
 
-import "github.com/nats-io/nats.go"
+import "github.com/nats-io/nats.go/jetstream"
 
-type MessageHandler func(message *nats.Msg)
+// MessageHandler processes message from JetStream consumer. Returned error decides, whether message
+// will be redelivered (any error) or terminated (PoisonMessageError).
+//
+//go:generate mockgen -destination=../../../mocks/nats/message.go -package=mocknats github.com/nats-io/nats.go/jetstream Msg
+type MessageHandler func(message jetstream.Msg) error
@@ -0,0 +1,341 @@
+package workers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/DKhorkov/libs/logging"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+
+	customnats "github.com/DKhorkov/libs/nats"
+
+	"github.com/DKhorkov/hmtm-notifications/dto"
+	"github.com/DKhorkov/hmtm-notifications/internal/workers/handlers"
+)
+
+// NewWorker creates *Worker, which consumes messages from JetStream durable consumer. Stream and consumer
+// are created or updated on worker creation, so messages, published during service downtime, will not be lost.
+func NewWorker(
+	url string,
+	streamName string,
+	consumerName string,
+	subject string,
+	logger logging.Logger,
+	opts ...WorkerOption,
+) (*Worker, error) {
+	options := newWorkerOptions()
+	for _, opt := range opts {
+		if err := opt(options); err != nil {
+			return nil, err
+		}
+	}
+
+	connection, err := nats.Connect(url, options.natsOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	js, err := jetstream.New(connection)
+	if err != nil {
+		connection.Close()
+
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), options.setupTimeout)
+	defer cancel()
+
+	consumer, err := createConsumer(
+		ctx,
+		js,
+		streamName,
+		consumerName,
+		subject,
+		options,
+	)
+	if err != nil {
+		connection.Close()
+
+		return nil, err
+	}
+
+	return &Worker{
+		connection:                connection,
+		consumer:                  consumer,
+		publisher:                 js,
+		messageHandler:            options.messageHandler,
+		goroutinesPoolSize:        options.goroutinesPoolSize,
+		messageChannelBufferSize:  options.messageChannelBufferSize,
+		maxDeliver:                options.maxDeliver,
+		nakDelay:                  options.nakDelay,
+		deadLetterSubject:         options.deadLetterSubject,
+		deadLetterPublishAttempts: options.deadLetterPublishAttempts,
+		deadLetterRetryDelay:      options.deadLetterRetryDelay,
+		publishTimeout:            options.publishTimeout,
+		logger:                    logger,
+		wg:                        new(sync.WaitGroup),
+	}, nil
+}
+
+func createConsumer(
+	ctx context.Context,
+	js jetstream.JetStream,
+	streamName string,
+	consumerName string,
+	subject string,
+	options *workerOptions,
+) (jetstream.Consumer, error) {
+	// Dead-lettered messages are stored in separate stream to be not consumed by workers:
+	if options.deadLetterSubject != "" {
+		if _, err := js.CreateOrUpdateStream(
+			ctx,
+			jetstream.StreamConfig{
+				Name:     options.deadLetterStreamName,
+				Subjects: []string{options.deadLetterSubject},
+			},
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	// Stream must capture subjects of all workers, because it is shared between them:
+	subjects := options.streamSubjects
+	if !slices.Contains(subjects, subject) {
+		subjects = append(subjects, subject)
+	}
+
+	stream, err := js.CreateOrUpdateStream(
+		ctx,
+		jetstream.StreamConfig{
+			Name:     streamName,
+			Subjects: subjects,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return stream.CreateOrUpdateConsumer(
+		ctx,
+		jetstream.ConsumerConfig{
+			Durable:       consumerName,
+			FilterSubject: subject,
+			AckPolicy:     jetstream.AckExplicitPolicy,
+			AckWait:       options.ackWait,
+			MaxDeliver:    options.maxDeliver,
+		},
+	)
+}
+
+// Worker processes JetStream messages in goroutines. Message is acknowledged only after successful processing.
+//
+//go:generate mockgen -destination=../../mocks/nats/publisher.go -package=mocknats github.com/nats-io/nats.go/jetstream Publisher
+type Worker struct {
+	connection                *nats.Conn
+	consumer                  jetstream.Consumer
+	consumeContext            jetstream.ConsumeContext
+	publisher                 jetstream.Publisher
+	messageChannel            chan jetstream.Msg
+	messageHandler            handlers.MessageHandler
+	goroutinesPoolSize        int
+	messageChannelBufferSize  int
+	maxDeliver                int
+	nakDelay                  time.Duration
+	deadLetterSubject         string
+	deadLetterPublishAttempts int
+	deadLetterRetryDelay      time.Duration
+	publishTimeout            time.Duration
+	logger                    logging.Logger
+	mutex                     sync.Mutex
+	isRunning                 bool
+	isStopped                 bool
+	wg                        *sync.WaitGroup
+}
+
+// Run starts goroutines for JetStream messages processing.
+func (w *Worker) Run() error {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if w.isRunning {
+		return &customnats.WorkerAlreadyRunningError{}
+	}
+
+	w.messageChannel = make(chan jetstream.Msg, w.messageChannelBufferSize)
+
+	w.wg.Add(w.goroutinesPoolSize)
+
+	for range w.goroutinesPoolSize {
+		go func() {
+			defer w.wg.Done()
+
+			for message := range w.messageChannel {
+				w.handle(message)
+			}
+		}()
+	}
+
+	consumeContext, err := w.consumer.Consume(
+		func(message jetstream.Msg) {
+			w.messageChannel <- message
+		},
+		jetstream.PullMaxMessages(w.messageChannelBufferSize),
+	)
+	if err != nil {
+		close(w.messageChannel)
+		w.wg.Wait()
+
+		return err
+	}
+
+	w.consumeContext = consumeContext
+	w.isRunning = true
+
+	return nil
+}
+
+// Stop stops receiving new messages and waits for processing of already received messages.
+func (w *Worker) Stop() error {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if !w.isRunning || w.isStopped {
+		return &customnats.WorkerAlreadyStoppedError{}
+	}
+
+	// Drain passes already fetched messages to callback, so channel can be closed only after it finishes:
+	w.consumeContext.Drain()
+	<-w.consumeContext.Closed()
+
+	close(w.messageChannel)
+	w.wg.Wait()
+
+	w.connection.Close()
+	w.isStopped = true
+
+	return nil
+}
+
+// handle acknowledges message after successful processing, dead-letters poison messages and messages
+// with exhausted delivery attempts, and asks for redelivery with delay in case of other errors.
+func (w *Worker) handle(message jetstream.Msg) {
+	var (
+		ackErr        error
+		poisonMessage *handlers.PoisonMessageError
+	)
+
+	err := w.messageHandler(message)
+
+	switch {
+	case err == nil:
+		ackErr = message.Ack()
+	case errors.As(err, &poisonMessage), w.isLastDelivery(message):
+		ackErr = w.deadLetter(message, err)
+	default:
+		ackErr = message.NakWithDelay(w.nakDelay)
+	}
+
+	if ackErr != nil {
+		logging.LogError(
+			w.logger,
+			fmt.Sprintf("Failed to acknowledge message from \"%s\" subject", message.Subject()),
+			ackErr,
+		)
+	}
+}
+
+// isLastDelivery checks, whether JetStream will not redeliver message after current attempt.
+func (w *Worker) isLastDelivery(message jetstream.Msg) bool {
+	metadata, err := message.Metadata()
+	if err != nil {
+		return false
+	}
+
+	return w.maxDeliver > 0 && metadata.NumDelivered >= uint64(w.maxDeliver)
+}
+
+// deadLetter publishes failed message to dead-letter subject and terminates its redelivery. Publishing is
+// retried with extension of message ack wait. If message still can not be dead-lettered, it is redelivered
+// while JetStream has remaining deliveries. On last delivery message is terminated and its full content
+// is logged for manual recovery, since NAK would not lead to redelivery.
+func (w *Worker) deadLetter(message jetstream.Msg, processingErr error) error {
+	if w.deadLetterSubject == "" {
+		return message.TermWithReason(processingErr.Error())
+	}
+
+	var attempts uint64
+	if metadata, err := message.Metadata(); err == nil {
+		attempts = metadata.NumDelivered
+	}
+
+	content, err := json.Marshal(
+		dto.DeadLetterDTO{
+			Subject:  message.Subject(),
+			Payload:  message.Data(),
+			Error:    processingErr.Error(),
+			Attempts: attempts,
+			FailedAt: time.Now().UTC(),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	if err = w.publishDeadLetter(message, content); err == nil {
+		return message.TermWithReason(processingErr.Error())
+	}
+
+	if w.maxDeliver <= 0 || attempts < uint64(w.maxDeliver) {
+		logging.LogError(
+			w.logger,
+			fmt.Sprintf("Failed to publish message from \"%s\" subject to dead-letter subject", message.Subject()),
+			err,
+		)
+
+		return message.NakWithDelay(w.nakDelay)
+	}
+
+	logging.LogError(
+		w.logger,
+		fmt.Sprintf(
+			"Failed to publish message from \"%s\" subject to dead-letter subject on last delivery. Dead letter: %s",
+			message.Subject(),
+			content,
+		),
+		err,
+	)
+
+	return message.TermWithReason(processingErr.Error())
+}
+
+// publishDeadLetter publishes dead letter with retries. Ack wait of message is extended before every retry
+// to prevent its redelivery during publishing.
+func (w *Worker) publishDeadLetter(message jetstream.Msg, content []byte) error {
+	var err error
+	for attempt := range max(w.deadLetterPublishAttempts, 1) {
+		if attempt > 0 {
+			if err = message.InProgress(); err != nil {
+				return err
+			}
+
+			time.Sleep(w.deadLetterRetryDelay)
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), w.publishTimeout)
+		_, err = w.publisher.Publish(ctx, w.deadLetterSubject, content)
+
+		cancel()
+
+		if err == nil {
+			return nil
+		}
+	}
+
+	return err
+}
@@ -0,0 +1,143 @@
+package workers
+
+import (
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+
+	"github.com/DKhorkov/hmtm-notifications/internal/workers/handlers"
+)
+
+const (
+	defaultMessageChannelBufferSize  = 1
+	defaultGoroutinesPoolSize        = 1
+	defaultAckWait                   = 30 * time.Second
+	defaultMaxDeliver                = 5
+	defaultNakDelay                  = 5 * time.Second
+	defaultSetupTimeout              = 10 * time.Second
+	defaultPublishTimeout            = 5 * time.Second
+	defaultDeadLetterPublishAttempts = 3
+	defaultDeadLetterRetryDelay      = time.Second
+)
+
+// newWorkerOptions creates *workerOptions with default values.
+func newWorkerOptions() *workerOptions {
+	return &workerOptions{
+		messageChannelBufferSize:  defaultMessageChannelBufferSize,
+		goroutinesPoolSize:        defaultGoroutinesPoolSize,
+		ackWait:                   defaultAckWait,
+		maxDeliver:                defaultMaxDeliver,
+		nakDelay:                  defaultNakDelay,
+		setupTimeout:              defaultSetupTimeout,
+		publishTimeout:            defaultPublishTimeout,
+		deadLetterPublishAttempts: defaultDeadLetterPublishAttempts,
+		deadLetterRetryDelay:      defaultDeadLetterRetryDelay,
+		messageHandler: func(message jetstream.Msg) error {
+			return nil
+		},
+	}
+}
+
+// workerOptions represents options for Worker configuration.
+type workerOptions struct {
+	messageChannelBufferSize  int
+	goroutinesPoolSize        int
+	ackWait                   time.Duration
+	maxDeliver                int
+	nakDelay                  time.Duration
+	setupTimeout              time.Duration
+	publishTimeout            time.Duration
+	deadLetterStreamName      string
+	deadLetterSubject         string
+	deadLetterPublishAttempts int
+	deadLetterRetryDelay      time.Duration
+	streamSubjects            []string
+	messageHandler            handlers.MessageHandler
+	natsOpts                  []nats.Option
+}
+
+// WorkerOption represents golang functional option pattern func for Worker configuration.
+type WorkerOption func(options *workerOptions) error
+
+// WithMessageChannelBufferSize sets number of messages, which are fetched from JetStream at once.
+func WithMessageChannelBufferSize(size int) WorkerOption {
+	return func(options *workerOptions) error {
+		options.messageChannelBufferSize = size
+
+		return nil
+	}
+}
+
+// WithGoroutinesPoolSize sets number of goroutines for process messages from JetStream.
+func WithGoroutinesPoolSize(size int) WorkerOption {
+	return func(options *workerOptions) error {
+		options.goroutinesPoolSize = size
+
+		return nil
+	}
+}
+
+// WithAckWait sets time, after which not acknowledged message will be redelivered.
+func WithAckWait(ackWait time.Duration) WorkerOption {
+	return func(options *workerOptions) error {
+		options.ackWait = ackWait
+
+		return nil
+	}
+}
+
+// WithMaxDeliver sets maximum number of delivery attempts for single message.
+func WithMaxDeliver(maxDeliver int) WorkerOption {
+	return func(options *workerOptions) error {
+		options.maxDeliver = maxDeliver
+
+		return nil
+	}
+}
+
+// WithNakDelay sets delay for redelivery of message, which processing failed due to transient error.
+func WithNakDelay(delay time.Duration) WorkerOption {
+	return func(options *workerOptions) error {
+		options.nakDelay = delay
+
+		return nil
+	}
+}
+
+// WithStreamSubjects sets all subjects, which are captured by stream, shared between workers.
+func WithStreamSubjects(subjects ...string) WorkerOption {
+	return func(options *workerOptions) error {
+		options.streamSubjects = subjects
+
+		return nil
+	}
+}
+
+// WithDeadLetter sets stream and subject, to which messages are published, if they could not be processed.
+func WithDeadLetter(streamName, subject string) WorkerOption {
+	return func(options *workerOptions) error {
+		options.deadLetterStreamName = streamName
+		options.deadLetterSubject = subject
+
+		return nil
+	}
+}
+
+// WithMessageHandler sets handler for received message.
+func WithMessageHandler(handler handlers.MessageHandler) WorkerOption {
+	return func(options *workerOptions) error {
+		options.messageHandler = handler
+
+		return nil
+	}
+}
+
+// WithNatsOptions sets options for NATS connection.
+func WithNatsOptions(opts ...nats.Option) WorkerOption {
+	return func(options *workerOptions) error {
+		options.natsOpts = append(options.natsOpts, opts...)
+
+		return nil
+	}
+}
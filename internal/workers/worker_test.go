@@ -0,0 +1,366 @@
+package workers
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats.go/jetstream"
+	"go.uber.org/mock/gomock"
+
+	mocklogging "github.com/DKhorkov/libs/logging/mocks"
+
+	"github.com/DKhorkov/hmtm-notifications/internal/workers/handlers"
+	mocknats "github.com/DKhorkov/hmtm-notifications/mocks/nats"
+)
+
+func TestWorker_handle(t *testing.T) {
+	testCases := []struct {
+		name              string
+		handlerErr        error
+		deadLetterSubject string
+		setupMocks        func(
+			message *mocknats.MockMsg,
+			publisher *mocknats.MockPublisher,
+			logger *mocklogging.MockLogger,
+		)
+	}{
+		{
+			name: "successful processing",
+			setupMocks: func(
+				message *mocknats.MockMsg,
+				_ *mocknats.MockPublisher,
+				_ *mocklogging.MockLogger,
+			) {
+				message.
+					EXPECT().
+					Ack().
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name:       "poison message without dead-letter subject",
+			handlerErr: &handlers.PoisonMessageError{BaseErr: errors.New("invalid json")},
+			setupMocks: func(
+				message *mocknats.MockMsg,
+				_ *mocknats.MockPublisher,
+				_ *mocklogging.MockLogger,
+			) {
+				message.
+					EXPECT().
+					TermWithReason("poison message: invalid json").
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name:              "poison message with dead-letter subject",
+			handlerErr:        &handlers.PoisonMessageError{BaseErr: errors.New("invalid json")},
+			deadLetterSubject: "dead-letter",
+			setupMocks: func(
+				message *mocknats.MockMsg,
+				publisher *mocknats.MockPublisher,
+				_ *mocklogging.MockLogger,
+			) {
+				message.
+					EXPECT().
+					Metadata().
+					Return(&jetstream.MsgMetadata{NumDelivered: 1}, nil).
+					Times(1)
+
+				message.
+					EXPECT().
+					Subject().
+					Return("subject").
+					Times(1)
+
+				message.
+					EXPECT().
+					Data().
+					Return([]byte("{invalid json}")).
+					Times(1)
+
+				publisher.
+					EXPECT().
+					Publish(gomock.Any(), "dead-letter", gomock.Any()).
+					Return(&jetstream.PubAck{}, nil).
+					Times(1)
+
+				message.
+					EXPECT().
+					TermWithReason("poison message: invalid json").
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name:              "processing error with remaining deliveries",
+			handlerErr:        errors.New("test"),
+			deadLetterSubject: "dead-letter",
+			setupMocks: func(
+				message *mocknats.MockMsg,
+				_ *mocknats.MockPublisher,
+				_ *mocklogging.MockLogger,
+			) {
+				message.
+					EXPECT().
+					Metadata().
+					Return(&jetstream.MsgMetadata{NumDelivered: 1}, nil).
+					Times(1)
+
+				message.
+					EXPECT().
+					NakWithDelay(time.Second).
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name:              "processing error on last delivery",
+			handlerErr:        errors.New("test"),
+			deadLetterSubject: "dead-letter",
+			setupMocks: func(
+				message *mocknats.MockMsg,
+				publisher *mocknats.MockPublisher,
+				_ *mocklogging.MockLogger,
+			) {
+				message.
+					EXPECT().
+					Metadata().
+					Return(&jetstream.MsgMetadata{NumDelivered: 3}, nil).
+					Times(2)
+
+				message.
+					EXPECT().
+					Subject().
+					Return("subject").
+					Times(1)
+
+				message.
+					EXPECT().
+					Data().
+					Return([]byte(`{"userId":1}`)).
+					Times(1)
+
+				publisher.
+					EXPECT().
+					Publish(gomock.Any(), "dead-letter", gomock.Any()).
+					Return(&jetstream.PubAck{}, nil).
+					Times(1)
+
+				message.
+					EXPECT().
+					TermWithReason("test").
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name:              "dead-letter publish error",
+			handlerErr:        &handlers.PoisonMessageError{BaseErr: errors.New("invalid json")},
+			deadLetterSubject: "dead-letter",
+			setupMocks: func(
+				message *mocknats.MockMsg,
+				publisher *mocknats.MockPublisher,
+				logger *mocklogging.MockLogger,
+			) {
+				message.
+					EXPECT().
+					Metadata().
+					Return(&jetstream.MsgMetadata{NumDelivered: 1}, nil).
+					Times(1)
+
+				message.
+					EXPECT().
+					Subject().
+					Return("subject").
+					Times(2)
+
+				message.
+					EXPECT().
+					Data().
+					Return([]byte("{invalid json}")).
+					Times(1)
+
+				publisher.
+					EXPECT().
+					Publish(gomock.Any(), "dead-letter", gomock.Any()).
+					Return(nil, errors.New("publish error")).
+					Times(2)
+
+				message.
+					EXPECT().
+					InProgress().
+					Return(nil).
+					Times(1)
+
+				logger.
+					EXPECT().
+					Error(gomock.Any(), gomock.Any()).
+					Times(1)
+
+				message.
+					EXPECT().
+					NakWithDelay(time.Second).
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name:              "dead-letter publish error on last delivery",
+			handlerErr:        errors.New("test"),
+			deadLetterSubject: "dead-letter",
+			setupMocks: func(
+				message *mocknats.MockMsg,
+				publisher *mocknats.MockPublisher,
+				logger *mocklogging.MockLogger,
+			) {
+				message.
+					EXPECT().
+					Metadata().
+					Return(&jetstream.MsgMetadata{NumDelivered: 3}, nil).
+					Times(2)
+
+				message.
+					EXPECT().
+					Subject().
+					Return("subject").
+					Times(2)
+
+				message.
+					EXPECT().
+					Data().
+					Return([]byte(`{"userId":1}`)).
+					Times(1)
+
+				publisher.
+					EXPECT().
+					Publish(gomock.Any(), "dead-letter", gomock.Any()).
+					Return(nil, errors.New("publish error")).
+					Times(2)
+
+				message.
+					EXPECT().
+					InProgress().
+					Return(nil).
+					Times(1)
+
+				// Message is not redelivered after last delivery, so it is logged instead of NAK:
+				logger.
+					EXPECT().
+					Error(gomock.Any(), gomock.Any()).
+					Times(1)
+
+				message.
+					EXPECT().
+					TermWithReason("test").
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name:              "dead-letter published after retry",
+			handlerErr:        errors.New("test"),
+			deadLetterSubject: "dead-letter",
+			setupMocks: func(
+				message *mocknats.MockMsg,
+				publisher *mocknats.MockPublisher,
+				_ *mocklogging.MockLogger,
+			) {
+				message.
+					EXPECT().
+					Metadata().
+					Return(&jetstream.MsgMetadata{NumDelivered: 3}, nil).
+					Times(2)
+
+				message.
+					EXPECT().
+					Subject().
+					Return("subject").
+					Times(1)
+
+				message.
+					EXPECT().
+					Data().
+					Return([]byte(`{"userId":1}`)).
+					Times(1)
+
+				gomock.InOrder(
+					publisher.
+						EXPECT().
+						Publish(gomock.Any(), "dead-letter", gomock.Any()).
+						Return(nil, errors.New("publish error")).
+						Times(1),
+					publisher.
+						EXPECT().
+						Publish(gomock.Any(), "dead-letter", gomock.Any()).
+						Return(&jetstream.PubAck{}, nil).
+						Times(1),
+				)
+
+				message.
+					EXPECT().
+					InProgress().
+					Return(nil).
+					Times(1)
+
+				message.
+					EXPECT().
+					TermWithReason("test").
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name: "acknowledgement error",
+			setupMocks: func(
+				message *mocknats.MockMsg,
+				_ *mocknats.MockPublisher,
+				logger *mocklogging.MockLogger,
+			) {
+				message.
+					EXPECT().
+					Ack().
+					Return(errors.New("test")).
+					Times(1)
+
+				message.
+					EXPECT().
+					Subject().
+					Return("subject").
+					Times(1)
+
+				logger.
+					EXPECT().
+					Error(gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			message := mocknats.NewMockMsg(ctrl)
+			publisher := mocknats.NewMockPublisher(ctrl)
+			logger := mocklogging.NewMockLogger(ctrl)
+			worker := &Worker{
+				publisher: publisher,
+				messageHandler: func(_ jetstream.Msg) error {
+					return tc.handlerErr
+				},
+				maxDeliver:                3,
+				nakDelay:                  time.Second,
+				deadLetterSubject:         tc.deadLetterSubject,
+				deadLetterPublishAttempts: 2,
+				deadLetterRetryDelay:      time.Millisecond,
+				publishTimeout:            time.Second,
+				logger:                    logger,
+			}
+
+			tc.setupMocks(message, publisher, logger)
+			worker.handle(message)
+		})
+	}
+}
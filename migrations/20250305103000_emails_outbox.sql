@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE emails RENAME COLUMN sent_at TO created_at;
+ALTER TABLE emails ADD COLUMN subject VARCHAR(255) NOT NULL DEFAULT '';
+ALTER TABLE emails ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'sent';
+ALTER TABLE emails ADD COLUMN attempts INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE emails ADD COLUMN last_error TEXT;
+ALTER TABLE emails ADD COLUMN next_attempt_at TIMESTAMP;
+ALTER TABLE emails ADD COLUMN sent_at TIMESTAMP;
+UPDATE emails SET sent_at = created_at;
+CREATE INDEX IF NOT EXISTS emails_status_next_attempt_at_idx ON emails (status, next_attempt_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS emails_status_next_attempt_at_idx;
+DELETE FROM emails WHERE status <> 'sent';
+ALTER TABLE emails DROP COLUMN sent_at;
+ALTER TABLE emails DROP COLUMN next_attempt_at;
+ALTER TABLE emails DROP COLUMN last_error;
+ALTER TABLE emails DROP COLUMN attempts;
+ALTER TABLE emails DROP COLUMN status;
+ALTER TABLE emails DROP COLUMN subject;
+ALTER TABLE emails RENAME COLUMN created_at TO sent_at;
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS processed_messages
+(
+    id              SERIAL PRIMARY KEY,
+    idempotency_key VARCHAR(255) NOT NULL,
+    email_ids       TEXT         NOT NULL DEFAULT '',
+    created_at      TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    processed_at    TIMESTAMP
+);
+CREATE UNIQUE INDEX IF NOT EXISTS processed_messages_idempotency_key_idx ON processed_messages (idempotency_key);
+CREATE INDEX IF NOT EXISTS processed_messages_created_at_idx ON processed_messages (created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS processed_messages_created_at_idx;
+DROP INDEX IF EXISTS processed_messages_idempotency_key_idx;
+DROP TABLE IF EXISTS processed_messages;
+-- +goose StatementEnd
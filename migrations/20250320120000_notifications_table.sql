@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS notifications
+(
+    id         SERIAL PRIMARY KEY,
+    user_id    INTEGER      NOT NULL,
+    type       VARCHAR(50)  NOT NULL,
+    title      VARCHAR(255) NOT NULL,
+    text       TEXT         NOT NULL,
+    link       TEXT         NOT NULL DEFAULT '',
+    created_at TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    read_at    TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS notifications_user_id_read_at_idx ON notifications (user_id, read_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS notifications_user_id_read_at_idx;
+DROP TABLE IF EXISTS notifications;
+-- +goose StatementEnd
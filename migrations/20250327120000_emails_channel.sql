@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE emails ADD COLUMN channel VARCHAR(20) NOT NULL DEFAULT 'email';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM emails WHERE channel <> 'email';
+ALTER TABLE emails DROP COLUMN channel;
+-- +goose StatementEnd
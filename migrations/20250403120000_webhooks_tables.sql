@@ -0,0 +1,39 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS webhooks
+(
+    id                   SERIAL PRIMARY KEY,
+    url                  TEXT        NOT NULL,
+    secret               VARCHAR(64) NOT NULL,
+    event_types          TEXT        NOT NULL DEFAULT '',
+    enabled              BOOLEAN     NOT NULL DEFAULT TRUE,
+    consecutive_failures INTEGER     NOT NULL DEFAULT 0,
+    created_at           TIMESTAMP   NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at           TIMESTAMP   NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    disabled_at          TIMESTAMP
+);
+CREATE TABLE IF NOT EXISTS webhook_deliveries
+(
+    id              SERIAL PRIMARY KEY,
+    webhook_id      INTEGER     NOT NULL REFERENCES webhooks (id) ON DELETE CASCADE,
+    delivery_id     VARCHAR(36) NOT NULL UNIQUE,
+    event_type      VARCHAR(50) NOT NULL,
+    payload         TEXT        NOT NULL,
+    status          VARCHAR(20) NOT NULL DEFAULT 'pending',
+    attempts        INTEGER     NOT NULL DEFAULT 0,
+    last_error      TEXT,
+    next_attempt_at TIMESTAMP,
+    created_at      TIMESTAMP   NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    delivered_at    TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS webhook_deliveries_status_next_attempt_at_idx ON webhook_deliveries (status, next_attempt_at);
+CREATE INDEX IF NOT EXISTS webhook_deliveries_webhook_id_idx ON webhook_deliveries (webhook_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS webhook_deliveries_webhook_id_idx;
+DROP INDEX IF EXISTS webhook_deliveries_status_next_attempt_at_idx;
+DROP TABLE IF EXISTS webhook_deliveries;
+DROP TABLE IF EXISTS webhooks;
+-- +goose StatementEnd
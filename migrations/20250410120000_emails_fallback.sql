@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE emails ADD COLUMN fallback_for INTEGER;
+CREATE INDEX IF NOT EXISTS emails_fallback_for_idx ON emails (fallback_for);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS emails_fallback_for_idx;
+DELETE FROM emails WHERE status IN ('standby', 'cancelled');
+ALTER TABLE emails DROP COLUMN fallback_for;
+-- +goose StatementEnd
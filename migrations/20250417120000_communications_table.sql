@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE emails RENAME TO communications;
+ALTER TABLE communications RENAME COLUMN email TO recipient;
+ALTER TABLE communications RENAME COLUMN content TO body;
+ALTER TABLE communications ADD COLUMN type VARCHAR(50) NOT NULL DEFAULT '';
+ALTER TABLE communications ADD COLUMN provider_message_id VARCHAR(255);
+DROP INDEX IF EXISTS emails_status_next_attempt_at_idx;
+DROP INDEX IF EXISTS emails_fallback_for_idx;
+CREATE INDEX IF NOT EXISTS communications_status_next_attempt_at_idx ON communications (status, next_attempt_at);
+CREATE INDEX IF NOT EXISTS communications_fallback_for_idx ON communications (fallback_for);
+CREATE INDEX IF NOT EXISTS communications_user_id_channel_idx ON communications (user_id, channel);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS communications_user_id_channel_idx;
+DROP INDEX IF EXISTS communications_fallback_for_idx;
+DROP INDEX IF EXISTS communications_status_next_attempt_at_idx;
+ALTER TABLE communications DROP COLUMN provider_message_id;
+ALTER TABLE communications DROP COLUMN type;
+ALTER TABLE communications RENAME COLUMN body TO content;
+ALTER TABLE communications RENAME COLUMN recipient TO email;
+ALTER TABLE communications RENAME TO emails;
+CREATE INDEX IF NOT EXISTS emails_status_next_attempt_at_idx ON emails (status, next_attempt_at);
+CREATE INDEX IF NOT EXISTS emails_fallback_for_idx ON emails (fallback_for);
+-- +goose StatementEnd
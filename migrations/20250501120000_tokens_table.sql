@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS tokens
+(
+    id          SERIAL PRIMARY KEY,
+    nonce       VARCHAR(64) NOT NULL UNIQUE,
+    user_id     INTEGER     NOT NULL,
+    purpose     VARCHAR(50) NOT NULL,
+    expires_at  TIMESTAMP   NOT NULL,
+    consumed_at TIMESTAMP,
+    created_at  TIMESTAMP   NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS tokens;
+-- +goose StatementEnd
@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS templates
+(
+    id                SERIAL PRIMARY KEY,
+    notification_type VARCHAR(50) NOT NULL,
+    locale            VARCHAR(35) NOT NULL,
+    version           INTEGER     NOT NULL,
+    state             VARCHAR(20) NOT NULL DEFAULT 'draft',
+    subject           TEXT        NOT NULL DEFAULT '',
+    html              TEXT        NOT NULL,
+    text              TEXT        NOT NULL DEFAULT '',
+    created_at        TIMESTAMP   NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at        TIMESTAMP   NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    published_at      TIMESTAMP,
+    UNIQUE (notification_type, locale, version)
+);
+-- Only one version of notification type and locale could be published:
+CREATE UNIQUE INDEX IF NOT EXISTS templates_published_idx ON templates (notification_type, locale)
+    WHERE state = 'published';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS templates_published_idx;
+DROP TABLE IF EXISTS templates;
+-- +goose StatementEnd
@@ -0,0 +1,69 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: broadcasters.go
+//
+// Generated by this command:
+//
+//	mockgen -source=broadcasters.go -destination=../../mocks/broadcasters/notifications_broadcaster.go -package=mockbroadcasters
+//
+
+// Package mockbroadcasters is a generated GoMock package.
+package mockbroadcasters
+
+import (
+	reflect "reflect"
+
+	entities "github.com/DKhorkov/hmtm-notifications/internal/entities"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockNotificationsBroadcaster is a mock of NotificationsBroadcaster interface.
+type MockNotificationsBroadcaster struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationsBroadcasterMockRecorder
+	isgomock struct{}
+}
+
+// MockNotificationsBroadcasterMockRecorder is the mock recorder for MockNotificationsBroadcaster.
+type MockNotificationsBroadcasterMockRecorder struct {
+	mock *MockNotificationsBroadcaster
+}
+
+// NewMockNotificationsBroadcaster creates a new mock instance.
+func NewMockNotificationsBroadcaster(ctrl *gomock.Controller) *MockNotificationsBroadcaster {
+	mock := &MockNotificationsBroadcaster{ctrl: ctrl}
+	mock.recorder = &MockNotificationsBroadcasterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotificationsBroadcaster) EXPECT() *MockNotificationsBroadcasterMockRecorder {
+	return m.recorder
+}
+
+// Publish mocks base method.
+func (m *MockNotificationsBroadcaster) Publish(notification entities.Notification) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Publish", notification)
+}
+
+// Publish indicates an expected call of Publish.
+func (mr *MockNotificationsBroadcasterMockRecorder) Publish(notification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockNotificationsBroadcaster)(nil).Publish), notification)
+}
+
+// Subscribe mocks base method.
+func (m *MockNotificationsBroadcaster) Subscribe(userID uint64) (<-chan entities.Notification, func(), error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", userID)
+	ret0, _ := ret[0].(<-chan entities.Notification)
+	ret1, _ := ret[1].(func())
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockNotificationsBroadcasterMockRecorder) Subscribe(userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockNotificationsBroadcaster)(nil).Subscribe), userID)
+}
@@ -0,0 +1,69 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: content_builders.go
+//
+// Generated by this command:
+//
+//	mockgen -source=content_builders.go -destination=../../mocks/contentbuilders/content_templates.go -package=mockcontentbuilders -exclude_interfaces=VerifyEmailContentBuilder,ForgetPasswordContentBuilder,TicketUpdatedContentBuilder,TicketDeletedContentBuilder,VerifyEmailTextContentBuilder,ForgetPasswordTextContentBuilder,TicketUpdatedTextContentBuilder,TicketDeletedTextContentBuilder,ForgetPasswordSMSContentBuilder,TicketUpdatedSMSContentBuilder,TicketDeletedSMSContentBuilder,VerifyEmailInboxContentBuilder,ForgetPasswordInboxContentBuilder,TicketUpdatedInboxContentBuilder,TicketDeletedInboxContentBuilder
+//
+
+// Package mockcontentbuilders is a generated GoMock package.
+package mockcontentbuilders
+
+import (
+	reflect "reflect"
+
+	entities "github.com/DKhorkov/hmtm-notifications/internal/entities"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockContentTemplates is a mock of ContentTemplates interface.
+type MockContentTemplates struct {
+	ctrl     *gomock.Controller
+	recorder *MockContentTemplatesMockRecorder
+	isgomock struct{}
+}
+
+// MockContentTemplatesMockRecorder is the mock recorder for MockContentTemplates.
+type MockContentTemplatesMockRecorder struct {
+	mock *MockContentTemplates
+}
+
+// NewMockContentTemplates creates a new mock instance.
+func NewMockContentTemplates(ctrl *gomock.Controller) *MockContentTemplates {
+	mock := &MockContentTemplates{ctrl: ctrl}
+	mock.recorder = &MockContentTemplatesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockContentTemplates) EXPECT() *MockContentTemplatesMockRecorder {
+	return m.recorder
+}
+
+// SetPublished mocks base method.
+func (m *MockContentTemplates) SetPublished(templates []entities.Template) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPublished", templates)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPublished indicates an expected call of SetPublished.
+func (mr *MockContentTemplatesMockRecorder) SetPublished(templates any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPublished", reflect.TypeOf((*MockContentTemplates)(nil).SetPublished), templates)
+}
+
+// Validate mocks base method.
+func (m *MockContentTemplates) Validate(template entities.Template) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Validate", template)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Validate indicates an expected call of Validate.
+func (mr *MockContentTemplatesMockRecorder) Validate(template any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Validate", reflect.TypeOf((*MockContentTemplates)(nil).Validate), template)
+}
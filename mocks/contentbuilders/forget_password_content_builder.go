@@ -3,7 +3,7 @@
 //
 // Generated by this command:
 //
-//	mockgen -source=content_builders.go -destination=../../mocks/contentbuilders/forget_password_content_builder.go -package=mockcontentbuilders -exclude_interfaces=VerifyEmailContentBuilder,TicketUpdatedContentBuilder,TicketDeletedContentBuilder
+//	mockgen -source=content_builders.go -destination=../../mocks/contentbuilders/forget_password_content_builder.go -package=mockcontentbuilders -exclude_interfaces=VerifyEmailContentBuilder,TicketUpdatedContentBuilder,TicketDeletedContentBuilder,VerifyEmailTextContentBuilder,ForgetPasswordTextContentBuilder,TicketUpdatedTextContentBuilder,TicketDeletedTextContentBuilder,ForgetPasswordSMSContentBuilder,TicketUpdatedSMSContentBuilder,TicketDeletedSMSContentBuilder,VerifyEmailInboxContentBuilder,ForgetPasswordInboxContentBuilder,TicketUpdatedInboxContentBuilder,TicketDeletedInboxContentBuilder,ContentTemplates
 //
 
 // Package mockcontentbuilders is a generated GoMock package.
@@ -41,29 +41,29 @@ func (m *MockForgetPasswordContentBuilder) EXPECT() *MockForgetPasswordContentBu
 }
 
 // Body mocks base method.
-func (m *MockForgetPasswordContentBuilder) Body(user entities.User) string {
+func (m *MockForgetPasswordContentBuilder) Body(user entities.User, token string) string {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Body", user)
+	ret := m.ctrl.Call(m, "Body", user, token)
 	ret0, _ := ret[0].(string)
 	return ret0
 }
 
 // Body indicates an expected call of Body.
-func (mr *MockForgetPasswordContentBuilderMockRecorder) Body(user any) *gomock.Call {
+func (mr *MockForgetPasswordContentBuilderMockRecorder) Body(user, token any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Body", reflect.TypeOf((*MockForgetPasswordContentBuilder)(nil).Body), user)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Body", reflect.TypeOf((*MockForgetPasswordContentBuilder)(nil).Body), user, token)
 }
 
 // Subject mocks base method.
-func (m *MockForgetPasswordContentBuilder) Subject() string {
+func (m *MockForgetPasswordContentBuilder) Subject(user entities.User) string {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Subject")
+	ret := m.ctrl.Call(m, "Subject", user)
 	ret0, _ := ret[0].(string)
 	return ret0
 }
 
 // Subject indicates an expected call of Subject.
-func (mr *MockForgetPasswordContentBuilderMockRecorder) Subject() *gomock.Call {
+func (mr *MockForgetPasswordContentBuilderMockRecorder) Subject(user any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subject", reflect.TypeOf((*MockForgetPasswordContentBuilder)(nil).Subject))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subject", reflect.TypeOf((*MockForgetPasswordContentBuilder)(nil).Subject), user)
 }
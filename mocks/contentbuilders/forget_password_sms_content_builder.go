@@ -0,0 +1,55 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: content_builders.go
+//
+// Generated by this command:
+//
+//	mockgen -source=content_builders.go -destination=../../mocks/contentbuilders/forget_password_sms_content_builder.go -package=mockcontentbuilders -exclude_interfaces=VerifyEmailContentBuilder,ForgetPasswordContentBuilder,TicketUpdatedContentBuilder,TicketDeletedContentBuilder,VerifyEmailTextContentBuilder,ForgetPasswordTextContentBuilder,TicketUpdatedTextContentBuilder,TicketDeletedTextContentBuilder,TicketUpdatedSMSContentBuilder,TicketDeletedSMSContentBuilder,VerifyEmailInboxContentBuilder,ForgetPasswordInboxContentBuilder,TicketUpdatedInboxContentBuilder,TicketDeletedInboxContentBuilder,ContentTemplates
+//
+
+// Package mockcontentbuilders is a generated GoMock package.
+package mockcontentbuilders
+
+import (
+	reflect "reflect"
+
+	entities "github.com/DKhorkov/hmtm-notifications/internal/entities"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockForgetPasswordSMSContentBuilder is a mock of ForgetPasswordSMSContentBuilder interface.
+type MockForgetPasswordSMSContentBuilder struct {
+	ctrl     *gomock.Controller
+	recorder *MockForgetPasswordSMSContentBuilderMockRecorder
+	isgomock struct{}
+}
+
+// MockForgetPasswordSMSContentBuilderMockRecorder is the mock recorder for MockForgetPasswordSMSContentBuilder.
+type MockForgetPasswordSMSContentBuilderMockRecorder struct {
+	mock *MockForgetPasswordSMSContentBuilder
+}
+
+// NewMockForgetPasswordSMSContentBuilder creates a new mock instance.
+func NewMockForgetPasswordSMSContentBuilder(ctrl *gomock.Controller) *MockForgetPasswordSMSContentBuilder {
+	mock := &MockForgetPasswordSMSContentBuilder{ctrl: ctrl}
+	mock.recorder = &MockForgetPasswordSMSContentBuilderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockForgetPasswordSMSContentBuilder) EXPECT() *MockForgetPasswordSMSContentBuilderMockRecorder {
+	return m.recorder
+}
+
+// Text mocks base method.
+func (m *MockForgetPasswordSMSContentBuilder) Text(user entities.User, token string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Text", user, token)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Text indicates an expected call of Text.
+func (mr *MockForgetPasswordSMSContentBuilderMockRecorder) Text(user, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Text", reflect.TypeOf((*MockForgetPasswordSMSContentBuilder)(nil).Text), user, token)
+}
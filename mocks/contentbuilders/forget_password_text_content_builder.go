@@ -0,0 +1,55 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: content_builders.go
+//
+// Generated by this command:
+//
+//	mockgen -source=content_builders.go -destination=../../mocks/contentbuilders/forget_password_text_content_builder.go -package=mockcontentbuilders -exclude_interfaces=VerifyEmailContentBuilder,ForgetPasswordContentBuilder,TicketUpdatedContentBuilder,TicketDeletedContentBuilder,VerifyEmailTextContentBuilder,TicketUpdatedTextContentBuilder,TicketDeletedTextContentBuilder,ForgetPasswordSMSContentBuilder,TicketUpdatedSMSContentBuilder,TicketDeletedSMSContentBuilder,VerifyEmailInboxContentBuilder,ForgetPasswordInboxContentBuilder,TicketUpdatedInboxContentBuilder,TicketDeletedInboxContentBuilder,ContentTemplates
+//
+
+// Package mockcontentbuilders is a generated GoMock package.
+package mockcontentbuilders
+
+import (
+	reflect "reflect"
+
+	entities "github.com/DKhorkov/hmtm-notifications/internal/entities"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockForgetPasswordTextContentBuilder is a mock of ForgetPasswordTextContentBuilder interface.
+type MockForgetPasswordTextContentBuilder struct {
+	ctrl     *gomock.Controller
+	recorder *MockForgetPasswordTextContentBuilderMockRecorder
+	isgomock struct{}
+}
+
+// MockForgetPasswordTextContentBuilderMockRecorder is the mock recorder for MockForgetPasswordTextContentBuilder.
+type MockForgetPasswordTextContentBuilderMockRecorder struct {
+	mock *MockForgetPasswordTextContentBuilder
+}
+
+// NewMockForgetPasswordTextContentBuilder creates a new mock instance.
+func NewMockForgetPasswordTextContentBuilder(ctrl *gomock.Controller) *MockForgetPasswordTextContentBuilder {
+	mock := &MockForgetPasswordTextContentBuilder{ctrl: ctrl}
+	mock.recorder = &MockForgetPasswordTextContentBuilderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockForgetPasswordTextContentBuilder) EXPECT() *MockForgetPasswordTextContentBuilderMockRecorder {
+	return m.recorder
+}
+
+// Text mocks base method.
+func (m *MockForgetPasswordTextContentBuilder) Text(user entities.User, token string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Text", user, token)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Text indicates an expected call of Text.
+func (mr *MockForgetPasswordTextContentBuilderMockRecorder) Text(user, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Text", reflect.TypeOf((*MockForgetPasswordTextContentBuilder)(nil).Text), user, token)
+}
@@ -3,7 +3,7 @@
 //
 // Generated by this command:
 //
-//	mockgen -source=content_builders.go -destination=../../mocks/contentbuilders/ticket_deleted_content_builder.go -package=mockcontentbuilders -exclude_interfaces=ForgetPasswordContentBuilder,TicketUpdatedContentBuilder,VerifyEmailContentBuilder
+//	mockgen -source=content_builders.go -destination=../../mocks/contentbuilders/ticket_deleted_content_builder.go -package=mockcontentbuilders -exclude_interfaces=VerifyEmailContentBuilder,ForgetPasswordContentBuilder,TicketUpdatedContentBuilder,VerifyEmailTextContentBuilder,ForgetPasswordTextContentBuilder,TicketUpdatedTextContentBuilder,TicketDeletedTextContentBuilder,ForgetPasswordSMSContentBuilder,TicketUpdatedSMSContentBuilder,TicketDeletedSMSContentBuilder,VerifyEmailInboxContentBuilder,ForgetPasswordInboxContentBuilder,TicketUpdatedInboxContentBuilder,TicketDeletedInboxContentBuilder,ContentTemplates
 //
 
 // Package mockcontentbuilders is a generated GoMock package.
@@ -56,15 +56,15 @@ func (mr *MockTicketDeletedContentBuilderMockRecorder) Body(ticketData, ticketOw
 }
 
 // Subject mocks base method.
-func (m *MockTicketDeletedContentBuilder) Subject(ticketData dto.TicketDeletedDTO) string {
+func (m *MockTicketDeletedContentBuilder) Subject(ticketData dto.TicketDeletedDTO, respondOwner entities.User) string {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Subject", ticketData)
+	ret := m.ctrl.Call(m, "Subject", ticketData, respondOwner)
 	ret0, _ := ret[0].(string)
 	return ret0
 }
 
 // Subject indicates an expected call of Subject.
-func (mr *MockTicketDeletedContentBuilderMockRecorder) Subject(ticketData any) *gomock.Call {
+func (mr *MockTicketDeletedContentBuilderMockRecorder) Subject(ticketData, respondOwner any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subject", reflect.TypeOf((*MockTicketDeletedContentBuilder)(nil).Subject), ticketData)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subject", reflect.TypeOf((*MockTicketDeletedContentBuilder)(nil).Subject), ticketData, respondOwner)
 }
@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: content_builders.go
+//
+// Generated by this command:
+//
+//	mockgen -source=content_builders.go -destination=../../mocks/contentbuilders/ticket_deleted_sms_content_builder.go -package=mockcontentbuilders -exclude_interfaces=VerifyEmailContentBuilder,ForgetPasswordContentBuilder,TicketUpdatedContentBuilder,TicketDeletedContentBuilder,VerifyEmailTextContentBuilder,ForgetPasswordTextContentBuilder,TicketUpdatedTextContentBuilder,TicketDeletedTextContentBuilder,ForgetPasswordSMSContentBuilder,TicketUpdatedSMSContentBuilder,VerifyEmailInboxContentBuilder,ForgetPasswordInboxContentBuilder,TicketUpdatedInboxContentBuilder,TicketDeletedInboxContentBuilder,ContentTemplates
+//
+
+// Package mockcontentbuilders is a generated GoMock package.
+package mockcontentbuilders
+
+import (
+	reflect "reflect"
+
+	dto "github.com/DKhorkov/hmtm-notifications/dto"
+	entities "github.com/DKhorkov/hmtm-notifications/internal/entities"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTicketDeletedSMSContentBuilder is a mock of TicketDeletedSMSContentBuilder interface.
+type MockTicketDeletedSMSContentBuilder struct {
+	ctrl     *gomock.Controller
+	recorder *MockTicketDeletedSMSContentBuilderMockRecorder
+	isgomock struct{}
+}
+
+// MockTicketDeletedSMSContentBuilderMockRecorder is the mock recorder for MockTicketDeletedSMSContentBuilder.
+type MockTicketDeletedSMSContentBuilderMockRecorder struct {
+	mock *MockTicketDeletedSMSContentBuilder
+}
+
+// NewMockTicketDeletedSMSContentBuilder creates a new mock instance.
+func NewMockTicketDeletedSMSContentBuilder(ctrl *gomock.Controller) *MockTicketDeletedSMSContentBuilder {
+	mock := &MockTicketDeletedSMSContentBuilder{ctrl: ctrl}
+	mock.recorder = &MockTicketDeletedSMSContentBuilderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTicketDeletedSMSContentBuilder) EXPECT() *MockTicketDeletedSMSContentBuilderMockRecorder {
+	return m.recorder
+}
+
+// Text mocks base method.
+func (m *MockTicketDeletedSMSContentBuilder) Text(ticketData dto.TicketDeletedDTO, respondOwner entities.User) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Text", ticketData, respondOwner)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Text indicates an expected call of Text.
+func (mr *MockTicketDeletedSMSContentBuilderMockRecorder) Text(ticketData, respondOwner any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Text", reflect.TypeOf((*MockTicketDeletedSMSContentBuilder)(nil).Text), ticketData, respondOwner)
+}
@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: content_builders.go
+//
+// Generated by this command:
+//
+//	mockgen -source=content_builders.go -destination=../../mocks/contentbuilders/ticket_deleted_text_content_builder.go -package=mockcontentbuilders -exclude_interfaces=VerifyEmailContentBuilder,ForgetPasswordContentBuilder,TicketUpdatedContentBuilder,TicketDeletedContentBuilder,VerifyEmailTextContentBuilder,ForgetPasswordTextContentBuilder,TicketUpdatedTextContentBuilder,ForgetPasswordSMSContentBuilder,TicketUpdatedSMSContentBuilder,TicketDeletedSMSContentBuilder,VerifyEmailInboxContentBuilder,ForgetPasswordInboxContentBuilder,TicketUpdatedInboxContentBuilder,TicketDeletedInboxContentBuilder,ContentTemplates
+//
+
+// Package mockcontentbuilders is a generated GoMock package.
+package mockcontentbuilders
+
+import (
+	reflect "reflect"
+
+	dto "github.com/DKhorkov/hmtm-notifications/dto"
+	entities "github.com/DKhorkov/hmtm-notifications/internal/entities"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTicketDeletedTextContentBuilder is a mock of TicketDeletedTextContentBuilder interface.
+type MockTicketDeletedTextContentBuilder struct {
+	ctrl     *gomock.Controller
+	recorder *MockTicketDeletedTextContentBuilderMockRecorder
+	isgomock struct{}
+}
+
+// MockTicketDeletedTextContentBuilderMockRecorder is the mock recorder for MockTicketDeletedTextContentBuilder.
+type MockTicketDeletedTextContentBuilderMockRecorder struct {
+	mock *MockTicketDeletedTextContentBuilder
+}
+
+// NewMockTicketDeletedTextContentBuilder creates a new mock instance.
+func NewMockTicketDeletedTextContentBuilder(ctrl *gomock.Controller) *MockTicketDeletedTextContentBuilder {
+	mock := &MockTicketDeletedTextContentBuilder{ctrl: ctrl}
+	mock.recorder = &MockTicketDeletedTextContentBuilderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTicketDeletedTextContentBuilder) EXPECT() *MockTicketDeletedTextContentBuilderMockRecorder {
+	return m.recorder
+}
+
+// Text mocks base method.
+func (m *MockTicketDeletedTextContentBuilder) Text(ticketData dto.TicketDeletedDTO, ticketOwner, respondOwner entities.User) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Text", ticketData, ticketOwner, respondOwner)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Text indicates an expected call of Text.
+func (mr *MockTicketDeletedTextContentBuilderMockRecorder) Text(ticketData, ticketOwner, respondOwner any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Text", reflect.TypeOf((*MockTicketDeletedTextContentBuilder)(nil).Text), ticketData, ticketOwner, respondOwner)
+}
@@ -3,7 +3,7 @@
 //
 // Generated by this command:
 //
-//	mockgen -source=content_builders.go -destination=../../mocks/contentbuilders/ticket_updated_content_builder.go -package=mockcontentbuilders -exclude_interfaces=ForgetPasswordContentBuilder,VerifyEmailContentBuilder,TicketDeletedContentBuilder
+//	mockgen -source=content_builders.go -destination=../../mocks/contentbuilders/ticket_updated_content_builder.go -package=mockcontentbuilders -exclude_interfaces=VerifyEmailContentBuilder,ForgetPasswordContentBuilder,TicketDeletedContentBuilder,VerifyEmailTextContentBuilder,ForgetPasswordTextContentBuilder,TicketUpdatedTextContentBuilder,TicketDeletedTextContentBuilder,ForgetPasswordSMSContentBuilder,TicketUpdatedSMSContentBuilder,TicketDeletedSMSContentBuilder,VerifyEmailInboxContentBuilder,ForgetPasswordInboxContentBuilder,TicketUpdatedInboxContentBuilder,TicketDeletedInboxContentBuilder,ContentTemplates
 //
 
 // Package mockcontentbuilders is a generated GoMock package.
@@ -41,29 +41,29 @@ func (m *MockTicketUpdatedContentBuilder) EXPECT() *MockTicketUpdatedContentBuil
 }
 
 // Body mocks base method.
-func (m *MockTicketUpdatedContentBuilder) Body(ticket entities.RawTicket, respondOwner entities.User) string {
+func (m *MockTicketUpdatedContentBuilder) Body(ticket entities.RawTicket, changes *entities.TicketChanges, respondOwner entities.User) string {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Body", ticket, respondOwner)
+	ret := m.ctrl.Call(m, "Body", ticket, changes, respondOwner)
 	ret0, _ := ret[0].(string)
 	return ret0
 }
 
 // Body indicates an expected call of Body.
-func (mr *MockTicketUpdatedContentBuilderMockRecorder) Body(ticket, respondOwner any) *gomock.Call {
+func (mr *MockTicketUpdatedContentBuilderMockRecorder) Body(ticket, changes, respondOwner any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Body", reflect.TypeOf((*MockTicketUpdatedContentBuilder)(nil).Body), ticket, respondOwner)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Body", reflect.TypeOf((*MockTicketUpdatedContentBuilder)(nil).Body), ticket, changes, respondOwner)
 }
 
 // Subject mocks base method.
-func (m *MockTicketUpdatedContentBuilder) Subject(ticket entities.RawTicket) string {
+func (m *MockTicketUpdatedContentBuilder) Subject(ticket entities.RawTicket, respondOwner entities.User) string {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Subject", ticket)
+	ret := m.ctrl.Call(m, "Subject", ticket, respondOwner)
 	ret0, _ := ret[0].(string)
 	return ret0
 }
 
 // Subject indicates an expected call of Subject.
-func (mr *MockTicketUpdatedContentBuilderMockRecorder) Subject(ticket any) *gomock.Call {
+func (mr *MockTicketUpdatedContentBuilderMockRecorder) Subject(ticket, respondOwner any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subject", reflect.TypeOf((*MockTicketUpdatedContentBuilder)(nil).Subject), ticket)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subject", reflect.TypeOf((*MockTicketUpdatedContentBuilder)(nil).Subject), ticket, respondOwner)
 }
@@ -0,0 +1,55 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: content_builders.go
+//
+// Generated by this command:
+//
+//	mockgen -source=content_builders.go -destination=../../mocks/contentbuilders/ticket_updated_sms_content_builder.go -package=mockcontentbuilders -exclude_interfaces=VerifyEmailContentBuilder,ForgetPasswordContentBuilder,TicketUpdatedContentBuilder,TicketDeletedContentBuilder,VerifyEmailTextContentBuilder,ForgetPasswordTextContentBuilder,TicketUpdatedTextContentBuilder,TicketDeletedTextContentBuilder,ForgetPasswordSMSContentBuilder,TicketDeletedSMSContentBuilder,VerifyEmailInboxContentBuilder,ForgetPasswordInboxContentBuilder,TicketUpdatedInboxContentBuilder,TicketDeletedInboxContentBuilder,ContentTemplates
+//
+
+// Package mockcontentbuilders is a generated GoMock package.
+package mockcontentbuilders
+
+import (
+	reflect "reflect"
+
+	entities "github.com/DKhorkov/hmtm-notifications/internal/entities"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTicketUpdatedSMSContentBuilder is a mock of TicketUpdatedSMSContentBuilder interface.
+type MockTicketUpdatedSMSContentBuilder struct {
+	ctrl     *gomock.Controller
+	recorder *MockTicketUpdatedSMSContentBuilderMockRecorder
+	isgomock struct{}
+}
+
+// MockTicketUpdatedSMSContentBuilderMockRecorder is the mock recorder for MockTicketUpdatedSMSContentBuilder.
+type MockTicketUpdatedSMSContentBuilderMockRecorder struct {
+	mock *MockTicketUpdatedSMSContentBuilder
+}
+
+// NewMockTicketUpdatedSMSContentBuilder creates a new mock instance.
+func NewMockTicketUpdatedSMSContentBuilder(ctrl *gomock.Controller) *MockTicketUpdatedSMSContentBuilder {
+	mock := &MockTicketUpdatedSMSContentBuilder{ctrl: ctrl}
+	mock.recorder = &MockTicketUpdatedSMSContentBuilderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTicketUpdatedSMSContentBuilder) EXPECT() *MockTicketUpdatedSMSContentBuilderMockRecorder {
+	return m.recorder
+}
+
+// Text mocks base method.
+func (m *MockTicketUpdatedSMSContentBuilder) Text(ticket entities.RawTicket, respondOwner entities.User) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Text", ticket, respondOwner)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Text indicates an expected call of Text.
+func (mr *MockTicketUpdatedSMSContentBuilderMockRecorder) Text(ticket, respondOwner any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Text", reflect.TypeOf((*MockTicketUpdatedSMSContentBuilder)(nil).Text), ticket, respondOwner)
+}
@@ -0,0 +1,55 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: content_builders.go
+//
+// Generated by this command:
+//
+//	mockgen -source=content_builders.go -destination=../../mocks/contentbuilders/ticket_updated_text_content_builder.go -package=mockcontentbuilders -exclude_interfaces=VerifyEmailContentBuilder,ForgetPasswordContentBuilder,TicketUpdatedContentBuilder,TicketDeletedContentBuilder,VerifyEmailTextContentBuilder,ForgetPasswordTextContentBuilder,TicketDeletedTextContentBuilder,ForgetPasswordSMSContentBuilder,TicketUpdatedSMSContentBuilder,TicketDeletedSMSContentBuilder,VerifyEmailInboxContentBuilder,ForgetPasswordInboxContentBuilder,TicketUpdatedInboxContentBuilder,TicketDeletedInboxContentBuilder,ContentTemplates
+//
+
+// Package mockcontentbuilders is a generated GoMock package.
+package mockcontentbuilders
+
+import (
+	reflect "reflect"
+
+	entities "github.com/DKhorkov/hmtm-notifications/internal/entities"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTicketUpdatedTextContentBuilder is a mock of TicketUpdatedTextContentBuilder interface.
+type MockTicketUpdatedTextContentBuilder struct {
+	ctrl     *gomock.Controller
+	recorder *MockTicketUpdatedTextContentBuilderMockRecorder
+	isgomock struct{}
+}
+
+// MockTicketUpdatedTextContentBuilderMockRecorder is the mock recorder for MockTicketUpdatedTextContentBuilder.
+type MockTicketUpdatedTextContentBuilderMockRecorder struct {
+	mock *MockTicketUpdatedTextContentBuilder
+}
+
+// NewMockTicketUpdatedTextContentBuilder creates a new mock instance.
+func NewMockTicketUpdatedTextContentBuilder(ctrl *gomock.Controller) *MockTicketUpdatedTextContentBuilder {
+	mock := &MockTicketUpdatedTextContentBuilder{ctrl: ctrl}
+	mock.recorder = &MockTicketUpdatedTextContentBuilderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTicketUpdatedTextContentBuilder) EXPECT() *MockTicketUpdatedTextContentBuilderMockRecorder {
+	return m.recorder
+}
+
+// Text mocks base method.
+func (m *MockTicketUpdatedTextContentBuilder) Text(ticket entities.RawTicket, changes *entities.TicketChanges, respondOwner entities.User) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Text", ticket, changes, respondOwner)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Text indicates an expected call of Text.
+func (mr *MockTicketUpdatedTextContentBuilderMockRecorder) Text(ticket, changes, respondOwner any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Text", reflect.TypeOf((*MockTicketUpdatedTextContentBuilder)(nil).Text), ticket, changes, respondOwner)
+}
@@ -0,0 +1,55 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: content_builders.go
+//
+// Generated by this command:
+//
+//	mockgen -source=content_builders.go -destination=../../mocks/contentbuilders/verify_email_text_content_builder.go -package=mockcontentbuilders -exclude_interfaces=VerifyEmailContentBuilder,ForgetPasswordContentBuilder,TicketUpdatedContentBuilder,TicketDeletedContentBuilder,ForgetPasswordTextContentBuilder,TicketUpdatedTextContentBuilder,TicketDeletedTextContentBuilder,ForgetPasswordSMSContentBuilder,TicketUpdatedSMSContentBuilder,TicketDeletedSMSContentBuilder,VerifyEmailInboxContentBuilder,ForgetPasswordInboxContentBuilder,TicketUpdatedInboxContentBuilder,TicketDeletedInboxContentBuilder,ContentTemplates
+//
+
+// Package mockcontentbuilders is a generated GoMock package.
+package mockcontentbuilders
+
+import (
+	reflect "reflect"
+
+	entities "github.com/DKhorkov/hmtm-notifications/internal/entities"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockVerifyEmailTextContentBuilder is a mock of VerifyEmailTextContentBuilder interface.
+type MockVerifyEmailTextContentBuilder struct {
+	ctrl     *gomock.Controller
+	recorder *MockVerifyEmailTextContentBuilderMockRecorder
+	isgomock struct{}
+}
+
+// MockVerifyEmailTextContentBuilderMockRecorder is the mock recorder for MockVerifyEmailTextContentBuilder.
+type MockVerifyEmailTextContentBuilderMockRecorder struct {
+	mock *MockVerifyEmailTextContentBuilder
+}
+
+// NewMockVerifyEmailTextContentBuilder creates a new mock instance.
+func NewMockVerifyEmailTextContentBuilder(ctrl *gomock.Controller) *MockVerifyEmailTextContentBuilder {
+	mock := &MockVerifyEmailTextContentBuilder{ctrl: ctrl}
+	mock.recorder = &MockVerifyEmailTextContentBuilderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVerifyEmailTextContentBuilder) EXPECT() *MockVerifyEmailTextContentBuilderMockRecorder {
+	return m.recorder
+}
+
+// Text mocks base method.
+func (m *MockVerifyEmailTextContentBuilder) Text(user entities.User, token string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Text", user, token)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Text indicates an expected call of Text.
+func (mr *MockVerifyEmailTextContentBuilderMockRecorder) Text(user, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Text", reflect.TypeOf((*MockVerifyEmailTextContentBuilder)(nil).Text), user, token)
+}
@@ -0,0 +1,163 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/nats-io/nats.go/jetstream (interfaces: Publisher)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../../mocks/nats/publisher.go -package=mocknats github.com/nats-io/nats.go/jetstream Publisher
+//
+
+// Package mocknats is a generated GoMock package.
+package mocknats
+
+import (
+	context "context"
+	reflect "reflect"
+
+	nats "github.com/nats-io/nats.go"
+	jetstream "github.com/nats-io/nats.go/jetstream"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPublisher is a mock of Publisher interface.
+type MockPublisher struct {
+	ctrl     *gomock.Controller
+	recorder *MockPublisherMockRecorder
+	isgomock struct{}
+}
+
+// MockPublisherMockRecorder is the mock recorder for MockPublisher.
+type MockPublisherMockRecorder struct {
+	mock *MockPublisher
+}
+
+// NewMockPublisher creates a new mock instance.
+func NewMockPublisher(ctrl *gomock.Controller) *MockPublisher {
+	mock := &MockPublisher{ctrl: ctrl}
+	mock.recorder = &MockPublisherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPublisher) EXPECT() *MockPublisherMockRecorder {
+	return m.recorder
+}
+
+// CleanupPublisher mocks base method.
+func (m *MockPublisher) CleanupPublisher() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CleanupPublisher")
+}
+
+// CleanupPublisher indicates an expected call of CleanupPublisher.
+func (mr *MockPublisherMockRecorder) CleanupPublisher() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CleanupPublisher", reflect.TypeOf((*MockPublisher)(nil).CleanupPublisher))
+}
+
+// Publish mocks base method.
+func (m *MockPublisher) Publish(ctx context.Context, subject string, payload []byte, opts ...jetstream.PublishOpt) (*jetstream.PubAck, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, subject, payload}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Publish", varargs...)
+	ret0, _ := ret[0].(*jetstream.PubAck)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Publish indicates an expected call of Publish.
+func (mr *MockPublisherMockRecorder) Publish(ctx, subject, payload any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, subject, payload}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockPublisher)(nil).Publish), varargs...)
+}
+
+// PublishAsync mocks base method.
+func (m *MockPublisher) PublishAsync(subject string, payload []byte, opts ...jetstream.PublishOpt) (jetstream.PubAckFuture, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{subject, payload}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PublishAsync", varargs...)
+	ret0, _ := ret[0].(jetstream.PubAckFuture)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublishAsync indicates an expected call of PublishAsync.
+func (mr *MockPublisherMockRecorder) PublishAsync(subject, payload any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{subject, payload}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishAsync", reflect.TypeOf((*MockPublisher)(nil).PublishAsync), varargs...)
+}
+
+// PublishAsyncComplete mocks base method.
+func (m *MockPublisher) PublishAsyncComplete() <-chan struct{} {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishAsyncComplete")
+	ret0, _ := ret[0].(<-chan struct{})
+	return ret0
+}
+
+// PublishAsyncComplete indicates an expected call of PublishAsyncComplete.
+func (mr *MockPublisherMockRecorder) PublishAsyncComplete() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishAsyncComplete", reflect.TypeOf((*MockPublisher)(nil).PublishAsyncComplete))
+}
+
+// PublishAsyncPending mocks base method.
+func (m *MockPublisher) PublishAsyncPending() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishAsyncPending")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// PublishAsyncPending indicates an expected call of PublishAsyncPending.
+func (mr *MockPublisherMockRecorder) PublishAsyncPending() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishAsyncPending", reflect.TypeOf((*MockPublisher)(nil).PublishAsyncPending))
+}
+
+// PublishMsg mocks base method.
+func (m *MockPublisher) PublishMsg(ctx context.Context, msg *nats.Msg, opts ...jetstream.PublishOpt) (*jetstream.PubAck, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, msg}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PublishMsg", varargs...)
+	ret0, _ := ret[0].(*jetstream.PubAck)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublishMsg indicates an expected call of PublishMsg.
+func (mr *MockPublisherMockRecorder) PublishMsg(ctx, msg any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, msg}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishMsg", reflect.TypeOf((*MockPublisher)(nil).PublishMsg), varargs...)
+}
+
+// PublishMsgAsync mocks base method.
+func (m *MockPublisher) PublishMsgAsync(msg *nats.Msg, opts ...jetstream.PublishOpt) (jetstream.PubAckFuture, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{msg}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PublishMsgAsync", varargs...)
+	ret0, _ := ret[0].(jetstream.PubAckFuture)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublishMsgAsync indicates an expected call of PublishMsgAsync.
+func (mr *MockPublisherMockRecorder) PublishMsgAsync(msg any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{msg}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishMsgAsync", reflect.TypeOf((*MockPublisher)(nil).PublishMsgAsync), varargs...)
+}
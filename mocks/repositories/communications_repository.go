@@ -0,0 +1,188 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repositories.go
+//
+// Generated by this command:
+//
+//	mockgen -source=repositories.go -destination=../../mocks/repositories/communications_repository.go -exclude_interfaces=ToysRepository,SsoRepository,TicketsRepository,ProcessedMessagesRepository,NotificationsRepository,WebhooksRepository,TokensRepository,TemplatesRepository -package=mockrepositories
+//
+
+// Package mockrepositories is a generated GoMock package.
+package mockrepositories
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entities "github.com/DKhorkov/hmtm-notifications/internal/entities"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCommunicationsRepository is a mock of CommunicationsRepository interface.
+type MockCommunicationsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCommunicationsRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockCommunicationsRepositoryMockRecorder is the mock recorder for MockCommunicationsRepository.
+type MockCommunicationsRepositoryMockRecorder struct {
+	mock *MockCommunicationsRepository
+}
+
+// NewMockCommunicationsRepository creates a new mock instance.
+func NewMockCommunicationsRepository(ctrl *gomock.Controller) *MockCommunicationsRepository {
+	mock := &MockCommunicationsRepository{ctrl: ctrl}
+	mock.recorder = &MockCommunicationsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCommunicationsRepository) EXPECT() *MockCommunicationsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ClaimCommunication mocks base method.
+func (m *MockCommunicationsRepository) ClaimCommunication(ctx context.Context, communication entities.Communication, leaseUntil time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimCommunication", ctx, communication, leaseUntil)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimCommunication indicates an expected call of ClaimCommunication.
+func (mr *MockCommunicationsRepositoryMockRecorder) ClaimCommunication(ctx, communication, leaseUntil any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimCommunication", reflect.TypeOf((*MockCommunicationsRepository)(nil).ClaimCommunication), ctx, communication, leaseUntil)
+}
+
+// CountUserCommunications mocks base method.
+func (m *MockCommunicationsRepository) CountUserCommunications(ctx context.Context, userID uint64, channel entities.CommunicationChannel) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUserCommunications", ctx, userID, channel)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountUserCommunications indicates an expected call of CountUserCommunications.
+func (mr *MockCommunicationsRepositoryMockRecorder) CountUserCommunications(ctx, userID, channel any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUserCommunications", reflect.TypeOf((*MockCommunicationsRepository)(nil).CountUserCommunications), ctx, userID, channel)
+}
+
+// DeferCommunication mocks base method.
+func (m *MockCommunicationsRepository) DeferCommunication(ctx context.Context, id uint64, attempts uint32, nextAttemptAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeferCommunication", ctx, id, attempts, nextAttemptAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeferCommunication indicates an expected call of DeferCommunication.
+func (mr *MockCommunicationsRepositoryMockRecorder) DeferCommunication(ctx, id, attempts, nextAttemptAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeferCommunication", reflect.TypeOf((*MockCommunicationsRepository)(nil).DeferCommunication), ctx, id, attempts, nextAttemptAt)
+}
+
+// GetPendingCommunications mocks base method.
+func (m *MockCommunicationsRepository) GetPendingCommunications(ctx context.Context, limit uint64) ([]entities.Communication, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingCommunications", ctx, limit)
+	ret0, _ := ret[0].([]entities.Communication)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingCommunications indicates an expected call of GetPendingCommunications.
+func (mr *MockCommunicationsRepositoryMockRecorder) GetPendingCommunications(ctx, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingCommunications", reflect.TypeOf((*MockCommunicationsRepository)(nil).GetPendingCommunications), ctx, limit)
+}
+
+// GetUserCommunications mocks base method.
+func (m *MockCommunicationsRepository) GetUserCommunications(ctx context.Context, userID uint64, channel entities.CommunicationChannel, pagination *entities.Pagination) ([]entities.Communication, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserCommunications", ctx, userID, channel, pagination)
+	ret0, _ := ret[0].([]entities.Communication)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserCommunications indicates an expected call of GetUserCommunications.
+func (mr *MockCommunicationsRepositoryMockRecorder) GetUserCommunications(ctx, userID, channel, pagination any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserCommunications", reflect.TypeOf((*MockCommunicationsRepository)(nil).GetUserCommunications), ctx, userID, channel, pagination)
+}
+
+// MarkCommunicationFailed mocks base method.
+func (m *MockCommunicationsRepository) MarkCommunicationFailed(ctx context.Context, id uint64, attempts uint32, lastError string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkCommunicationFailed", ctx, id, attempts, lastError)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkCommunicationFailed indicates an expected call of MarkCommunicationFailed.
+func (mr *MockCommunicationsRepositoryMockRecorder) MarkCommunicationFailed(ctx, id, attempts, lastError any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkCommunicationFailed", reflect.TypeOf((*MockCommunicationsRepository)(nil).MarkCommunicationFailed), ctx, id, attempts, lastError)
+}
+
+// MarkCommunicationSent mocks base method.
+func (m *MockCommunicationsRepository) MarkCommunicationSent(ctx context.Context, id uint64, attempts uint32, providerMessageID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkCommunicationSent", ctx, id, attempts, providerMessageID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkCommunicationSent indicates an expected call of MarkCommunicationSent.
+func (mr *MockCommunicationsRepositoryMockRecorder) MarkCommunicationSent(ctx, id, attempts, providerMessageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkCommunicationSent", reflect.TypeOf((*MockCommunicationsRepository)(nil).MarkCommunicationSent), ctx, id, attempts, providerMessageID)
+}
+
+// RescheduleCommunication mocks base method.
+func (m *MockCommunicationsRepository) RescheduleCommunication(ctx context.Context, id uint64, attempts uint32, lastError string, nextAttemptAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RescheduleCommunication", ctx, id, attempts, lastError, nextAttemptAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RescheduleCommunication indicates an expected call of RescheduleCommunication.
+func (mr *MockCommunicationsRepositoryMockRecorder) RescheduleCommunication(ctx, id, attempts, lastError, nextAttemptAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RescheduleCommunication", reflect.TypeOf((*MockCommunicationsRepository)(nil).RescheduleCommunication), ctx, id, attempts, lastError, nextAttemptAt)
+}
+
+// SaveCommunication mocks base method.
+func (m *MockCommunicationsRepository) SaveCommunication(ctx context.Context, communication entities.Communication) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveCommunication", ctx, communication)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveCommunication indicates an expected call of SaveCommunication.
+func (mr *MockCommunicationsRepositoryMockRecorder) SaveCommunication(ctx, communication any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveCommunication", reflect.TypeOf((*MockCommunicationsRepository)(nil).SaveCommunication), ctx, communication)
+}
+
+// SaveCommunicationSentParts mocks base method.
+func (m *MockCommunicationsRepository) SaveCommunicationSentParts(ctx context.Context, id uint64, attempts, sentParts uint32, providerMessageID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveCommunicationSentParts", ctx, id, attempts, sentParts, providerMessageID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveCommunicationSentParts indicates an expected call of SaveCommunicationSentParts.
+func (mr *MockCommunicationsRepositoryMockRecorder) SaveCommunicationSentParts(ctx, id, attempts, sentParts, providerMessageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveCommunicationSentParts", reflect.TypeOf((*MockCommunicationsRepository)(nil).SaveCommunicationSentParts), ctx, id, attempts, sentParts, providerMessageID)
+}
@@ -0,0 +1,116 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repositories.go
+//
+// Generated by this command:
+//
+//	mockgen -source=repositories.go -destination=../../mocks/repositories/processed_messages_repository.go -exclude_interfaces=ToysRepository,CommunicationsRepository,SsoRepository,TicketsRepository,NotificationsRepository,WebhooksRepository,TokensRepository,TemplatesRepository -package=mockrepositories
+//
+
+// Package mockrepositories is a generated GoMock package.
+package mockrepositories
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entities "github.com/DKhorkov/hmtm-notifications/internal/entities"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockProcessedMessagesRepository is a mock of ProcessedMessagesRepository interface.
+type MockProcessedMessagesRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockProcessedMessagesRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockProcessedMessagesRepositoryMockRecorder is the mock recorder for MockProcessedMessagesRepository.
+type MockProcessedMessagesRepositoryMockRecorder struct {
+	mock *MockProcessedMessagesRepository
+}
+
+// NewMockProcessedMessagesRepository creates a new mock instance.
+func NewMockProcessedMessagesRepository(ctrl *gomock.Controller) *MockProcessedMessagesRepository {
+	mock := &MockProcessedMessagesRepository{ctrl: ctrl}
+	mock.recorder = &MockProcessedMessagesRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProcessedMessagesRepository) EXPECT() *MockProcessedMessagesRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CompleteProcessedMessage mocks base method.
+func (m *MockProcessedMessagesRepository) CompleteProcessedMessage(ctx context.Context, idempotencyKey string, emailIDs []uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteProcessedMessage", ctx, idempotencyKey, emailIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteProcessedMessage indicates an expected call of CompleteProcessedMessage.
+func (mr *MockProcessedMessagesRepositoryMockRecorder) CompleteProcessedMessage(ctx, idempotencyKey, emailIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteProcessedMessage", reflect.TypeOf((*MockProcessedMessagesRepository)(nil).CompleteProcessedMessage), ctx, idempotencyKey, emailIDs)
+}
+
+// DeleteExpiredProcessedMessages mocks base method.
+func (m *MockProcessedMessagesRepository) DeleteExpiredProcessedMessages(ctx context.Context, processedBefore, reservedBefore time.Time) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpiredProcessedMessages", ctx, processedBefore, reservedBefore)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteExpiredProcessedMessages indicates an expected call of DeleteExpiredProcessedMessages.
+func (mr *MockProcessedMessagesRepositoryMockRecorder) DeleteExpiredProcessedMessages(ctx, processedBefore, reservedBefore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpiredProcessedMessages", reflect.TypeOf((*MockProcessedMessagesRepository)(nil).DeleteExpiredProcessedMessages), ctx, processedBefore, reservedBefore)
+}
+
+// GetProcessedMessage mocks base method.
+func (m *MockProcessedMessagesRepository) GetProcessedMessage(ctx context.Context, idempotencyKey string) (*entities.ProcessedMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProcessedMessage", ctx, idempotencyKey)
+	ret0, _ := ret[0].(*entities.ProcessedMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProcessedMessage indicates an expected call of GetProcessedMessage.
+func (mr *MockProcessedMessagesRepositoryMockRecorder) GetProcessedMessage(ctx, idempotencyKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProcessedMessage", reflect.TypeOf((*MockProcessedMessagesRepository)(nil).GetProcessedMessage), ctx, idempotencyKey)
+}
+
+// ReleaseProcessedMessage mocks base method.
+func (m *MockProcessedMessagesRepository) ReleaseProcessedMessage(ctx context.Context, idempotencyKey string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseProcessedMessage", ctx, idempotencyKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseProcessedMessage indicates an expected call of ReleaseProcessedMessage.
+func (mr *MockProcessedMessagesRepositoryMockRecorder) ReleaseProcessedMessage(ctx, idempotencyKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseProcessedMessage", reflect.TypeOf((*MockProcessedMessagesRepository)(nil).ReleaseProcessedMessage), ctx, idempotencyKey)
+}
+
+// ReserveProcessedMessage mocks base method.
+func (m *MockProcessedMessagesRepository) ReserveProcessedMessage(ctx context.Context, idempotencyKey string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReserveProcessedMessage", ctx, idempotencyKey)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReserveProcessedMessage indicates an expected call of ReserveProcessedMessage.
+func (mr *MockProcessedMessagesRepositoryMockRecorder) ReserveProcessedMessage(ctx, idempotencyKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReserveProcessedMessage", reflect.TypeOf((*MockProcessedMessagesRepository)(nil).ReserveProcessedMessage), ctx, idempotencyKey)
+}
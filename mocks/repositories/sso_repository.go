@@ -3,7 +3,7 @@
 //
 // Generated by this command:
 //
-//	mockgen -source=repositories.go -destination=../../mocks/repositories/sso_repository.go -exclude_interfaces=ToysRepository,EmailsRepository,TicketsRepository -package=mockrepositories
+//	mockgen -source=repositories.go -destination=../../mocks/repositories/sso_repository.go -exclude_interfaces=ToysRepository,CommunicationsRepository,TicketsRepository,ProcessedMessagesRepository,NotificationsRepository,WebhooksRepository,TokensRepository,TemplatesRepository -package=mockrepositories
 //
 
 // Package mockrepositories is a generated GoMock package.
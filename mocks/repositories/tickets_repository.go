@@ -3,7 +3,7 @@
 //
 // Generated by this command:
 //
-//	mockgen -source=repositories.go -destination=../../mocks/repositories/tickets_repository.go -exclude_interfaces=ToysRepository,EmailsRepository,SsoRepository -package=mockrepositories
+//	mockgen -source=repositories.go -destination=../../mocks/repositories/tickets_repository.go -exclude_interfaces=ToysRepository,CommunicationsRepository,SsoRepository,ProcessedMessagesRepository,NotificationsRepository,WebhooksRepository,TokensRepository,TemplatesRepository -package=mockrepositories
 //
 
 // Package mockrepositories is a generated GoMock package.
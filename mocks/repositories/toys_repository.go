@@ -3,7 +3,7 @@
 //
 // Generated by this command:
 //
-//	mockgen -source=repositories.go -destination=../../mocks/repositories/toys_repository.go -exclude_interfaces=TicketsRepository,EmailsRepository,SsoRepository -package=mockrepositories
+//	mockgen -source=repositories.go -destination=../../mocks/repositories/toys_repository.go -exclude_interfaces=TicketsRepository,CommunicationsRepository,SsoRepository,ProcessedMessagesRepository,NotificationsRepository,WebhooksRepository,TokensRepository,TemplatesRepository -package=mockrepositories
 //
 
 // Package mockrepositories is a generated GoMock package.
@@ -0,0 +1,276 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repositories.go
+//
+// Generated by this command:
+//
+//	mockgen -source=repositories.go -destination=../../mocks/repositories/webhooks_repository.go -exclude_interfaces=ToysRepository,CommunicationsRepository,SsoRepository,TicketsRepository,ProcessedMessagesRepository,NotificationsRepository,TokensRepository,TemplatesRepository -package=mockrepositories
+//
+
+// Package mockrepositories is a generated GoMock package.
+package mockrepositories
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entities "github.com/DKhorkov/hmtm-notifications/internal/entities"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWebhooksRepository is a mock of WebhooksRepository interface.
+type MockWebhooksRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhooksRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockWebhooksRepositoryMockRecorder is the mock recorder for MockWebhooksRepository.
+type MockWebhooksRepositoryMockRecorder struct {
+	mock *MockWebhooksRepository
+}
+
+// NewMockWebhooksRepository creates a new mock instance.
+func NewMockWebhooksRepository(ctrl *gomock.Controller) *MockWebhooksRepository {
+	mock := &MockWebhooksRepository{ctrl: ctrl}
+	mock.recorder = &MockWebhooksRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhooksRepository) EXPECT() *MockWebhooksRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ClaimWebhookDelivery mocks base method.
+func (m *MockWebhooksRepository) ClaimWebhookDelivery(ctx context.Context, delivery entities.WebhookDelivery, leaseUntil time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimWebhookDelivery", ctx, delivery, leaseUntil)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimWebhookDelivery indicates an expected call of ClaimWebhookDelivery.
+func (mr *MockWebhooksRepositoryMockRecorder) ClaimWebhookDelivery(ctx, delivery, leaseUntil any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimWebhookDelivery", reflect.TypeOf((*MockWebhooksRepository)(nil).ClaimWebhookDelivery), ctx, delivery, leaseUntil)
+}
+
+// DeferWebhookDelivery mocks base method.
+func (m *MockWebhooksRepository) DeferWebhookDelivery(ctx context.Context, id uint64, attempts uint32, nextAttemptAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeferWebhookDelivery", ctx, id, attempts, nextAttemptAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeferWebhookDelivery indicates an expected call of DeferWebhookDelivery.
+func (mr *MockWebhooksRepositoryMockRecorder) DeferWebhookDelivery(ctx, id, attempts, nextAttemptAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeferWebhookDelivery", reflect.TypeOf((*MockWebhooksRepository)(nil).DeferWebhookDelivery), ctx, id, attempts, nextAttemptAt)
+}
+
+// DeleteWebhook mocks base method.
+func (m *MockWebhooksRepository) DeleteWebhook(ctx context.Context, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWebhook", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWebhook indicates an expected call of DeleteWebhook.
+func (mr *MockWebhooksRepositoryMockRecorder) DeleteWebhook(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhook", reflect.TypeOf((*MockWebhooksRepository)(nil).DeleteWebhook), ctx, id)
+}
+
+// GetEnabledWebhooks mocks base method.
+func (m *MockWebhooksRepository) GetEnabledWebhooks(ctx context.Context) ([]entities.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEnabledWebhooks", ctx)
+	ret0, _ := ret[0].([]entities.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEnabledWebhooks indicates an expected call of GetEnabledWebhooks.
+func (mr *MockWebhooksRepositoryMockRecorder) GetEnabledWebhooks(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEnabledWebhooks", reflect.TypeOf((*MockWebhooksRepository)(nil).GetEnabledWebhooks), ctx)
+}
+
+// GetPendingWebhookDeliveries mocks base method.
+func (m *MockWebhooksRepository) GetPendingWebhookDeliveries(ctx context.Context, limit uint64) ([]entities.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingWebhookDeliveries", ctx, limit)
+	ret0, _ := ret[0].([]entities.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingWebhookDeliveries indicates an expected call of GetPendingWebhookDeliveries.
+func (mr *MockWebhooksRepositoryMockRecorder) GetPendingWebhookDeliveries(ctx, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingWebhookDeliveries", reflect.TypeOf((*MockWebhooksRepository)(nil).GetPendingWebhookDeliveries), ctx, limit)
+}
+
+// GetWebhookByID mocks base method.
+func (m *MockWebhooksRepository) GetWebhookByID(ctx context.Context, id uint64) (*entities.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhookByID", ctx, id)
+	ret0, _ := ret[0].(*entities.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhookByID indicates an expected call of GetWebhookByID.
+func (mr *MockWebhooksRepositoryMockRecorder) GetWebhookByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookByID", reflect.TypeOf((*MockWebhooksRepository)(nil).GetWebhookByID), ctx, id)
+}
+
+// GetWebhookDeliveries mocks base method.
+func (m *MockWebhooksRepository) GetWebhookDeliveries(ctx context.Context, webhookID uint64, pagination *entities.Pagination) ([]entities.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhookDeliveries", ctx, webhookID, pagination)
+	ret0, _ := ret[0].([]entities.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhookDeliveries indicates an expected call of GetWebhookDeliveries.
+func (mr *MockWebhooksRepositoryMockRecorder) GetWebhookDeliveries(ctx, webhookID, pagination any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookDeliveries", reflect.TypeOf((*MockWebhooksRepository)(nil).GetWebhookDeliveries), ctx, webhookID, pagination)
+}
+
+// GetWebhooks mocks base method.
+func (m *MockWebhooksRepository) GetWebhooks(ctx context.Context) ([]entities.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhooks", ctx)
+	ret0, _ := ret[0].([]entities.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhooks indicates an expected call of GetWebhooks.
+func (mr *MockWebhooksRepositoryMockRecorder) GetWebhooks(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhooks", reflect.TypeOf((*MockWebhooksRepository)(nil).GetWebhooks), ctx)
+}
+
+// MarkWebhookDeliveryDelivered mocks base method.
+func (m *MockWebhooksRepository) MarkWebhookDeliveryDelivered(ctx context.Context, id uint64, attempts uint32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkWebhookDeliveryDelivered", ctx, id, attempts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkWebhookDeliveryDelivered indicates an expected call of MarkWebhookDeliveryDelivered.
+func (mr *MockWebhooksRepositoryMockRecorder) MarkWebhookDeliveryDelivered(ctx, id, attempts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkWebhookDeliveryDelivered", reflect.TypeOf((*MockWebhooksRepository)(nil).MarkWebhookDeliveryDelivered), ctx, id, attempts)
+}
+
+// MarkWebhookDeliveryFailed mocks base method.
+func (m *MockWebhooksRepository) MarkWebhookDeliveryFailed(ctx context.Context, id uint64, attempts uint32, lastError string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkWebhookDeliveryFailed", ctx, id, attempts, lastError)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkWebhookDeliveryFailed indicates an expected call of MarkWebhookDeliveryFailed.
+func (mr *MockWebhooksRepositoryMockRecorder) MarkWebhookDeliveryFailed(ctx, id, attempts, lastError any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkWebhookDeliveryFailed", reflect.TypeOf((*MockWebhooksRepository)(nil).MarkWebhookDeliveryFailed), ctx, id, attempts, lastError)
+}
+
+// RegisterWebhookFailure mocks base method.
+func (m *MockWebhooksRepository) RegisterWebhookFailure(ctx context.Context, id uint64, disableThreshold int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterWebhookFailure", ctx, id, disableThreshold)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterWebhookFailure indicates an expected call of RegisterWebhookFailure.
+func (mr *MockWebhooksRepositoryMockRecorder) RegisterWebhookFailure(ctx, id, disableThreshold any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterWebhookFailure", reflect.TypeOf((*MockWebhooksRepository)(nil).RegisterWebhookFailure), ctx, id, disableThreshold)
+}
+
+// RescheduleWebhookDelivery mocks base method.
+func (m *MockWebhooksRepository) RescheduleWebhookDelivery(ctx context.Context, id uint64, attempts uint32, lastError string, nextAttemptAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RescheduleWebhookDelivery", ctx, id, attempts, lastError, nextAttemptAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RescheduleWebhookDelivery indicates an expected call of RescheduleWebhookDelivery.
+func (mr *MockWebhooksRepositoryMockRecorder) RescheduleWebhookDelivery(ctx, id, attempts, lastError, nextAttemptAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RescheduleWebhookDelivery", reflect.TypeOf((*MockWebhooksRepository)(nil).RescheduleWebhookDelivery), ctx, id, attempts, lastError, nextAttemptAt)
+}
+
+// ResetWebhookFailures mocks base method.
+func (m *MockWebhooksRepository) ResetWebhookFailures(ctx context.Context, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetWebhookFailures", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetWebhookFailures indicates an expected call of ResetWebhookFailures.
+func (mr *MockWebhooksRepositoryMockRecorder) ResetWebhookFailures(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetWebhookFailures", reflect.TypeOf((*MockWebhooksRepository)(nil).ResetWebhookFailures), ctx, id)
+}
+
+// SaveWebhook mocks base method.
+func (m *MockWebhooksRepository) SaveWebhook(ctx context.Context, webhook entities.Webhook) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveWebhook", ctx, webhook)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveWebhook indicates an expected call of SaveWebhook.
+func (mr *MockWebhooksRepositoryMockRecorder) SaveWebhook(ctx, webhook any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveWebhook", reflect.TypeOf((*MockWebhooksRepository)(nil).SaveWebhook), ctx, webhook)
+}
+
+// SaveWebhookDelivery mocks base method.
+func (m *MockWebhooksRepository) SaveWebhookDelivery(ctx context.Context, delivery entities.WebhookDelivery) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveWebhookDelivery", ctx, delivery)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveWebhookDelivery indicates an expected call of SaveWebhookDelivery.
+func (mr *MockWebhooksRepositoryMockRecorder) SaveWebhookDelivery(ctx, delivery any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveWebhookDelivery", reflect.TypeOf((*MockWebhooksRepository)(nil).SaveWebhookDelivery), ctx, delivery)
+}
+
+// UpdateWebhook mocks base method.
+func (m *MockWebhooksRepository) UpdateWebhook(ctx context.Context, webhook entities.Webhook) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWebhook", ctx, webhook)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWebhook indicates an expected call of UpdateWebhook.
+func (mr *MockWebhooksRepositoryMockRecorder) UpdateWebhook(ctx, webhook any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWebhook", reflect.TypeOf((*MockWebhooksRepository)(nil).UpdateWebhook), ctx, webhook)
+}
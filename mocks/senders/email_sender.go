@@ -3,7 +3,7 @@
 //
 // Generated by this command:
 //
-//	mockgen -source=senders.go -destination=../../mocks/senders/email_sender.go -package=mocksenders -exclude_interfaces=
+//	mockgen -source=senders.go -destination=../../mocks/senders/email_sender.go -package=mocksenders -exclude_interfaces=TelegramSender,SMSSender,SMSProvider,WebhookSender
 //
 
 // Package mocksenders is a generated GoMock package.
@@ -41,15 +41,16 @@ func (m *MockEmailSender) EXPECT() *MockEmailSenderMockRecorder {
 }
 
 // Send mocks base method.
-func (m *MockEmailSender) Send(ctx context.Context, subject, body string, recipients []string) error {
+func (m *MockEmailSender) Send(ctx context.Context, subject, body, text string, recipients []string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Send", ctx, subject, body, recipients)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "Send", ctx, subject, body, text, recipients)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // Send indicates an expected call of Send.
-func (mr *MockEmailSenderMockRecorder) Send(ctx, subject, body, recipients any) *gomock.Call {
+func (mr *MockEmailSenderMockRecorder) Send(ctx, subject, body, text, recipients any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockEmailSender)(nil).Send), ctx, subject, body, recipients)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockEmailSender)(nil).Send), ctx, subject, body, text, recipients)
 }
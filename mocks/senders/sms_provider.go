@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: senders.go
+//
+// Generated by this command:
+//
+//	mockgen -source=senders.go -destination=../../mocks/senders/sms_provider.go -package=mocksenders -exclude_interfaces=EmailSender,TelegramSender,SMSSender,WebhookSender
+//
+
+// Package mocksenders is a generated GoMock package.
+package mocksenders
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSMSProvider is a mock of SMSProvider interface.
+type MockSMSProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockSMSProviderMockRecorder
+	isgomock struct{}
+}
+
+// MockSMSProviderMockRecorder is the mock recorder for MockSMSProvider.
+type MockSMSProviderMockRecorder struct {
+	mock *MockSMSProvider
+}
+
+// NewMockSMSProvider creates a new mock instance.
+func NewMockSMSProvider(ctrl *gomock.Controller) *MockSMSProvider {
+	mock := &MockSMSProvider{ctrl: ctrl}
+	mock.recorder = &MockSMSProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSMSProvider) EXPECT() *MockSMSProviderMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method.
+func (m *MockSMSProvider) Send(ctx context.Context, phone, text string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", ctx, phone, text)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockSMSProviderMockRecorder) Send(ctx, phone, text any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockSMSProvider)(nil).Send), ctx, phone, text)
+}
@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: senders.go
+//
+// Generated by this command:
+//
+//	mockgen -source=senders.go -destination=../../mocks/senders/sms_sender.go -package=mocksenders -exclude_interfaces=EmailSender,TelegramSender,SMSProvider,WebhookSender
+//
+
+// Package mocksenders is a generated GoMock package.
+package mocksenders
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSMSSender is a mock of SMSSender interface.
+type MockSMSSender struct {
+	ctrl     *gomock.Controller
+	recorder *MockSMSSenderMockRecorder
+	isgomock struct{}
+}
+
+// MockSMSSenderMockRecorder is the mock recorder for MockSMSSender.
+type MockSMSSenderMockRecorder struct {
+	mock *MockSMSSender
+}
+
+// NewMockSMSSender creates a new mock instance.
+func NewMockSMSSender(ctrl *gomock.Controller) *MockSMSSender {
+	mock := &MockSMSSender{ctrl: ctrl}
+	mock.recorder = &MockSMSSenderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSMSSender) EXPECT() *MockSMSSenderMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method.
+func (m *MockSMSSender) Send(ctx context.Context, phone, content string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", ctx, phone, content)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockSMSSenderMockRecorder) Send(ctx, phone, content any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockSMSSender)(nil).Send), ctx, phone, content)
+}
@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: senders.go
+//
+// Generated by this command:
+//
+//	mockgen -source=senders.go -destination=../../mocks/senders/telegram_sender.go -package=mocksenders -exclude_interfaces=EmailSender,SMSSender,SMSProvider,WebhookSender
+//
+
+// Package mocksenders is a generated GoMock package.
+package mocksenders
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTelegramSender is a mock of TelegramSender interface.
+type MockTelegramSender struct {
+	ctrl     *gomock.Controller
+	recorder *MockTelegramSenderMockRecorder
+	isgomock struct{}
+}
+
+// MockTelegramSenderMockRecorder is the mock recorder for MockTelegramSender.
+type MockTelegramSenderMockRecorder struct {
+	mock *MockTelegramSender
+}
+
+// NewMockTelegramSender creates a new mock instance.
+func NewMockTelegramSender(ctrl *gomock.Controller) *MockTelegramSender {
+	mock := &MockTelegramSender{ctrl: ctrl}
+	mock.recorder = &MockTelegramSenderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTelegramSender) EXPECT() *MockTelegramSenderMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method.
+func (m *MockTelegramSender) Send(ctx context.Context, chatID, content string, sentParts uint32) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", ctx, chatID, content, sentParts)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockTelegramSenderMockRecorder) Send(ctx, chatID, content, sentParts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockTelegramSender)(nil).Send), ctx, chatID, content, sentParts)
+}
@@ -0,0 +1,116 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: services.go
+//
+// Generated by this command:
+//
+//	mockgen -source=services.go -destination=../../mocks/services/processed_messages_service.go -package=mockservices -exclude_interfaces=ToysService,CommunicationsService,SsoService,TicketsService,NotificationsService,WebhooksService,TokensService,TemplatesService
+//
+
+// Package mockservices is a generated GoMock package.
+package mockservices
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entities "github.com/DKhorkov/hmtm-notifications/internal/entities"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockProcessedMessagesService is a mock of ProcessedMessagesService interface.
+type MockProcessedMessagesService struct {
+	ctrl     *gomock.Controller
+	recorder *MockProcessedMessagesServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockProcessedMessagesServiceMockRecorder is the mock recorder for MockProcessedMessagesService.
+type MockProcessedMessagesServiceMockRecorder struct {
+	mock *MockProcessedMessagesService
+}
+
+// NewMockProcessedMessagesService creates a new mock instance.
+func NewMockProcessedMessagesService(ctrl *gomock.Controller) *MockProcessedMessagesService {
+	mock := &MockProcessedMessagesService{ctrl: ctrl}
+	mock.recorder = &MockProcessedMessagesServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProcessedMessagesService) EXPECT() *MockProcessedMessagesServiceMockRecorder {
+	return m.recorder
+}
+
+// CompleteProcessedMessage mocks base method.
+func (m *MockProcessedMessagesService) CompleteProcessedMessage(ctx context.Context, idempotencyKey string, emailIDs []uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteProcessedMessage", ctx, idempotencyKey, emailIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteProcessedMessage indicates an expected call of CompleteProcessedMessage.
+func (mr *MockProcessedMessagesServiceMockRecorder) CompleteProcessedMessage(ctx, idempotencyKey, emailIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteProcessedMessage", reflect.TypeOf((*MockProcessedMessagesService)(nil).CompleteProcessedMessage), ctx, idempotencyKey, emailIDs)
+}
+
+// DeleteExpiredProcessedMessages mocks base method.
+func (m *MockProcessedMessagesService) DeleteExpiredProcessedMessages(ctx context.Context, processedBefore, reservedBefore time.Time) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpiredProcessedMessages", ctx, processedBefore, reservedBefore)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteExpiredProcessedMessages indicates an expected call of DeleteExpiredProcessedMessages.
+func (mr *MockProcessedMessagesServiceMockRecorder) DeleteExpiredProcessedMessages(ctx, processedBefore, reservedBefore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpiredProcessedMessages", reflect.TypeOf((*MockProcessedMessagesService)(nil).DeleteExpiredProcessedMessages), ctx, processedBefore, reservedBefore)
+}
+
+// GetProcessedMessage mocks base method.
+func (m *MockProcessedMessagesService) GetProcessedMessage(ctx context.Context, idempotencyKey string) (*entities.ProcessedMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProcessedMessage", ctx, idempotencyKey)
+	ret0, _ := ret[0].(*entities.ProcessedMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProcessedMessage indicates an expected call of GetProcessedMessage.
+func (mr *MockProcessedMessagesServiceMockRecorder) GetProcessedMessage(ctx, idempotencyKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProcessedMessage", reflect.TypeOf((*MockProcessedMessagesService)(nil).GetProcessedMessage), ctx, idempotencyKey)
+}
+
+// ReleaseProcessedMessage mocks base method.
+func (m *MockProcessedMessagesService) ReleaseProcessedMessage(ctx context.Context, idempotencyKey string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseProcessedMessage", ctx, idempotencyKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseProcessedMessage indicates an expected call of ReleaseProcessedMessage.
+func (mr *MockProcessedMessagesServiceMockRecorder) ReleaseProcessedMessage(ctx, idempotencyKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseProcessedMessage", reflect.TypeOf((*MockProcessedMessagesService)(nil).ReleaseProcessedMessage), ctx, idempotencyKey)
+}
+
+// ReserveProcessedMessage mocks base method.
+func (m *MockProcessedMessagesService) ReserveProcessedMessage(ctx context.Context, idempotencyKey string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReserveProcessedMessage", ctx, idempotencyKey)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReserveProcessedMessage indicates an expected call of ReserveProcessedMessage.
+func (mr *MockProcessedMessagesServiceMockRecorder) ReserveProcessedMessage(ctx, idempotencyKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReserveProcessedMessage", reflect.TypeOf((*MockProcessedMessagesService)(nil).ReserveProcessedMessage), ctx, idempotencyKey)
+}
@@ -3,7 +3,7 @@
 //
 // Generated by this command:
 //
-//	mockgen -source=services.go -destination=../../mocks/services/sso_service.go -package=mockservices -exclude_interfaces=ToysService,EmailsService,TicketsService
+//	mockgen -source=services.go -destination=../../mocks/services/sso_service.go -package=mockservices -exclude_interfaces=ToysService,CommunicationsService,TicketsService,ProcessedMessagesService,NotificationsService,WebhooksService,TokensService,TemplatesService
 //
 
 // Package mockservices is a generated GoMock package.
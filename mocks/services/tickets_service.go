@@ -3,7 +3,7 @@
 //
 // Generated by this command:
 //
-//	mockgen -source=services.go -destination=../../mocks/services/tickets_service.go -package=mockservices -exclude_interfaces=ToysService,EmailsService,SsoService
+//	mockgen -source=services.go -destination=../../mocks/services/tickets_service.go -package=mockservices -exclude_interfaces=ToysService,CommunicationsService,SsoService,ProcessedMessagesService,NotificationsService,WebhooksService,TokensService,TemplatesService
 //
 
 // Package mockservices is a generated GoMock package.
@@ -3,7 +3,7 @@
 //
 // Generated by this command:
 //
-//	mockgen -source=services.go -destination=../../mocks/services/toys_service.go -package=mockservices -exclude_interfaces=SsoService,EmailsService,TicketsService
+//	mockgen -source=services.go -destination=../../mocks/services/toys_service.go -package=mockservices -exclude_interfaces=SsoService,CommunicationsService,TicketsService,ProcessedMessagesService,NotificationsService,WebhooksService,TokensService,TemplatesService
 //
 
 // Package mockservices is a generated GoMock package.
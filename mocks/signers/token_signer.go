@@ -0,0 +1,71 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: signers.go
+//
+// Generated by this command:
+//
+//	mockgen -source=signers.go -destination=../../mocks/signers/token_signer.go -package=mocksigners
+//
+
+// Package mocksigners is a generated GoMock package.
+package mocksigners
+
+import (
+	reflect "reflect"
+
+	entities "github.com/DKhorkov/hmtm-notifications/internal/entities"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTokenSigner is a mock of TokenSigner interface.
+type MockTokenSigner struct {
+	ctrl     *gomock.Controller
+	recorder *MockTokenSignerMockRecorder
+	isgomock struct{}
+}
+
+// MockTokenSignerMockRecorder is the mock recorder for MockTokenSigner.
+type MockTokenSignerMockRecorder struct {
+	mock *MockTokenSigner
+}
+
+// NewMockTokenSigner creates a new mock instance.
+func NewMockTokenSigner(ctrl *gomock.Controller) *MockTokenSigner {
+	mock := &MockTokenSigner{ctrl: ctrl}
+	mock.recorder = &MockTokenSignerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTokenSigner) EXPECT() *MockTokenSignerMockRecorder {
+	return m.recorder
+}
+
+// Parse mocks base method.
+func (m *MockTokenSigner) Parse(token string) (*entities.TokenClaims, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Parse", token)
+	ret0, _ := ret[0].(*entities.TokenClaims)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Parse indicates an expected call of Parse.
+func (mr *MockTokenSignerMockRecorder) Parse(token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Parse", reflect.TypeOf((*MockTokenSigner)(nil).Parse), token)
+}
+
+// Sign mocks base method.
+func (m *MockTokenSigner) Sign(claims entities.TokenClaims) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Sign", claims)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Sign indicates an expected call of Sign.
+func (mr *MockTokenSignerMockRecorder) Sign(claims any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sign", reflect.TypeOf((*MockTokenSigner)(nil).Sign), claims)
+}
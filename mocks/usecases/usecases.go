@@ -42,56 +42,322 @@ func (m *MockUseCases) EXPECT() *MockUseCasesMockRecorder {
 	return m.recorder
 }
 
-// CountUserEmailCommunications mocks base method.
-func (m *MockUseCases) CountUserEmailCommunications(ctx context.Context, userID uint64) (uint64, error) {
+// ConsumeToken mocks base method.
+func (m *MockUseCases) ConsumeToken(ctx context.Context, rawToken string, purpose entities.TokenPurpose) (*entities.Token, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CountUserEmailCommunications", ctx, userID)
+	ret := m.ctrl.Call(m, "ConsumeToken", ctx, rawToken, purpose)
+	ret0, _ := ret[0].(*entities.Token)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsumeToken indicates an expected call of ConsumeToken.
+func (mr *MockUseCasesMockRecorder) ConsumeToken(ctx, rawToken, purpose any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumeToken", reflect.TypeOf((*MockUseCases)(nil).ConsumeToken), ctx, rawToken, purpose)
+}
+
+// CountUserCommunications mocks base method.
+func (m *MockUseCases) CountUserCommunications(ctx context.Context, userID uint64, channel entities.CommunicationChannel) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUserCommunications", ctx, userID, channel)
 	ret0, _ := ret[0].(uint64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CountUserEmailCommunications indicates an expected call of CountUserEmailCommunications.
-func (mr *MockUseCasesMockRecorder) CountUserEmailCommunications(ctx, userID any) *gomock.Call {
+// CountUserCommunications indicates an expected call of CountUserCommunications.
+func (mr *MockUseCasesMockRecorder) CountUserCommunications(ctx, userID, channel any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUserCommunications", reflect.TypeOf((*MockUseCases)(nil).CountUserCommunications), ctx, userID, channel)
+}
+
+// CountUserUnreadNotifications mocks base method.
+func (m *MockUseCases) CountUserUnreadNotifications(ctx context.Context, userID uint64) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUserUnreadNotifications", ctx, userID)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountUserUnreadNotifications indicates an expected call of CountUserUnreadNotifications.
+func (mr *MockUseCasesMockRecorder) CountUserUnreadNotifications(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUserUnreadNotifications", reflect.TypeOf((*MockUseCases)(nil).CountUserUnreadNotifications), ctx, userID)
+}
+
+// CreateTemplate mocks base method.
+func (m *MockUseCases) CreateTemplate(ctx context.Context, template entities.Template) (*entities.Template, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTemplate", ctx, template)
+	ret0, _ := ret[0].(*entities.Template)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTemplate indicates an expected call of CreateTemplate.
+func (mr *MockUseCasesMockRecorder) CreateTemplate(ctx, template any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTemplate", reflect.TypeOf((*MockUseCases)(nil).CreateTemplate), ctx, template)
+}
+
+// CreateWebhook mocks base method.
+func (m *MockUseCases) CreateWebhook(ctx context.Context, webhookURL string, eventTypes []entities.NotificationType) (*entities.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWebhook", ctx, webhookURL, eventTypes)
+	ret0, _ := ret[0].(*entities.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWebhook indicates an expected call of CreateWebhook.
+func (mr *MockUseCasesMockRecorder) CreateWebhook(ctx, webhookURL, eventTypes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhook", reflect.TypeOf((*MockUseCases)(nil).CreateWebhook), ctx, webhookURL, eventTypes)
+}
+
+// DeleteNotification mocks base method.
+func (m *MockUseCases) DeleteNotification(ctx context.Context, id, userID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNotification", ctx, id, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteNotification indicates an expected call of DeleteNotification.
+func (mr *MockUseCasesMockRecorder) DeleteNotification(ctx, id, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNotification", reflect.TypeOf((*MockUseCases)(nil).DeleteNotification), ctx, id, userID)
+}
+
+// DeleteWebhook mocks base method.
+func (m *MockUseCases) DeleteWebhook(ctx context.Context, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWebhook", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWebhook indicates an expected call of DeleteWebhook.
+func (mr *MockUseCasesMockRecorder) DeleteWebhook(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhook", reflect.TypeOf((*MockUseCases)(nil).DeleteWebhook), ctx, id)
+}
+
+// DiffTemplates mocks base method.
+func (m *MockUseCases) DiffTemplates(ctx context.Context, fromID, toID uint64) (*entities.TemplateDiff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiffTemplates", ctx, fromID, toID)
+	ret0, _ := ret[0].(*entities.TemplateDiff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiffTemplates indicates an expected call of DiffTemplates.
+func (mr *MockUseCasesMockRecorder) DiffTemplates(ctx, fromID, toID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiffTemplates", reflect.TypeOf((*MockUseCases)(nil).DiffTemplates), ctx, fromID, toID)
+}
+
+// GetTemplates mocks base method.
+func (m *MockUseCases) GetTemplates(ctx context.Context, filters entities.TemplatesFilters) ([]entities.Template, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTemplates", ctx, filters)
+	ret0, _ := ret[0].([]entities.Template)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTemplates indicates an expected call of GetTemplates.
+func (mr *MockUseCasesMockRecorder) GetTemplates(ctx, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplates", reflect.TypeOf((*MockUseCases)(nil).GetTemplates), ctx, filters)
+}
+
+// GetUserCommunications mocks base method.
+func (m *MockUseCases) GetUserCommunications(ctx context.Context, userID uint64, channel entities.CommunicationChannel, pagination *entities.Pagination) ([]entities.Communication, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserCommunications", ctx, userID, channel, pagination)
+	ret0, _ := ret[0].([]entities.Communication)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserCommunications indicates an expected call of GetUserCommunications.
+func (mr *MockUseCasesMockRecorder) GetUserCommunications(ctx, userID, channel, pagination any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUserEmailCommunications", reflect.TypeOf((*MockUseCases)(nil).CountUserEmailCommunications), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserCommunications", reflect.TypeOf((*MockUseCases)(nil).GetUserCommunications), ctx, userID, channel, pagination)
 }
 
-// GetUserEmailCommunications mocks base method.
-func (m *MockUseCases) GetUserEmailCommunications(ctx context.Context, userID uint64, pagination *entities.Pagination) ([]entities.Email, error) {
+// GetUserNotifications mocks base method.
+func (m *MockUseCases) GetUserNotifications(ctx context.Context, userID uint64, pagination *entities.Pagination) ([]entities.Notification, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserEmailCommunications", ctx, userID, pagination)
-	ret0, _ := ret[0].([]entities.Email)
+	ret := m.ctrl.Call(m, "GetUserNotifications", ctx, userID, pagination)
+	ret0, _ := ret[0].([]entities.Notification)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUserEmailCommunications indicates an expected call of GetUserEmailCommunications.
-func (mr *MockUseCasesMockRecorder) GetUserEmailCommunications(ctx, userID, pagination any) *gomock.Call {
+// GetUserNotifications indicates an expected call of GetUserNotifications.
+func (mr *MockUseCasesMockRecorder) GetUserNotifications(ctx, userID, pagination any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserEmailCommunications", reflect.TypeOf((*MockUseCases)(nil).GetUserEmailCommunications), ctx, userID, pagination)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserNotifications", reflect.TypeOf((*MockUseCases)(nil).GetUserNotifications), ctx, userID, pagination)
+}
+
+// GetWebhookDeliveries mocks base method.
+func (m *MockUseCases) GetWebhookDeliveries(ctx context.Context, webhookID uint64, pagination *entities.Pagination) ([]entities.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhookDeliveries", ctx, webhookID, pagination)
+	ret0, _ := ret[0].([]entities.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhookDeliveries indicates an expected call of GetWebhookDeliveries.
+func (mr *MockUseCasesMockRecorder) GetWebhookDeliveries(ctx, webhookID, pagination any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookDeliveries", reflect.TypeOf((*MockUseCases)(nil).GetWebhookDeliveries), ctx, webhookID, pagination)
+}
+
+// GetWebhooks mocks base method.
+func (m *MockUseCases) GetWebhooks(ctx context.Context) ([]entities.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhooks", ctx)
+	ret0, _ := ret[0].([]entities.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhooks indicates an expected call of GetWebhooks.
+func (mr *MockUseCasesMockRecorder) GetWebhooks(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhooks", reflect.TypeOf((*MockUseCases)(nil).GetWebhooks), ctx)
+}
+
+// MarkAllNotificationsAsRead mocks base method.
+func (m *MockUseCases) MarkAllNotificationsAsRead(ctx context.Context, userID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAllNotificationsAsRead", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkAllNotificationsAsRead indicates an expected call of MarkAllNotificationsAsRead.
+func (mr *MockUseCasesMockRecorder) MarkAllNotificationsAsRead(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAllNotificationsAsRead", reflect.TypeOf((*MockUseCases)(nil).MarkAllNotificationsAsRead), ctx, userID)
+}
+
+// MarkNotificationAsRead mocks base method.
+func (m *MockUseCases) MarkNotificationAsRead(ctx context.Context, id, userID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkNotificationAsRead", ctx, id, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkNotificationAsRead indicates an expected call of MarkNotificationAsRead.
+func (mr *MockUseCasesMockRecorder) MarkNotificationAsRead(ctx, id, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkNotificationAsRead", reflect.TypeOf((*MockUseCases)(nil).MarkNotificationAsRead), ctx, id, userID)
+}
+
+// PreviewForgetPasswordEmailCommunication mocks base method.
+func (m *MockUseCases) PreviewForgetPasswordEmailCommunication(ctx context.Context, forgetPasswordData dto.ForgetPasswordDTO) (*entities.NotificationPreview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreviewForgetPasswordEmailCommunication", ctx, forgetPasswordData)
+	ret0, _ := ret[0].(*entities.NotificationPreview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreviewForgetPasswordEmailCommunication indicates an expected call of PreviewForgetPasswordEmailCommunication.
+func (mr *MockUseCasesMockRecorder) PreviewForgetPasswordEmailCommunication(ctx, forgetPasswordData any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewForgetPasswordEmailCommunication", reflect.TypeOf((*MockUseCases)(nil).PreviewForgetPasswordEmailCommunication), ctx, forgetPasswordData)
+}
+
+// PreviewTicketDeletedEmailCommunication mocks base method.
+func (m *MockUseCases) PreviewTicketDeletedEmailCommunication(ctx context.Context, ticketData dto.TicketDeletedDTO, masterID *uint64) (*entities.NotificationPreview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreviewTicketDeletedEmailCommunication", ctx, ticketData, masterID)
+	ret0, _ := ret[0].(*entities.NotificationPreview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreviewTicketDeletedEmailCommunication indicates an expected call of PreviewTicketDeletedEmailCommunication.
+func (mr *MockUseCasesMockRecorder) PreviewTicketDeletedEmailCommunication(ctx, ticketData, masterID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewTicketDeletedEmailCommunication", reflect.TypeOf((*MockUseCases)(nil).PreviewTicketDeletedEmailCommunication), ctx, ticketData, masterID)
+}
+
+// PreviewTicketUpdatedEmailCommunication mocks base method.
+func (m *MockUseCases) PreviewTicketUpdatedEmailCommunication(ctx context.Context, ticketData dto.TicketUpdatedDTO, masterID *uint64) (*entities.NotificationPreview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreviewTicketUpdatedEmailCommunication", ctx, ticketData, masterID)
+	ret0, _ := ret[0].(*entities.NotificationPreview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreviewTicketUpdatedEmailCommunication indicates an expected call of PreviewTicketUpdatedEmailCommunication.
+func (mr *MockUseCasesMockRecorder) PreviewTicketUpdatedEmailCommunication(ctx, ticketData, masterID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewTicketUpdatedEmailCommunication", reflect.TypeOf((*MockUseCases)(nil).PreviewTicketUpdatedEmailCommunication), ctx, ticketData, masterID)
+}
+
+// PreviewVerifyEmailCommunication mocks base method.
+func (m *MockUseCases) PreviewVerifyEmailCommunication(ctx context.Context, verifyEmailData dto.VerifyEmailDTO) (*entities.NotificationPreview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreviewVerifyEmailCommunication", ctx, verifyEmailData)
+	ret0, _ := ret[0].(*entities.NotificationPreview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreviewVerifyEmailCommunication indicates an expected call of PreviewVerifyEmailCommunication.
+func (mr *MockUseCasesMockRecorder) PreviewVerifyEmailCommunication(ctx, verifyEmailData any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewVerifyEmailCommunication", reflect.TypeOf((*MockUseCases)(nil).PreviewVerifyEmailCommunication), ctx, verifyEmailData)
+}
+
+// PublishTemplate mocks base method.
+func (m *MockUseCases) PublishTemplate(ctx context.Context, id uint64) (*entities.Template, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishTemplate", ctx, id)
+	ret0, _ := ret[0].(*entities.Template)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublishTemplate indicates an expected call of PublishTemplate.
+func (mr *MockUseCasesMockRecorder) PublishTemplate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishTemplate", reflect.TypeOf((*MockUseCases)(nil).PublishTemplate), ctx, id)
 }
 
 // SendForgetPasswordEmailCommunication mocks base method.
-func (m *MockUseCases) SendForgetPasswordEmailCommunication(ctx context.Context, userID uint64) (uint64, error) {
+func (m *MockUseCases) SendForgetPasswordEmailCommunication(ctx context.Context, forgetPasswordData dto.ForgetPasswordDTO) (uint64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SendForgetPasswordEmailCommunication", ctx, userID)
+	ret := m.ctrl.Call(m, "SendForgetPasswordEmailCommunication", ctx, forgetPasswordData)
 	ret0, _ := ret[0].(uint64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SendForgetPasswordEmailCommunication indicates an expected call of SendForgetPasswordEmailCommunication.
-func (mr *MockUseCasesMockRecorder) SendForgetPasswordEmailCommunication(ctx, userID any) *gomock.Call {
+func (mr *MockUseCasesMockRecorder) SendForgetPasswordEmailCommunication(ctx, forgetPasswordData any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendForgetPasswordEmailCommunication", reflect.TypeOf((*MockUseCases)(nil).SendForgetPasswordEmailCommunication), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendForgetPasswordEmailCommunication", reflect.TypeOf((*MockUseCases)(nil).SendForgetPasswordEmailCommunication), ctx, forgetPasswordData)
 }
 
 // SendTicketDeletedEmailCommunication mocks base method.
-func (m *MockUseCases) SendTicketDeletedEmailCommunication(ctx context.Context, ticketData dto.TicketDeletedDTO) ([]uint64, error) {
+func (m *MockUseCases) SendTicketDeletedEmailCommunication(ctx context.Context, ticketData dto.TicketDeletedDTO) (*entities.FanOutResult, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SendTicketDeletedEmailCommunication", ctx, ticketData)
-	ret0, _ := ret[0].([]uint64)
+	ret0, _ := ret[0].(*entities.FanOutResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -103,31 +369,90 @@ func (mr *MockUseCasesMockRecorder) SendTicketDeletedEmailCommunication(ctx, tic
 }
 
 // SendTicketUpdatedEmailCommunication mocks base method.
-func (m *MockUseCases) SendTicketUpdatedEmailCommunication(ctx context.Context, ticketID uint64) ([]uint64, error) {
+func (m *MockUseCases) SendTicketUpdatedEmailCommunication(ctx context.Context, ticketData dto.TicketUpdatedDTO) (*entities.FanOutResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SendTicketUpdatedEmailCommunication", ctx, ticketID)
-	ret0, _ := ret[0].([]uint64)
+	ret := m.ctrl.Call(m, "SendTicketUpdatedEmailCommunication", ctx, ticketData)
+	ret0, _ := ret[0].(*entities.FanOutResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SendTicketUpdatedEmailCommunication indicates an expected call of SendTicketUpdatedEmailCommunication.
-func (mr *MockUseCasesMockRecorder) SendTicketUpdatedEmailCommunication(ctx, ticketID any) *gomock.Call {
+func (mr *MockUseCasesMockRecorder) SendTicketUpdatedEmailCommunication(ctx, ticketData any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendTicketUpdatedEmailCommunication", reflect.TypeOf((*MockUseCases)(nil).SendTicketUpdatedEmailCommunication), ctx, ticketID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendTicketUpdatedEmailCommunication", reflect.TypeOf((*MockUseCases)(nil).SendTicketUpdatedEmailCommunication), ctx, ticketData)
 }
 
 // SendVerifyEmailCommunication mocks base method.
-func (m *MockUseCases) SendVerifyEmailCommunication(ctx context.Context, userID uint64) (uint64, error) {
+func (m *MockUseCases) SendVerifyEmailCommunication(ctx context.Context, verifyEmailData dto.VerifyEmailDTO) (uint64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SendVerifyEmailCommunication", ctx, userID)
+	ret := m.ctrl.Call(m, "SendVerifyEmailCommunication", ctx, verifyEmailData)
 	ret0, _ := ret[0].(uint64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SendVerifyEmailCommunication indicates an expected call of SendVerifyEmailCommunication.
-func (mr *MockUseCasesMockRecorder) SendVerifyEmailCommunication(ctx, userID any) *gomock.Call {
+func (mr *MockUseCasesMockRecorder) SendVerifyEmailCommunication(ctx, verifyEmailData any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendVerifyEmailCommunication", reflect.TypeOf((*MockUseCases)(nil).SendVerifyEmailCommunication), ctx, verifyEmailData)
+}
+
+// SubscribeToUserNotifications mocks base method.
+func (m *MockUseCases) SubscribeToUserNotifications(ctx context.Context, userID uint64, lastSeenID *uint64) (<-chan entities.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeToUserNotifications", ctx, userID, lastSeenID)
+	ret0, _ := ret[0].(<-chan entities.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubscribeToUserNotifications indicates an expected call of SubscribeToUserNotifications.
+func (mr *MockUseCasesMockRecorder) SubscribeToUserNotifications(ctx, userID, lastSeenID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeToUserNotifications", reflect.TypeOf((*MockUseCases)(nil).SubscribeToUserNotifications), ctx, userID, lastSeenID)
+}
+
+// UpdateTemplate mocks base method.
+func (m *MockUseCases) UpdateTemplate(ctx context.Context, id uint64, subject, html, text string) (*entities.Template, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTemplate", ctx, id, subject, html, text)
+	ret0, _ := ret[0].(*entities.Template)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTemplate indicates an expected call of UpdateTemplate.
+func (mr *MockUseCasesMockRecorder) UpdateTemplate(ctx, id, subject, html, text any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTemplate", reflect.TypeOf((*MockUseCases)(nil).UpdateTemplate), ctx, id, subject, html, text)
+}
+
+// UpdateWebhook mocks base method.
+func (m *MockUseCases) UpdateWebhook(ctx context.Context, webhook entities.Webhook) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWebhook", ctx, webhook)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWebhook indicates an expected call of UpdateWebhook.
+func (mr *MockUseCasesMockRecorder) UpdateWebhook(ctx, webhook any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWebhook", reflect.TypeOf((*MockUseCases)(nil).UpdateWebhook), ctx, webhook)
+}
+
+// VerifyToken mocks base method.
+func (m *MockUseCases) VerifyToken(ctx context.Context, rawToken string, purpose entities.TokenPurpose) (*entities.Token, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyToken", ctx, rawToken, purpose)
+	ret0, _ := ret[0].(*entities.Token)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyToken indicates an expected call of VerifyToken.
+func (mr *MockUseCasesMockRecorder) VerifyToken(ctx, rawToken, purpose any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendVerifyEmailCommunication", reflect.TypeOf((*MockUseCases)(nil).SendVerifyEmailCommunication), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyToken", reflect.TypeOf((*MockUseCases)(nil).VerifyToken), ctx, rawToken, purpose)
 }